@@ -28,6 +28,7 @@ const (
 
 var (
 	ErrEmptyInput        = errs.WithDomain(errDomain, ErrEmpty, ErrInput)
+	ErrInvalidInput      = errs.WithDomain(errDomain, ErrInvalid, ErrInput)
 	ErrInvalidHTTPURL    = errs.WithDomain(errDomain, ErrInvalid, ErrHTTPURL)
 	ErrInvalidHost       = errs.WithDomain(errDomain, ErrInvalid, ErrHost)
 	ErrInvalidPort       = errs.WithDomain(errDomain, ErrInvalid, ErrPort)
@@ -51,6 +52,15 @@ const (
 
 	urlSchemeHTTP  = "http"
 	urlSchemeHTTPS = "https"
+	urlSchemeTCP   = "tcp"
+	urlSchemeUDP   = "udp"
+	urlSchemeFile  = "file"
+
+	inputStdin     = "stdin"
+	inputStdinDash = "-"
+
+	defaultRawBitDepth = 16
+	defaultRawChannels = 2
 
 	outputToLog        = "log"
 	outputToLogger     = "logger"
@@ -60,6 +70,7 @@ const (
 	modePeaks    = "peaks"
 	modeSpectrum = "spectrum"
 	modeCombined = "combined"
+	modeLoudness = "loudness"
 
 	batchMax     = "max"
 	batchMaximum = "maximum"
@@ -85,8 +96,19 @@ type Config struct {
 	// BufferRatio defines the audio stream's buffer size as a ratio to one second (1.0 is one second).
 	BufferRatio float64 `envconfig:"X_AUDIO_BUFFER_RATIO"`
 
-	// Input points to an audio stream source (URL)
+	// Input points to an audio stream source: a HTTP(S) URL, a WAV file path (optionally as a file:// URL),
+	// a TCP or UDP address for raw PCM (as tcp:// or udp:// URLs), or the standard input (as "stdin" or "-")
 	Input string `envconfig:"X_AUDIO_INPUT"`
+	// Loop sets a WAV file input to restart once it reaches its end
+	Loop bool `envconfig:"X_AUDIO_LOOP"`
+	// Realtime sets a WAV file input to be read at its byte rate, like a live audio stream
+	Realtime bool `envconfig:"X_AUDIO_REALTIME"`
+	// RawSampleRate sets the sample rate of raw PCM inputs; it also sets the standard input to raw PCM
+	RawSampleRate int `envconfig:"X_AUDIO_RAW_SAMPLE_RATE"`
+	// RawBitDepth sets the bit depth of raw PCM inputs
+	RawBitDepth int `envconfig:"X_AUDIO_RAW_BIT_DEPTH"`
+	// RawChannels sets the number of channels of raw PCM inputs
+	RawChannels int `envconfig:"X_AUDIO_RAW_CHANNELS"`
 
 	// OutputType sets the type of exporter for the processor
 	OutputType string `envconfig:"X_AUDIO_OUTPUT_TYPE"`
@@ -145,6 +167,11 @@ func newFlagsConfig() *Config {
 		"defines the audio stream's buffer size as a ratio to one second (1.0 is one second)")
 
 	input := flag.String("input", "", "")
+	loop := flag.Bool("loop", false, "restarts a WAV file input once it reaches its end")
+	realtime := flag.Bool("realtime", false, "reads a WAV file input at its byte rate, like a live audio stream")
+	rawSampleRate := flag.Int("raw-sample-rate", 0, "sample rate of raw PCM inputs (TCP, UDP or stdin)")
+	rawBitDepth := flag.Int("raw-bit-depth", 0, "bit depth of raw PCM inputs (TCP, UDP or stdin)")
+	rawChannels := flag.Int("raw-channels", 0, "number of channels of raw PCM inputs (TCP, UDP or stdin)")
 
 	outputType := flag.String("output-type", "", "")
 	output := flag.String("output", "", "")
@@ -169,6 +196,11 @@ func newFlagsConfig() *Config {
 		BufferDur:      *bufferDur,
 		BufferRatio:    *bufferRatio,
 		Input:          *input,
+		Loop:           *loop,
+		Realtime:       *realtime,
+		RawSampleRate:  *rawSampleRate,
+		RawBitDepth:    *rawBitDepth,
+		RawChannels:    *rawChannels,
 		OutputType:     *outputType,
 		Output:         *output,
 		Mode:           *mode,
@@ -215,6 +247,26 @@ func merge(base, input *Config) *Config {
 		base.Input = input.Input
 	}
 
+	if input.Loop {
+		base.Loop = input.Loop
+	}
+
+	if input.Realtime {
+		base.Realtime = input.Realtime
+	}
+
+	if input.RawSampleRate > 0 {
+		base.RawSampleRate = input.RawSampleRate
+	}
+
+	if input.RawBitDepth > 0 {
+		base.RawBitDepth = input.RawBitDepth
+	}
+
+	if input.RawChannels > 0 {
+		base.RawChannels = input.RawChannels
+	}
+
 	if input.OutputType != "" {
 		base.OutputType = input.OutputType
 	}
@@ -269,27 +321,16 @@ func validate(config *Config) error {
 		return ErrEmptyInput
 	}
 
-	// currently there is only one (HTTP) consumer. We can try to
-	// parse input as URL and default to OS Path if failed
-	parsedURL, err := url.Parse(config.Input)
-	if err != nil {
+	if err := validateInput(config); err != nil {
 		return err
 	}
 
-	if parsedURL.Scheme != urlSchemeHTTP && parsedURL.Scheme != urlSchemeHTTPS {
-		return fmt.Errorf("%w: %s", ErrInvalidHTTPURL, config.Input)
-	}
-
-	if parsedURL.Host == "" {
-		return fmt.Errorf("%w: %s", ErrInvalidHost, config.Input)
-	}
-
 	// validate output
 	switch strings.ToLower(config.OutputType) {
 	case outputToLogger, outputToLog:
 
 	case outputToProm, outputToPrometheus:
-		if _, err = getPort(config.Output); err != nil {
+		if _, err := getPort(config.Output); err != nil {
 			return fmt.Errorf("%w: %w", ErrInvalidPort, err)
 		}
 	default:
@@ -298,7 +339,7 @@ func validate(config *Config) error {
 
 	// validate mode
 	switch config.Mode {
-	case modePeaks, modeSpectrum, modeCombined, "":
+	case modePeaks, modeSpectrum, modeCombined, modeLoudness, "":
 		// OK state
 	default:
 		return fmt.Errorf("%w: %s", ErrInvalidMode, config.Mode)
@@ -334,3 +375,63 @@ func validate(config *Config) error {
 
 	return nil
 }
+
+// parseInput returns the URL scheme of the input source (which is empty for WAV file paths, and "stdin" for the
+// standard input), as well as its target address or path.
+func parseInput(input string) (scheme, target string, err error) {
+	if input == inputStdin || input == inputStdinDash {
+		return inputStdin, "", nil
+	}
+
+	parsedURL, err := url.Parse(input)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch parsedURL.Scheme {
+	case "":
+		return "", input, nil
+	case urlSchemeFile:
+		return "", parsedURL.Host + parsedURL.Path, nil
+	case urlSchemeHTTP, urlSchemeHTTPS:
+		if parsedURL.Host == "" {
+			return parsedURL.Scheme, "", nil
+		}
+
+		return parsedURL.Scheme, input, nil
+	default:
+		return parsedURL.Scheme, parsedURL.Host, nil
+	}
+}
+
+func validateInput(config *Config) error {
+	scheme, target, err := parseInput(config.Input)
+	if err != nil {
+		return err
+	}
+
+	switch scheme {
+	case inputStdin:
+	case "":
+		if target == "" {
+			return fmt.Errorf("%w: %s", ErrInvalidInput, config.Input)
+		}
+	case urlSchemeHTTP, urlSchemeHTTPS, urlSchemeTCP, urlSchemeUDP:
+		if target == "" {
+			return fmt.Errorf("%w: %s", ErrInvalidHost, config.Input)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidInput, config.Input)
+	}
+
+	// raw PCM inputs default to 16-bit stereo audio
+	if config.RawBitDepth <= 0 {
+		config.RawBitDepth = defaultRawBitDepth
+	}
+
+	if config.RawChannels <= 0 {
+		config.RawChannels = defaultRawChannels
+	}
+
+	return nil
+}
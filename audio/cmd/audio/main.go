@@ -19,7 +19,10 @@ import (
 	"github.com/zalgonoise/x/audio/fft"
 	"github.com/zalgonoise/x/audio/sdk/audio"
 	"github.com/zalgonoise/x/audio/sdk/audio/compactors"
+	"github.com/zalgonoise/x/audio/sdk/audio/consumers/fileaudio"
 	"github.com/zalgonoise/x/audio/sdk/audio/consumers/httpaudio"
+	"github.com/zalgonoise/x/audio/sdk/audio/consumers/netaudio"
+	"github.com/zalgonoise/x/audio/sdk/audio/consumers/stdinaudio"
 	"github.com/zalgonoise/x/audio/sdk/audio/exporters"
 	"github.com/zalgonoise/x/audio/sdk/audio/exporters/stats/prom"
 	"github.com/zalgonoise/x/audio/sdk/audio/exporters/stats/stdout"
@@ -68,13 +71,7 @@ func run() (int, error) {
 
 	logger.InfoContext(ctx, "setting up consumer")
 
-	consumer, err := httpaudio.New(
-		[]cfg.Option[httpaudio.Config]{
-			httpaudio.WithTarget(config.Input),
-			httpaudio.WithTimeout(config.Duration),
-		},
-		logger, consumerMetrics, tracer,
-	)
+	consumer, err := newConsumer(config, logger, consumerMetrics, tracer)
 	if err != nil {
 		return 1, err
 	}
@@ -150,6 +147,67 @@ func run() (int, error) {
 	}
 }
 
+func newConsumer(
+	config *Config, logger *slog.Logger, metrics audio.ConsumerMetrics, tracer trace.Tracer,
+) (audio.Consumer, error) {
+	scheme, target, err := parseInput(config.Input)
+	if err != nil {
+		return audio.NoOpConsumer(), err
+	}
+
+	switch scheme {
+	case urlSchemeHTTP, urlSchemeHTTPS:
+		return httpaudio.New(
+			[]cfg.Option[httpaudio.Config]{
+				httpaudio.WithTarget(target),
+				httpaudio.WithTimeout(config.Duration),
+			},
+			logger, metrics, tracer,
+		)
+	case urlSchemeTCP, urlSchemeUDP:
+		options := []cfg.Option[netaudio.Config]{
+			netaudio.WithTCP(target),
+			newRawFormatOpt(config),
+		}
+
+		if scheme == urlSchemeUDP {
+			options[0] = netaudio.WithUDP(target)
+		}
+
+		return netaudio.New(options, logger, metrics, tracer)
+	case inputStdin:
+		options := make([]cfg.Option[stdinaudio.Config], 0, 1)
+
+		if config.RawSampleRate > 0 {
+			options = append(options, stdinaudio.WithRawPCM(
+				uint32(config.RawSampleRate), uint16(config.RawBitDepth), uint16(config.RawChannels),
+			))
+		}
+
+		return stdinaudio.New(options, logger, metrics, tracer)
+	default:
+		options := []cfg.Option[fileaudio.Config]{fileaudio.WithPath(target)}
+
+		if config.Loop {
+			options = append(options, fileaudio.WithLoop())
+		}
+
+		if config.Realtime {
+			options = append(options, fileaudio.WithRealtime())
+		}
+
+		return fileaudio.New(options, logger, metrics, tracer)
+	}
+}
+
+func newRawFormatOpt(config *Config) cfg.Option[netaudio.Config] {
+	if config.RawSampleRate <= 0 {
+		return cfg.NoOp[netaudio.Config]{}
+	}
+
+	return netaudio.WithFormat(uint32(config.RawSampleRate), uint16(config.RawBitDepth), uint16(config.RawChannels))
+}
+
 func newDataExporter(ctx context.Context, config *Config, logHandler slog.Handler) (audio.Exporter, error) {
 	logger := slog.New(logHandler)
 	db, err := database.OpenSQLite(config.StorageURI, database.ReadWritePragmas(), logger)
@@ -204,11 +262,12 @@ func newExporterOpts(config *Config, logHandler slog.Handler) []cfg.Option[*expo
 		exporters.WithLogHandler(logHandler),
 		newPeaksOpt(config),
 		newSpectrumOpt(config),
+		newLoudnessOpt(config),
 	}
 }
 
 func newPeaksOpt(config *Config) cfg.Option[*exporters.StatsConfig] {
-	if config.Mode == "spectrum" {
+	if config.Mode == "spectrum" || config.Mode == "loudness" {
 		return cfg.NoOp[*exporters.StatsConfig]{}
 	}
 
@@ -224,7 +283,7 @@ func newPeaksOpt(config *Config) cfg.Option[*exporters.StatsConfig] {
 }
 
 func newSpectrumOpt(config *Config) cfg.Option[*exporters.StatsConfig] {
-	if config.Mode == "peaks" {
+	if config.Mode == "peaks" || config.Mode == "loudness" {
 		return cfg.NoOp[*exporters.StatsConfig]{}
 	}
 
@@ -239,6 +298,14 @@ func newSpectrumOpt(config *Config) cfg.Option[*exporters.StatsConfig] {
 	return exporters.WithSpectrum(config.BucketSize)
 }
 
+func newLoudnessOpt(config *Config) cfg.Option[*exporters.StatsConfig] {
+	if config.Mode != "loudness" {
+		return cfg.NoOp[*exporters.StatsConfig]{}
+	}
+
+	return exporters.WithLoudness()
+}
+
 func getPort(addr string) (port int, err error) {
 	if addr == "" {
 		return defaultPort, nil
@@ -0,0 +1,94 @@
+package flac
+
+import "github.com/zalgonoise/cfg"
+
+// Config holds the encoder's settings.
+type Config struct {
+	blockSize         int
+	maxLPCOrder       int
+	precision         int
+	maxPartitionOrder int
+	independent       bool
+}
+
+func newConfig(opts ...cfg.Option[Config]) Config {
+	config := cfg.New(opts...)
+
+	if config.blockSize == 0 {
+		config.blockSize = defaultBlockSize
+	}
+
+	if config.maxLPCOrder == 0 {
+		config.maxLPCOrder = defaultMaxLPCOrder
+	}
+
+	if config.maxPartitionOrder == 0 {
+		config.maxPartitionOrder = defaultPartitionOrder
+	}
+
+	return config
+}
+
+// WithBlockSize sets the number of samples (per channel) in each frame.
+func WithBlockSize(size int) cfg.Option[Config] {
+	if size < minBlockSize || size > maxBlockSize {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.blockSize = size
+
+		return config
+	})
+}
+
+// WithMaxLPCOrder sets the highest linear prediction order evaluated for each subframe. A negative value disables
+// linear prediction, leaving only the fixed predictors.
+func WithMaxLPCOrder(order int) cfg.Option[Config] {
+	if order == 0 || order > maxLPCOrder {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.maxLPCOrder = order
+
+		return config
+	})
+}
+
+// WithPrecision sets the precision (in bits) of the quantized linear prediction coefficients. By default, it is
+// derived from the block size and bit depth.
+func WithPrecision(precision int) cfg.Option[Config] {
+	if precision < minPrecision || precision > maxPrecision {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.precision = precision
+
+		return config
+	})
+}
+
+// WithMaxPartitionOrder sets the highest partition order evaluated for the Rice-coded residuals, where each block's
+// residual is split into 2^order partitions with their own Rice parameter.
+func WithMaxPartitionOrder(order int) cfg.Option[Config] {
+	if order <= 0 || order > maxPartitionOrder {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.maxPartitionOrder = order
+
+		return config
+	})
+}
+
+// WithIndependentChannels disables stereo decorrelation, encoding the left and right channels as they are.
+func WithIndependentChannels() cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.independent = true
+
+		return config
+	})
+}
@@ -0,0 +1,43 @@
+package flac
+
+// crc8 returns the CRC-8 of the input data (polynomial x^8 + x^2 + x + 1), as used in frame headers.
+func crc8(data []byte) uint8 {
+	var crc uint8
+
+	for _, b := range data {
+		crc ^= b
+
+		for range 8 {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+
+				continue
+			}
+
+			crc <<= 1
+		}
+	}
+
+	return crc
+}
+
+// crc16 returns the CRC-16 of the input data (polynomial x^16 + x^15 + x^2 + 1), as used in frame footers.
+func crc16(data []byte) uint16 {
+	var crc uint16
+
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x8005
+
+				continue
+			}
+
+			crc <<= 1
+		}
+	}
+
+	return crc
+}
@@ -0,0 +1,589 @@
+package flac
+
+import (
+	"bytes"
+	"crypto/md5"
+	"errors"
+
+	"github.com/zalgonoise/x/audio/encoding/lpc"
+)
+
+// Decode decodes the input FLAC stream, returning its StreamInfo and PCM samples (interleaved by channel).
+//
+// The decoded audio is checked against the stream's MD5 signature, when present.
+func Decode(data []byte) (*StreamInfo, []int, error) {
+	if !bytes.HasPrefix(data, []byte(streamMarker)) {
+		return nil, nil, ErrInvalidMarker
+	}
+
+	info, n, err := decodeMetadata(data[len(streamMarker):])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data = data[len(streamMarker)+n:]
+
+	var (
+		// the stream's total samples are not trusted for the allocation, as they may be arbitrarily large
+		samples = make([]int, 0, min(info.TotalSamples*uint64(info.Channels), uint64(len(data))*8))
+		block   = make([][]int64, info.Channels)
+	)
+
+	for len(data) > 0 {
+		size, read, err := decodeFrame(info, data, block)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i := range size {
+			for c := range block {
+				samples = append(samples, int(block[c][i]))
+			}
+		}
+
+		data = data[read:]
+	}
+
+	if info.TotalSamples != 0 && uint64(len(samples)) != info.TotalSamples*uint64(info.Channels) {
+		return nil, nil, ErrMismatchingSampleSize
+	}
+
+	if info.MD5 != [md5.Size]byte{} && signature(samples, int(info.BitsPerSample)) != info.MD5 {
+		return nil, nil, ErrMismatchingMD5
+	}
+
+	return info, samples, nil
+}
+
+// decodeMetadata reads the metadata blocks following the stream marker, returning the StreamInfo and the number of
+// bytes read. Metadata blocks other than STREAMINFO are skipped.
+func decodeMetadata(data []byte) (*StreamInfo, int, error) {
+	var (
+		info *StreamInfo
+		n    int
+	)
+
+	for {
+		if len(data[n:]) < 4 {
+			return nil, 0, ErrMissingStreamInfo
+		}
+
+		var (
+			last      = data[n]&0x80 != 0
+			blockType = data[n] & 0x7f
+			size      = int(data[n+1])<<16 | int(data[n+2])<<8 | int(data[n+3])
+		)
+
+		n += 4
+
+		if len(data[n:]) < size {
+			return nil, 0, ErrInvalidStreamInfo
+		}
+
+		if blockType == streamInfoType {
+			if size != streamInfoSize {
+				return nil, 0, ErrInvalidStreamInfo
+			}
+
+			var err error
+			if info, err = decodeStreamInfo(data[n : n+size]); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		n += size
+
+		if last {
+			break
+		}
+	}
+
+	if info == nil {
+		return nil, 0, ErrMissingStreamInfo
+	}
+
+	return info, n, nil
+}
+
+func decodeStreamInfo(data []byte) (*StreamInfo, error) {
+	var (
+		r    = lpc.NewBitReader(data)
+		info = new(StreamInfo)
+	)
+
+	fields := []struct {
+		bits  int
+		value func(uint64)
+	}{
+		{16, func(v uint64) { info.MinBlockSize = uint16(v) }},
+		{16, func(v uint64) { info.MaxBlockSize = uint16(v) }},
+		{24, func(v uint64) { info.MinFrameSize = uint32(v) }},
+		{24, func(v uint64) { info.MaxFrameSize = uint32(v) }},
+		{20, func(v uint64) { info.SampleRate = uint32(v) }},
+		{3, func(v uint64) { info.Channels = uint8(v + 1) }},
+		{5, func(v uint64) { info.BitsPerSample = uint8(v + 1) }},
+		{36, func(v uint64) { info.TotalSamples = v }},
+	}
+
+	for i := range fields {
+		value, err := r.ReadUint(fields[i].bits)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidStreamInfo, err)
+		}
+
+		fields[i].value(value)
+	}
+
+	copy(info.MD5[:], data[r.Offset():])
+
+	if info.BitsPerSample < minBitDepth {
+		return nil, ErrInvalidBitDepth
+	}
+
+	if info.MinBlockSize < minBlockSize || info.MaxBlockSize < info.MinBlockSize {
+		return nil, ErrInvalidBlockSize
+	}
+
+	return info, nil
+}
+
+// frameHeader holds the decoded fields of a frame header.
+type frameHeader struct {
+	blockSize  int
+	assignment int
+	bps        int
+}
+
+// decodeFrame decodes a single frame into the input block (one slice per channel), returning the frame's block size
+// and the number of bytes read.
+func decodeFrame(info *StreamInfo, data []byte, block [][]int64) (size, n int, err error) {
+	r := lpc.NewBitReader(data)
+
+	header, err := decodeFrameHeader(info, r, data)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	numChannels := 2
+	if header.assignment <= channelsIndependentMax {
+		numChannels = header.assignment + 1
+	}
+
+	if numChannels != len(block) {
+		return 0, 0, ErrInvalidFrameHeader
+	}
+
+	for c := range block {
+		bps := header.bps
+
+		switch {
+		case header.assignment == channelsLeftSide && c == 1,
+			header.assignment == channelsSideRight && c == 0,
+			header.assignment == channelsMidSide && c == 1:
+			bps++
+		}
+
+		if block[c], err = decodeSubframe(r, header.blockSize, bps, block[c]); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	r.Align()
+	end := r.Offset()
+
+	crc, err := r.ReadUint(16)
+	if err != nil {
+		return 0, 0, errors.Join(ErrInvalidFrameHeader, err)
+	}
+
+	if uint16(crc) != crc16(data[:end]) {
+		return 0, 0, ErrMismatchingCRC
+	}
+
+	restoreChannels(header, block)
+
+	return header.blockSize, end + 2, nil
+}
+
+func decodeFrameHeader(info *StreamInfo, r *lpc.BitReader, data []byte) (frameHeader, error) {
+	var header frameHeader
+
+	fields := make([]uint64, 0, 7)
+
+	for _, bits := range []int{14, 1, 1, 4, 4, 4, 3, 1} {
+		value, err := r.ReadUint(bits)
+		if err != nil {
+			return header, errors.Join(ErrInvalidFrameHeader, err)
+		}
+
+		fields = append(fields, value)
+	}
+
+	if fields[0] != frameSync || fields[1] != 0 || fields[7] != 0 {
+		return header, ErrInvalidFrameHeader
+	}
+
+	// the frame (or sample) number is not needed, as frames are decoded in order
+	if _, err := readUTF8(r); err != nil {
+		return header, err
+	}
+
+	var err error
+
+	if header.blockSize, err = decodeBlockSize(r, int(fields[3])); err != nil {
+		return header, err
+	}
+
+	if err = skipSampleRate(r, int(fields[4])); err != nil {
+		return header, err
+	}
+
+	if header.assignment = int(fields[5]); header.assignment > channelsMidSide {
+		return header, ErrInvalidFrameHeader
+	}
+
+	if header.bps, err = decodeBitDepth(info, int(fields[6])); err != nil {
+		return header, err
+	}
+
+	end := r.Offset()
+
+	crc, err := r.ReadUint(8)
+	if err != nil {
+		return header, errors.Join(ErrInvalidFrameHeader, err)
+	}
+
+	if uint8(crc) != crc8(data[:end]) {
+		return header, ErrMismatchingCRC
+	}
+
+	return header, nil
+}
+
+// readUTF8 reads a value with the (extended) UTF-8 coding used for frame and sample numbers.
+func readUTF8(r *lpc.BitReader) (uint64, error) {
+	first, err := r.ReadUint(8)
+	if err != nil {
+		return 0, errors.Join(ErrInvalidFrameHeader, err)
+	}
+
+	n := 0
+	for mask := uint64(0x80); first&mask != 0 && mask > 0; mask >>= 1 {
+		n++
+	}
+
+	switch {
+	case n == 0:
+		return first, nil
+	case n == 1, n > 7:
+		return 0, ErrInvalidFrameHeader
+	}
+
+	value := first & (0xff >> (n + 1))
+
+	for range n - 1 {
+		next, err := r.ReadUint(8)
+		if err != nil {
+			return 0, errors.Join(ErrInvalidFrameHeader, err)
+		}
+
+		if next&0xc0 != 0x80 {
+			return 0, ErrInvalidFrameHeader
+		}
+
+		value = value<<6 | next&0x3f
+	}
+
+	return value, nil
+}
+
+func decodeBlockSize(r *lpc.BitReader, code int) (int, error) {
+	switch {
+	case code == 0:
+		return 0, ErrInvalidBlockSize
+	case code == 1:
+		return 192, nil
+	case code <= 5:
+		return 576 << (code - 2), nil
+	case code == 6, code == 7:
+		value, err := r.ReadUint(8 << (code - 6))
+		if err != nil {
+			return 0, errors.Join(ErrInvalidFrameHeader, err)
+		}
+
+		return int(value) + 1, nil
+	default:
+		return 256 << (code - 8), nil
+	}
+}
+
+// skipSampleRate reads past the sample rate stored at the end of the frame header, if any; as the STREAMINFO's
+// sample rate applies to the whole stream.
+func skipSampleRate(r *lpc.BitReader, code int) error {
+	var bits int
+
+	switch code {
+	case 12:
+		bits = 8
+	case 13, 14:
+		bits = 16
+	case 15:
+		return ErrInvalidSampleRate
+	}
+
+	if _, err := r.ReadUint(bits); err != nil {
+		return errors.Join(ErrInvalidFrameHeader, err)
+	}
+
+	return nil
+}
+
+func decodeBitDepth(info *StreamInfo, code int) (int, error) {
+	switch code {
+	case 0:
+		return int(info.BitsPerSample), nil
+	case 1:
+		return 8, nil
+	case 2:
+		return 12, nil
+	case 4:
+		return 16, nil
+	case 5:
+		return 20, nil
+	case 6:
+		return 24, nil
+	case 7:
+		return 32, nil
+	default:
+		return 0, ErrInvalidBitDepth
+	}
+}
+
+func decodeSubframe(r *lpc.BitReader, blockSize, bps int, samples []int64) ([]int64, error) {
+	header, err := r.ReadUint(8)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidSubframe, err)
+	}
+
+	if header&0x80 != 0 {
+		return nil, ErrInvalidSubframe
+	}
+
+	wasted := 0
+	if header&1 != 0 {
+		k, err := r.ReadUnary()
+		if err != nil {
+			return nil, errors.Join(ErrInvalidSubframe, err)
+		}
+
+		wasted = int(k) + 1
+		bps -= wasted
+
+		if bps <= 0 {
+			return nil, ErrInvalidSubframe
+		}
+	}
+
+	if cap(samples) < blockSize {
+		samples = make([]int64, blockSize)
+	}
+
+	samples = samples[:blockSize]
+
+	switch kind := int(header>>1) & 0x3f; {
+	case kind == 0b000000:
+		value, err := r.ReadInt(bps)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidSubframe, err)
+		}
+
+		for i := range samples {
+			samples[i] = value
+		}
+	case kind == 0b000001:
+		if err = readSamples(r, samples, bps); err != nil {
+			return nil, err
+		}
+	case kind&0b111000 == 0b001000 && kind&0b111 <= maxFixedOrder:
+		if err = decodeFixed(r, samples, bps, kind&0b111); err != nil {
+			return nil, err
+		}
+	case kind&0b100000 != 0:
+		if err = decodeLPC(r, samples, bps, kind&0b11111+1); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidSubframe
+	}
+
+	if wasted > 0 {
+		for i := range samples {
+			samples[i] <<= wasted
+		}
+	}
+
+	return samples, nil
+}
+
+func readSamples(r *lpc.BitReader, samples []int64, bps int) error {
+	for i := range samples {
+		value, err := r.ReadInt(bps)
+		if err != nil {
+			return errors.Join(ErrInvalidSubframe, err)
+		}
+
+		samples[i] = value
+	}
+
+	return nil
+}
+
+func decodeFixed(r *lpc.BitReader, samples []int64, bps, order int) error {
+	if order > len(samples) {
+		return ErrInvalidSubframe
+	}
+
+	if err := readSamples(r, samples[:order], bps); err != nil {
+		return err
+	}
+
+	if err := decodeResidual(r, samples, order); err != nil {
+		return err
+	}
+
+	lpc.Restore(samples, lpc.FixedCoefficients[order], 0)
+
+	return nil
+}
+
+func decodeLPC(r *lpc.BitReader, samples []int64, bps, order int) error {
+	if order > len(samples) {
+		return ErrInvalidSubframe
+	}
+
+	if err := readSamples(r, samples[:order], bps); err != nil {
+		return err
+	}
+
+	precision, err := r.ReadUint(4)
+	if err != nil {
+		return errors.Join(ErrInvalidSubframe, err)
+	}
+
+	if precision == 0b1111 {
+		return ErrInvalidSubframe
+	}
+
+	shift, err := r.ReadInt(5)
+	if err != nil {
+		return errors.Join(ErrInvalidSubframe, err)
+	}
+
+	if shift < 0 {
+		return ErrInvalidSubframe
+	}
+
+	coeffs := make([]int32, order)
+
+	for i := range coeffs {
+		value, err := r.ReadInt(int(precision) + 1)
+		if err != nil {
+			return errors.Join(ErrInvalidSubframe, err)
+		}
+
+		coeffs[i] = int32(value)
+	}
+
+	if err = decodeResidual(r, samples, order); err != nil {
+		return err
+	}
+
+	lpc.Restore(samples, coeffs, int(shift))
+
+	return nil
+}
+
+// decodeResidual reads the Rice-coded residual into samples[order:].
+func decodeResidual(r *lpc.BitReader, samples []int64, order int) error {
+	method, err := r.ReadUint(2)
+	if err != nil {
+		return errors.Join(ErrInvalidResidual, err)
+	}
+
+	paramBits, escape := riceParamBits, uint64(riceEscape)
+
+	switch method {
+	case 0:
+	case 1:
+		paramBits, escape = rice2ParamBits, rice2Escape
+	default:
+		return ErrInvalidResidual
+	}
+
+	partitionOrder, err := r.ReadUint(4)
+	if err != nil {
+		return errors.Join(ErrInvalidResidual, err)
+	}
+
+	var (
+		partitions    = 1 << partitionOrder
+		partitionSize = len(samples) >> partitionOrder
+	)
+
+	if len(samples)%partitions != 0 || partitionSize < order {
+		return ErrInvalidResidual
+	}
+
+	for p, start := 0, order; p < partitions; p++ {
+		end := (p + 1) * partitionSize
+
+		param, err := r.ReadUint(paramBits)
+		if err != nil {
+			return errors.Join(ErrInvalidResidual, err)
+		}
+
+		if param == escape {
+			bits, err := r.ReadUint(5)
+			if err != nil {
+				return errors.Join(ErrInvalidResidual, err)
+			}
+
+			if err = readSamples(r, samples[start:end], int(bits)); err != nil {
+				return errors.Join(ErrInvalidResidual, err)
+			}
+
+			start = end
+
+			continue
+		}
+
+		for i := start; i < end; i++ {
+			if samples[i], err = r.ReadRice(int(param)); err != nil {
+				return errors.Join(ErrInvalidResidual, err)
+			}
+		}
+
+		start = end
+	}
+
+	return nil
+}
+
+// restoreChannels reverses the frame's stereo decorrelation, if any.
+func restoreChannels(header frameHeader, block [][]int64) {
+	switch header.assignment {
+	case channelsLeftSide:
+		for i := range header.blockSize {
+			block[1][i] = block[0][i] - block[1][i]
+		}
+	case channelsSideRight:
+		for i := range header.blockSize {
+			block[0][i] += block[1][i]
+		}
+	case channelsMidSide:
+		for i := range header.blockSize {
+			mid := block[0][i]<<1 | block[1][i]&1
+			block[0][i], block[1][i] = lpc.LeftRight(mid, block[1][i])
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package flac
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"math"
+
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/audio/encoding/lpc"
+)
+
+// Encode encodes the input PCM samples (interleaved by channel) as a FLAC stream, with the given sample rate,
+// number of channels and bit depth.
+func Encode(samples []int, sampleRate uint32, channels, bitDepth uint16, opts ...cfg.Option[Config]) ([]byte, error) {
+	if err := validate(sampleRate, channels, bitDepth); err != nil {
+		return nil, err
+	}
+
+	if len(samples)%int(channels) != 0 {
+		return nil, ErrShortSampleBuffer
+	}
+
+	if err := validateSamples(samples, int(bitDepth)); err != nil {
+		return nil, err
+	}
+
+	config := newConfig(opts...)
+
+	var (
+		numChannels = int(channels)
+		bps         = int(bitDepth)
+		total       = len(samples) / numChannels
+		info        = StreamInfo{
+			MinBlockSize:  uint16(config.blockSize),
+			MaxBlockSize:  uint16(config.blockSize),
+			MinFrameSize:  math.MaxUint32,
+			SampleRate:    sampleRate,
+			Channels:      uint8(channels),
+			BitsPerSample: uint8(bitDepth),
+			TotalSamples:  uint64(total),
+			MD5:           signature(samples, bps),
+		}
+		frames = make([]byte, 0, len(samples)*bps/8/2)
+		block  = make([][]int64, numChannels)
+	)
+
+	for number, start := 0, 0; start < total; number, start = number+1, start+config.blockSize {
+		size := min(config.blockSize, total-start)
+
+		for c := range block {
+			block[c] = make([]int64, size)
+
+			for i := range size {
+				block[c][i] = int64(samples[(start+i)*numChannels+c])
+			}
+		}
+
+		frame := encodeFrame(config, info, uint64(number), block)
+		frames = append(frames, frame...)
+
+		info.MinFrameSize = min(info.MinFrameSize, uint32(len(frame)))
+		info.MaxFrameSize = max(info.MaxFrameSize, uint32(len(frame)))
+	}
+
+	if info.MinFrameSize == math.MaxUint32 {
+		info.MinFrameSize = 0
+	}
+
+	header := make([]byte, 0, len(streamMarker)+4+streamInfoSize)
+	header = append(header, streamMarker...)
+	header = append(header, 1<<7|streamInfoType, 0, 0, streamInfoSize)
+	header = append(header, info.Bytes()...)
+
+	return append(header, frames...), nil
+}
+
+// Bytes encodes the StreamInfo as a STREAMINFO metadata block body.
+func (s StreamInfo) Bytes() []byte {
+	w := lpc.NewBitWriter(streamInfoSize)
+
+	w.WriteUint(uint64(s.MinBlockSize), 16)
+	w.WriteUint(uint64(s.MaxBlockSize), 16)
+	w.WriteUint(uint64(s.MinFrameSize), 24)
+	w.WriteUint(uint64(s.MaxFrameSize), 24)
+	w.WriteUint(uint64(s.SampleRate), 20)
+	w.WriteUint(uint64(s.Channels-1), 3)
+	w.WriteUint(uint64(s.BitsPerSample-1), 5)
+	w.WriteUint(s.TotalSamples, 36)
+
+	return append(w.Buffer, s.MD5[:]...)
+}
+
+func validate(sampleRate uint32, channels, bitDepth uint16) error {
+	switch {
+	case sampleRate == 0, sampleRate > maxSampleRate:
+		return ErrInvalidSampleRate
+	case channels == 0, channels > maxChannels:
+		return ErrInvalidNumChannels
+	case bitDepth < minBitDepth, bitDepth > maxBitDepth:
+		return ErrInvalidBitDepth
+	default:
+		return nil
+	}
+}
+
+func validateSamples(samples []int, bps int) error {
+	var (
+		maxValue = 1<<(bps-1) - 1
+		minValue = -maxValue - 1
+	)
+
+	for i := range samples {
+		if samples[i] > maxValue || samples[i] < minValue {
+			return ErrOutOfRangeSample
+		}
+	}
+
+	return nil
+}
+
+// signature returns the MD5 signature of the interleaved samples, as little-endian signed values with the least
+// number of bytes that fit the bit depth.
+func signature(samples []int, bps int) [16]byte {
+	var (
+		size = (bps + 7) / 8
+		buf  = make([]byte, 0, len(samples)*size)
+		le   [8]byte
+	)
+
+	for i := range samples {
+		binary.LittleEndian.PutUint64(le[:], uint64(samples[i]))
+		buf = append(buf, le[:size]...)
+	}
+
+	return md5.Sum(buf)
+}
+
+func encodeFrame(config Config, info StreamInfo, number uint64, block [][]int64) []byte {
+	var (
+		size       = len(block[0])
+		bps        = int(info.BitsPerSample)
+		assignment = len(block) - 1
+		subframes  = make([]subframe, len(block))
+	)
+
+	for c := range block {
+		subframes[c] = bestSubframe(config, block[c], bps)
+	}
+
+	// stereo decorrelation requires an extra bit for the side channel, beyond what 32-bit samples allow
+	if len(block) == 2 && !config.independent && bps < maxBitDepth {
+		mid := make([]int64, size)
+		side := make([]int64, size)
+
+		for i := range size {
+			mid[i], side[i] = lpc.MidSide(block[0][i], block[1][i])
+			mid[i] >>= 1
+		}
+
+		midFrame := bestSubframe(config, mid, bps)
+		sideFrame := bestSubframe(config, side, bps+1)
+
+		best := subframes[0].bits + subframes[1].bits
+
+		if bits := subframes[0].bits + sideFrame.bits; bits < best {
+			best, assignment = bits, channelsLeftSide
+		}
+
+		if bits := sideFrame.bits + subframes[1].bits; bits < best {
+			best, assignment = bits, channelsSideRight
+		}
+
+		if bits := midFrame.bits + sideFrame.bits; bits < best {
+			assignment = channelsMidSide
+		}
+
+		switch assignment {
+		case channelsLeftSide:
+			subframes[1] = sideFrame
+		case channelsSideRight:
+			subframes[0] = sideFrame
+		case channelsMidSide:
+			subframes[0], subframes[1] = midFrame, sideFrame
+		}
+	}
+
+	w := lpc.NewBitWriter(size * len(block) * bps / 8)
+
+	writeFrameHeader(w, info, number, size, assignment)
+
+	for i := range subframes {
+		subframes[i].write(w)
+	}
+
+	w.Align()
+	crc := crc16(w.Buffer)
+	w.WriteUint(uint64(crc), 16)
+
+	return w.Buffer
+}
+
+func writeFrameHeader(w *lpc.BitWriter, info StreamInfo, number uint64, size, assignment int) {
+	blockSizeCode, blockSizeBits := encodeBlockSize(size)
+	sampleRateCode, sampleRateValue, sampleRateBits := encodeSampleRate(info.SampleRate)
+
+	w.WriteUint(frameSync, 14)
+	w.WriteUint(0, 1) // reserved
+	w.WriteUint(0, 1) // fixed block size
+	w.WriteUint(uint64(blockSizeCode), 4)
+	w.WriteUint(uint64(sampleRateCode), 4)
+	w.WriteUint(uint64(assignment), 4)
+	w.WriteUint(uint64(encodeBitDepth(int(info.BitsPerSample))), 3)
+	w.WriteUint(0, 1) // reserved
+
+	writeUTF8(w, number)
+
+	if blockSizeBits > 0 {
+		w.WriteUint(uint64(size-1), blockSizeBits)
+	}
+
+	if sampleRateBits > 0 {
+		w.WriteUint(uint64(sampleRateValue), sampleRateBits)
+	}
+
+	w.WriteUint(uint64(crc8(w.Buffer)), 8)
+}
+
+// writeUTF8 writes the input value with the (extended) UTF-8 coding used for frame numbers.
+func writeUTF8(w *lpc.BitWriter, value uint64) {
+	if value < 0x80 {
+		w.WriteUint(value, 8)
+
+		return
+	}
+
+	n := 2
+	for value >= 1<<(5*n+1) && n < 7 {
+		n++
+	}
+
+	w.WriteUint(uint64(0xff<<(8-n))&0xff|value>>(6*(n-1)), 8)
+
+	for i := n - 2; i >= 0; i-- {
+		w.WriteUint(0x80|(value>>(6*i))&0x3f, 8)
+	}
+}
+
+// encodeBlockSize returns the frame header's block size code and, when not implied by the code, the number of bits
+// storing it at the end of the header.
+func encodeBlockSize(size int) (code, bits int) {
+	switch size {
+	case 192:
+		return 1, 0
+	case 576, 1152, 2304, 4608:
+		return 2 + int(math.Log2(float64(size/576))), 0
+	case 256, 512, 1024, 2048, 4096, 8192, 16384, 32768:
+		return 8 + int(math.Log2(float64(size/256))), 0
+	}
+
+	if size <= 1<<8 {
+		return 6, 8
+	}
+
+	return 7, 16
+}
+
+// encodeSampleRate returns the frame header's sample rate code and, when not implied by the code, the value and
+// number of bits storing it at the end of the header.
+func encodeSampleRate(rate uint32) (code int, value uint32, bits int) {
+	switch rate {
+	case 88200:
+		return 1, 0, 0
+	case 176400:
+		return 2, 0, 0
+	case 192000:
+		return 3, 0, 0
+	case 8000:
+		return 4, 0, 0
+	case 16000:
+		return 5, 0, 0
+	case 22050:
+		return 6, 0, 0
+	case 24000:
+		return 7, 0, 0
+	case 32000:
+		return 8, 0, 0
+	case 44100:
+		return 9, 0, 0
+	case 48000:
+		return 10, 0, 0
+	case 96000:
+		return 11, 0, 0
+	}
+
+	switch {
+	case rate%1000 == 0 && rate/1000 < 1<<8:
+		return 12, rate / 1000, 8
+	case rate < 1<<16:
+		return 13, rate, 16
+	case rate%10 == 0 && rate/10 < 1<<16:
+		return 14, rate / 10, 16
+	default:
+		// read from the STREAMINFO block
+		return 0, 0, 0
+	}
+}
+
+func encodeBitDepth(bps int) int {
+	switch bps {
+	case 8:
+		return 1
+	case 12:
+		return 2
+	case 16:
+		return 4
+	case 20:
+		return 5
+	case 24:
+		return 6
+	case 32:
+		return 7
+	default:
+		// read from the STREAMINFO block
+		return 0
+	}
+}
@@ -0,0 +1,119 @@
+// Package flac implements a lossless audio codec producing FLAC-compatible streams, built on the linear prediction
+// and entropy coding primitives in the lpc package.
+//
+// ref: https://www.rfc-editor.org/rfc/rfc9639
+package flac
+
+import (
+	"github.com/zalgonoise/x/errs"
+)
+
+const (
+	streamMarker = "fLaC"
+
+	streamInfoType = 0
+	streamInfoSize = 34
+
+	frameSync = 0x3ffe
+
+	minBlockSize     = 16
+	maxBlockSize     = 1<<16 - 1
+	defaultBlockSize = 4096
+
+	minBitDepth = 4
+	maxBitDepth = 32
+
+	maxChannels   = 8
+	maxSampleRate = 1<<20 - 1
+
+	maxFixedOrder          = 4
+	maxLPCOrder            = 32
+	defaultMaxLPCOrder     = 8
+	minPrecision           = 5
+	maxPrecision           = 15
+	maxPartitionOrder      = 15
+	defaultPartitionOrder  = 6
+	tukeyRatio             = 0.5
+	riceParamBits          = 4
+	rice2ParamBits         = 5
+	riceEscape             = 1<<riceParamBits - 1
+	rice2Escape            = 1<<rice2ParamBits - 1
+	maxResidual            = 1<<31 - 1
+	minResidual            = -1 << 31
+	subframeHeaderBits     = 8
+	residualHeaderBits     = 6
+	lpcPrecisionShiftBits  = 9
+	channelsIndependentMax = 7
+)
+
+const (
+	ErrDomain = errs.Domain("audio/flac")
+
+	ErrInvalid     = errs.Kind("invalid")
+	ErrMissing     = errs.Kind("missing")
+	ErrMismatching = errs.Kind("mismatching")
+	ErrShort       = errs.Kind("short")
+	ErrOutOfRange  = errs.Kind("out of range")
+
+	ErrMarker       = errs.Entity("stream marker")
+	ErrStreamInfo   = errs.Entity("stream info")
+	ErrFrameHeader  = errs.Entity("frame header")
+	ErrSubframe     = errs.Entity("subframe")
+	ErrResidual     = errs.Entity("residual")
+	ErrCRC          = errs.Entity("frame CRC")
+	ErrMD5          = errs.Entity("audio MD5 signature")
+	ErrBitDepth     = errs.Entity("bit depth")
+	ErrNumChannels  = errs.Entity("number of channels")
+	ErrSampleRate   = errs.Entity("sample rate")
+	ErrBlockSize    = errs.Entity("block size")
+	ErrSampleBuffer = errs.Entity("sample buffer")
+	ErrSample       = errs.Entity("sample value")
+)
+
+var (
+	ErrInvalidMarker         = errs.WithDomain(ErrDomain, ErrInvalid, ErrMarker)
+	ErrMissingStreamInfo     = errs.WithDomain(ErrDomain, ErrMissing, ErrStreamInfo)
+	ErrInvalidStreamInfo     = errs.WithDomain(ErrDomain, ErrInvalid, ErrStreamInfo)
+	ErrInvalidFrameHeader    = errs.WithDomain(ErrDomain, ErrInvalid, ErrFrameHeader)
+	ErrInvalidSubframe       = errs.WithDomain(ErrDomain, ErrInvalid, ErrSubframe)
+	ErrInvalidResidual       = errs.WithDomain(ErrDomain, ErrInvalid, ErrResidual)
+	ErrMismatchingCRC        = errs.WithDomain(ErrDomain, ErrMismatching, ErrCRC)
+	ErrMismatchingMD5        = errs.WithDomain(ErrDomain, ErrMismatching, ErrMD5)
+	ErrInvalidBitDepth       = errs.WithDomain(ErrDomain, ErrInvalid, ErrBitDepth)
+	ErrInvalidNumChannels    = errs.WithDomain(ErrDomain, ErrInvalid, ErrNumChannels)
+	ErrInvalidSampleRate     = errs.WithDomain(ErrDomain, ErrInvalid, ErrSampleRate)
+	ErrInvalidBlockSize      = errs.WithDomain(ErrDomain, ErrInvalid, ErrBlockSize)
+	ErrShortSampleBuffer     = errs.WithDomain(ErrDomain, ErrShort, ErrSampleBuffer)
+	ErrMismatchingSampleSize = errs.WithDomain(ErrDomain, ErrMismatching, ErrSampleBuffer)
+	ErrOutOfRangeSample      = errs.WithDomain(ErrDomain, ErrOutOfRange, ErrSample)
+)
+
+// StreamInfo describes the STREAMINFO metadata block of a FLAC stream.
+type StreamInfo struct {
+	MinBlockSize  uint16
+	MaxBlockSize  uint16
+	MinFrameSize  uint32
+	MaxFrameSize  uint32
+	SampleRate    uint32
+	Channels      uint8
+	BitsPerSample uint8
+	// TotalSamples is the number of samples per channel, or zero if unknown.
+	TotalSamples uint64
+	// MD5 is the MD5 signature of the unencoded (interleaved, little-endian) audio data, or zeroes if unknown.
+	MD5 [16]byte
+}
+
+// subframe kinds, as encoded in the subframe header.
+const (
+	subframeConstant = iota
+	subframeVerbatim
+	subframeFixed
+	subframeLPC
+)
+
+// channel assignments, beyond the independent channels (0 through 7).
+const (
+	channelsLeftSide  = 8
+	channelsSideRight = 9
+	channelsMidSide   = 10
+)
@@ -0,0 +1,331 @@
+package flac
+
+import (
+	"encoding/hex"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+)
+
+const (
+	testdataDir = "../wav/data/internal/testdata/amen_kick"
+	// referenceDir holds FLAC files encoded by libFLAC
+	referenceDir = "testdata"
+)
+
+func TestEncodeDecode_Wav(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(testdataDir, "*.wav"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			buf, err := os.ReadFile(file)
+			require.NoError(t, err)
+
+			w, err := wav.Decode(buf)
+			require.NoError(t, err)
+
+			samples := w.Data.Value()
+
+			encoded, err := EncodeWav(w)
+			require.NoError(t, err)
+
+			info, decoded, err := Decode(encoded)
+			require.NoError(t, err)
+			require.Equal(t, samples, decoded)
+			require.Equal(t, w.Header.SampleRate, info.SampleRate)
+			require.Equal(t, uint8(w.Header.NumChannels), info.Channels)
+			require.Equal(t, uint8(w.Header.BitsPerSample), info.BitsPerSample)
+			require.Equal(t, uint64(len(samples)/int(w.Header.NumChannels)), info.TotalSamples)
+
+			// lossless compression must actually compress real audio, below 16-bit or wider PCM
+			if w.Header.BitsPerSample >= 16 {
+				require.Less(t, len(encoded), len(samples)*int(w.Header.BitsPerSample)/8)
+			}
+
+			decodedWav, err := DecodeWav(encoded)
+			require.NoError(t, err)
+			require.Equal(t, w.Header.SampleRate, decodedWav.Header.SampleRate)
+			require.Equal(t, w.Header.NumChannels, decodedWav.Header.NumChannels)
+			require.Equal(t, w.Header.BitsPerSample, decodedWav.Header.BitsPerSample)
+			require.Len(t, decodedWav.Data.Float(), len(samples))
+		})
+	}
+}
+
+func TestDecode_Reference(t *testing.T) {
+	for _, testcase := range []struct {
+		file string
+		info StreamInfo
+		md5  string
+	}{
+		{
+			file: "189983.flac",
+			info: StreamInfo{
+				MinBlockSize: 4608, MaxBlockSize: 4608, MinFrameSize: 2381, MaxFrameSize: 9802,
+				SampleRate: 44100, Channels: 2, BitsPerSample: 16, TotalSamples: 20724,
+			},
+			md5: "6328ed6dd30e55fba573692bb73573b7",
+		},
+		{
+			file: "243749.flac",
+			info: StreamInfo{
+				MinBlockSize: 4096, MaxBlockSize: 4096, MinFrameSize: 1089, MaxFrameSize: 1089,
+				SampleRate: 8000, Channels: 1, BitsPerSample: 24, TotalSamples: 402,
+			},
+			md5: "dfc196fd415953b679d92ceb1a59ccf1",
+		},
+	} {
+		t.Run(testcase.file, func(t *testing.T) {
+			buf, err := os.ReadFile(filepath.Join(referenceDir, testcase.file))
+			require.NoError(t, err)
+
+			info, samples, err := Decode(buf)
+			require.NoError(t, err)
+
+			md5, err := hex.DecodeString(testcase.md5)
+			require.NoError(t, err)
+
+			copy(testcase.info.MD5[:], md5)
+
+			// the signature is computed by libFLAC when encoding, so it checks the decoded audio independently of
+			// this package's encoder
+			require.Equal(t, testcase.info, *info)
+			require.Len(t, samples, int(info.TotalSamples)*int(info.Channels))
+			require.Equal(t, info.MD5, signature(samples, int(info.BitsPerSample)))
+
+			// re-encoding the reference audio is lossless
+			encoded, err := Encode(samples, info.SampleRate, uint16(info.Channels), uint16(info.BitsPerSample))
+			require.NoError(t, err)
+
+			_, decoded, err := Decode(encoded)
+			require.NoError(t, err)
+			require.Equal(t, samples, decoded)
+		})
+	}
+}
+
+func TestEncodeDecode_Generated(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		bitDepth uint16
+		channels uint16
+		opts     []cfg.Option[Config]
+	}{
+		{name: "Mono16Bit", bitDepth: 16, channels: 1},
+		{name: "Stereo16Bit", bitDepth: 16, channels: 2},
+		{name: "Stereo24Bit", bitDepth: 24, channels: 2},
+		{name: "Stereo32Bit", bitDepth: 32, channels: 2},
+		{name: "Stereo16Bit/Independent", bitDepth: 16, channels: 2, opts: []cfg.Option[Config]{WithIndependentChannels()}},
+		{name: "Stereo16Bit/FixedOnly", bitDepth: 16, channels: 2, opts: []cfg.Option[Config]{WithMaxLPCOrder(-1)}},
+		{name: "Stereo16Bit/SmallBlocks", bitDepth: 16, channels: 2, opts: []cfg.Option[Config]{WithBlockSize(100)}},
+		{
+			name: "Stereo16Bit/HighOrder", bitDepth: 16, channels: 2,
+			opts: []cfg.Option[Config]{WithMaxLPCOrder(32), WithPrecision(15), WithMaxPartitionOrder(8)},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			samples := chord(44100/4, int(testcase.channels), int(testcase.bitDepth))
+
+			encoded, err := Encode(samples, 44100, testcase.channels, testcase.bitDepth, testcase.opts...)
+			require.NoError(t, err)
+			require.Less(t, len(encoded), len(samples)*int(testcase.bitDepth)/8/2)
+
+			_, decoded, err := Decode(encoded)
+			require.NoError(t, err)
+			require.Equal(t, samples, decoded)
+		})
+	}
+}
+
+// chord generates a few sine waves per channel, slightly out of phase between channels, at 44.1kHz.
+func chord(size, channels, bps int) []int {
+	var (
+		samples = make([]int, size*channels)
+		scale   = float64(int64(1)<<(bps-1)-1) / 4
+	)
+
+	for i := range size {
+		for c := range channels {
+			phase := float64(c) / 10
+			x := 2 * math.Pi * float64(i) / 44100
+
+			samples[i*channels+c] = int(scale * (math.Sin(440*x+phase) + math.Sin(554.37*x+phase) + math.Sin(659.25*x)))
+		}
+	}
+
+	return samples
+}
+
+func TestEncodeDecode_Signals(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, testcase := range []struct {
+		name       string
+		bitDepth   uint16
+		channels   uint16
+		sampleRate uint32
+		samples    func() []int
+	}{
+		{
+			name: "Silence", bitDepth: 16, channels: 2, sampleRate: 48000,
+			samples: func() []int { return make([]int, 20000) },
+		},
+		{
+			name: "WhiteNoise", bitDepth: 16, channels: 2, sampleRate: 44100,
+			samples: func() []int {
+				s := make([]int, 10000)
+				for i := range s {
+					s[i] = rng.Intn(1<<16) - 1<<15
+				}
+
+				return s
+			},
+		},
+		{
+			name: "FullScale32Bit", bitDepth: 32, channels: 1, sampleRate: 96000,
+			samples: func() []int {
+				s := make([]int, 5000)
+				for i := range s {
+					if i%2 == 0 {
+						s[i] = math.MaxInt32
+					} else {
+						s[i] = math.MinInt32
+					}
+				}
+
+				return s
+			},
+		},
+		{
+			name: "OddBitDepth", bitDepth: 12, channels: 3, sampleRate: 22050,
+			samples: func() []int {
+				s := make([]int, 3*1000)
+				for i := range s {
+					s[i] = int(2000 * math.Sin(float64(i)/30))
+				}
+
+				return s
+			},
+		},
+		{
+			name: "UncommonSampleRate", bitDepth: 8, channels: 1, sampleRate: 11025,
+			samples: func() []int {
+				s := make([]int, 333)
+				for i := range s {
+					s[i] = rng.Intn(256) - 128
+				}
+
+				return s
+			},
+		},
+		{
+			name: "ShortStream", bitDepth: 16, channels: 2, sampleRate: 44100,
+			samples: func() []int { return []int{1, -1, 2, -2, 3, -3} },
+		},
+		{
+			name: "Empty", bitDepth: 16, channels: 2, sampleRate: 44100,
+			samples: func() []int { return []int{} },
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			samples := testcase.samples()
+
+			encoded, err := Encode(samples, testcase.sampleRate, testcase.channels, testcase.bitDepth)
+			require.NoError(t, err)
+
+			info, decoded, err := Decode(encoded)
+			require.NoError(t, err)
+			require.Equal(t, samples, decoded)
+			require.Equal(t, testcase.sampleRate, info.SampleRate)
+		})
+	}
+}
+
+func TestEncode_Errors(t *testing.T) {
+	for _, testcase := range []struct {
+		name       string
+		samples    []int
+		sampleRate uint32
+		channels   uint16
+		bitDepth   uint16
+		err        error
+	}{
+		{name: "SampleRate", sampleRate: 0, channels: 1, bitDepth: 16, err: ErrInvalidSampleRate},
+		{name: "Channels", sampleRate: 44100, channels: 9, bitDepth: 16, err: ErrInvalidNumChannels},
+		{name: "BitDepth", sampleRate: 44100, channels: 1, bitDepth: 33, err: ErrInvalidBitDepth},
+		{
+			name: "SampleValue", samples: []int{1 << 15}, sampleRate: 44100, channels: 1, bitDepth: 16,
+			err: ErrOutOfRangeSample,
+		},
+		{
+			name: "SampleBuffer", samples: []int{1, 2, 3}, sampleRate: 44100, channels: 2, bitDepth: 16,
+			err: ErrShortSampleBuffer,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			_, err := Encode(testcase.samples, testcase.sampleRate, testcase.channels, testcase.bitDepth)
+			require.ErrorIs(t, err, testcase.err)
+		})
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	samples := make([]int, 8192)
+	for i := range samples {
+		samples[i] = int(10000 * math.Sin(float64(i)/10))
+	}
+
+	encoded, err := Encode(samples, 44100, 2, 16)
+	require.NoError(t, err)
+
+	for _, testcase := range []struct {
+		name string
+		data func() []byte
+		err  error
+	}{
+		{
+			name: "Marker",
+			data: func() []byte { return []byte("RIFF") },
+			err:  ErrInvalidMarker,
+		},
+		{
+			name: "MissingStreamInfo",
+			data: func() []byte { return []byte(streamMarker) },
+			err:  ErrMissingStreamInfo,
+		},
+		{
+			name: "CorruptedFrame",
+			data: func() []byte {
+				data := append([]byte{}, encoded...)
+				data[len(data)-10] ^= 0xff
+
+				return data
+			},
+			err: ErrMismatchingCRC,
+		},
+		{
+			name: "CorruptedSignature",
+			data: func() []byte {
+				data := append([]byte{}, encoded...)
+				data[len(streamMarker)+4+streamInfoSize-1] ^= 0xff
+
+				return data
+			},
+			err: ErrMismatchingMD5,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			_, _, err := Decode(testcase.data())
+			require.ErrorIs(t, err, testcase.err)
+		})
+	}
+}
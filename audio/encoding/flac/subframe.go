@@ -0,0 +1,297 @@
+package flac
+
+import (
+	"github.com/zalgonoise/x/audio/encoding/lpc"
+)
+
+// subframe is an encoding candidate for a channel's block of samples.
+type subframe struct {
+	kind int
+	bps  int
+
+	samples []int64
+
+	// order, coeffs, shift and precision describe the predictor of fixed and LPC subframes.
+	order     int
+	coeffs    []int32
+	shift     int
+	precision int
+
+	residual []int64
+	plan     residualPlan
+
+	// bits is the encoded size of the subframe.
+	bits uint64
+}
+
+// residualPlan describes the Rice coding of a residual: its coding method (0 for 4-bit parameters, 1 for 5-bit
+// parameters), partition order, and each partition's Rice parameter.
+type residualPlan struct {
+	method int
+	order  int
+	params []int
+	bits   uint64
+}
+
+// bestSubframe returns the smallest subframe encoding of the input samples, among constant, verbatim, fixed and LPC
+// subframes.
+func bestSubframe(config Config, samples []int64, bps int) subframe {
+	if isConstant(samples) {
+		return subframe{
+			kind:    subframeConstant,
+			bps:     bps,
+			samples: samples,
+			bits:    subframeHeaderBits + uint64(bps),
+		}
+	}
+
+	best := subframe{
+		kind:    subframeVerbatim,
+		bps:     bps,
+		samples: samples,
+		bits:    subframeHeaderBits + uint64(len(samples)*bps),
+	}
+
+	for order := 0; order <= maxFixedOrder && order < len(samples); order++ {
+		if candidate, ok := predicted(config, subframeFixed, samples, bps, lpc.FixedCoefficients[order], 0, 0); ok &&
+			candidate.bits < best.bits {
+			best = candidate
+		}
+	}
+
+	if config.maxLPCOrder < 0 {
+		return best
+	}
+
+	data := make([]float64, len(samples))
+	for i := range samples {
+		data[i] = float64(samples[i])
+	}
+
+	maxOrder := min(config.maxLPCOrder, len(samples)-1)
+	precision := config.precision
+
+	if precision == 0 {
+		precision = defaultPrecision(len(samples), bps)
+	}
+
+	for _, coeffs := range lpc.LevinsonDurbin(lpc.Autocorrelation(lpc.Tukey(data, tukeyRatio), maxOrder), maxOrder) {
+		quantized, shift, err := lpc.Quantize(coeffs, precision)
+		if err != nil {
+			continue
+		}
+
+		if candidate, ok := predicted(config, subframeLPC, samples, bps, quantized, shift, precision); ok &&
+			candidate.bits < best.bits {
+			best = candidate
+		}
+	}
+
+	return best
+}
+
+// predicted builds a fixed or LPC subframe candidate, returning false if its residual cannot be encoded.
+func predicted(
+	config Config, kind int, samples []int64, bps int, coeffs []int32, shift, precision int,
+) (subframe, bool) {
+	order := len(coeffs)
+	residual := lpc.Residual(samples, coeffs, shift)
+
+	for i := range residual {
+		if residual[i] > maxResidual || residual[i] < minResidual {
+			return subframe{}, false
+		}
+	}
+
+	plan, ok := planResidual(residual, len(samples), order, config.maxPartitionOrder)
+	if !ok {
+		return subframe{}, false
+	}
+
+	bits := subframeHeaderBits + uint64(order*bps) + plan.bits
+	if kind == subframeLPC {
+		bits += lpcPrecisionShiftBits + uint64(order*precision)
+	}
+
+	return subframe{
+		kind:      kind,
+		bps:       bps,
+		samples:   samples,
+		order:     order,
+		coeffs:    coeffs,
+		shift:     shift,
+		precision: precision,
+		residual:  residual,
+		plan:      plan,
+		bits:      bits,
+	}, true
+}
+
+// planResidual returns the partition order and Rice parameters that encode the residual in the least number of bits.
+func planResidual(residual []int64, blockSize, predictorOrder, maxOrder int) (residualPlan, bool) {
+	var (
+		best  residualPlan
+		found bool
+	)
+
+	for order := 0; order <= maxOrder; order++ {
+		partitionSize := blockSize >> order
+
+		if blockSize%(1<<order) != 0 || partitionSize <= predictorOrder {
+			break
+		}
+
+		plan := residualPlan{
+			order:  order,
+			params: make([]int, 1<<order),
+			bits:   residualHeaderBits,
+		}
+
+		var riceBits uint64
+
+		for p, start := 0, 0; p < len(plan.params); p++ {
+			end := start + partitionSize
+			if p == 0 {
+				end -= predictorOrder
+			}
+
+			k, bits := lpc.RiceParameter(residual[start:end], rice2Escape-1)
+			if k >= riceEscape {
+				plan.method = 1
+			}
+
+			plan.params[p] = k
+			riceBits += bits
+			start = end
+		}
+
+		paramBits := uint64(riceParamBits)
+		if plan.method == 1 {
+			paramBits = rice2ParamBits
+		}
+
+		plan.bits += riceBits + paramBits*uint64(len(plan.params))
+
+		if !found || plan.bits < best.bits {
+			best, found = plan, true
+		}
+	}
+
+	return best, found
+}
+
+// defaultPrecision returns the precision of the quantized LPC coefficients for the block size and bit depth.
+func defaultPrecision(blockSize, bps int) int {
+	switch {
+	case bps < 16:
+		return max(minPrecision, 2+bps/2)
+	case bps == 16:
+		switch {
+		case blockSize <= 192:
+			return 7
+		case blockSize <= 384:
+			return 8
+		case blockSize <= 576:
+			return 9
+		case blockSize <= 1152:
+			return 10
+		case blockSize <= 2304:
+			return 11
+		case blockSize <= 4608:
+			return 12
+		default:
+			return 13
+		}
+	default:
+		switch {
+		case blockSize <= 384:
+			return 12
+		case blockSize <= 1152:
+			return 13
+		default:
+			return 14
+		}
+	}
+}
+
+func isConstant(samples []int64) bool {
+	for i := 1; i < len(samples); i++ {
+		if samples[i] != samples[0] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s subframe) write(w *lpc.BitWriter) {
+	w.WriteUint(0, 1) // padding
+
+	switch s.kind {
+	case subframeConstant:
+		w.WriteUint(0b000000, 6)
+	case subframeVerbatim:
+		w.WriteUint(0b000001, 6)
+	case subframeFixed:
+		w.WriteUint(uint64(0b001000|s.order), 6)
+	case subframeLPC:
+		w.WriteUint(uint64(0b100000|(s.order-1)), 6)
+	}
+
+	w.WriteUint(0, 1) // no wasted bits
+
+	switch s.kind {
+	case subframeConstant:
+		w.WriteInt(s.samples[0], s.bps)
+
+		return
+	case subframeVerbatim:
+		for i := range s.samples {
+			w.WriteInt(s.samples[i], s.bps)
+		}
+
+		return
+	}
+
+	for i := range s.order {
+		w.WriteInt(s.samples[i], s.bps)
+	}
+
+	if s.kind == subframeLPC {
+		w.WriteUint(uint64(s.precision-1), 4)
+		w.WriteInt(int64(s.shift), 5)
+
+		for i := range s.coeffs {
+			w.WriteInt(int64(s.coeffs[i]), s.precision)
+		}
+	}
+
+	s.writeResidual(w)
+}
+
+func (s subframe) writeResidual(w *lpc.BitWriter) {
+	paramBits := riceParamBits
+	if s.plan.method == 1 {
+		paramBits = rice2ParamBits
+	}
+
+	w.WriteUint(uint64(s.plan.method), 2)
+	w.WriteUint(uint64(s.plan.order), 4)
+
+	partitionSize := len(s.samples) >> s.plan.order
+
+	for p, start := 0, 0; p < len(s.plan.params); p++ {
+		end := start + partitionSize
+		if p == 0 {
+			end -= s.order
+		}
+
+		w.WriteUint(uint64(s.plan.params[p]), paramBits)
+
+		for i := start; i < end; i++ {
+			w.WriteRice(s.residual[i], s.plan.params[p])
+		}
+
+		start = end
+	}
+}
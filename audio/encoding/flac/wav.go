@@ -0,0 +1,56 @@
+package flac
+
+import (
+	"encoding/binary"
+
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+)
+
+// EncodeWav encodes the PCM audio data in the input wav.Wav as a FLAC stream.
+func EncodeWav(w *wav.Wav, opts ...cfg.Option[Config]) ([]byte, error) {
+	if w == nil || w.Header == nil {
+		return nil, wav.ErrEmptyHeader
+	}
+
+	if w.Header.AudioFormat != wav.PCMFormat {
+		return nil, wav.ErrInvalidAudioFormat
+	}
+
+	return Encode(w.Data.Value(), w.Header.SampleRate, w.Header.NumChannels, w.Header.BitsPerSample, opts...)
+}
+
+// DecodeWav decodes the input FLAC stream into a PCM wav.Wav. The stream's bit depth must be supported by WAV
+// (8, 16, 24 or 32 bits).
+func DecodeWav(data []byte) (*wav.Wav, error) {
+	info, samples, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := wav.New(info.SampleRate, uint16(info.BitsPerSample), uint16(info.Channels), wav.PCMFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Data.Parse(pcmBytes(samples, int(info.BitsPerSample)))
+
+	return w, nil
+}
+
+// pcmBytes encodes the input samples as little-endian signed values, in the number of bytes for the bit depth.
+func pcmBytes(samples []int, bps int) []byte {
+	var (
+		size = bps / 8
+		buf  = make([]byte, 0, len(samples)*size)
+		le   [8]byte
+	)
+
+	for i := range samples {
+		binary.LittleEndian.PutUint64(le[:], uint64(samples[i]))
+		buf = append(buf, le[:size]...)
+	}
+
+	return buf
+}
@@ -0,0 +1,96 @@
+package lpc
+
+import "io"
+
+const byteSize = 8
+
+// BitReader reads bits from a buffer, most significant bit first; as written by a BitWriter using Align.
+type BitReader struct {
+	buf []byte
+	pos int
+}
+
+func NewBitReader(buf []byte) *BitReader {
+	return &BitReader{buf: buf}
+}
+
+// ReadBit reads a single bit, returning io.ErrUnexpectedEOF if the buffer is exhausted.
+func (r *BitReader) ReadBit() (bool, error) {
+	if r.pos >= len(r.buf)*byteSize {
+		return false, io.ErrUnexpectedEOF
+	}
+
+	bit := r.buf[r.pos/byteSize]&(1<<(7-r.pos%byteSize)) != 0
+	r.pos++
+
+	return bit, nil
+}
+
+// ReadUint reads n bits (up to 64) as an unsigned value.
+func (r *BitReader) ReadUint(n int) (uint64, error) {
+	if r.pos+n > len(r.buf)*byteSize {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	var value uint64
+
+	for ; n > 0; n-- {
+		value <<= 1
+
+		if r.buf[r.pos/byteSize]&(1<<(7-r.pos%byteSize)) != 0 {
+			value |= 1
+		}
+
+		r.pos++
+	}
+
+	return value, nil
+}
+
+// ReadInt reads n bits (up to 64) as a signed value in two's complement.
+func (r *BitReader) ReadInt(n int) (int64, error) {
+	value, err := r.ReadUint(n)
+	if err != nil {
+		return 0, err
+	}
+
+	if n == 0 || n == 64 {
+		return int64(value), nil
+	}
+
+	if value&(1<<(n-1)) != 0 {
+		value |= ^uint64(0) << n
+	}
+
+	return int64(value), nil
+}
+
+// ReadUnary reads a unary code, returning the number of zero bits preceding the first one bit.
+func (r *BitReader) ReadUnary() (uint64, error) {
+	var value uint64
+
+	for {
+		bit, err := r.ReadBit()
+		if err != nil {
+			return 0, err
+		}
+
+		if bit {
+			return value, nil
+		}
+
+		value++
+	}
+}
+
+// Align skips any remaining bits in the current byte.
+func (r *BitReader) Align() {
+	if rem := r.pos % byteSize; rem != 0 {
+		r.pos += byteSize - rem
+	}
+}
+
+// Offset returns the number of bytes read so far, including a partially read byte.
+func (r *BitReader) Offset() int {
+	return (r.pos + byteSize - 1) / byteSize
+}
@@ -0,0 +1,91 @@
+package lpc
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitReader(t *testing.T) {
+	for _, testcase := range []struct {
+		name   string
+		values []uint64
+		sizes  []int
+	}{
+		{
+			name:   "SingleBits",
+			values: []uint64{1, 0, 1, 1},
+			sizes:  []int{1, 1, 1, 1},
+		},
+		{
+			name:   "MixedSizes",
+			values: []uint64{0b11111111111110, 0, 0b1001, 44100, 1<<36 - 1},
+			sizes:  []int{14, 2, 4, 20, 36},
+		},
+		{
+			name:   "FullWidth",
+			values: []uint64{1<<64 - 1, 0x0123456789abcdef},
+			sizes:  []int{64, 64},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			w := NewBitWriter(8)
+
+			for i := range testcase.values {
+				w.WriteUint(testcase.values[i], testcase.sizes[i])
+			}
+
+			w.Align()
+
+			r := NewBitReader(w.Buffer)
+
+			for i := range testcase.values {
+				value, err := r.ReadUint(testcase.sizes[i])
+				require.NoError(t, err)
+				require.Equal(t, testcase.values[i], value)
+			}
+
+			r.Align()
+			require.Equal(t, len(w.Buffer), r.Offset())
+
+			_, err := r.ReadBit()
+			require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		})
+	}
+}
+
+func TestBitReader_ReadInt(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		value int64
+		size  int
+	}{
+		{name: "Zero", value: 0, size: 8},
+		{name: "Positive", value: 1023, size: 12},
+		{name: "Negative", value: -1024, size: 12},
+		{name: "MinusOne", value: -1, size: 3},
+		{name: "Min32", value: -1 << 31, size: 32},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			w := NewBitWriter(8)
+			w.WriteInt(testcase.value, testcase.size)
+			w.Align()
+
+			value, err := NewBitReader(w.Buffer).ReadInt(testcase.size)
+			require.NoError(t, err)
+			require.Equal(t, testcase.value, value)
+		})
+	}
+}
+
+func TestBitWriter_Align(t *testing.T) {
+	w := NewBitWriter(8)
+
+	w.WriteBits(true, false, true)
+	w.Align()
+	w.WriteUint(0xff, 8)
+	w.Align()
+
+	require.Equal(t, []byte{0b10100000, 0xff}, w.Buffer)
+}
@@ -35,6 +35,37 @@ func (b *BitWriter) WriteBits(bits ...bool) {
 	}
 }
 
+// WriteUint writes the n least significant bits of value, most significant bit first.
+func (b *BitWriter) WriteUint(value uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		b.WriteBits(value&(1<<i) != 0)
+	}
+}
+
+// WriteInt writes the n least significant bits of value, in two's complement.
+func (b *BitWriter) WriteInt(value int64, n int) {
+	b.WriteUint(uint64(value), n)
+}
+
+// WriteUnary writes value as a unary code: value zero bits followed by a one bit.
+func (b *BitWriter) WriteUnary(value uint64) {
+	for ; value > 0; value-- {
+		b.WriteBits(false)
+	}
+
+	b.WriteBits(true)
+}
+
+// Align pads the current byte with zero bits, so that the next write starts on a byte boundary.
+//
+// Unlike Flush, which right-aligns the bits of an incomplete byte, Align keeps the written bits as the most
+// significant ones.
+func (b *BitWriter) Align() {
+	for b.bitN != 7 {
+		b.WriteBits(false)
+	}
+}
+
 func (b *BitWriter) Flush() {
 	if b.bit == 0 && b.bitN == 7 {
 		return
@@ -0,0 +1,150 @@
+package lpc
+
+import (
+	"errors"
+	"math"
+)
+
+const maxShift = 15
+
+var (
+	ErrZeroCoefficients = errors.New("lpc: all coefficients are zero")
+	ErrShiftOutOfRange  = errors.New("lpc: coefficients cannot be quantized with the given precision")
+)
+
+// Tukey returns a copy of the input data, tapered by a Tukey window with ratio p (0 is rectangular, 1 is Hann).
+//
+// ref: https://en.wikipedia.org/wiki/Window_function#Tukey_window
+func Tukey(data []float64, p float64) []float64 {
+	out := make([]float64, len(data))
+	copy(out, data)
+
+	n := len(data)
+	taper := int(p / 2 * float64(n-1))
+
+	if taper < 1 {
+		return out
+	}
+
+	for i := 0; i < taper; i++ {
+		w := 0.5 * (1 - math.Cos(math.Pi*float64(i)/float64(taper)))
+
+		out[i] *= w
+		out[n-1-i] *= w
+	}
+
+	return out
+}
+
+// Autocorrelation returns the autocorrelation of the input data, for lags 0 through maxLag.
+func Autocorrelation(data []float64, maxLag int) []float64 {
+	autoc := make([]float64, maxLag+1)
+
+	for lag := 0; lag <= maxLag && lag < len(data); lag++ {
+		var sum float64
+
+		for i := lag; i < len(data); i++ {
+			sum += data[i] * data[i-lag]
+		}
+
+		autoc[lag] = sum
+	}
+
+	return autoc
+}
+
+// LevinsonDurbin solves the linear prediction coefficients from the input autocorrelation, for every order from
+// 1 through maxOrder; where the coefficients of order n are at index n-1, predicting a sample as:
+//
+//	x[i] = c[0]*x[i-1] + c[1]*x[i-2] + ... + c[n-1]*x[i-n]
+//
+// Orders that cannot be solved (e.g. when the prediction error reaches zero) are not returned.
+//
+// ref: https://en.wikipedia.org/wiki/Levinson_recursion
+func LevinsonDurbin(autoc []float64, maxOrder int) [][]float64 {
+	if len(autoc) == 0 || autoc[0] == 0 {
+		return nil
+	}
+
+	maxOrder = min(maxOrder, len(autoc)-1)
+
+	var (
+		lpc    = make([]float64, maxOrder)
+		coeffs = make([][]float64, 0, maxOrder)
+		err    = autoc[0]
+	)
+
+	for i := 0; i < maxOrder; i++ {
+		r := -autoc[i+1]
+		for j := 0; j < i; j++ {
+			r -= lpc[j] * autoc[i-j]
+		}
+
+		r /= err
+
+		lpc[i] = r
+		for j := 0; j < i>>1; j++ {
+			tmp := lpc[j]
+			lpc[j] += r * lpc[i-1-j]
+			lpc[i-1-j] += r * tmp
+		}
+
+		if i&1 != 0 {
+			lpc[i>>1] += lpc[i>>1] * r
+		}
+
+		order := make([]float64, i+1)
+		for j := range order {
+			order[j] = -lpc[j]
+		}
+
+		coeffs = append(coeffs, order)
+
+		if err *= 1 - r*r; err <= 0 {
+			break
+		}
+	}
+
+	return coeffs
+}
+
+// Quantize converts the input coefficients into integers of the given precision (in bits, including sign), returning
+// them along with the shift to apply to the prediction sum.
+//
+// Rounding errors are carried over to the next coefficient, to reduce the overall quantization error.
+func Quantize(coeffs []float64, precision int) (quantized []int32, shift int, err error) {
+	var (
+		qMax = int64(1)<<(precision-1) - 1
+		qMin = -qMax - 1
+		cMax float64
+	)
+
+	for i := range coeffs {
+		cMax = max(cMax, math.Abs(coeffs[i]))
+	}
+
+	if cMax <= 0 {
+		return nil, 0, ErrZeroCoefficients
+	}
+
+	_, log2cMax := math.Frexp(cMax)
+
+	shift = min(precision-1-log2cMax, maxShift)
+	if shift < 0 {
+		return nil, 0, ErrShiftOutOfRange
+	}
+
+	quantized = make([]int32, len(coeffs))
+
+	var carry float64
+
+	for i := range coeffs {
+		carry += coeffs[i] * float64(int64(1)<<shift)
+
+		q := min(max(int64(math.Round(carry)), qMin), qMax)
+		carry -= float64(q)
+		quantized[i] = int32(q)
+	}
+
+	return quantized, shift, nil
+}
@@ -0,0 +1,89 @@
+package lpc
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevinsonDurbin(t *testing.T) {
+	t.Run("SecondOrderProcess", func(t *testing.T) {
+		// x[i] = 1.6*x[i-1] - 0.8*x[i-2] + e[i], driven by white noise
+		rng := rand.New(rand.NewSource(1))
+		data := make([]float64, 1<<16)
+
+		for i := 2; i < len(data); i++ {
+			data[i] = 1.6*data[i-1] - 0.8*data[i-2] + rng.NormFloat64()
+		}
+
+		coeffs := LevinsonDurbin(Autocorrelation(data, 2), 2)
+		require.Len(t, coeffs, 2)
+		require.InDelta(t, 1.6, coeffs[1][0], 0.01)
+		require.InDelta(t, -0.8, coeffs[1][1], 0.01)
+	})
+
+	t.Run("Silence", func(t *testing.T) {
+		require.Nil(t, LevinsonDurbin(Autocorrelation(make([]float64, 64), 8), 8))
+	})
+}
+
+func TestQuantize(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		coeffs    []float64
+		precision int
+		err       error
+	}{
+		{name: "Success/Order2", coeffs: []float64{1.6, -0.8}, precision: 15},
+		{name: "Success/LowPrecision", coeffs: []float64{0.9, -0.2, 0.05}, precision: 5},
+		{name: "Fail/ZeroCoefficients", coeffs: []float64{0, 0}, precision: 15, err: ErrZeroCoefficients},
+		{name: "Fail/ShiftOutOfRange", coeffs: []float64{1 << 20}, precision: 8, err: ErrShiftOutOfRange},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			quantized, shift, err := Quantize(testcase.coeffs, testcase.precision)
+			if err != nil {
+				require.ErrorIs(t, err, testcase.err)
+
+				return
+			}
+
+			require.NoError(t, testcase.err)
+			require.Len(t, quantized, len(testcase.coeffs))
+
+			limit := int32(1) << (testcase.precision - 1)
+			scale := math.Ldexp(1, -shift)
+
+			for i := range quantized {
+				require.Less(t, quantized[i], limit)
+				require.GreaterOrEqual(t, quantized[i], -limit)
+				require.InDelta(t, testcase.coeffs[i], float64(quantized[i])*scale, 2*scale)
+			}
+		})
+	}
+}
+
+func TestResidualRestore(t *testing.T) {
+	samples := make([]int64, 512)
+	for i := range samples {
+		samples[i] = int64(10000 * math.Sin(float64(i)/8))
+	}
+
+	for order, coeffs := range FixedCoefficients {
+		residual := Residual(samples, coeffs, 0)
+		require.Len(t, residual, len(samples)-order)
+
+		restored := append(append([]int64{}, samples[:order]...), residual...)
+		Restore(restored, coeffs, 0)
+		require.Equal(t, samples, restored)
+	}
+
+	quantized, shift, err := Quantize([]float64{1.98, -0.99}, 15)
+	require.NoError(t, err)
+
+	residual := Residual(samples, quantized, shift)
+	restored := append(append([]int64{}, samples[:2]...), residual...)
+	Restore(restored, quantized, shift)
+	require.Equal(t, samples, restored)
+}
@@ -0,0 +1,48 @@
+package lpc
+
+// FixedCoefficients lists the coefficients of the fixed polynomial predictors of orders 0 through 4, to be used with
+// a shift of zero.
+//
+//nolint:gochecknoglobals // read-only lookup table
+var FixedCoefficients = [][]int32{
+	{},
+	{1},
+	{2, -1},
+	{3, -3, 1},
+	{4, -6, 4, -1},
+}
+
+// Residual returns the prediction error of the input samples, for the quantized coefficients and shift; skipping the
+// first len(coeffs) samples, used as warm-up.
+func Residual(samples []int64, coeffs []int32, shift int) []int64 {
+	order := len(coeffs)
+	if len(samples) <= order {
+		return []int64{}
+	}
+
+	residual := make([]int64, len(samples)-order)
+
+	for i := order; i < len(samples); i++ {
+		residual[i-order] = samples[i] - predict(samples, i, coeffs, shift)
+	}
+
+	return residual
+}
+
+// Restore reverses Residual in-place, where the first len(coeffs) samples are the warm-up samples and the remaining
+// ones are the prediction error.
+func Restore(samples []int64, coeffs []int32, shift int) {
+	for i := len(coeffs); i < len(samples); i++ {
+		samples[i] += predict(samples, i, coeffs, shift)
+	}
+}
+
+func predict(samples []int64, i int, coeffs []int32, shift int) int64 {
+	var sum int64
+
+	for j := range coeffs {
+		sum += int64(coeffs[j]) * samples[i-j-1]
+	}
+
+	return sum >> shift
+}
@@ -0,0 +1,79 @@
+package lpc
+
+import "math"
+
+// Fold maps a signed value into an unsigned one, interleaving positive and negative values (0, -1, 1, -2, 2, ...),
+// as used by Rice-coded residuals.
+//
+// It is the zig-zag encoding shifted down by one, so that zero is also represented.
+func Fold(value int64) uint64 {
+	return zigZag[uint64](value) - 1
+}
+
+// Unfold reverses Fold.
+func Unfold(value uint64) int64 {
+	if value&1 == 0 {
+		return int64(value >> 1)
+	}
+
+	return -int64(value>>1) - 1
+}
+
+// WriteRice writes the input value as a Rice code with parameter k: the folded value's quotient by 2^k in unary,
+// followed by its k least significant bits.
+//
+// ref: https://en.wikipedia.org/wiki/Golomb_coding#Rice_coding
+func (b *BitWriter) WriteRice(value int64, k int) {
+	u := Fold(value)
+
+	b.WriteUnary(u >> k)
+	b.WriteUint(u, k)
+}
+
+// ReadRice reads a Rice code with parameter k, as written by BitWriter.WriteRice.
+func (r *BitReader) ReadRice(k int) (int64, error) {
+	q, err := r.ReadUnary()
+	if err != nil {
+		return 0, err
+	}
+
+	low, err := r.ReadUint(k)
+	if err != nil {
+		return 0, err
+	}
+
+	return Unfold(q<<k | low), nil
+}
+
+// RiceParameter returns the Rice parameter (up to maxParam) that encodes the input values in the least number of
+// bits, as well as that number of bits.
+func RiceParameter(values []int64, maxParam int) (k int, bits uint64) {
+	folded := make([]uint64, len(values))
+
+	var sum uint64
+	for i := range values {
+		folded[i] = Fold(values[i])
+		sum += folded[i]
+	}
+
+	bits = math.MaxUint64
+
+	// the optimal parameter is close to log2 of the mean, so only its neighborhood is evaluated
+	estimate := 0
+	if n := uint64(len(values)); n > 0 && sum > n {
+		estimate = int(math.Log2(float64(sum) / float64(n)))
+	}
+
+	for param := min(max(estimate-2, 0), maxParam); param <= min(estimate+2, maxParam); param++ {
+		cost := uint64(len(values)) * uint64(param+1)
+		for i := range folded {
+			cost += folded[i] >> param
+		}
+
+		if cost < bits {
+			k, bits = param, cost
+		}
+	}
+
+	return k, bits
+}
@@ -0,0 +1,92 @@
+package lpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFold(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input int64
+		wants uint64
+	}{
+		{name: "Zero", input: 0, wants: 0},
+		{name: "X-1", input: -1, wants: 1},
+		{name: "X1", input: 1, wants: 2},
+		{name: "X-2", input: -2, wants: 3},
+		{name: "X2", input: 2, wants: 4},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			require.Equal(t, testcase.wants, Fold(testcase.input))
+			require.Equal(t, testcase.input, Unfold(testcase.wants))
+		})
+	}
+}
+
+func TestRice(t *testing.T) {
+	for _, testcase := range []struct {
+		name   string
+		values []int64
+		k      int
+	}{
+		{name: "ZeroParameter", values: []int64{0, -1, 1, 3, -4}, k: 0},
+		{name: "SmallValues", values: []int64{12, -7, 0, 5, -31, 16}, k: 3},
+		{name: "LargeValues", values: []int64{32767, -32768, 1 << 20, -(1 << 20)}, k: 14},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			w := NewBitWriter(8)
+
+			for i := range testcase.values {
+				w.WriteRice(testcase.values[i], testcase.k)
+			}
+
+			w.Align()
+
+			r := NewBitReader(w.Buffer)
+
+			for i := range testcase.values {
+				value, err := r.ReadRice(testcase.k)
+				require.NoError(t, err)
+				require.Equal(t, testcase.values[i], value)
+			}
+		})
+	}
+}
+
+func TestRiceParameter(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		values   []int64
+		maxParam int
+	}{
+		{name: "Zeroes", values: []int64{0, 0, 0, 0}, maxParam: 14},
+		{name: "Small", values: []int64{1, -2, 3, -1, 0, 2}, maxParam: 14},
+		{name: "Large", values: []int64{3000, -2500, 4100, -3900, 1200}, maxParam: 14},
+		{name: "Capped", values: []int64{1 << 20, -(1 << 20)}, maxParam: 4},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			k, bits := RiceParameter(testcase.values, testcase.maxParam)
+			require.LessOrEqual(t, k, testcase.maxParam)
+
+			// the returned parameter must match the size of the encoded values, and be no worse than any other
+			w := NewBitWriter(8)
+			for i := range testcase.values {
+				w.WriteRice(testcase.values[i], k)
+			}
+
+			w.Align()
+			require.Equal(t, (bits+7)/8, uint64(len(w.Buffer)))
+
+			for param := 0; param <= testcase.maxParam; param++ {
+				cost := uint64(len(testcase.values)) * uint64(param+1)
+				for i := range testcase.values {
+					cost += Fold(testcase.values[i]) >> param
+				}
+
+				require.LessOrEqual(t, bits, cost)
+			}
+		})
+	}
+}
@@ -49,6 +49,8 @@ type Chunk interface {
 	Generate(waveType osc.Type, freq, sampleRate int, dur time.Duration)
 	// Apply transforms the floating-point audio data with each FilterFunc in `filters`.
 	Apply(filters ...data.FilterFunc)
+	// Transform replaces the floating-point audio data with the result of each TransformFunc in `transforms`.
+	Transform(transforms ...data.TransformFunc)
 }
 
 // NewChunk is a factory for Chunk interfaces.
@@ -47,6 +47,11 @@ type Chunk struct {
 // FilterFunc is a function that applies a transformation to a floating-point audio buffer.
 type FilterFunc func([]float64)
 
+// TransformFunc is a function that rewrites a floating-point audio buffer, returning the resulting
+// buffer. Unlike FilterFunc, the output may differ in length from the input, as it happens when
+// resampling or remixing the audio data.
+type TransformFunc func([]float64) []float64
+
 // Write implements the io.Writer interface.
 //
 // It allows to grow the Chunk's audio data with the input `buf` bytes, returning the number of
@@ -209,6 +214,13 @@ func (d *Chunk) Apply(filters ...FilterFunc) {
 	}
 }
 
+// Transform replaces the floating-point audio data with the result of each TransformFunc in `transforms`.
+func (d *Chunk) Transform(transforms ...TransformFunc) {
+	for i := range transforms {
+		d.Data = transforms[i](d.Data)
+	}
+}
+
 // NewPCMChunk creates a PCM Chunk with the appropriate Converter, from the input
 // `bitDepth` and `subchunk`.
 func NewPCMChunk(bitDepth uint16, h *Header) *Chunk {
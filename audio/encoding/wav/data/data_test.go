@@ -207,6 +207,24 @@ func TestDataChunk(t *testing.T) {
 						require.Equal(t, orig, chunk.Data)
 					},
 				},
+				{
+					name: "Transform",
+					op: func(chunk *Chunk) {
+						orig := make([]float64, len(chunk.Data))
+						copy(orig, chunk.Data)
+
+						chunk.Transform(
+							filters.Remix(1, 2),
+							filters.Remix(2, 1),
+						)
+
+						require.Equal(t, orig, chunk.Data)
+
+						chunk.Transform(filters.Remix(1, 2))
+
+						require.Len(t, chunk.Data, 2*len(orig))
+					},
+				},
 			} {
 				t.Run(testcase.name, func(t *testing.T) {
 					chunk := NewPCMChunk(class.bitDepth, h)
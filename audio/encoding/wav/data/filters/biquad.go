@@ -0,0 +1,267 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// TODO: move to a different package outside of audio/encoding/wav/data
+
+// Response describes the frequency response of a Biquad filter.
+type Response uint8
+
+const (
+	// LowPassResponse attenuates the frequencies above the cutoff frequency.
+	LowPassResponse Response = iota
+	// HighPassResponse attenuates the frequencies below the cutoff frequency.
+	HighPassResponse
+	// BandPassResponse attenuates the frequencies outside of a band around the center frequency,
+	// with a 0dB peak gain.
+	BandPassResponse
+	// NotchResponse attenuates the frequencies within a band around the center frequency.
+	NotchResponse
+	// AllPassResponse preserves the magnitude of all frequencies, shifting their phase around the
+	// center frequency.
+	AllPassResponse
+	// PeakingResponse boosts or cuts (by the filter's gain) the frequencies around the center frequency.
+	PeakingResponse
+	// LowShelfResponse boosts or cuts (by the filter's gain) the frequencies below the corner frequency.
+	LowShelfResponse
+	// HighShelfResponse boosts or cuts (by the filter's gain) the frequencies above the corner frequency.
+	HighShelfResponse
+)
+
+const (
+	// DefaultQ is the Q factor for a maximally-flat (Butterworth) second-order response.
+	DefaultQ = 1 / math.Sqrt2
+
+	// maxFreqRatio caps the filter's frequency slightly below the Nyquist frequency, where the
+	// bilinear transform is undefined.
+	maxFreqRatio = 0.499
+	minFreq      = 1e-3
+)
+
+// Biquad is a stateful second-order IIR filter, designed from the formulas in Robert
+// Bristow-Johnson's "Cookbook formulae for audio EQ biquad filter coefficients".
+//
+// A Biquad filters interleaved audio data with one or more channels, keeping the state of each
+// channel across calls, so that consecutive buffers of the same signal (like the chunks processed
+// by a wav.Stream) are filtered without discontinuities at their edges. As such, a single Biquad
+// must not be shared across different signals, or used concurrently. Use Reset to reuse a Biquad
+// for a new signal.
+type Biquad struct {
+	response   Response
+	sampleRate float64
+	freq       float64
+	q          float64
+	gain       float64
+
+	// normalized coefficients, where a0 is 1
+	b0, b1, b2 float64
+	a1, a2     float64
+
+	// state holds the (transposed direct form II) delay line for each channel
+	state [][2]float64
+	// next is the channel index of the next sample, when a buffer does not end on a frame boundary
+	next int
+}
+
+// NewBiquad creates a Biquad filter with the Response `response`, for audio data with a sample rate of
+// `sampleRate` and `channels` interleaved channels.
+//
+// The frequency `freq` is the filter's cutoff, center or corner frequency (depending on the Response),
+// and it is limited to the range between 0 and the Nyquist frequency. The Q factor `q` sets the
+// filter's bandwidth (or the shelf slope, for shelving filters); where a value of zero or below is
+// replaced with DefaultQ. The gain `gain` (in decibels) only applies to peaking and shelving filters.
+//
+// A zero value for `channels` is treated as mono audio.
+func NewBiquad(response Response, sampleRate uint32, freq, q, gain float64, channels uint16) *Biquad {
+	if channels == 0 {
+		channels = 1
+	}
+
+	if q <= 0 {
+		q = DefaultQ
+	}
+
+	freq = math.Max(minFreq, math.Min(freq, maxFreqRatio*float64(sampleRate)))
+
+	b := &Biquad{
+		response:   response,
+		sampleRate: float64(sampleRate),
+		freq:       freq,
+		q:          q,
+		gain:       gain,
+		state:      make([][2]float64, channels),
+	}
+
+	b.design()
+
+	return b
+}
+
+// NewBiquadFromCoefficients creates a Biquad filter from its transfer function coefficients, for audio data with
+// a sample rate of `sampleRate` and `channels` interleaved channels. The coefficients are normalized by `a0`.
+//
+// It allows building filters that are specified by their coefficients rather than by a Response, like the
+// K-weighting filters in ITU-R BS.1770. A zero value for `a0` is treated as 1, and a zero value for `channels`
+// is treated as mono audio.
+func NewBiquadFromCoefficients(sampleRate uint32, b0, b1, b2, a0, a1, a2 float64, channels uint16) *Biquad {
+	if channels == 0 {
+		channels = 1
+	}
+
+	if a0 == 0 {
+		a0 = 1
+	}
+
+	return &Biquad{
+		sampleRate: float64(sampleRate),
+		b0:         b0 / a0,
+		b1:         b1 / a0,
+		b2:         b2 / a0,
+		a1:         a1 / a0,
+		a2:         a2 / a0,
+		state:      make([][2]float64, channels),
+	}
+}
+
+func (b *Biquad) design() {
+	var (
+		w0    = 2 * math.Pi * b.freq / b.sampleRate
+		cosW0 = math.Cos(w0)
+		alpha = math.Sin(w0) / (2 * b.q)
+		amp   = math.Pow(10, b.gain/40)
+
+		b0, b1, b2, a0, a1, a2 float64
+	)
+
+	switch b.response {
+	case LowPassResponse:
+		b0, b1, b2 = (1-cosW0)/2, 1-cosW0, (1-cosW0)/2
+		a0, a1, a2 = 1+alpha, -2*cosW0, 1-alpha
+	case HighPassResponse:
+		b0, b1, b2 = (1+cosW0)/2, -(1 + cosW0), (1+cosW0)/2
+		a0, a1, a2 = 1+alpha, -2*cosW0, 1-alpha
+	case BandPassResponse:
+		b0, b1, b2 = alpha, 0, -alpha
+		a0, a1, a2 = 1+alpha, -2*cosW0, 1-alpha
+	case NotchResponse:
+		b0, b1, b2 = 1, -2*cosW0, 1
+		a0, a1, a2 = 1+alpha, -2*cosW0, 1-alpha
+	case AllPassResponse:
+		b0, b1, b2 = 1-alpha, -2*cosW0, 1+alpha
+		a0, a1, a2 = 1+alpha, -2*cosW0, 1-alpha
+	case PeakingResponse:
+		b0, b1, b2 = 1+alpha*amp, -2*cosW0, 1-alpha*amp
+		a0, a1, a2 = 1+alpha/amp, -2*cosW0, 1-alpha/amp
+	case LowShelfResponse:
+		sqrtAmp := 2 * math.Sqrt(amp) * alpha
+
+		b0 = amp * ((amp + 1) - (amp-1)*cosW0 + sqrtAmp)
+		b1 = 2 * amp * ((amp - 1) - (amp+1)*cosW0)
+		b2 = amp * ((amp + 1) - (amp-1)*cosW0 - sqrtAmp)
+		a0 = (amp + 1) + (amp-1)*cosW0 + sqrtAmp
+		a1 = -2 * ((amp - 1) + (amp+1)*cosW0)
+		a2 = (amp + 1) + (amp-1)*cosW0 - sqrtAmp
+	case HighShelfResponse:
+		sqrtAmp := 2 * math.Sqrt(amp) * alpha
+
+		b0 = amp * ((amp + 1) + (amp-1)*cosW0 + sqrtAmp)
+		b1 = -2 * amp * ((amp - 1) + (amp+1)*cosW0)
+		b2 = amp * ((amp + 1) + (amp-1)*cosW0 - sqrtAmp)
+		a0 = (amp + 1) - (amp-1)*cosW0 + sqrtAmp
+		a1 = 2 * ((amp - 1) - (amp+1)*cosW0)
+		a2 = (amp + 1) - (amp-1)*cosW0 - sqrtAmp
+	default:
+		// unknown responses pass the signal through
+		b0, a0 = 1, 1
+	}
+
+	b.b0, b.b1, b.b2 = b0/a0, b1/a0, b2/a0
+	b.a1, b.a2 = a1/a0, a2/a0
+}
+
+// Filter applies the Biquad filter to the interleaved audio data in `buffer`, in place.
+//
+// Its signature matches data.FilterFunc, so it can be passed directly to a Chunk's Apply method.
+func (b *Biquad) Filter(buffer []float64) {
+	channels := len(b.state)
+
+	for i := range buffer {
+		state := &b.state[b.next]
+		in := buffer[i]
+		out := b.b0*in + state[0]
+
+		state[0] = b.b1*in - b.a1*out + state[1]
+		state[1] = b.b2*in - b.a2*out
+
+		buffer[i] = out
+
+		if b.next++; b.next == channels {
+			b.next = 0
+		}
+	}
+}
+
+// Reset clears the Biquad's state, so it can be used with a new signal.
+func (b *Biquad) Reset() {
+	clear(b.state)
+	b.next = 0
+}
+
+// Magnitude returns the Biquad's gain (in decibels) at the frequency `freq`.
+func (b *Biquad) Magnitude(freq float64) float64 {
+	z := cmplx.Exp(complex(0, -2*math.Pi*freq/b.sampleRate))
+	z2 := z * z
+
+	h := (complex(b.b0, 0) + complex(b.b1, 0)*z + complex(b.b2, 0)*z2) /
+		(1 + complex(b.a1, 0)*z + complex(b.a2, 0)*z2)
+
+	return 20 * math.Log10(cmplx.Abs(h))
+}
+
+// LowPass returns a stateful low-pass filter for mono audio data with a sample rate of `sampleRate`,
+// with a cutoff frequency `freq` and a Q factor `q`.
+func LowPass(sampleRate uint32, freq, q float64) func([]float64) {
+	return NewBiquad(LowPassResponse, sampleRate, freq, q, 0, 1).Filter
+}
+
+// HighPass returns a stateful high-pass filter for mono audio data with a sample rate of `sampleRate`,
+// with a cutoff frequency `freq` and a Q factor `q`.
+func HighPass(sampleRate uint32, freq, q float64) func([]float64) {
+	return NewBiquad(HighPassResponse, sampleRate, freq, q, 0, 1).Filter
+}
+
+// BandPass returns a stateful band-pass filter for mono audio data with a sample rate of `sampleRate`,
+// with a center frequency `freq` and a Q factor `q`.
+func BandPass(sampleRate uint32, freq, q float64) func([]float64) {
+	return NewBiquad(BandPassResponse, sampleRate, freq, q, 0, 1).Filter
+}
+
+// Notch returns a stateful notch (band-stop) filter for mono audio data with a sample rate of
+// `sampleRate`, with a center frequency `freq` and a Q factor `q`.
+func Notch(sampleRate uint32, freq, q float64) func([]float64) {
+	return NewBiquad(NotchResponse, sampleRate, freq, q, 0, 1).Filter
+}
+
+// Peaking returns a stateful peaking EQ filter for mono audio data with a sample rate of `sampleRate`,
+// boosting or cutting the frequencies around `freq` by `gain` decibels, within a bandwidth set by the
+// Q factor `q`.
+func Peaking(sampleRate uint32, freq, q, gain float64) func([]float64) {
+	return NewBiquad(PeakingResponse, sampleRate, freq, q, gain, 1).Filter
+}
+
+// LowShelf returns a stateful low-shelf filter for mono audio data with a sample rate of `sampleRate`,
+// boosting or cutting the frequencies below `freq` by `gain` decibels, with a slope set by the
+// Q factor `q`.
+func LowShelf(sampleRate uint32, freq, q, gain float64) func([]float64) {
+	return NewBiquad(LowShelfResponse, sampleRate, freq, q, gain, 1).Filter
+}
+
+// HighShelf returns a stateful high-shelf filter for mono audio data with a sample rate of `sampleRate`,
+// boosting or cutting the frequencies above `freq` by `gain` decibels, with a slope set by the
+// Q factor `q`.
+func HighShelf(sampleRate uint32, freq, q, gain float64) func([]float64) {
+	return NewBiquad(HighShelfResponse, sampleRate, freq, q, gain, 1).Filter
+}
@@ -0,0 +1,228 @@
+package filters_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/encoding/wav/data/filters"
+)
+
+const sampleRate = 48000
+
+// gain measures the attenuation (in decibels) of a sine wave of frequency `freq` through the filter `fn`.
+func gain(fn func([]float64), freq float64) float64 {
+	buf := sine(freq, sampleRate, sampleRate/2)
+	want := rms(buf, 4800)
+
+	fn(buf)
+
+	return 20 * math.Log10(rms(buf, 4800)/want)
+}
+
+func TestBiquad(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		response filters.Response
+		freq     float64
+		q        float64
+		gain     float64
+		wants    map[float64]float64
+	}{
+		{
+			name:     "LowPass",
+			response: filters.LowPassResponse,
+			freq:     1000,
+			wants:    map[float64]float64{0: 0, 100: 0, 1000: -3.01, 10000: -42.74},
+		},
+		{
+			name:     "HighPass",
+			response: filters.HighPassResponse,
+			freq:     1000,
+			wants:    map[float64]float64{100: -40, 1000: -3.01, 20000: 0},
+		},
+		{
+			name:     "BandPass",
+			response: filters.BandPassResponse,
+			freq:     2000,
+			q:        2,
+			wants:    map[float64]float64{2000: 0},
+		},
+		{
+			name:     "Notch",
+			response: filters.NotchResponse,
+			freq:     50,
+			q:        10,
+			wants:    map[float64]float64{0: 0, 1000: 0},
+		},
+		{
+			name:     "AllPass",
+			response: filters.AllPassResponse,
+			freq:     1000,
+			wants:    map[float64]float64{100: 0, 1000: 0, 10000: 0},
+		},
+		{
+			name:     "Peaking",
+			response: filters.PeakingResponse,
+			freq:     1000,
+			q:        1,
+			gain:     6,
+			wants:    map[float64]float64{0: 0, 1000: 6, 23000: 0},
+		},
+		{
+			name:     "LowShelf",
+			response: filters.LowShelfResponse,
+			freq:     200,
+			gain:     -12,
+			wants:    map[float64]float64{0: -12, 200: -6, 20000: 0},
+		},
+		{
+			name:     "HighShelf",
+			response: filters.HighShelfResponse,
+			freq:     5000,
+			gain:     9,
+			wants:    map[float64]float64{0: 0, 5000: 4.5, 23000: 9},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			b := filters.NewBiquad(testcase.response, sampleRate, testcase.freq, testcase.q, testcase.gain, 1)
+
+			for freq, wants := range testcase.wants {
+				require.InDelta(t, wants, b.Magnitude(freq), 0.1, "frequency: %v", freq)
+			}
+		})
+	}
+
+	t.Run("NotchCenter", func(t *testing.T) {
+		require.Less(t, filters.NewBiquad(filters.NotchResponse, sampleRate, 50, 10, 0, 1).Magnitude(50), -100.0)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		require.InDelta(t, 0, gain(filters.LowPass(sampleRate, 1000, 0), 100), 0.1)
+		require.InDelta(t, -3.01, gain(filters.LowPass(sampleRate, 1000, 0), 1000), 0.1)
+		require.InDelta(t, -42.74, gain(filters.LowPass(sampleRate, 1000, 0), 10000), 0.5)
+		require.InDelta(t, -40, gain(filters.HighPass(sampleRate, 1000, 0), 100), 0.5)
+		require.InDelta(t, 0, gain(filters.BandPass(sampleRate, 2000, 2), 2000), 0.1)
+		require.Less(t, gain(filters.Notch(sampleRate, 1000, 10), 1000), -40.0)
+		require.InDelta(t, 6, gain(filters.Peaking(sampleRate, 1000, 1, 6), 1000), 0.1)
+		require.InDelta(t, -12, gain(filters.LowShelf(sampleRate, 200, 0, -12), 20), 0.1)
+		require.InDelta(t, 9, gain(filters.HighShelf(sampleRate, 5000, 0, 9), 20000), 0.2)
+	})
+
+	t.Run("Chunked", func(t *testing.T) {
+		whole := sine(440, sampleRate, 4800)
+		chunked := make([]float64, len(whole))
+		copy(chunked, whole)
+
+		filters.NewBiquad(filters.PeakingResponse, sampleRate, 440, 2, 6, 2).Filter(whole)
+
+		b := filters.NewBiquad(filters.PeakingResponse, sampleRate, 440, 2, 6, 2)
+
+		// odd-sized chunks also split frames across calls
+		for i := 0; i < len(chunked); i += 333 {
+			b.Filter(chunked[i:min(i+333, len(chunked))])
+		}
+
+		require.Equal(t, whole, chunked)
+	})
+
+	t.Run("Stereo", func(t *testing.T) {
+		left := sine(100, sampleRate, sampleRate/2)
+		right := sine(10000, sampleRate, sampleRate/2)
+		buf := interleave(left, right)
+
+		filters.NewBiquad(filters.LowPassResponse, sampleRate, 1000, 0, 0, 2).Filter(buf)
+
+		outLeft := make([]float64, len(left))
+		outRight := make([]float64, len(right))
+
+		for i := range outLeft {
+			outLeft[i] = buf[2*i]
+			outRight[i] = buf[2*i+1]
+		}
+
+		require.InDelta(t, 0, 20*math.Log10(rms(outLeft, 4800)/rms(left, 4800)), 0.1)
+		require.InDelta(t, -42.74, 20*math.Log10(rms(outRight, 4800)/rms(right, 4800)), 0.5)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		in := sine(440, sampleRate, 4800)
+		first := make([]float64, len(in))
+		second := make([]float64, len(in))
+
+		copy(first, in)
+		copy(second, in)
+
+		b := filters.NewBiquad(filters.HighPassResponse, sampleRate, 1000, 0, 0, 1)
+
+		b.Filter(first)
+		b.Reset()
+		b.Filter(second)
+
+		require.Equal(t, first, second)
+	})
+
+	t.Run("Clamping", func(t *testing.T) {
+		b := filters.NewBiquad(filters.LowPassResponse, sampleRate, 100000, -1, 0, 0)
+		buf := sine(440, sampleRate, 4800)
+
+		b.Filter(buf)
+
+		for i := range buf {
+			require.False(t, math.IsNaN(buf[i]) || math.IsInf(buf[i], 0))
+		}
+	})
+}
+
+func TestCascade(t *testing.T) {
+	t.Run("EQ", func(t *testing.T) {
+		eq := filters.NewEQ(sampleRate, 1,
+			filters.Band{Response: filters.HighPassResponse, Freq: 30},
+			filters.Band{Response: filters.LowShelfResponse, Freq: 100, Gain: -6},
+			filters.Band{Response: filters.PeakingResponse, Freq: 3000, Q: 2, Gain: 4},
+			filters.Band{Response: filters.HighShelfResponse, Freq: 15000, Gain: 3},
+		)
+
+		require.Less(t, eq.Magnitude(5), -30.0)
+		require.InDelta(t, -6, eq.Magnitude(50), 0.5)
+		require.InDelta(t, 4, eq.Magnitude(3000), 0.1)
+		require.InDelta(t, 3, eq.Magnitude(22000), 0.2)
+		require.InDelta(t, eq.Magnitude(3000), gain(eq.Filter, 3000), 0.1)
+	})
+
+	t.Run("Butterworth", func(t *testing.T) {
+		lp := filters.Butterworth(filters.LowPassResponse, sampleRate, 1000, 4, 1)
+
+		require.InDelta(t, 0, lp.Magnitude(100), 0.01)
+		require.InDelta(t, -3.01, lp.Magnitude(1000), 0.05)
+		// a 4th-order filter rolls off at 24dB per octave
+		require.InDelta(t, -24, lp.Magnitude(4000)-lp.Magnitude(2000), 1)
+
+		hp := filters.Butterworth(filters.HighPassResponse, sampleRate, 1000, 3, 1)
+
+		require.InDelta(t, -3.01, hp.Magnitude(1000), 0.05)
+		require.InDelta(t, 0, hp.Magnitude(20000), 0.01)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		in := sine(440, sampleRate, 4800)
+		first := make([]float64, len(in))
+		second := make([]float64, len(in))
+
+		copy(first, in)
+		copy(second, in)
+
+		c := filters.NewCascade(
+			filters.NewBiquad(filters.HighPassResponse, sampleRate, 100, 0, 0, 1),
+			nil,
+			filters.NewBiquad(filters.LowPassResponse, sampleRate, 5000, 0, 0, 1),
+		)
+
+		c.Filter(first)
+		c.Reset()
+		c.Filter(second)
+
+		require.Equal(t, first, second)
+	})
+}
@@ -0,0 +1,108 @@
+package filters
+
+import "math"
+
+// TODO: move to a different package outside of audio/encoding/wav/data
+
+// Cascade chains multiple Biquad filters in series, where the output of each filter is the input of
+// the next one. It is used to build higher-order filters, or a parametric EQ with multiple bands.
+type Cascade struct {
+	stages []*Biquad
+}
+
+// NewCascade creates a Cascade from the input Biquad filters `stages`, applied in order.
+//
+// Nil stages are skipped.
+func NewCascade(stages ...*Biquad) *Cascade {
+	c := &Cascade{
+		stages: make([]*Biquad, 0, len(stages)),
+	}
+
+	for i := range stages {
+		if stages[i] != nil {
+			c.stages = append(c.stages, stages[i])
+		}
+	}
+
+	return c
+}
+
+// Filter applies each of the Cascade's Biquad filters to the interleaved audio data in `buffer`, in place.
+//
+// Its signature matches data.FilterFunc, so it can be passed directly to a Chunk's Apply method.
+func (c *Cascade) Filter(buffer []float64) {
+	for i := range c.stages {
+		c.stages[i].Filter(buffer)
+	}
+}
+
+// Reset clears the state of each of the Cascade's Biquad filters, so it can be used with a new signal.
+func (c *Cascade) Reset() {
+	for i := range c.stages {
+		c.stages[i].Reset()
+	}
+}
+
+// Magnitude returns the Cascade's gain (in decibels) at the frequency `freq`.
+func (c *Cascade) Magnitude(freq float64) float64 {
+	var gain float64
+
+	for i := range c.stages {
+		gain += c.stages[i].Magnitude(freq)
+	}
+
+	return gain
+}
+
+// Band describes a single band of a parametric EQ.
+type Band struct {
+	// Response is the shape of the band, usually one of PeakingResponse, LowShelfResponse or HighShelfResponse;
+	// although pass and notch responses can be used to trim the ends of the spectrum.
+	Response Response
+	// Freq is the center (or corner) frequency of the band, in Hz.
+	Freq float64
+	// Q is the bandwidth (or shelf slope) of the band. A zero value is replaced with DefaultQ.
+	Q float64
+	// Gain is the boost (if positive) or cut (if negative) of the band, in decibels.
+	Gain float64
+}
+
+// NewEQ creates a parametric EQ as a Cascade of Biquad filters, one for each Band in `bands`, for audio
+// data with a sample rate of `sampleRate` and `channels` interleaved channels.
+func NewEQ(sampleRate uint32, channels uint16, bands ...Band) *Cascade {
+	stages := make([]*Biquad, 0, len(bands))
+
+	for i := range bands {
+		stages = append(stages, NewBiquad(bands[i].Response, sampleRate, bands[i].Freq, bands[i].Q, bands[i].Gain, channels))
+	}
+
+	return NewCascade(stages...)
+}
+
+// Butterworth creates a Cascade of Biquad filters forming a Butterworth low-pass or high-pass filter (as
+// set in `response`) of order `order`, for audio data with a sample rate of `sampleRate` and `channels`
+// interleaved channels, with a cutoff frequency `freq`.
+//
+// The order is rounded up to an even number, as each Biquad filter adds two orders to the Cascade.
+// Responses other than LowPassResponse and HighPassResponse are replaced with LowPassResponse.
+func Butterworth(response Response, sampleRate uint32, freq float64, order int, channels uint16) *Cascade {
+	if response != HighPassResponse {
+		response = LowPassResponse
+	}
+
+	sections := (order + 1) / 2
+	if sections < 1 {
+		sections = 1
+	}
+
+	stages := make([]*Biquad, 0, sections)
+
+	// the Q factor of each section places its poles evenly on the Butterworth circle
+	for k := range sections {
+		q := 1 / (2 * math.Cos(math.Pi*float64(2*k+1)/float64(4*sections)))
+
+		stages = append(stages, NewBiquad(response, sampleRate, freq, q, 0, channels))
+	}
+
+	return NewCascade(stages...)
+}
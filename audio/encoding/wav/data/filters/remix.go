@@ -0,0 +1,68 @@
+package filters
+
+// TODO: move to a different package outside of audio/encoding/wav/data
+
+// Remix returns a TransformFunc-compatible function that converts interleaved audio data with `from`
+// channels into interleaved audio data with `to` channels.
+//
+// When down-mixing, each output channel is the average of the input channels folded onto it (e.g.
+// stereo to mono averages the left and right channels), which avoids clipping. When up-mixing, the
+// input channels are repeated across the output channels (e.g. mono to stereo copies the signal into
+// both channels).
+//
+// Trailing samples in the input buffer that do not complete a frame are discarded. A zero value for
+// either `from` or `to` is treated as mono audio.
+func Remix(from, to uint16) func([]float64) []float64 {
+	if from == 0 {
+		from = 1
+	}
+
+	if to == 0 {
+		to = 1
+	}
+
+	if from == to {
+		return func(buffer []float64) []float64 {
+			return buffer
+		}
+	}
+
+	var (
+		inputs  = int(from)
+		outputs = int(to)
+		// weights holds the number of input channels folded onto each output channel
+		weights = make([]float64, outputs)
+	)
+
+	for c := range inputs {
+		weights[c%outputs]++
+	}
+
+	return func(buffer []float64) []float64 {
+		frames := len(buffer) / inputs
+		out := make([]float64, frames*outputs)
+
+		for i := range frames {
+			frame := buffer[i*inputs : (i+1)*inputs]
+			mix := out[i*outputs : (i+1)*outputs]
+
+			if outputs > inputs {
+				for c := range mix {
+					mix[c] = frame[c%inputs]
+				}
+
+				continue
+			}
+
+			for c := range frame {
+				mix[c%outputs] += frame[c]
+			}
+
+			for c := range mix {
+				mix[c] /= weights[c]
+			}
+		}
+
+		return out
+	}
+}
@@ -0,0 +1,286 @@
+package filters
+
+import "math"
+
+// TODO: move to a different package outside of audio/encoding/wav/data
+
+const (
+	// resampleHalfWidth is the number of input samples on each side of the windowed-sinc kernel's center,
+	// weighed into each output sample.
+	resampleHalfWidth = 32
+	// resampleRolloff places the cutoff frequency slightly below the (lowest) Nyquist frequency,
+	// leaving room for the kernel's transition band.
+	resampleRolloff = 0.95
+	// resampleKaiserBeta configures the Kaiser window's trade-off between stop-band attenuation and
+	// transition-band width (~90dB of attenuation).
+	resampleKaiserBeta = 9.0
+	// resampleMaxPhases caps the number of precomputed polyphase filters. Conversions requiring more
+	// phases than this (from uncommon sample rate pairs) compute the kernel on the fly instead.
+	resampleMaxPhases = 1024
+
+	besselEpsilon = 1e-21
+)
+
+// Resampler is a band-limited sample rate converter for (interleaved) floating-point audio data,
+// based on a Kaiser-windowed sinc kernel applied as a polyphase filter.
+//
+// A Resampler is stateful: it keeps the tail of the previous buffer as history, so that consecutive
+// buffers of the same signal (like the chunks processed by a wav.Stream) are converted without
+// discontinuities at their edges. As a consequence, the last resampleHalfWidth input samples of each
+// buffer are only converted once the following buffer is received, or when the Resampler is flushed at
+// the end of the signal. A single Resampler must not be shared across different signals, or used
+// concurrently. Use Reset to reuse a Resampler for a new signal.
+type Resampler struct {
+	from     uint32
+	to       uint32
+	channels int
+
+	// up and down are the interpolation and decimation factors, as the reduced fraction of to / from
+	up   int
+	down int
+
+	cutoff float64
+	phases [][]float64
+	kernel []float64
+
+	// history holds, per channel, the input samples not yet fully consumed by the filter
+	history [][]float64
+	// pending holds the trailing samples of a buffer which did not complete a frame
+	pending []float64
+	index   int
+	phase   int
+}
+
+// NewResampler creates a Resampler converting interleaved audio data with `channels` channels
+// from the sample rate `from` to the sample rate `to`.
+//
+// A zero value for `channels` is treated as mono audio.
+func NewResampler(from, to uint32, channels uint16) *Resampler {
+	if channels == 0 {
+		channels = 1
+	}
+
+	r := &Resampler{
+		from:     from,
+		to:       to,
+		channels: int(channels),
+	}
+
+	if from == 0 || to == 0 || from == to {
+		return r
+	}
+
+	div := gcd(int(from), int(to))
+	r.up = int(to) / div
+	r.down = int(from) / div
+	r.cutoff = resampleRolloff * math.Min(1, float64(to)/float64(from))
+
+	if r.up <= resampleMaxPhases {
+		r.phases = make([][]float64, r.up)
+
+		for p := range r.phases {
+			r.phases[p] = r.coefficients(p, make([]float64, 2*resampleHalfWidth))
+		}
+	} else {
+		r.kernel = make([]float64, 2*resampleHalfWidth)
+	}
+
+	r.Reset()
+
+	return r
+}
+
+// Reset clears the Resampler's state, so it can be used with a new signal.
+func (r *Resampler) Reset() {
+	if r.up == 0 {
+		return
+	}
+
+	r.history = make([][]float64, r.channels)
+
+	for c := range r.history {
+		// pad the start of the signal with silence, leaving the first input sample in the
+		// center of the kernel
+		r.history[c] = make([]float64, resampleHalfWidth-1)
+	}
+
+	r.pending = nil
+	r.index = resampleHalfWidth - 1
+	r.phase = 0
+}
+
+// Resample converts the interleaved audio data in `buffer` to the Resampler's target sample rate,
+// returning a new buffer with the converted audio data.
+//
+// The length of the output buffer is roughly len(buffer) * to / from, varying slightly between calls
+// as the fractional position of the converter progresses. Trailing samples that do not complete a
+// frame are held until the next call.
+func (r *Resampler) Resample(buffer []float64) []float64 {
+	if r.up == 0 {
+		return buffer
+	}
+
+	if len(r.pending) > 0 {
+		buffer = append(r.pending, buffer...)
+		r.pending = nil
+	}
+
+	frames := len(buffer) / r.channels
+
+	if rem := len(buffer) % r.channels; rem > 0 {
+		r.pending = append(make([]float64, 0, rem), buffer[len(buffer)-rem:]...)
+	}
+
+	for c := range r.history {
+		for i := range frames {
+			r.history[c] = append(r.history[c], buffer[i*r.channels+c])
+		}
+	}
+
+	available := len(r.history[0])
+	out := make([]float64, 0, (frames*r.up/r.down+1)*r.channels)
+	out = r.convolve(out, available-resampleHalfWidth)
+
+	// drop the input samples that are no longer reachable by the kernel
+	if drop := min(r.index-resampleHalfWidth+1, available); drop > 0 {
+		for c := range r.history {
+			r.history[c] = append(r.history[c][:0], r.history[c][drop:]...)
+		}
+
+		r.index -= drop
+	}
+
+	return out
+}
+
+// Flush converts the input samples still held in the Resampler's history, as if the signal was followed
+// by silence, returning the end of the converted audio data. It must be called once the signal ends, as
+// otherwise its last resampleHalfWidth samples are never converted.
+//
+// The Resampler is Reset afterwards, so it can be used with a new signal.
+func (r *Resampler) Flush() []float64 {
+	if r.up == 0 {
+		return nil
+	}
+
+	available := len(r.history[0])
+
+	for c := range r.history {
+		r.history[c] = append(r.history[c], make([]float64, resampleHalfWidth)...)
+	}
+
+	out := r.convolve(make([]float64, 0, (resampleHalfWidth*r.up/r.down+1)*r.channels), available)
+
+	r.Reset()
+
+	return out
+}
+
+// convolve appends to `out` the output samples centered on the history's input samples before `limit`.
+func (r *Resampler) convolve(out []float64, limit int) []float64 {
+	for r.index < limit {
+		coeffs := r.kernelAt(r.phase)
+		start := r.index - resampleHalfWidth + 1
+
+		for c := range r.history {
+			window := r.history[c][start : start+len(coeffs)]
+
+			var sum float64
+			for k := range coeffs {
+				sum += window[k] * coeffs[k]
+			}
+
+			out = append(out, sum)
+		}
+
+		r.phase += r.down
+		r.index += r.phase / r.up
+		r.phase %= r.up
+	}
+
+	return out
+}
+
+func (r *Resampler) kernelAt(phase int) []float64 {
+	if r.phases != nil {
+		return r.phases[phase]
+	}
+
+	return r.coefficients(phase, r.kernel)
+}
+
+// coefficients computes the filter taps for the output samples placed `phase` / r.up samples after
+// an input sample, normalized for unity gain.
+func (r *Resampler) coefficients(phase int, taps []float64) []float64 {
+	offset := float64(phase) / float64(r.up)
+
+	var sum float64
+
+	for j := range taps {
+		t := offset + float64(resampleHalfWidth-1-j)
+		taps[j] = r.cutoff * sinc(r.cutoff*t) * kaiser(t/resampleHalfWidth, resampleKaiserBeta)
+		sum += taps[j]
+	}
+
+	if sum != 0 {
+		for j := range taps {
+			taps[j] /= sum
+		}
+	}
+
+	return taps
+}
+
+// Resample returns a TransformFunc-compatible function that converts interleaved audio data with
+// `channels` channels from the sample rate `from` to the sample rate `to`, with a band-limited
+// (windowed-sinc) interpolation.
+//
+// The returned function is stateful, as it is backed by a Resampler; it is meant to be applied to
+// consecutive buffers of the same signal. As it cannot be flushed, the end of the signal is not converted;
+// use a Resampler directly when the signal has an end.
+func Resample(from, to uint32, channels uint16) func([]float64) []float64 {
+	return NewResampler(from, to, channels).Resample
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+
+	x *= math.Pi
+
+	return math.Sin(x) / x
+}
+
+// kaiser returns the Kaiser window value for the normalized position x, within [-1, 1].
+func kaiser(x, beta float64) float64 {
+	if x <= -1 || x >= 1 {
+		return 0
+	}
+
+	return bessel0(beta*math.Sqrt(1-x*x)) / bessel0(beta)
+}
+
+// bessel0 is the zeroth-order modified Bessel function of the first kind, computed from its power series.
+func bessel0(x float64) float64 {
+	var (
+		sum  = 1.0
+		term = 1.0
+		half = x / 2
+	)
+
+	for k := 1.0; term > besselEpsilon*sum; k++ {
+		term *= (half / k) * (half / k)
+		sum += term
+	}
+
+	return sum
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
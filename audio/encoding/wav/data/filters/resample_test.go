@@ -0,0 +1,243 @@
+package filters_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/encoding/wav/data/filters"
+)
+
+func sine(freq float64, sampleRate, size int) []float64 {
+	buf := make([]float64, size)
+
+	for i := range buf {
+		buf[i] = 0.5 * math.Sin(2*math.Pi*freq*float64(i)/float64(sampleRate))
+	}
+
+	return buf
+}
+
+func interleave(channels ...[]float64) []float64 {
+	buf := make([]float64, 0, len(channels)*len(channels[0]))
+
+	for i := range channels[0] {
+		for c := range channels {
+			buf = append(buf, channels[c][i])
+		}
+	}
+
+	return buf
+}
+
+// rms measures the root-mean-square of the buffer, skipping the first and last `skip` samples.
+func rms(buf []float64, skip int) float64 {
+	var sum float64
+
+	buf = buf[skip : len(buf)-skip]
+
+	for i := range buf {
+		sum += buf[i] * buf[i]
+	}
+
+	return math.Sqrt(sum / float64(len(buf)))
+}
+
+// correlate measures the normalized correlation between the buffer and a sine wave of frequency `freq`.
+func correlate(buf []float64, freq float64, sampleRate, skip int) float64 {
+	want := sine(freq, sampleRate, len(buf))
+
+	var dot, normA, normB float64
+
+	for i := skip; i < len(buf)-skip; i++ {
+		dot += buf[i] * want[i]
+		normA += buf[i] * buf[i]
+		normB += want[i] * want[i]
+	}
+
+	return dot / math.Sqrt(normA*normB)
+}
+
+func TestResample(t *testing.T) {
+	const size = 44100
+
+	for _, testcase := range []struct {
+		name string
+		from int
+		to   int
+		freq float64
+	}{
+		{name: "44100To48000", from: 44100, to: 48000, freq: 1000},
+		{name: "48000To44100", from: 48000, to: 44100, freq: 1000},
+		{name: "44100To96000", from: 44100, to: 96000, freq: 5000},
+		{name: "96000To44100", from: 96000, to: 44100, freq: 5000},
+		{name: "96000To48000", from: 96000, to: 48000, freq: 440},
+		{name: "LargePhaseCount", from: 44100, to: 44101, freq: 440},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			out := filters.Resample(uint32(testcase.from), uint32(testcase.to), 1)(sine(testcase.freq, testcase.from, size))
+
+			// the last input samples are held back until more data is received
+			held := 32 * testcase.to / testcase.from
+
+			require.InDelta(t, size*testcase.to/testcase.from, len(out), float64(held+1))
+			require.InDelta(t, 0.5/math.Sqrt2, rms(out, 64), 0.005)
+			require.Greater(t, correlate(out, testcase.freq, testcase.to, 64), 0.999)
+		})
+	}
+
+	t.Run("SuppressAliasing", func(t *testing.T) {
+		// 30kHz is above the Nyquist frequency of the output (22.05kHz)
+		out := filters.Resample(96000, 44100, 1)(sine(30000, 96000, 96000))
+
+		require.Less(t, rms(out, 64), 0.0005)
+	})
+
+	t.Run("Identity", func(t *testing.T) {
+		in := sine(440, 44100, 1024)
+
+		require.Equal(t, in, filters.Resample(44100, 44100, 1)(in))
+	})
+
+	t.Run("Chunked", func(t *testing.T) {
+		left := sine(440, 44100, 4410)
+		right := sine(880, 44100, 4410)
+		in := interleave(left, right)
+
+		whole := filters.Resample(44100, 48000, 2)(in)
+
+		resample := filters.Resample(44100, 48000, 2)
+		chunked := make([]float64, 0, len(whole))
+
+		// odd-sized chunks also split frames across calls
+		for i := 0; i < len(in); i += 333 {
+			chunked = append(chunked, resample(in[i:min(i+333, len(in))])...)
+		}
+
+		require.Len(t, chunked, len(whole))
+		require.InDeltaSlice(t, whole, chunked, 1e-12)
+	})
+
+	t.Run("Stereo", func(t *testing.T) {
+		left := sine(440, 44100, size)
+		right := sine(3000, 44100, size)
+
+		out := filters.Resample(44100, 48000, 2)(interleave(left, right))
+
+		outLeft := make([]float64, len(out)/2)
+		outRight := make([]float64, len(out)/2)
+
+		for i := range outLeft {
+			outLeft[i] = out[2*i]
+			outRight[i] = out[2*i+1]
+		}
+
+		require.Greater(t, correlate(outLeft, 440, 48000, 64), 0.999)
+		require.Greater(t, correlate(outRight, 3000, 48000, 64), 0.999)
+	})
+
+	t.Run("Flush", func(t *testing.T) {
+		for _, testcase := range []struct {
+			name     string
+			from     int
+			to       int
+			channels int
+			size     int
+		}{
+			{name: "44100To48000", from: 44100, to: 48000, channels: 1, size: 4410},
+			{name: "48000To44100", from: 48000, to: 44100, channels: 1, size: 4800},
+			{name: "96000To44100/Stereo", from: 96000, to: 44100, channels: 2, size: 9601},
+			{name: "LargePhaseCount", from: 44100, to: 44101, channels: 1, size: 1000},
+			{name: "ShorterThanKernel", from: 44100, to: 48000, channels: 2, size: 10},
+		} {
+			t.Run(testcase.name, func(t *testing.T) {
+				r := filters.NewResampler(uint32(testcase.from), uint32(testcase.to), uint16(testcase.channels))
+				channels := make([][]float64, testcase.channels)
+
+				for c := range channels {
+					channels[c] = sine(440*float64(c+1), testcase.from, testcase.size)
+				}
+
+				in := interleave(channels...)
+
+				out := append(r.Resample(in), r.Flush()...)
+
+				// one output frame per input frame position, rounded up
+				frames := (testcase.size*testcase.to + testcase.from - 1) / testcase.from
+
+				require.Len(t, out, frames*testcase.channels)
+
+				// the Resampler is reset after flushing
+				require.Empty(t, r.Flush())
+				require.Equal(t, out, append(r.Resample(in), r.Flush()...))
+			})
+		}
+	})
+
+	t.Run("FlushIdentity", func(t *testing.T) {
+		r := filters.NewResampler(44100, 44100, 1)
+
+		require.Empty(t, r.Flush())
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		in := sine(440, 44100, 4410)
+		r := filters.NewResampler(44100, 48000, 1)
+
+		first := r.Resample(in)
+		r.Reset()
+
+		require.Equal(t, first, r.Resample(in))
+	})
+}
+
+func TestRemix(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		from  uint16
+		to    uint16
+		input []float64
+		wants []float64
+	}{
+		{
+			name:  "MonoToStereo",
+			from:  1,
+			to:    2,
+			input: []float64{0.1, -0.2, 0.3},
+			wants: []float64{0.1, 0.1, -0.2, -0.2, 0.3, 0.3},
+		},
+		{
+			name:  "StereoToMono",
+			from:  2,
+			to:    1,
+			input: []float64{0.1, 0.3, -0.2, 0.2, 1.0, 1.0},
+			wants: []float64{0.2, 0.0, 1.0},
+		},
+		{
+			name:  "SameLayout",
+			from:  2,
+			to:    2,
+			input: []float64{0.1, 0.3, -0.2, 0.2},
+			wants: []float64{0.1, 0.3, -0.2, 0.2},
+		},
+		{
+			name:  "FourToStereo",
+			from:  4,
+			to:    2,
+			input: []float64{0.1, 0.2, 0.3, 0.4},
+			wants: []float64{0.2, 0.3},
+		},
+		{
+			name:  "IncompleteFrame",
+			from:  2,
+			to:    1,
+			input: []float64{0.1, 0.3, -0.2},
+			wants: []float64{0.2},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			require.InDeltaSlice(t, testcase.wants, filters.Remix(testcase.from, testcase.to)(testcase.input), 1e-12)
+		})
+	}
+}
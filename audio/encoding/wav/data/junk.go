@@ -110,6 +110,9 @@ func (d *Junk) Generate(_ osc.Type, _, _ int, _ time.Duration) {}
 // Apply transforms the floating-point audio data with each FilterFunc in `filters`.
 func (d *Junk) Apply(_ ...FilterFunc) {}
 
+// Transform replaces the floating-point audio data with the result of each TransformFunc in `transforms`.
+func (d *Junk) Transform(_ ...TransformFunc) {}
+
 // NewJunk creates a Junk with the input `subchunk` ChunkHeader, or with a default one if nil.
 func NewJunk(h *Header) *Junk {
 	if h == nil {
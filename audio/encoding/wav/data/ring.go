@@ -110,6 +110,23 @@ func (d *Ring) Apply(filters ...FilterFunc) {
 	_, _ = d.Data.Write(data)
 }
 
+// Transform replaces the floating-point audio data with the result of each TransformFunc in `transforms`.
+//
+// Since the Ring has a fixed capacity, only its most recent values are retained when a TransformFunc
+// returns a buffer larger than the Ring.
+func (d *Ring) Transform(transforms ...TransformFunc) {
+	data := d.Data.Value()
+
+	for i := range transforms {
+		data = transforms[i](data)
+	}
+
+	d.Data.Reset()
+
+	//nolint:errcheck // writing to the in-memory RingFilter should not raise any errors, and can be safely ignored.
+	_, _ = d.Data.Write(data)
+}
+
 // NewPCMRing creates a PCM Ring with the appropriate Converter, from the input
 // `bitDepth` and `subchunk`, with the fixed buffer-size `size`.
 func NewPCMRing(bitDepth uint16, h *Header, size int, proc func([]float64) error) *Ring {
@@ -0,0 +1,25 @@
+package wav
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/zalgonoise/x/audio/encoding/wav/data"
+)
+
+// NewRawReader wraps the io.Reader `r`, which yields raw (headerless) PCM data, with the encoded Header `h`
+// and a "data" subchunk header, so that it can be read as a WAV stream, e.g. by a Stream.
+//
+// The data subchunk is unsized, so the audio data is read until the io.Reader `r` is exhausted. The Header
+// is validated in the returned error, in which case the returned io.Reader is nil.
+func NewRawReader(h *Header, r io.Reader) (io.Reader, error) {
+	if err := ValidateHeader(h); err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, 0, Size+data.Size)
+	head = append(head, h.Bytes()...)
+	head = append(head, data.NewDataHeader().Bytes()...)
+
+	return io.MultiReader(bytes.NewReader(head), r), nil
+}
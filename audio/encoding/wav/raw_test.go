@@ -0,0 +1,50 @@
+package wav_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+)
+
+func TestNewRawReader(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		buf, err := testdataFS.ReadFile("data/internal/testdata/amen_kick/amen_kick_stereo_16bit_44100hz.wav")
+		require.NoError(t, err)
+
+		source, err := wav.Decode(buf)
+		require.NoError(t, err)
+
+		h, err := wav.NewHeader(source.Header.SampleRate, source.Header.BitsPerSample,
+			source.Header.NumChannels, source.Header.AudioFormat)
+		require.NoError(t, err)
+
+		r, err := wav.NewRawReader(h, bytes.NewReader(source.Data.Bytes()))
+		require.NoError(t, err)
+
+		var (
+			header  *wav.Header
+			samples int
+		)
+
+		w := wav.NewStream(func(h *wav.Header, data []float64) error {
+			header = h
+			samples += len(data)
+
+			return nil
+		}, wav.WithSize(1024))
+
+		_, err = w.ReadFrom(r)
+		require.NoError(t, err)
+
+		require.Equal(t, *h, *header)
+		require.Equal(t, len(source.Data.Float()), samples)
+	})
+
+	t.Run("InvalidHeader", func(t *testing.T) {
+		_, err := wav.NewRawReader(&wav.Header{}, bytes.NewReader(nil))
+		require.Error(t, err)
+	})
+}
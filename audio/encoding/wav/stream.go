@@ -193,6 +193,12 @@ func (w *Stream) ReadFrom(r io.Reader) (n int64, err error) {
 		n += num
 	}
 
+	if w.cfg.flush != nil {
+		if err = w.cfg.flush(ctx); err != nil {
+			return n, err
+		}
+	}
+
 	return n, nil
 }
 
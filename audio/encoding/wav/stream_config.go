@@ -12,7 +12,8 @@ type Config struct {
 	dur   time.Duration
 	ratio float64
 
-	hook HookContextFunc
+	hook  HookContextFunc
+	flush FlushFunc
 }
 
 // WithSize defines a concrete value for the Stream's buffer size (in bytes).
@@ -66,3 +67,16 @@ func WithHook(fn HookContextFunc) cfg.Option[Config] {
 		return config
 	})
 }
+
+// WithFlush sets a FlushFunc to be called once the Stream's data chunk is fully read, like a Converter's Flush.
+func WithFlush(fn FlushFunc) cfg.Option[Config] {
+	if fn == nil {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.flush = fn
+
+		return config
+	})
+}
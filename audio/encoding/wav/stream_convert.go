@@ -0,0 +1,184 @@
+package wav
+
+import (
+	"context"
+
+	"github.com/zalgonoise/x/audio/encoding/wav/data/filters"
+)
+
+type converter struct {
+	sampleRate  uint32
+	numChannels uint16
+
+	// source is a copy of the last seen input Header, used to detect changes in the audio layout
+	source Header
+	header *Header
+
+	transforms []func([]float64) []float64
+
+	// resampler is kept to be flushed at the end of the stream, as its transform holds back the tail of the signal
+	resampler *filters.Resampler
+	// remixTail is set when the resampled tail of the signal still needs to be remixed
+	remixTail func([]float64) []float64
+}
+
+// setup prepares the converter's output Header and transforms for the input Header `h`, if
+// it is not yet configured or if the input Header changed since the last call.
+func (c *converter) setup(h *Header) error {
+	if c.header != nil && c.source == *h {
+		return nil
+	}
+
+	out := *h
+
+	if c.sampleRate > 0 {
+		out.SampleRate = c.sampleRate
+	}
+
+	if c.numChannels > 0 {
+		out.NumChannels = c.numChannels
+	}
+
+	out.ByteRate = ByteRate(out.SampleRate, out.BitsPerSample, out.NumChannels)
+	out.BlockAlign = out.BitsPerSample * out.NumChannels / byteSize
+
+	if err := ValidateHeader(&out); err != nil {
+		return err
+	}
+
+	c.resampler = filters.NewResampler(h.SampleRate, out.SampleRate, min(h.NumChannels, out.NumChannels))
+	remix := filters.Remix(h.NumChannels, out.NumChannels)
+
+	// resample the signal while it holds the least amount of channels
+	switch {
+	case h.NumChannels > out.NumChannels:
+		c.transforms = []func([]float64) []float64{remix, c.resampler.Resample}
+		c.remixTail = nil
+	default:
+		c.transforms = []func([]float64) []float64{c.resampler.Resample, remix}
+		c.remixTail = remix
+	}
+
+	c.source = *h
+	c.header = &out
+
+	return nil
+}
+
+func (c *converter) convert(h *Header, data []float64) (*Header, []float64, error) {
+	if err := c.setup(h); err != nil {
+		return nil, nil, err
+	}
+
+	for i := range c.transforms {
+		data = c.transforms[i](data)
+	}
+
+	return c.header, data, nil
+}
+
+func (c *converter) flush() (*Header, []float64) {
+	if c.resampler == nil {
+		return nil, nil
+	}
+
+	data := c.resampler.Flush()
+
+	if c.remixTail != nil && len(data) > 0 {
+		data = c.remixTail(data)
+	}
+
+	return c.header, data
+}
+
+// Converter converts audio data to a sample rate and number of channels, before passing it on to a
+// ProcessContextFunc. Unlike Convert, it can be flushed at the end of the stream, so that the tail of the
+// signal held back by the resampler is also converted.
+//
+// A Converter is stateful and must be used with a single Stream, for example:
+//
+//	c := wav.NewConverter(proc, wav.SampleRate48000, wav.ChannelsMono)
+//	w := wav.NewStreamContext(c.Process, wav.WithFlush(c.Flush))
+type Converter struct {
+	c    converter
+	proc ProcessContextFunc
+}
+
+// NewConverter creates a Converter to the sample rate `sampleRate` and to `numChannels` channels, passing the
+// converted audio data to the ProcessContextFunc `proc`. A zero value for either `sampleRate` or `numChannels`
+// preserves the input's sample rate or number of channels, respectively.
+func NewConverter(proc ProcessContextFunc, sampleRate uint32, numChannels uint16) *Converter {
+	return &Converter{
+		c: converter{
+			sampleRate:  sampleRate,
+			numChannels: numChannels,
+		},
+		proc: proc,
+	}
+}
+
+// Process implements the ProcessContextFunc signature, converting the audio data before passing it on.
+//
+// The Header passed on is rewritten to describe the converted audio data.
+func (c *Converter) Process(ctx context.Context, header *Header, data []float64) error {
+	h, converted, err := c.c.convert(header, data)
+	if err != nil {
+		return err
+	}
+
+	if len(converted) == 0 {
+		return nil
+	}
+
+	return c.proc(ctx, h, converted)
+}
+
+// Flush passes on the audio data still held by the Converter, at the end of the stream. It implements the
+// FlushFunc signature, to be set in a Stream with WithFlush.
+func (c *Converter) Flush(ctx context.Context) error {
+	h, data := c.c.flush()
+	if len(data) == 0 {
+		return nil
+	}
+
+	return c.proc(ctx, h, data)
+}
+
+// Convert returns a ProcessFunc that converts the audio data to the sample rate `sampleRate` and
+// to `numChannels` channels, before passing it on to the ProcessFunc `proc`.
+//
+// The Header passed to `proc` is rewritten to describe the converted audio data. A zero value
+// for either `sampleRate` or `numChannels` preserves the input's sample rate or number of channels,
+// respectively. Sample rate conversion is done with a band-limited (windowed-sinc) resampler, and
+// channel conversion follows filters.Remix.
+//
+// Since the resampler keeps state across calls, the returned ProcessFunc must be used with a
+// single Stream; and changes in the input Header will reset the conversion. The returned ProcessFunc
+// cannot be flushed, so the tail of the signal is not passed on; use a Converter when the stream has an end.
+func Convert(proc ProcessFunc, sampleRate uint32, numChannels uint16) ProcessFunc {
+	c := &converter{
+		sampleRate:  sampleRate,
+		numChannels: numChannels,
+	}
+
+	return func(header *Header, data []float64) error {
+		h, converted, err := c.convert(header, data)
+		if err != nil {
+			return err
+		}
+
+		if len(converted) == 0 {
+			return nil
+		}
+
+		return proc(h, converted)
+	}
+}
+
+// ConvertContext returns a ProcessContextFunc that converts the audio data to the sample rate
+// `sampleRate` and to `numChannels` channels, before passing it on to the ProcessContextFunc `proc`.
+//
+// It behaves the same way as Convert.
+func ConvertContext(proc ProcessContextFunc, sampleRate uint32, numChannels uint16) ProcessContextFunc {
+	return NewConverter(proc, sampleRate, numChannels).Process
+}
@@ -0,0 +1,100 @@
+package wav_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+)
+
+func TestConvert(t *testing.T) {
+	for _, testcase := range []struct {
+		name        string
+		path        string
+		sampleRate  uint32
+		numChannels uint16
+		wants       wav.Header
+	}{
+		{
+			name:        "StereoToMono48000",
+			path:        "data/internal/testdata/amen_kick/amen_kick_stereo_16bit_44100hz.wav",
+			sampleRate:  wav.SampleRate48000,
+			numChannels: wav.ChannelsMono,
+		},
+		{
+			name:        "MonoToStereo",
+			path:        "data/internal/testdata/amen_kick/amen_kick_mono_32bit_96000hz.wav",
+			numChannels: wav.ChannelsStereo,
+		},
+		{
+			name:       "Downsample",
+			path:       "data/internal/testdata/amen_kick/amen_kick_mono_32bit_192000hz.wav",
+			sampleRate: wav.SampleRate44100,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			buf, err := testdataFS.ReadFile(testcase.path)
+			require.NoError(t, err)
+
+			source, err := wav.Decode(buf)
+			require.NoError(t, err)
+
+			var (
+				header  *wav.Header
+				samples int
+			)
+
+			c := wav.NewConverter(func(_ context.Context, h *wav.Header, data []float64) error {
+				header = h
+				samples += len(data)
+
+				return nil
+			}, testcase.sampleRate, testcase.numChannels)
+
+			w := wav.NewStreamContext(c.Process, wav.WithSize(1024), wav.WithFlush(c.Flush))
+
+			_, err = w.ReadFrom(bytes.NewReader(buf))
+			require.NoError(t, err)
+
+			sampleRate := source.Header.SampleRate
+			if testcase.sampleRate > 0 {
+				sampleRate = testcase.sampleRate
+			}
+
+			numChannels := source.Header.NumChannels
+			if testcase.numChannels > 0 {
+				numChannels = testcase.numChannels
+			}
+
+			require.NotNil(t, header)
+			require.Equal(t, sampleRate, header.SampleRate)
+			require.Equal(t, numChannels, header.NumChannels)
+			require.Equal(t, wav.ByteRate(sampleRate, header.BitsPerSample, numChannels), header.ByteRate)
+			require.Equal(t, header.BitsPerSample*numChannels/8, header.BlockAlign)
+			require.NoError(t, wav.ValidateHeader(header))
+
+			// the source Header is left untouched
+			require.NotEqual(t, w.Header, header)
+
+			frames := len(source.Data.Float()) / int(source.Header.NumChannels)
+			wants := frames * int(sampleRate) / int(source.Header.SampleRate) * int(numChannels)
+
+			// flushing the Converter passes on the whole signal, up to the last (rounded up) output frame
+			require.InDelta(t, wants, samples, float64(numChannels))
+		})
+	}
+
+	t.Run("InvalidSampleRate", func(t *testing.T) {
+		h, err := wav.NewHeader(wav.SampleRate44100, wav.BitDepth16, wav.ChannelsMono, wav.PCMFormat)
+		require.NoError(t, err)
+
+		err = wav.ConvertContext(func(context.Context, *wav.Header, []float64) error {
+			return nil
+		}, 22050, 0)(context.Background(), h, make([]float64, 64))
+
+		require.ErrorIs(t, err, wav.ErrInvalidSampleRate)
+	})
+}
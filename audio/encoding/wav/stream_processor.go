@@ -13,6 +13,10 @@ type ProcessFunc func(header *Header, data []float64) error
 // as it is read and decoded from the incoming byte stream, and also accepts a context.
 type ProcessContextFunc func(ctx context.Context, header *Header, data []float64) error
 
+// FlushFunc describes a function that is called once the audio data in the incoming byte stream is fully
+// read, so that processors buffering audio data across calls can pass it on.
+type FlushFunc func(ctx context.Context) error
+
 func processFuncWithContext(fn ProcessFunc) ProcessContextFunc {
 	return func(_ context.Context, header *Header, data []float64) error {
 		return fn(header, data)
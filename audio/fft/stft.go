@@ -0,0 +1,125 @@
+package fft
+
+import (
+	"math"
+
+	"github.com/zalgonoise/x/audio/fft/window"
+)
+
+// Frame is a single time frame of a Short-Time Fourier Transform (STFT), holding the magnitude of
+// each frequency bin of a windowed block of the signal.
+type Frame struct {
+	// Index is the sequence number of the Frame within the signal, starting at zero.
+	Index int
+	// Offset is the position (in samples) of the first sample of the Frame within the signal.
+	Offset int
+	// Magnitudes holds the magnitude of the frequency bins from 0Hz up to the Nyquist frequency (inclusive),
+	// for a total of size / 2 + 1 bins.
+	Magnitudes []float64
+}
+
+// STFT computes the Short-Time Fourier Transform of a (mono) signal, as a sequence of overlapping,
+// windowed blocks.
+//
+// An STFT is stateful: it keeps the samples that did not yet complete a block, so that the signal can be
+// pushed in chunks of any size (like the chunks processed by a wav.Stream) while the time frames are
+// still placed every hop samples, regardless of the chunk boundaries. As such, a single STFT must not be
+// shared across different signals, or used concurrently. Use Reset to reuse an STFT for a new signal.
+type STFT struct {
+	size   int
+	hop    int
+	window window.Window
+
+	buffer []float64
+	// offset is the position (in samples) of buffer[0] within the signal
+	offset int
+	index  int
+}
+
+// NewSTFT creates an STFT with blocks of `size` samples (rounded to the nearest supported block size), placed
+// every `hop` samples, and shaped by the window generated by `gen`.
+//
+// A `hop` value of zero or below, or greater than the block size, is replaced by half the block size (for a 50%
+// overlap between frames). A nil `gen` defaults to a Hann window.
+func NewSTFT(size, hop int, gen window.GeneratorFunc) *STFT {
+	size = NearestBlock(size)
+
+	if hop <= 0 || hop > size {
+		hop = size / 2
+	}
+
+	if gen == nil {
+		gen = window.Hann
+	}
+
+	return &STFT{
+		size:   size,
+		hop:    hop,
+		window: gen(size),
+		buffer: make([]float64, 0, 2*size),
+	}
+}
+
+// Size returns the STFT's block size, in samples.
+func (s *STFT) Size() int {
+	return s.size
+}
+
+// Hop returns the STFT's hop size, as the distance (in samples) between consecutive frames.
+func (s *STFT) Hop() int {
+	return s.hop
+}
+
+// Frequency returns the center frequency (in Hz) of the frequency bin `bin`, for a signal with a sample rate
+// of `sampleRate`.
+func (s *STFT) Frequency(bin int, sampleRate uint32) float64 {
+	return float64(bin) * float64(sampleRate) / float64(s.size)
+}
+
+// Apply pushes the (mono) audio data in `data` to the STFT, returning the Frame for each block completed by it.
+func (s *STFT) Apply(data []float64) []Frame {
+	s.buffer = append(s.buffer, data...)
+
+	if len(s.buffer) < s.size {
+		return nil
+	}
+
+	frames := make([]Frame, 0, (len(s.buffer)-s.size)/s.hop+1)
+	block := make([]float64, s.size)
+
+	var start int
+
+	for ; start+s.size <= len(s.buffer); start += s.hop {
+		copy(block, s.buffer[start:start+s.size])
+		s.window.Apply(block)
+
+		spectrum := FFT(ToComplex(block))
+		magnitudes := make([]float64, s.size/2+1)
+
+		for i := range magnitudes {
+			re, im := real(spectrum[i]), imag(spectrum[i])
+			magnitudes[i] = math.Sqrt(re*re + im*im)
+		}
+
+		frames = append(frames, Frame{
+			Index:      s.index,
+			Offset:     s.offset + start,
+			Magnitudes: magnitudes,
+		})
+
+		s.index++
+	}
+
+	// keep the samples that the next frames still overlap with
+	s.buffer = append(s.buffer[:0], s.buffer[start:]...)
+	s.offset += start
+
+	return frames
+}
+
+// Reset clears the STFT's state, so it can be used with a new signal.
+func (s *STFT) Reset() {
+	s.buffer = s.buffer[:0]
+	s.offset = 0
+	s.index = 0
+}
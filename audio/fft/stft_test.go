@@ -0,0 +1,78 @@
+package fft_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/fft/window"
+)
+
+func TestSTFT(t *testing.T) {
+	const (
+		sampleRate = 44100
+		size       = 1024
+		hop        = 256
+		freq       = 3000.0
+	)
+
+	data := make([]float64, sampleRate)
+	for i := range data {
+		data[i] = 0.5 * math.Sin(2*math.Pi*freq*float64(i)/sampleRate)
+	}
+
+	t.Run("Frames", func(t *testing.T) {
+		s := fft.NewSTFT(size, hop, window.Blackman)
+		frames := s.Apply(data)
+
+		require.Len(t, frames, (len(data)-size)/hop+1)
+
+		for i := range frames {
+			require.Equal(t, i, frames[i].Index)
+			require.Equal(t, i*hop, frames[i].Offset)
+			require.Len(t, frames[i].Magnitudes, size/2+1)
+
+			var peak int
+
+			for bin := range frames[i].Magnitudes {
+				if frames[i].Magnitudes[bin] > frames[i].Magnitudes[peak] {
+					peak = bin
+				}
+			}
+
+			require.InDelta(t, freq, s.Frequency(peak, sampleRate), sampleRate/size)
+		}
+	})
+
+	t.Run("Chunked", func(t *testing.T) {
+		whole := fft.NewSTFT(size, hop, nil).Apply(data)
+
+		s := fft.NewSTFT(size, hop, nil)
+		chunked := make([]fft.Frame, 0, len(whole))
+
+		for i := 0; i < len(data); i += 700 {
+			chunked = append(chunked, s.Apply(data[i:min(i+700, len(data))])...)
+		}
+
+		require.Equal(t, whole, chunked)
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		s := fft.NewSTFT(1000, 0, nil)
+
+		require.Equal(t, 512, s.Size())
+		require.Equal(t, 256, s.Hop())
+		require.Empty(t, s.Apply(data[:100]))
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		s := fft.NewSTFT(size, hop, nil)
+		first := s.Apply(data[:4096])
+
+		s.Reset()
+
+		require.Equal(t, first, s.Apply(data[:4096]))
+	})
+}
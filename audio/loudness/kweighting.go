@@ -0,0 +1,48 @@
+package loudness
+
+import (
+	"math"
+
+	"github.com/zalgonoise/x/audio/encoding/wav/data/filters"
+)
+
+// K-weighting filter parameters, as derived from the coefficients (at 48kHz) in ITU-R BS.1770, which allow
+// designing the same filters for any sample rate.
+const (
+	// shelfFreq, shelfGain and shelfQ describe the first stage of the K-weighting filter: a high-shelf filter
+	// modelling the acoustic effect of the head.
+	shelfFreq  = 1681.974450955533
+	shelfGain  = 3.999843853973347
+	shelfQ     = 0.7071752369554196
+	shelfSlope = 0.4996667741545416
+
+	// highPassFreq and highPassQ describe the second stage of the K-weighting filter: the revised low-frequency
+	// B-weighting (RLB) high-pass filter.
+	highPassFreq = 38.13547087602444
+	highPassQ    = 0.5003270373238773
+)
+
+// newKWeighting creates the two stages of the K-weighting filter, for audio data with a sample rate of
+// `sampleRate` and `channels` interleaved channels.
+func newKWeighting(sampleRate uint32, channels uint16) *filters.Cascade {
+	k := math.Tan(math.Pi * shelfFreq / float64(sampleRate))
+	vh := math.Pow(10, shelfGain/20)
+	vb := math.Pow(vh, shelfSlope)
+
+	shelf := filters.NewBiquadFromCoefficients(sampleRate,
+		vh+vb*k/shelfQ+k*k, 2*(k*k-vh), vh-vb*k/shelfQ+k*k,
+		1+k/shelfQ+k*k, 2*(k*k-1), 1-k/shelfQ+k*k,
+		channels,
+	)
+
+	k = math.Tan(math.Pi * highPassFreq / float64(sampleRate))
+
+	highPass := filters.NewBiquadFromCoefficients(sampleRate,
+		1, -2, 1,
+		// the RLB filter's numerator is not normalized, as in ITU-R BS.1770
+		1, 2*(k*k-1)/(1+k/highPassQ+k*k), (1-k/highPassQ+k*k)/(1+k/highPassQ+k*k),
+		channels,
+	)
+
+	return filters.NewCascade(shelf, highPass)
+}
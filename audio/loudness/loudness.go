@@ -0,0 +1,347 @@
+// Package loudness implements loudness metering as specified in ITU-R BS.1770 and EBU R128, measuring the
+// perceived loudness of an audio signal (in LUFS) rather than its sample levels.
+package loudness
+
+import (
+	"math"
+	"slices"
+
+	"github.com/zalgonoise/x/audio/encoding/wav/data/filters"
+)
+
+const (
+	// lufsOffset is the constant in the loudness formula of ITU-R BS.1770, which offsets the gain of the
+	// K-weighting filter at 1kHz.
+	lufsOffset = -0.691
+
+	// subBlocksPerSecond sets the duration of each sub-block (100ms); measurements are updated on each one.
+	subBlocksPerSecond = 10
+	// momentaryBlocks is the number of sub-blocks in the momentary loudness window (400ms).
+	momentaryBlocks = 4
+	// shortTermBlocks is the number of sub-blocks in the short-term loudness window (3s).
+	shortTermBlocks = 30
+
+	// absoluteGate is the level (in LUFS) below which blocks are ignored in the integrated loudness and
+	// loudness range measurements.
+	absoluteGate = -70.0
+	// integratedGate is the level (in LU, relative to the ungated loudness) below which blocks are ignored
+	// in the integrated loudness measurement.
+	integratedGate = -10.0
+	// rangeGate is the level (in LU, relative to the ungated loudness) below which blocks are ignored in the
+	// loudness range measurement.
+	rangeGate = -20.0
+
+	rangeLowPercentile  = 0.10
+	rangeHighPercentile = 0.95
+
+	// surroundWeight is the channel weight applied to the surround channels (in a 5.1 layout).
+	surroundWeight = 1.41
+	surroundLayout = 6
+
+	trueOversampling   = 4
+	trueOversamplingHi = 2
+	trueHighRate       = 96000
+	trueMaxRate        = 192000
+)
+
+// Measurement holds the loudness values of an audio signal at a given point in time.
+//
+// Loudness values are in LUFS (Loudness Units relative to Full Scale), the loudness range is in LU (Loudness
+// Units) and the true peak is in dBTP (decibels relative to full scale, true-peak). Values that cannot be
+// measured yet (e.g. when less audio than the measurement window was processed, or from silence) are set to
+// negative infinity, except for the loudness range, which is zero.
+type Measurement struct {
+	// Momentary is the loudness of the last 400ms of audio.
+	Momentary float64
+	// ShortTerm is the loudness of the last 3 seconds of audio.
+	ShortTerm float64
+	// Integrated is the (gated) loudness of the entire signal.
+	Integrated float64
+	// Range is the loudness range (LRA) of the entire signal, as the spread between its quiet and loud
+	// portions, as per EBU Tech 3342.
+	Range float64
+	// TruePeak is the maximum (oversampled) peak level of the entire signal.
+	TruePeak float64
+}
+
+// Meter measures the loudness of an audio signal, as specified in ITU-R BS.1770 and EBU R128.
+//
+// A Meter is stateful: it accumulates the signal's (K-weighted) power in blocks of 100ms, across consecutive
+// buffers of the same signal (like the chunks processed by a wav.Stream). As such, a single Meter must not be
+// shared across different signals, or used concurrently. Use Reset to reuse a Meter for a new signal.
+//
+// The integrated loudness and the loudness range keep a single value for each 100ms of audio, growing by 80 bytes
+// for each second of audio processed.
+type Meter struct {
+	sampleRate uint32
+	channels   int
+	weights    []float64
+
+	kWeighting  *filters.Cascade
+	oversampler *filters.Resampler
+
+	subBlockSize int
+	frames       int
+	next         int
+	sums         []float64
+
+	// recent holds the power of the last shortTermBlocks sub-blocks
+	recent []float64
+	// blocks holds the power of each momentary (400ms) block, for the integrated loudness
+	blocks []float64
+	// shortTerms holds the power of each short-term (3s) block, for the loudness range
+	shortTerms []float64
+
+	momentary float64
+	shortTerm float64
+	peak      float64
+}
+
+// NewMeter creates a Meter for audio data with a sample rate of `sampleRate` and `channels` interleaved channels.
+//
+// Channels are weighted as per ITU-R BS.1770: for a 6-channel (5.1) layout, the LFE channel (4th) is ignored and
+// the surround channels (5th and 6th) are boosted; otherwise, all channels weigh the same.
+//
+// A zero value for `sampleRate` is treated as 44.1kHz, and a zero value for `channels` is treated as mono audio.
+func NewMeter(sampleRate uint32, channels uint16) *Meter {
+	if sampleRate == 0 {
+		sampleRate = 44100
+	}
+
+	if channels == 0 {
+		channels = 1
+	}
+
+	weights := make([]float64, channels)
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	if channels == surroundLayout {
+		weights[3] = 0
+		weights[4] = surroundWeight
+		weights[5] = surroundWeight
+	}
+
+	m := &Meter{
+		sampleRate:   sampleRate,
+		channels:     int(channels),
+		weights:      weights,
+		subBlockSize: max(int(sampleRate)/subBlocksPerSecond, 1),
+	}
+
+	m.Reset()
+
+	return m
+}
+
+// Reset clears the Meter's state, so it can be used with a new signal.
+func (m *Meter) Reset() {
+	m.kWeighting = newKWeighting(m.sampleRate, uint16(m.channels))
+
+	switch {
+	case m.sampleRate < trueHighRate:
+		m.oversampler = filters.NewResampler(m.sampleRate, m.sampleRate*trueOversampling, uint16(m.channels))
+	case m.sampleRate < trueMaxRate:
+		m.oversampler = filters.NewResampler(m.sampleRate, m.sampleRate*trueOversamplingHi, uint16(m.channels))
+	default:
+		m.oversampler = nil
+	}
+
+	m.frames = 0
+	m.next = 0
+	m.sums = make([]float64, m.channels)
+	m.recent = make([]float64, 0, shortTermBlocks)
+	m.blocks = m.blocks[:0]
+	m.shortTerms = m.shortTerms[:0]
+	m.momentary = 0
+	m.shortTerm = 0
+	m.peak = 0
+}
+
+// Write pushes the interleaved audio data in `data` to the Meter, updating its measurements.
+func (m *Meter) Write(data []float64) {
+	m.measurePeak(data)
+
+	weighted := make([]float64, len(data))
+	copy(weighted, data)
+
+	m.kWeighting.Filter(weighted)
+
+	for i := range weighted {
+		m.sums[m.next] += weighted[i] * weighted[i]
+
+		if m.next++; m.next < m.channels {
+			continue
+		}
+
+		m.next = 0
+
+		if m.frames++; m.frames == m.subBlockSize {
+			m.closeSubBlock()
+		}
+	}
+}
+
+func (m *Meter) measurePeak(data []float64) {
+	for i := range data {
+		m.peak = max(m.peak, math.Abs(data[i]))
+	}
+
+	if m.oversampler == nil {
+		return
+	}
+
+	for _, value := range m.oversampler.Resample(data) {
+		m.peak = max(m.peak, math.Abs(value))
+	}
+}
+
+func (m *Meter) closeSubBlock() {
+	var power float64
+
+	for c := range m.sums {
+		power += m.weights[c] * m.sums[c] / float64(m.subBlockSize)
+		m.sums[c] = 0
+	}
+
+	m.frames = 0
+
+	if len(m.recent) == shortTermBlocks {
+		m.recent = append(m.recent[:0], m.recent[1:]...)
+	}
+
+	m.recent = append(m.recent, power)
+
+	if len(m.recent) >= momentaryBlocks {
+		m.momentary = mean(m.recent[len(m.recent)-momentaryBlocks:])
+		m.blocks = append(m.blocks, m.momentary)
+	}
+
+	if len(m.recent) == shortTermBlocks {
+		m.shortTerm = mean(m.recent)
+		m.shortTerms = append(m.shortTerms, m.shortTerm)
+	}
+}
+
+// Momentary returns the loudness (in LUFS) of the last 400ms of audio.
+func (m *Meter) Momentary() float64 {
+	return lufs(m.momentary)
+}
+
+// ShortTerm returns the loudness (in LUFS) of the last 3 seconds of audio.
+func (m *Meter) ShortTerm() float64 {
+	return lufs(m.shortTerm)
+}
+
+// Integrated returns the gated loudness (in LUFS) of the entire signal, as per ITU-R BS.1770.
+func (m *Meter) Integrated() float64 {
+	gated := gate(m.blocks, integratedGate)
+	if len(gated) == 0 {
+		return math.Inf(-1)
+	}
+
+	return lufs(mean(gated))
+}
+
+// Range returns the loudness range (in LU) of the entire signal, as per EBU Tech 3342.
+func (m *Meter) Range() float64 {
+	gated := gate(m.shortTerms, rangeGate)
+	if len(gated) == 0 {
+		return 0
+	}
+
+	values := make([]float64, len(gated))
+	for i := range gated {
+		values[i] = lufs(gated[i])
+	}
+
+	slices.Sort(values)
+
+	return percentile(values, rangeHighPercentile) - percentile(values, rangeLowPercentile)
+}
+
+// TruePeak returns the maximum (oversampled) peak level (in dBTP) of the entire signal.
+//
+// The signal is oversampled four times (or twice, for sample rates of 96kHz and above) to find the peaks between
+// samples, as per ITU-R BS.1770.
+func (m *Meter) TruePeak() float64 {
+	if m.peak == 0 {
+		return math.Inf(-1)
+	}
+
+	return 20 * math.Log10(m.peak)
+}
+
+// Measure returns all of the Meter's measurements.
+func (m *Meter) Measure() Measurement {
+	return Measurement{
+		Momentary:  m.Momentary(),
+		ShortTerm:  m.ShortTerm(),
+		Integrated: m.Integrated(),
+		Range:      m.Range(),
+		TruePeak:   m.TruePeak(),
+	}
+}
+
+// gate applies the absolute gate and then the relative gate `relative` (in LU) to the blocks' power values,
+// returning the ones above both.
+func gate(blocks []float64, relative float64) []float64 {
+	threshold := math.Pow(10, (absoluteGate-lufsOffset)/10)
+
+	gated := make([]float64, 0, len(blocks))
+
+	for i := range blocks {
+		if blocks[i] > threshold {
+			gated = append(gated, blocks[i])
+		}
+	}
+
+	if len(gated) == 0 {
+		return nil
+	}
+
+	threshold = mean(gated) * math.Pow(10, relative/10)
+
+	n := 0
+
+	for i := range gated {
+		if gated[i] > threshold {
+			gated[n] = gated[i]
+			n++
+		}
+	}
+
+	return gated[:n]
+}
+
+// percentile returns the value at the percentile `p` (from 0.0 to 1.0) of the sorted `values`, interpolating
+// between the nearest ranks.
+func percentile(values []float64, p float64) float64 {
+	pos := p * float64(len(values)-1)
+	idx := int(pos)
+
+	if idx >= len(values)-1 {
+		return values[len(values)-1]
+	}
+
+	return values[idx] + (values[idx+1]-values[idx])*(pos-float64(idx))
+}
+
+func lufs(power float64) float64 {
+	if power <= 0 {
+		return math.Inf(-1)
+	}
+
+	return lufsOffset + 10*math.Log10(power)
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+
+	for i := range values {
+		sum += values[i]
+	}
+
+	return sum / float64(len(values))
+}
@@ -0,0 +1,142 @@
+package loudness_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/loudness"
+)
+
+// tone generates a stereo 1kHz sine wave with a peak level of `dbfs`, lasting `seconds`.
+func tone(sampleRate int, dbfs, seconds float64) []float64 {
+	var (
+		amplitude = math.Pow(10, dbfs/20)
+		buf       = make([]float64, 2*int(seconds*float64(sampleRate)))
+	)
+
+	for i := 0; i < len(buf)/2; i++ {
+		value := amplitude * math.Sin(2*math.Pi*1000*float64(i)/float64(sampleRate))
+
+		buf[2*i] = value
+		buf[2*i+1] = value
+	}
+
+	return buf
+}
+
+func TestMeter(t *testing.T) {
+	// reference cases from EBU Tech 3341 and Tech 3342
+	for _, sampleRate := range []int{44100, 48000} {
+		t.Run("", func(t *testing.T) {
+			t.Run("Integrated/Minus23", func(t *testing.T) {
+				m := loudness.NewMeter(uint32(sampleRate), 2)
+				m.Write(tone(sampleRate, -23, 5))
+
+				require.InDelta(t, -23, m.Integrated(), 0.1)
+				require.InDelta(t, -23, m.Momentary(), 0.1)
+				require.InDelta(t, -23, m.ShortTerm(), 0.1)
+			})
+
+			t.Run("Integrated/Minus33", func(t *testing.T) {
+				m := loudness.NewMeter(uint32(sampleRate), 2)
+				m.Write(tone(sampleRate, -33, 5))
+
+				require.InDelta(t, -33, m.Integrated(), 0.1)
+			})
+
+			t.Run("Integrated/RelativeGate", func(t *testing.T) {
+				m := loudness.NewMeter(uint32(sampleRate), 2)
+				m.Write(tone(sampleRate, -36, 5))
+				m.Write(tone(sampleRate, -23, 20))
+				m.Write(tone(sampleRate, -36, 5))
+
+				require.InDelta(t, -23, m.Integrated(), 0.1)
+			})
+
+			t.Run("Integrated/AbsoluteGate", func(t *testing.T) {
+				m := loudness.NewMeter(uint32(sampleRate), 2)
+				m.Write(tone(sampleRate, -72, 5))
+				m.Write(tone(sampleRate, -26, 10))
+				m.Write(tone(sampleRate, -20, 0.01))
+				m.Write(tone(sampleRate, -26, 5))
+				m.Write(tone(sampleRate, -72, 5))
+
+				require.InDelta(t, -26, m.Integrated(), 0.1)
+			})
+
+			t.Run("Range", func(t *testing.T) {
+				m := loudness.NewMeter(uint32(sampleRate), 2)
+				m.Write(tone(sampleRate, -20, 10))
+				m.Write(tone(sampleRate, -30, 10))
+
+				require.InDelta(t, 10, m.Range(), 1)
+			})
+
+			t.Run("Range/Steady", func(t *testing.T) {
+				m := loudness.NewMeter(uint32(sampleRate), 2)
+				m.Write(tone(sampleRate, -20, 10))
+
+				require.InDelta(t, 0, m.Range(), 0.1)
+			})
+		})
+	}
+
+	t.Run("TruePeak", func(t *testing.T) {
+		const sampleRate = 48000
+
+		// a sine at a quarter of the sample rate, out of phase with the samples, peaks between them;
+		// faded in to avoid the overshoot of an abrupt start
+		buf := make([]float64, sampleRate)
+		for i := range buf {
+			buf[i] = 0.5 * math.Sin(math.Pi/2*float64(i)+math.Pi/4) * min(1, float64(i)/1000)
+		}
+
+		m := loudness.NewMeter(sampleRate, 1)
+		m.Write(buf)
+
+		require.InDelta(t, -6.02, m.TruePeak(), 0.1)
+	})
+
+	t.Run("Chunked", func(t *testing.T) {
+		buf := append(tone(44100, -20, 5), tone(44100, -30, 5)...)
+
+		whole := loudness.NewMeter(44100, 2)
+		whole.Write(buf)
+
+		chunked := loudness.NewMeter(44100, 2)
+		for i := 0; i < len(buf); i += 1001 {
+			chunked.Write(buf[i:min(i+1001, len(buf))])
+		}
+
+		require.InDelta(t, whole.Integrated(), chunked.Integrated(), 1e-9)
+		require.InDelta(t, whole.Momentary(), chunked.Momentary(), 1e-9)
+		require.InDelta(t, whole.ShortTerm(), chunked.ShortTerm(), 1e-9)
+		require.InDelta(t, whole.Range(), chunked.Range(), 1e-9)
+		require.InDelta(t, whole.TruePeak(), chunked.TruePeak(), 1e-9)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		m := loudness.NewMeter(48000, 2)
+		m.Write(make([]float64, 2*48000))
+
+		require.Equal(t, loudness.Measurement{
+			Momentary:  math.Inf(-1),
+			ShortTerm:  math.Inf(-1),
+			Integrated: math.Inf(-1),
+			Range:      0,
+			TruePeak:   math.Inf(-1),
+		}, m.Measure())
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		m := loudness.NewMeter(48000, 2)
+		m.Write(tone(48000, -10, 5))
+		m.Reset()
+		m.Write(tone(48000, -23, 5))
+
+		require.InDelta(t, -23, m.Integrated(), 0.1)
+		require.InDelta(t, -23, m.TruePeak(), 0.1)
+	})
+}
@@ -0,0 +1,177 @@
+package osc
+
+import "math"
+
+// maxPhaseIncrement caps the oscillator's frequency slightly below the Nyquist frequency, where the
+// PolyBLEP residuals of consecutive discontinuities would overlap.
+const maxPhaseIncrement = 0.499
+
+// PolyBLEP is a stateful, band-limited oscillator, which generates waves with a continuous phase across calls,
+// at any (floating-point) frequency.
+//
+// The naive square and sawtooth waves (like the ones written by Square or SawtoothUp) have instant jumps which
+// contain harmonics above the Nyquist frequency, that alias (or fold back) into the audible range, especially
+// at high frequencies. PolyBLEP smooths each jump with a polynomial band-limited step (PolyBLEP), and each
+// corner of the triangle wave with its integral (PolyBLAMP), greatly attenuating the aliased harmonics.
+//
+// The generated values range from -1.0 to 1.0, and each wave shape starts at the same phase as its naive
+// counterpart. A PolyBLEP must not be used concurrently.
+type PolyBLEP struct {
+	waveType   Type
+	sampleRate float64
+	freq       float64
+
+	// increment is the phase increment per sample, as a fraction of a cycle
+	increment float64
+	// phase is the position in the current cycle, from 0.0 to 1.0
+	phase float64
+}
+
+// NewPolyBLEP creates a PolyBLEP oscillator for the wave shape of `waveType`, with frequency `freq` and sample
+// rate `sampleRate`.
+//
+// Both the naive and band-limited Type variants result in the same (band-limited) wave shape. An unknown
+// Type results in a sine wave, which is band-limited by definition.
+func NewPolyBLEP(waveType Type, freq float64, sampleRate int) *PolyBLEP {
+	o := &PolyBLEP{
+		waveType:   waveType,
+		sampleRate: float64(max(sampleRate, 1)),
+	}
+
+	o.SetFrequency(freq)
+
+	return o
+}
+
+// SetFrequency changes the PolyBLEP's frequency to `freq`, keeping its phase. Frequencies are limited to the range
+// between 0 and the Nyquist frequency.
+func (o *PolyBLEP) SetFrequency(freq float64) {
+	o.freq = math.Max(0, math.Min(freq, maxPhaseIncrement*o.sampleRate))
+	o.increment = o.freq / o.sampleRate
+}
+
+// Frequency returns the PolyBLEP's frequency.
+func (o *PolyBLEP) Frequency() float64 {
+	return o.freq
+}
+
+// Reset restarts the PolyBLEP's phase.
+func (o *PolyBLEP) Reset() {
+	o.phase = 0
+}
+
+// Next returns the next sample of the wave, advancing the PolyBLEP's phase.
+func (o *PolyBLEP) Next() float64 {
+	var (
+		phase = o.phase
+		value float64
+	)
+
+	switch o.waveType {
+	case SquareWave, BandLimitedSquareWave:
+		value = -1.0
+		if phase < 0.5 {
+			value = 1.0
+		}
+
+		value += polyBLEP(phase, o.increment) - polyBLEP(wrap(phase+0.5), o.increment)
+	case TriangleWave, BandLimitedTriangleWave:
+		value = 3 - 4*phase
+		if phase < 0.5 {
+			value = 4*phase - 1
+		}
+
+		value += 4 * o.increment * (polyBLAMP(phase, o.increment) - polyBLAMP(wrap(phase+0.5), o.increment))
+	case SawtoothUpWave, BandLimitedSawtoothUpWave:
+		value = 2*phase - 1 - polyBLEP(phase, o.increment)
+	case SawtoothDownWave, BandLimitedSawtoothDownWave:
+		value = 1 - 2*phase + polyBLEP(phase, o.increment)
+	default:
+		value = math.Sin(tau * phase)
+	}
+
+	o.phase = wrap(phase + o.increment)
+
+	return value
+}
+
+// Read writes the next samples of the wave into `buffer`, advancing the PolyBLEP's phase.
+func (o *PolyBLEP) Read(buffer []float64) {
+	for i := range buffer {
+		buffer[i] = o.Next()
+	}
+}
+
+// polyBLEP returns the residual of a polynomial band-limited step at phase `t`, for a phase increment `dt`;
+// which is non-zero in the samples around a discontinuity at phase 0.
+func polyBLEP(t, dt float64) float64 {
+	switch {
+	case dt <= 0:
+		return 0
+	case t < dt:
+		t /= dt
+
+		return t + t - t*t - 1
+	case t > 1-dt:
+		t = (t - 1) / dt
+
+		return t*t + t + t + 1
+	default:
+		return 0
+	}
+}
+
+// polyBLAMP returns the residual of a polynomial band-limited ramp (the integral of polyBLEP) at phase `t`, for a
+// phase increment `dt`; which is non-zero in the samples around a corner at phase 0.
+func polyBLAMP(t, dt float64) float64 {
+	switch {
+	case dt <= 0:
+		return 0
+	case t < dt:
+		t = t/dt - 1
+
+		return -t * t * t / 3
+	case t > 1-dt:
+		t = (t-1)/dt + 1
+
+		return t * t * t / 3
+	default:
+		return 0
+	}
+}
+
+func wrap(phase float64) float64 {
+	return phase - math.Floor(phase)
+}
+
+// BandLimitedSquare is an oscillator that writes a band-limited (PolyBLEP) square wave of frequency `freq`,
+// bit-depth `depth`, and sample rate `sampleRate`, into the buffer `buffer`.
+func BandLimitedSquare(buffer []float64, freq, depth, sampleRate int) {
+	bandLimited(buffer, BandLimitedSquareWave, freq, depth, sampleRate)
+}
+
+// BandLimitedTriangle is an oscillator that writes a band-limited (PolyBLAMP) triangle wave of frequency `freq`,
+// bit-depth `depth`, and sample rate `sampleRate`, into the buffer `buffer`.
+func BandLimitedTriangle(buffer []float64, freq, depth, sampleRate int) {
+	bandLimited(buffer, BandLimitedTriangleWave, freq, depth, sampleRate)
+}
+
+// BandLimitedSawtoothUp is an oscillator that writes a band-limited (PolyBLEP) rising sawtooth wave of frequency
+// `freq`, bit-depth `depth`, and sample rate `sampleRate`, into the buffer `buffer`.
+func BandLimitedSawtoothUp(buffer []float64, freq, depth, sampleRate int) {
+	bandLimited(buffer, BandLimitedSawtoothUpWave, freq, depth, sampleRate)
+}
+
+// BandLimitedSawtoothDown is an oscillator that writes a band-limited (PolyBLEP) falling sawtooth wave of frequency
+// `freq`, bit-depth `depth`, and sample rate `sampleRate`, into the buffer `buffer`.
+func BandLimitedSawtoothDown(buffer []float64, freq, depth, sampleRate int) {
+	bandLimited(buffer, BandLimitedSawtoothDownWave, freq, depth, sampleRate)
+}
+
+func bandLimited(buffer []float64, waveType Type, freq, depth, sampleRate int) {
+	NewPolyBLEP(waveType, float64(freq), sampleRate).Read(buffer)
+
+	for i := range buffer {
+		buffer[i] *= float64(int(2)<<(depth-2) - 1)
+	}
+}
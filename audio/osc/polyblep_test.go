@@ -0,0 +1,128 @@
+package osc_test
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/fft/window"
+	"github.com/zalgonoise/x/audio/osc"
+)
+
+// magnitude returns the amplitude of the frequency `freq` in the signal `buffer`, with a single-bin DFT.
+func magnitude(buffer []float64, freq, sampleRate float64) float64 {
+	var re, im float64
+
+	for i := range buffer {
+		w := 2 * math.Pi * freq * float64(i) / sampleRate
+		re += buffer[i] * math.Cos(w)
+		im -= buffer[i] * math.Sin(w)
+	}
+
+	return 2 * math.Hypot(re, im) / float64(len(buffer))
+}
+
+func TestBandLimited(t *testing.T) {
+	const (
+		sampleRate = 44100
+		maxDrift   = 50
+		blockSize  = 1024
+	)
+
+	for _, waveType := range []osc.Type{
+		osc.BandLimitedSquareWave,
+		osc.BandLimitedTriangleWave,
+		osc.BandLimitedSawtoothUpWave,
+		osc.BandLimitedSawtoothDownWave,
+	} {
+		for _, testFreq := range []int{13, 2000, 4000, 8000, 16000} {
+			t.Run(fmt.Sprintf("%d/%dHz", waveType, testFreq), func(t *testing.T) {
+				chunk := wav.NewChunk(nil, 16, 1)
+
+				chunk.Generate(waveType, testFreq, sampleRate, 500*time.Millisecond)
+				require.NotEmpty(t, chunk.Value())
+
+				spectrum := fft.Apply(
+					sampleRate, chunk.Float()[:blockSize],
+					window.New(window.Blackman, blockSize),
+				)
+
+				sort.Slice(spectrum, func(i, j int) bool {
+					return spectrum[i].Mag > spectrum[j].Mag
+				})
+
+				require.InDelta(t, testFreq, spectrum[0].Freq, maxDrift)
+			})
+		}
+	}
+}
+
+func TestPolyBLEP(t *testing.T) {
+	const (
+		sampleRate = 44100
+		freq       = 3000
+		// the 9th harmonic of a 3kHz wave (27kHz) aliases to 17.1kHz
+		aliasFreq = 17100
+	)
+
+	t.Run("Aliasing", func(t *testing.T) {
+		for _, testcase := range []struct {
+			naive       osc.Oscillator
+			bandLimited osc.Type
+		}{
+			{osc.Square, osc.BandLimitedSquareWave},
+			{osc.Triangle, osc.BandLimitedTriangleWave},
+			{osc.SawtoothUp, osc.BandLimitedSawtoothUpWave},
+			{osc.SawtoothDown, osc.BandLimitedSawtoothDownWave},
+		} {
+			t.Run(fmt.Sprintf("%d", testcase.bandLimited), func(t *testing.T) {
+				naive := make([]float64, sampleRate)
+				testcase.naive(naive, freq, 2, sampleRate)
+
+				bandLimited := make([]float64, sampleRate)
+				osc.NewPolyBLEP(testcase.bandLimited, freq, sampleRate).Read(bandLimited)
+
+				// the fundamental is preserved, and the aliased harmonic is attenuated by at least 9dB
+				require.InDelta(t, magnitude(naive, freq, sampleRate), magnitude(bandLimited, freq, sampleRate), 0.05)
+				require.Less(t,
+					magnitude(bandLimited, aliasFreq, sampleRate),
+					magnitude(naive, aliasFreq, sampleRate)/math.Pow(10, 9.0/20),
+				)
+			})
+		}
+	})
+
+	t.Run("PhaseContinuity", func(t *testing.T) {
+		whole := make([]float64, 1000)
+		osc.NewPolyBLEP(osc.SquareWave, 440.5, sampleRate).Read(whole)
+
+		o := osc.NewPolyBLEP(osc.SquareWave, 440.5, sampleRate)
+		chunked := make([]float64, 1000)
+		o.Read(chunked[:333])
+		o.Read(chunked[333:])
+
+		require.Equal(t, whole, chunked)
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		for _, waveType := range []osc.Type{osc.SineWave, osc.SquareWave, osc.TriangleWave, osc.SawtoothUpWave} {
+			buffer := make([]float64, sampleRate)
+			osc.NewPolyBLEP(waveType, 1000, sampleRate).Read(buffer)
+
+			for i := range buffer {
+				require.LessOrEqual(t, math.Abs(buffer[i]), 1.0+1e-9)
+			}
+		}
+	})
+
+	t.Run("Nyquist", func(t *testing.T) {
+		o := osc.NewPolyBLEP(osc.SineWave, 30000, sampleRate)
+		require.Less(t, o.Frequency(), float64(sampleRate)/2)
+	})
+}
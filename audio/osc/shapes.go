@@ -18,6 +18,14 @@ const (
 	SawtoothUpWave
 	// SawtoothDownWave is the oscillator Type for a falling Sawtooth Oscillator.
 	SawtoothDownWave
+	// BandLimitedSquareWave is the oscillator Type for a band-limited (PolyBLEP) Square Oscillator.
+	BandLimitedSquareWave
+	// BandLimitedTriangleWave is the oscillator Type for a band-limited (PolyBLAMP) Triangle Oscillator.
+	BandLimitedTriangleWave
+	// BandLimitedSawtoothUpWave is the oscillator Type for a band-limited (PolyBLEP) rising Sawtooth Oscillator.
+	BandLimitedSawtoothUpWave
+	// BandLimitedSawtoothDownWave is the oscillator Type for a band-limited (PolyBLEP) falling Sawtooth Oscillator.
+	BandLimitedSawtoothDownWave
 )
 
 func NewOscillator(waveType Type) Oscillator {
@@ -32,6 +40,14 @@ func NewOscillator(waveType Type) Oscillator {
 		return SawtoothUp
 	case SawtoothDownWave:
 		return SawtoothDown
+	case BandLimitedSquareWave:
+		return BandLimitedSquare
+	case BandLimitedTriangleWave:
+		return BandLimitedTriangle
+	case BandLimitedSawtoothUpWave:
+		return BandLimitedSawtoothUp
+	case BandLimitedSawtoothDownWave:
+		return BandLimitedSawtoothDown
 	default:
 		return nil
 	}
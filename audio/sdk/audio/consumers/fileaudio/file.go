@@ -0,0 +1,279 @@
+package fileaudio
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/sdk/audio"
+)
+
+const (
+	riffHeaderSize  = 12
+	chunkHeaderSize = 8
+	minFmtSize      = 16
+	extensibleSize  = 26
+
+	riffID           = "RIFF"
+	waveID           = "WAVE"
+	fmtID            = "fmt "
+	dataID           = "data"
+	extensibleFormat = 0xFFFE
+)
+
+type fileConsumer struct {
+	logger  *slog.Logger
+	metrics audio.ConsumerMetrics
+	tracer  trace.Tracer
+
+	cfg Config
+
+	file *os.File
+}
+
+// Consume interacts with the audio source to extract its audio content or stream as an io.Reader.
+//
+// It opens the configured WAV file and returns its audio data, re-encoded with a canonical WAV header, so that
+// any additional (non-audio) chunks in the file are skipped. If configured, the audio data is looped and / or read
+// in realtime.
+func (c *fileConsumer) Consume(ctx context.Context) (reader io.Reader, err error) {
+	c.logger.DebugContext(ctx, "opening WAV file",
+		slog.String("path", c.cfg.path),
+	)
+
+	file, err := os.Open(c.cfg.path)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "error opening WAV file",
+			slog.String("path", c.cfg.path),
+			slog.String("error", err.Error()),
+		)
+
+		return nil, err
+	}
+
+	h, offset, size, err := locate(file)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "error reading WAV file",
+			slog.String("path", c.cfg.path),
+			slog.String("error", err.Error()),
+		)
+
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	c.file = file
+
+	section := io.NewSectionReader(file, offset, size)
+	reader = section
+
+	if c.cfg.loop && size > 0 {
+		reader = &loopReader{r: section}
+	}
+
+	if c.cfg.realtime {
+		reader = &pacedReader{ctx: ctx, r: reader, byteRate: float64(h.ByteRate)}
+	}
+
+	c.logger.InfoContext(ctx, "opened WAV file",
+		slog.String("path", c.cfg.path),
+		slog.Int("sample_rate", int(h.SampleRate)),
+		slog.Int("bit_depth", int(h.BitsPerSample)),
+		slog.Int("num_channels", int(h.NumChannels)),
+		slog.Bool("loop", c.cfg.loop),
+		slog.Bool("realtime", c.cfg.realtime),
+	)
+
+	return wav.NewRawReader(h, reader)
+}
+
+// Shutdown gracefully shuts down the Consumer.
+func (c *fileConsumer) Shutdown(ctx context.Context) error {
+	c.logger.InfoContext(ctx, "closing WAV file")
+
+	if c.file == nil {
+		return nil
+	}
+
+	return c.file.Close()
+}
+
+// locate reads the RIFF chunks in the WAV file `f`, returning the Header described in its fmt chunk, as well as
+// the offset and size of its data chunk.
+func locate(f *os.File) (h *wav.Header, offset, size int64, err error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	buf := make([]byte, riffHeaderSize)
+
+	if _, err = io.ReadFull(f, buf); err != nil {
+		return nil, 0, 0, ErrInvalidRIFFHeader
+	}
+
+	if string(buf[:4]) != riffID || string(buf[8:12]) != waveID {
+		return nil, 0, 0, ErrInvalidRIFFHeader
+	}
+
+	offset = riffHeaderSize
+
+	for {
+		if _, err = io.ReadFull(f, buf[:chunkHeaderSize]); err != nil {
+			if h == nil {
+				return nil, 0, 0, ErrMissingFmtChunk
+			}
+
+			return nil, 0, 0, ErrMissingDataChunk
+		}
+
+		offset += chunkHeaderSize
+		size = int64(binary.LittleEndian.Uint32(buf[4:8]))
+
+		switch string(buf[:4]) {
+		case fmtID:
+			if h, err = readFmt(f, size); err != nil {
+				return nil, 0, 0, err
+			}
+		case dataID:
+			if h == nil {
+				return nil, 0, 0, ErrMissingFmtChunk
+			}
+
+			// streamed or truncated files may not have a valid data chunk size
+			if size == 0 || offset+size > info.Size() {
+				size = info.Size() - offset
+			}
+
+			return h, offset, size - size%int64(h.BlockAlign), nil
+		}
+
+		// chunks are padded to an even size
+		offset += size + size%2
+
+		if _, err = f.Seek(offset, io.SeekStart); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+}
+
+func readFmt(r io.Reader, size int64) (*wav.Header, error) {
+	if size < minFmtSize {
+		return nil, ErrMissingFmtChunk
+	}
+
+	buf := make([]byte, min(size, extensibleSize))
+
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, ErrMissingFmtChunk
+	}
+
+	format := binary.LittleEndian.Uint16(buf[0:2])
+
+	// WAVE_FORMAT_EXTENSIBLE stores the actual format in the first bytes of its sub-format GUID
+	if format == extensibleFormat && len(buf) == extensibleSize {
+		format = binary.LittleEndian.Uint16(buf[24:26])
+	}
+
+	return wav.NewHeader(
+		binary.LittleEndian.Uint32(buf[4:8]),
+		binary.LittleEndian.Uint16(buf[14:16]),
+		binary.LittleEndian.Uint16(buf[2:4]),
+		format,
+	)
+}
+
+// loopReader reads the audio data in its io.SectionReader indefinitely, restarting from its beginning once it is
+// exhausted.
+type loopReader struct {
+	r *io.SectionReader
+}
+
+func (l *loopReader) Read(buf []byte) (n int, err error) {
+	n, err = l.r.Read(buf)
+	if !errors.Is(err, io.EOF) {
+		return n, err
+	}
+
+	if _, err = l.r.Seek(0, io.SeekStart); err != nil {
+		return n, err
+	}
+
+	return n, nil
+}
+
+// pacedReader limits the reads on its io.Reader to its byte rate, blocking after each read until the time the data
+// would be played back.
+type pacedReader struct {
+	ctx context.Context
+
+	r        io.Reader
+	byteRate float64
+
+	start time.Time
+	total int64
+}
+
+func (p *pacedReader) Read(buf []byte) (n int, err error) {
+	if p.start.IsZero() {
+		p.start = time.Now()
+	}
+
+	n, err = p.r.Read(buf)
+	p.total += int64(n)
+
+	wait := time.Duration(float64(p.total)/p.byteRate*float64(time.Second)) - time.Since(p.start)
+	if wait <= 0 {
+		return n, err
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-p.ctx.Done():
+		return n, p.ctx.Err()
+	case <-timer.C:
+		return n, err
+	}
+}
+
+func New(
+	options []cfg.Option[Config],
+	logger *slog.Logger, metrics audio.ConsumerMetrics, tracer trace.Tracer,
+) (audio.Consumer, error) {
+	config := cfg.Set(DefaultConfig(), options...)
+
+	if err := Validate(config); err != nil {
+		return audio.NoOpConsumer(), err
+	}
+
+	if logger == nil {
+		logger = slog.New(audio.NoOpLogHandler())
+	}
+
+	if metrics == nil {
+		metrics = audio.NoOpConsumerMetrics{}
+	}
+
+	if tracer == nil {
+		tracer = noop.NewTracerProvider().Tracer("no-op")
+	}
+
+	return &fileConsumer{
+		logger:  logger,
+		metrics: metrics,
+		tracer:  tracer,
+		cfg:     config,
+	}, nil
+}
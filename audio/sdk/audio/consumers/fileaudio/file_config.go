@@ -0,0 +1,86 @@
+package fileaudio
+
+import (
+	"github.com/zalgonoise/cfg"
+	"github.com/zalgonoise/valigator"
+	"github.com/zalgonoise/x/errs"
+)
+
+const (
+	consumerDomain = errs.Domain("audio/sdk/audio/consumers/fileaudio")
+
+	ErrEmpty   = errs.Kind("empty")
+	ErrMissing = errs.Kind("missing")
+	ErrInvalid = errs.Kind("invalid")
+
+	ErrPath       = errs.Entity("file path")
+	ErrRIFFHeader = errs.Entity("RIFF header")
+	ErrFmtChunk   = errs.Entity("fmt chunk")
+	ErrDataChunk  = errs.Entity("data chunk")
+)
+
+var (
+	ErrEmptyPath         = errs.WithDomain(consumerDomain, ErrEmpty, ErrPath)
+	ErrInvalidRIFFHeader = errs.WithDomain(consumerDomain, ErrInvalid, ErrRIFFHeader)
+	ErrMissingFmtChunk   = errs.WithDomain(consumerDomain, ErrMissing, ErrFmtChunk)
+	ErrMissingDataChunk  = errs.WithDomain(consumerDomain, ErrMissing, ErrDataChunk)
+
+	//nolint:gochecknoglobals // it's faster to initialize it on startup, as it is an immutable object
+	configValidator = valigator.New(validatePath)
+)
+
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// Config defines a data structure for configurations and options related to a WAV file audio.Consumer.
+type Config struct {
+	path     string
+	loop     bool
+	realtime bool
+}
+
+// WithPath defines the path to the WAV file to read.
+func WithPath(path string) cfg.Option[Config] {
+	if path == "" {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.path = path
+
+		return config
+	})
+}
+
+// WithLoop sets the Consumer to restart reading the file's audio data once it reaches its end, indefinitely.
+func WithLoop() cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.loop = true
+
+		return config
+	})
+}
+
+// WithRealtime sets the Consumer to pace its reads to the file's byte rate, so that its audio data is read at the
+// same rate as a live audio stream would be.
+func WithRealtime() cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.realtime = true
+
+		return config
+	})
+}
+
+func validatePath(config Config) error {
+	if config.path == "" {
+		return ErrEmptyPath
+	}
+
+	return nil
+}
+
+// Validate verifies if the input Config contains missing or invalid fields.
+func Validate(config Config) error {
+	return configValidator.Validate(config)
+}
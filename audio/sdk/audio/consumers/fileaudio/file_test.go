@@ -0,0 +1,145 @@
+package fileaudio_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/sdk/audio/consumers/fileaudio"
+)
+
+const (
+	sampleRate = 44100
+	numSamples = 4410
+)
+
+// writeFile writes a mono, 16-bit WAV file with a ramp of `numSamples` samples, and an additional LIST chunk
+// before its data chunk.
+func writeFile(t *testing.T) (path string, pcm []byte) {
+	t.Helper()
+
+	h, err := wav.NewHeader(sampleRate, wav.BitDepth16, wav.ChannelsMono, wav.PCMFormat)
+	require.NoError(t, err)
+
+	pcm = make([]byte, 2*numSamples)
+	for i := 0; i < numSamples; i++ {
+		binary.LittleEndian.PutUint16(pcm[2*i:], uint16(i))
+	}
+
+	buf := bytes.NewBuffer(nil)
+	buf.WriteString("RIFF")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(0))
+	buf.Write(h.Bytes()[8:])
+	buf.WriteString("LIST")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(3))
+	buf.Write([]byte{1, 2, 3, 0})
+	buf.WriteString("data")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(len(pcm)))
+	buf.Write(pcm)
+
+	path = filepath.Join(t.TempDir(), "audio.wav")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+	return path, pcm
+}
+
+func consume(t *testing.T, options ...cfg.Option[fileaudio.Config]) io.Reader {
+	t.Helper()
+
+	c, err := fileaudio.New(options, nil, nil, nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, c.Shutdown(context.Background()))
+	})
+
+	r, err := c.Consume(context.Background())
+	require.NoError(t, err)
+
+	return r
+}
+
+func TestConsumer(t *testing.T) {
+	path, pcm := writeFile(t)
+
+	t.Run("Success", func(t *testing.T) {
+		r := consume(t, fileaudio.WithPath(path))
+
+		buf, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Len(t, buf, wav.Size+8+len(pcm))
+
+		h, err := wav.HeaderFrom(buf)
+		require.NoError(t, err)
+		require.Equal(t, uint32(sampleRate), h.SampleRate)
+		require.Equal(t, wav.ChannelsMono, h.NumChannels)
+		require.Equal(t, "data", string(buf[wav.Size:wav.Size+4]))
+		require.Equal(t, pcm, buf[wav.Size+8:])
+	})
+
+	t.Run("Loop", func(t *testing.T) {
+		r := consume(t, fileaudio.WithPath(path), fileaudio.WithLoop())
+
+		buf := make([]byte, wav.Size+8+3*len(pcm))
+		_, err := io.ReadFull(r, buf)
+		require.NoError(t, err)
+
+		buf = buf[wav.Size+8:]
+		for i := 0; i < 3; i++ {
+			require.Equal(t, pcm, buf[i*len(pcm):(i+1)*len(pcm)])
+		}
+	})
+
+	t.Run("Realtime", func(t *testing.T) {
+		r := consume(t, fileaudio.WithPath(path), fileaudio.WithRealtime())
+
+		start := time.Now()
+
+		_, err := io.ReadAll(r)
+		require.NoError(t, err)
+
+		// the file holds 100ms of audio
+		require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		r := consume(t, fileaudio.WithPath(path))
+
+		var samples int
+
+		w := wav.NewStream(func(_ *wav.Header, data []float64) error {
+			samples += len(data)
+
+			return nil
+		}, wav.WithSize(1024))
+
+		_, err := w.ReadFrom(r)
+		require.NoError(t, err)
+		require.Equal(t, numSamples, samples)
+	})
+
+	t.Run("Fail/EmptyPath", func(t *testing.T) {
+		_, err := fileaudio.New(nil, nil, nil, nil)
+		require.ErrorIs(t, err, fileaudio.ErrEmptyPath)
+	})
+
+	t.Run("Fail/NotWAV", func(t *testing.T) {
+		invalid := filepath.Join(t.TempDir(), "audio.txt")
+		require.NoError(t, os.WriteFile(invalid, []byte("not a WAV file at all"), 0o600))
+
+		c, err := fileaudio.New([]cfg.Option[fileaudio.Config]{fileaudio.WithPath(invalid)}, nil, nil, nil)
+		require.NoError(t, err)
+
+		_, err = c.Consume(context.Background())
+		require.ErrorIs(t, err, fileaudio.ErrInvalidRIFFHeader)
+	})
+}
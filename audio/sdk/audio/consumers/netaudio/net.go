@@ -0,0 +1,183 @@
+package netaudio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/sdk/audio"
+)
+
+// maxDatagramSize is the maximum payload of a UDP datagram.
+const maxDatagramSize = 65507
+
+type netConsumer struct {
+	logger  *slog.Logger
+	metrics audio.ConsumerMetrics
+	tracer  trace.Tracer
+
+	cfg Config
+
+	conn io.Closer
+	stop func() bool
+}
+
+// Consume interacts with the audio source to extract its audio content or stream as an io.Reader.
+//
+// It listens on the configured TCP or UDP address, returning the received raw PCM data wrapped with the configured
+// WAV header. Over TCP, the call blocks until a connection is accepted (or the context is done, or the configured
+// timeout is reached); over UDP, the received datagrams are read in sequence.
+func (c *netConsumer) Consume(ctx context.Context) (reader io.Reader, err error) {
+	c.logger.DebugContext(ctx, "listening for raw PCM audio feed",
+		slog.String("network", c.cfg.network),
+		slog.String("address", c.cfg.address),
+	)
+
+	switch c.cfg.network {
+	case networkUDP:
+		reader, err = c.listenUDP(ctx)
+	default:
+		reader, err = c.listenTCP(ctx)
+	}
+
+	if err != nil {
+		c.logger.ErrorContext(ctx, "error listening for raw PCM audio feed",
+			slog.String("network", c.cfg.network),
+			slog.String("address", c.cfg.address),
+			slog.String("error", err.Error()),
+		)
+
+		return nil, err
+	}
+
+	c.logger.InfoContext(ctx, "connected to raw PCM audio feed",
+		slog.String("network", c.cfg.network),
+		slog.Int("sample_rate", int(c.cfg.header.SampleRate)),
+		slog.Int("bit_depth", int(c.cfg.header.BitsPerSample)),
+		slog.Int("num_channels", int(c.cfg.header.NumChannels)),
+	)
+
+	return wav.NewRawReader(c.cfg.header, reader)
+}
+
+func (c *netConsumer) listenTCP(ctx context.Context) (io.Reader, error) {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, networkTCP, c.cfg.address)
+	if err != nil {
+		return nil, err
+	}
+
+	// only a single connection is accepted, so the listener is closed once it is done
+	defer listener.Close()
+
+	if c.cfg.timeout > 0 {
+		if tcpListener, ok := listener.(*net.TCPListener); ok {
+			if err = tcpListener.SetDeadline(time.Now().Add(c.cfg.timeout)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	stopAccept := context.AfterFunc(ctx, func() { _ = listener.Close() })
+	defer stopAccept()
+
+	conn, err := listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	c.conn = conn
+	c.stop = context.AfterFunc(ctx, func() { _ = conn.Close() })
+
+	return conn, nil
+}
+
+func (c *netConsumer) listenUDP(ctx context.Context) (io.Reader, error) {
+	conn, err := (&net.ListenConfig{}).ListenPacket(ctx, networkUDP, c.cfg.address)
+	if err != nil {
+		return nil, err
+	}
+
+	c.conn = conn
+	c.stop = context.AfterFunc(ctx, func() { _ = conn.Close() })
+
+	return &datagramReader{conn: conn, buf: make([]byte, maxDatagramSize)}, nil
+}
+
+// Shutdown gracefully shuts down the Consumer.
+func (c *netConsumer) Shutdown(ctx context.Context) error {
+	c.logger.InfoContext(ctx, "closing connection to raw PCM audio feed")
+
+	if c.stop != nil {
+		c.stop()
+	}
+
+	if c.conn == nil {
+		return nil
+	}
+
+	if err := c.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// datagramReader reads the payloads of the datagrams received in a net.PacketConn as a continuous stream.
+type datagramReader struct {
+	conn    net.PacketConn
+	buf     []byte
+	pending []byte
+}
+
+func (r *datagramReader) Read(buf []byte) (n int, err error) {
+	if len(r.pending) == 0 {
+		if n, _, err = r.conn.ReadFrom(r.buf); err != nil {
+			return 0, err
+		}
+
+		r.pending = r.buf[:n]
+	}
+
+	n = copy(buf, r.pending)
+	r.pending = r.pending[n:]
+
+	return n, nil
+}
+
+func New(
+	options []cfg.Option[Config],
+	logger *slog.Logger, metrics audio.ConsumerMetrics, tracer trace.Tracer,
+) (audio.Consumer, error) {
+	config := cfg.Set(DefaultConfig(), options...)
+
+	if err := Validate(config); err != nil {
+		return audio.NoOpConsumer(), err
+	}
+
+	if logger == nil {
+		logger = slog.New(audio.NoOpLogHandler())
+	}
+
+	if metrics == nil {
+		metrics = audio.NoOpConsumerMetrics{}
+	}
+
+	if tracer == nil {
+		tracer = noop.NewTracerProvider().Tracer("no-op")
+	}
+
+	return &netConsumer{
+		logger:  logger,
+		metrics: metrics,
+		tracer:  tracer,
+		cfg:     config,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package netaudio
+
+import (
+	"time"
+
+	"github.com/zalgonoise/cfg"
+	"github.com/zalgonoise/valigator"
+	"github.com/zalgonoise/x/errs"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+)
+
+const (
+	networkTCP = "tcp"
+	networkUDP = "udp"
+
+	consumerDomain = errs.Domain("audio/sdk/audio/consumers/netaudio")
+
+	ErrEmpty   = errs.Kind("empty")
+	ErrInvalid = errs.Kind("invalid")
+
+	ErrNetwork = errs.Entity("network")
+	ErrAddress = errs.Entity("address")
+)
+
+var (
+	ErrInvalidNetwork = errs.WithDomain(consumerDomain, ErrInvalid, ErrNetwork)
+	ErrEmptyAddress   = errs.WithDomain(consumerDomain, ErrEmpty, ErrAddress)
+
+	//nolint:gochecknoglobals // it's faster to initialize it on startup, as it is an immutable object
+	configValidator = valigator.New(validateNetwork, validateAddress, validateHeader)
+)
+
+func DefaultConfig() Config {
+	// the default header is always valid
+	h, _ := wav.NewHeader(wav.SampleRate44100, wav.BitDepth16, wav.ChannelsStereo, wav.PCMFormat)
+
+	return Config{
+		network: networkTCP,
+		header:  h,
+	}
+}
+
+// Config defines a data structure for configurations and options related to a raw PCM over TCP or UDP
+// audio.Consumer.
+type Config struct {
+	network string
+	address string
+	header  *wav.Header
+	timeout time.Duration
+}
+
+// WithTCP sets the Consumer to listen on the TCP address `address`, reading the audio data from the first
+// connection it accepts.
+func WithTCP(address string) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.network = networkTCP
+		config.address = address
+
+		return config
+	})
+}
+
+// WithUDP sets the Consumer to listen on the UDP address `address`, reading the audio data from the datagrams it
+// receives.
+func WithUDP(address string) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.network = networkUDP
+		config.address = address
+
+		return config
+	})
+}
+
+// WithFormat describes the raw PCM data received by the Consumer, with the input sampleRate, bitDepth and
+// numChannels. By default, it is 16-bit stereo audio with a sample rate of 44.1kHz.
+func WithFormat(sampleRate uint32, bitDepth, numChannels uint16) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		// the header is validated with the Config
+		config.header, _ = wav.NewHeader(sampleRate, bitDepth, numChannels, wav.PCMFormat)
+
+		return config
+	})
+}
+
+// WithTimeout sets a timeout for accepting an incoming TCP connection.
+func WithTimeout(dur time.Duration) cfg.Option[Config] {
+	if dur == 0 {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.timeout = dur
+
+		return config
+	})
+}
+
+func validateNetwork(config Config) error {
+	switch config.network {
+	case networkTCP, networkUDP:
+		return nil
+	default:
+		return ErrInvalidNetwork
+	}
+}
+
+func validateAddress(config Config) error {
+	if config.address == "" {
+		return ErrEmptyAddress
+	}
+
+	return nil
+}
+
+func validateHeader(config Config) error {
+	return wav.ValidateHeader(config.header)
+}
+
+// Validate verifies if the input Config contains missing or invalid fields.
+func Validate(config Config) error {
+	return configValidator.Validate(config)
+}
@@ -0,0 +1,146 @@
+package netaudio_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/sdk/audio/consumers/netaudio"
+)
+
+// freeAddress returns a local address with a free port for the network `network`.
+func freeAddress(t *testing.T, network string) string {
+	t.Helper()
+
+	switch network {
+	case "udp":
+		conn, err := net.ListenPacket(network, "127.0.0.1:0")
+		require.NoError(t, err)
+
+		defer conn.Close()
+
+		return conn.LocalAddr().String()
+	default:
+		listener, err := net.Listen(network, "127.0.0.1:0")
+		require.NoError(t, err)
+
+		defer listener.Close()
+
+		return listener.Addr().String()
+	}
+}
+
+// send dials the address `address` on the network `network` until it succeeds, writing `pcm` once connected.
+func send(t *testing.T, network, address string, pcm []byte) {
+	t.Helper()
+
+	for i := 0; i < 100; i++ {
+		conn, err := net.Dial(network, address)
+		if err != nil {
+			time.Sleep(10 * time.Millisecond)
+
+			continue
+		}
+
+		// errors are reported with t.Error, as it may be called from a different goroutine
+		if _, err = conn.Write(pcm); err != nil {
+			t.Error(err)
+		}
+
+		if err = conn.Close(); err != nil {
+			t.Error(err)
+		}
+
+		return
+	}
+
+	t.Error("failed to connect to consumer")
+}
+
+func TestConsumer(t *testing.T) {
+	pcm := make([]byte, 1024)
+	for i := range pcm {
+		pcm[i] = byte(i)
+	}
+
+	t.Run("TCP", func(t *testing.T) {
+		address := freeAddress(t, "tcp")
+
+		c, err := netaudio.New([]cfg.Option[netaudio.Config]{
+			netaudio.WithTCP(address),
+			netaudio.WithFormat(wav.SampleRate48000, wav.BitDepth16, wav.ChannelsMono),
+		}, nil, nil, nil)
+		require.NoError(t, err)
+
+		go send(t, "tcp", address, pcm)
+
+		r, err := c.Consume(context.Background())
+		require.NoError(t, err)
+
+		buf, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, c.Shutdown(context.Background()))
+
+		h, err := wav.HeaderFrom(buf)
+		require.NoError(t, err)
+		require.Equal(t, wav.SampleRate48000, h.SampleRate)
+		require.Equal(t, wav.ChannelsMono, h.NumChannels)
+		require.Equal(t, pcm, buf[wav.Size+8:])
+	})
+
+	t.Run("UDP", func(t *testing.T) {
+		address := freeAddress(t, "udp")
+
+		c, err := netaudio.New([]cfg.Option[netaudio.Config]{
+			netaudio.WithUDP(address),
+		}, nil, nil, nil)
+		require.NoError(t, err)
+
+		r, err := c.Consume(context.Background())
+		require.NoError(t, err)
+
+		send(t, "udp", address, pcm[:512])
+		send(t, "udp", address, pcm[512:])
+
+		buf := make([]byte, wav.Size+8+len(pcm))
+		_, err = io.ReadFull(r, buf)
+		require.NoError(t, err)
+		require.NoError(t, c.Shutdown(context.Background()))
+
+		h, err := wav.HeaderFrom(buf)
+		require.NoError(t, err)
+		require.Equal(t, wav.SampleRate44100, h.SampleRate)
+		require.Equal(t, wav.ChannelsStereo, h.NumChannels)
+		require.Equal(t, pcm, buf[wav.Size+8:])
+	})
+
+	t.Run("Fail/Timeout", func(t *testing.T) {
+		c, err := netaudio.New([]cfg.Option[netaudio.Config]{
+			netaudio.WithTCP(freeAddress(t, "tcp")),
+			netaudio.WithTimeout(20 * time.Millisecond),
+		}, nil, nil, nil)
+		require.NoError(t, err)
+
+		_, err = c.Consume(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("Fail/EmptyAddress", func(t *testing.T) {
+		_, err := netaudio.New([]cfg.Option[netaudio.Config]{netaudio.WithUDP("")}, nil, nil, nil)
+		require.ErrorIs(t, err, netaudio.ErrEmptyAddress)
+	})
+
+	t.Run("Fail/InvalidFormat", func(t *testing.T) {
+		_, err := netaudio.New([]cfg.Option[netaudio.Config]{
+			netaudio.WithTCP(":0"),
+			netaudio.WithFormat(wav.SampleRate44100, 12, wav.ChannelsStereo),
+		}, nil, nil, nil)
+		require.ErrorIs(t, err, wav.ErrInvalidBitDepth)
+	})
+}
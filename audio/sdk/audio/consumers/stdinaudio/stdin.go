@@ -0,0 +1,91 @@
+package stdinaudio
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/zalgonoise/cfg"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/sdk/audio"
+)
+
+type stdinConsumer struct {
+	logger  *slog.Logger
+	metrics audio.ConsumerMetrics
+	tracer  trace.Tracer
+
+	cfg Config
+}
+
+// Consume interacts with the audio source to extract its audio content or stream as an io.Reader.
+//
+// It returns the standard input (or the configured io.Reader) as-is if it yields a WAV stream, or wrapped with the
+// configured WAV header if it yields raw PCM data.
+func (c *stdinConsumer) Consume(ctx context.Context) (reader io.Reader, err error) {
+	if c.cfg.header == nil {
+		c.logger.InfoContext(ctx, "reading WAV stream from standard input")
+
+		return c.cfg.reader, nil
+	}
+
+	c.logger.InfoContext(ctx, "reading raw PCM stream from standard input",
+		slog.Int("sample_rate", int(c.cfg.header.SampleRate)),
+		slog.Int("bit_depth", int(c.cfg.header.BitsPerSample)),
+		slog.Int("num_channels", int(c.cfg.header.NumChannels)),
+	)
+
+	return wav.NewRawReader(c.cfg.header, c.cfg.reader)
+}
+
+// Shutdown gracefully shuts down the Consumer.
+//
+// The standard input is left open, as it is not owned by the Consumer; however a configured io.Reader is closed if
+// it implements io.Closer.
+func (c *stdinConsumer) Shutdown(ctx context.Context) error {
+	c.logger.InfoContext(ctx, "closing standard input audio feed")
+
+	if c.cfg.reader == io.Reader(os.Stdin) {
+		return nil
+	}
+
+	if closer, ok := c.cfg.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+func New(
+	options []cfg.Option[Config],
+	logger *slog.Logger, metrics audio.ConsumerMetrics, tracer trace.Tracer,
+) (audio.Consumer, error) {
+	config := cfg.Set(DefaultConfig(), options...)
+
+	if err := Validate(config); err != nil {
+		return audio.NoOpConsumer(), err
+	}
+
+	if logger == nil {
+		logger = slog.New(audio.NoOpLogHandler())
+	}
+
+	if metrics == nil {
+		metrics = audio.NoOpConsumerMetrics{}
+	}
+
+	if tracer == nil {
+		tracer = noop.NewTracerProvider().Tracer("no-op")
+	}
+
+	return &stdinConsumer{
+		logger:  logger,
+		metrics: metrics,
+		tracer:  tracer,
+		cfg:     config,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package stdinaudio
+
+import (
+	"io"
+	"os"
+
+	"github.com/zalgonoise/cfg"
+	"github.com/zalgonoise/valigator"
+	"github.com/zalgonoise/x/errs"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+)
+
+const (
+	consumerDomain = errs.Domain("audio/sdk/audio/consumers/stdinaudio")
+
+	ErrNil = errs.Kind("nil")
+
+	ErrReader = errs.Entity("reader")
+)
+
+var (
+	ErrNilReader = errs.WithDomain(consumerDomain, ErrNil, ErrReader)
+
+	//nolint:gochecknoglobals // it's faster to initialize it on startup, as it is an immutable object
+	configValidator = valigator.New(validateReader, validateHeader)
+)
+
+func DefaultConfig() Config {
+	return Config{
+		reader: os.Stdin,
+	}
+}
+
+// Config defines a data structure for configurations and options related to a standard input audio.Consumer.
+type Config struct {
+	reader io.Reader
+	header *wav.Header
+}
+
+// WithReader replaces the standard input with the io.Reader `r`, e.g. to consume the output of a pipe or a
+// process started by the caller.
+func WithReader(r io.Reader) cfg.Option[Config] {
+	if r == nil {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register(func(config Config) Config {
+		config.reader = r
+
+		return config
+	})
+}
+
+// WithRawPCM sets the Consumer to read raw (headerless) PCM data instead of a WAV stream, described by the input
+// sampleRate, bitDepth and numChannels.
+func WithRawPCM(sampleRate uint32, bitDepth, numChannels uint16) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		// the header is validated with the Config
+		config.header, _ = wav.NewHeader(sampleRate, bitDepth, numChannels, wav.PCMFormat)
+
+		return config
+	})
+}
+
+func validateReader(config Config) error {
+	if config.reader == nil {
+		return ErrNilReader
+	}
+
+	return nil
+}
+
+func validateHeader(config Config) error {
+	if config.header == nil {
+		return nil
+	}
+
+	return wav.ValidateHeader(config.header)
+}
+
+// Validate verifies if the input Config contains missing or invalid fields.
+func Validate(config Config) error {
+	return configValidator.Validate(config)
+}
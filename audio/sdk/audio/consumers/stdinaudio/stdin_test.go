@@ -0,0 +1,78 @@
+package stdinaudio_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/sdk/audio/consumers/stdinaudio"
+)
+
+func TestConsumer(t *testing.T) {
+	pcm := make([]byte, 2*1024)
+	for i := 0; i < 1024; i++ {
+		binary.LittleEndian.PutUint16(pcm[2*i:], uint16(i))
+	}
+
+	t.Run("WAV", func(t *testing.T) {
+		h, err := wav.NewHeader(wav.SampleRate44100, wav.BitDepth16, wav.ChannelsMono, wav.PCMFormat)
+		require.NoError(t, err)
+
+		input := append(h.Bytes(), append([]byte("data\x00\x00\x00\x00"), pcm...)...)
+
+		c, err := stdinaudio.New([]cfg.Option[stdinaudio.Config]{
+			stdinaudio.WithReader(bytes.NewReader(input)),
+		}, nil, nil, nil)
+		require.NoError(t, err)
+
+		r, err := c.Consume(context.Background())
+		require.NoError(t, err)
+
+		buf, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, input, buf)
+		require.NoError(t, c.Shutdown(context.Background()))
+	})
+
+	t.Run("RawPCM", func(t *testing.T) {
+		c, err := stdinaudio.New([]cfg.Option[stdinaudio.Config]{
+			stdinaudio.WithReader(bytes.NewReader(pcm)),
+			stdinaudio.WithRawPCM(wav.SampleRate48000, wav.BitDepth16, wav.ChannelsStereo),
+		}, nil, nil, nil)
+		require.NoError(t, err)
+
+		r, err := c.Consume(context.Background())
+		require.NoError(t, err)
+
+		var (
+			header  *wav.Header
+			samples int
+		)
+
+		w := wav.NewStream(func(h *wav.Header, data []float64) error {
+			header = h
+			samples += len(data)
+
+			return nil
+		}, wav.WithSize(512))
+
+		_, err = w.ReadFrom(r)
+		require.NoError(t, err)
+		require.Equal(t, wav.SampleRate48000, header.SampleRate)
+		require.Equal(t, wav.ChannelsStereo, header.NumChannels)
+		require.Equal(t, 1024, samples)
+	})
+
+	t.Run("Fail/InvalidRawPCM", func(t *testing.T) {
+		_, err := stdinaudio.New([]cfg.Option[stdinaudio.Config]{
+			stdinaudio.WithRawPCM(12345, wav.BitDepth16, wav.ChannelsStereo),
+		}, nil, nil, nil)
+		require.ErrorIs(t, err, wav.ErrInvalidSampleRate)
+	})
+}
@@ -2,6 +2,7 @@ package audio
 
 import (
 	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/loudness"
 )
 
 // Emitter is responsible for pushing the output data of an exporter to the
@@ -33,3 +34,16 @@ type Emitter interface {
 	// Closer requires the Shutdown method, allowing an Emitter to gracefully shutdown.
 	Closer
 }
+
+// LoudnessEmitter is an optional extension of an Emitter, for implementations that are also able to publish
+// loudness measurements (as per ITU-R BS.1770 and EBU R128).
+//
+// It is kept apart from the Emitter interface so that existing implementations remain valid; an Exporter configured
+// with loudness metering checks whether its Emitter implements LoudnessEmitter.
+//
+// parent: Exporter
+// child:
+type LoudnessEmitter interface {
+	// EmitLoudness registers loudness measurements, as received by a Registry in an Exporter.
+	EmitLoudness(loudness.Measurement)
+}
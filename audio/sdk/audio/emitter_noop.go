@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/loudness"
 )
 
 type noOpEmitter struct{}
@@ -18,6 +19,11 @@ func (noOpEmitter) EmitPeaks(float64) {}
 // This is a no-op call, and has no effect.
 func (noOpEmitter) EmitSpectrum([]fft.FrequencyPower) {}
 
+// EmitLoudness implements the LoudnessEmitter interface.
+//
+// This is a no-op call, and has no effect.
+func (noOpEmitter) EmitLoudness(loudness.Measurement) {}
+
 // Shutdown implements the Emitter and Closer interfaces.
 //
 // This is a no-op call, and the returned error is always nil.
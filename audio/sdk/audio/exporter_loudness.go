@@ -0,0 +1,130 @@
+package audio
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/loudness"
+)
+
+type loudnessExporter struct {
+	logger  *slog.Logger
+	metrics ExporterMetrics
+	tracer  trace.Tracer
+
+	loudness Collector[loudness.Measurement]
+
+	emitter LoudnessEmitter
+
+	cancel context.CancelFunc
+}
+
+// Export implements the Exporter interface.
+//
+// It consumes the audio data chunks from the Processor, as the signal is streamed from a Process call, feeding them
+// to its loudness Collector.
+func (e loudnessExporter) Export(ctx context.Context, h *wav.Header, data []float64) error {
+	e.logger.DebugContext(ctx, "exporting audio chunks from processor for loudness metering",
+		slog.Int("num_samples", len(data)),
+	)
+
+	return e.loudness.Collect(ctx, h, data)
+}
+
+// ForceFlush implements the Exporter and StreamCloser interfaces.
+//
+// It will call on the loudness Collector ForceFlush method if its Registry has it.
+func (e loudnessExporter) ForceFlush() error {
+	e.logger.DebugContext(context.TODO(), "flushing loudness exporter registry buffers")
+
+	return e.loudness.ForceFlush()
+}
+
+// Shutdown implements the Exporter and StreamCloser interfaces.
+//
+// It will stop the running goroutine which listens to the Registry's incoming values, and call on the loudness
+// Collector Shutdown method.
+//
+// Unlike the Exporter returned by NewExporter, its LoudnessEmitter is not shut down, as it is usually an Emitter
+// that is shared with (and owned by) another Exporter.
+func (e loudnessExporter) Shutdown(ctx context.Context) error {
+	e.logger.InfoContext(context.TODO(), "shutting down loudness exporter")
+
+	e.cancel()
+
+	return e.loudness.Shutdown(ctx)
+}
+
+func (e loudnessExporter) export(ctx context.Context) {
+	values := e.loudness.Load()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case v, ok := <-values:
+			if !ok {
+				e.logger.DebugContext(ctx, "loudness values channel closed")
+
+				return
+			}
+
+			e.logger.DebugContext(ctx, "received loudness values")
+
+			e.emitter.EmitLoudness(v)
+		}
+	}
+}
+
+// NewLoudnessExporter creates an audio Exporter for loudness measurements, based on the input LoudnessEmitter (that
+// publishes the values somewhere) and the input loudness Collector, which will extract and buffer the measurements
+// from the incoming audio signal.
+//
+// A nil LoudnessEmitter results in a no-op Exporter with an ErrNilEmitter error, and a nil Collector results in a
+// no-op Exporter with an ErrNilCollectors error.
+//
+// The created Exporter launches a goroutine to listen on the Collector's value channel, that is controlled via
+// a context.Context. Its done signal is sent on the Exporter's Shutdown method call.
+func NewLoudnessExporter(
+	emitter LoudnessEmitter, collector Collector[loudness.Measurement],
+	logger *slog.Logger, metrics ExporterMetrics, tracer trace.Tracer,
+) (Exporter, error) {
+	switch {
+	case emitter == nil:
+		return NoOpExporter(), ErrNilEmitter
+	case collector == nil:
+		return NoOpExporter(), ErrNilCollectors
+	}
+
+	if logger == nil {
+		logger = slog.New(noOpLogHandler{})
+	}
+
+	if metrics == nil {
+		metrics = NoOpProcessorMetrics{}
+	}
+
+	if tracer == nil {
+		tracer = noop.NewTracerProvider().Tracer("no-op")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	e := loudnessExporter{
+		logger:  logger,
+		metrics: metrics,
+		tracer:  tracer,
+
+		loudness: collector,
+		emitter:  emitter,
+		cancel:   cancel,
+	}
+
+	go e.export(ctx)
+
+	return e, nil
+}
@@ -0,0 +1,252 @@
+package exporters
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/sdk/audio"
+	"github.com/zalgonoise/x/audio/sdk/audio/extractors"
+)
+
+//nolint:gochecknoglobals // immutable color stops for the spectrogram's heat map, from the quietest to the loudest value
+var heatMap = []color.NRGBA{
+	{R: 0, G: 0, B: 0, A: 255},
+	{R: 32, G: 12, B: 120, A: 255},
+	{R: 180, G: 30, B: 110, A: 255},
+	{R: 250, G: 140, B: 20, A: 255},
+	{R: 255, G: 255, B: 200, A: 255},
+}
+
+// NewSpectrogramExporter creates an audio Exporter that computes the Short-Time Fourier Transform of the incoming
+// audio (mixed down to mono), and writes it as a spectrogram to the io.Writer `writer`, either as a PNG image or
+// as CSV.
+//
+// A spectrogram is written when it reaches the configured maximum number of frames, and on each ForceFlush or
+// Shutdown call, with the frames collected so far. After writing a spectrogram, the writer is closed if it
+// implements io.Closer, and reset if it implements a `Reset() error` method (e.g. a file writer that rotates
+// filenames).
+func NewSpectrogramExporter(writer io.Writer, options ...cfg.Option[SpectrogramConfig]) (audio.Exporter, error) {
+	config := cfg.Set(defaultSpectrogramConfig(), options...)
+
+	return &spectrogramExporter{
+		writer:    writer,
+		extractor: extractors.STFT(config.size, config.hop, config.window),
+		blockSize: fft.NearestBlock(config.size),
+		config:    config,
+		frames:    make([]fft.Frame, 0, config.maxFrames),
+	}, nil
+}
+
+type spectrogramExporter struct {
+	mu sync.Mutex
+
+	writer    io.Writer
+	extractor audio.Extractor[[]fft.Frame]
+	blockSize int
+	config    SpectrogramConfig
+
+	sampleRate uint32
+	frames     []fft.Frame
+}
+
+func (e *spectrogramExporter) Export(ctx context.Context, header *wav.Header, data []float64) error {
+	frames := e.extractor.Extract(ctx, header, data)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if header != nil && header.SampleRate > 0 {
+		e.sampleRate = header.SampleRate
+	}
+
+	for len(frames) > 0 {
+		n := min(len(frames), e.config.maxFrames-len(e.frames))
+
+		e.frames = append(e.frames, frames[:n]...)
+		frames = frames[n:]
+
+		if len(e.frames) >= e.config.maxFrames {
+			if err := e.flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *spectrogramExporter) ForceFlush() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.flush()
+}
+
+func (e *spectrogramExporter) Shutdown(context.Context) error {
+	return e.ForceFlush()
+}
+
+func (e *spectrogramExporter) flush() error {
+	if e.writer == nil || len(e.frames) == 0 {
+		return nil
+	}
+
+	var err error
+
+	switch e.config.format {
+	case SpectrogramCSV:
+		err = e.writeCSV()
+	default:
+		err = e.writePNG()
+	}
+
+	e.frames = e.frames[:0]
+
+	if closer, ok := e.writer.(io.Closer); ok {
+		err = errors.Join(err, closer.Close())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// if the writer is reusable (e.g. file writer that rotates filenames)
+	// finds its `Reset() error` method to arm the writer again
+	if resetter, ok := e.writer.(interface {
+		Reset() error
+	}); ok {
+		return resetter.Reset()
+	}
+
+	return nil
+}
+
+// decibels converts the magnitudes in the frames to decibels relative to the loudest value, clamped to the
+// configured dynamic range.
+func (e *spectrogramExporter) decibels() [][]float64 {
+	var peak float64
+
+	for i := range e.frames {
+		for _, mag := range e.frames[i].Magnitudes {
+			peak = max(peak, mag)
+		}
+	}
+
+	values := make([][]float64, len(e.frames))
+
+	for i := range e.frames {
+		values[i] = make([]float64, len(e.frames[i].Magnitudes))
+
+		for j, mag := range e.frames[i].Magnitudes {
+			db := -e.config.dynamicRange
+
+			if mag > 0 && peak > 0 {
+				db = max(db, 20*math.Log10(mag/peak))
+			}
+
+			values[i][j] = db
+		}
+	}
+
+	return values
+}
+
+func (e *spectrogramExporter) writeCSV() error {
+	var (
+		w       = csv.NewWriter(e.writer)
+		values  = e.decibels()
+		numBins = len(values[0])
+		row     = make([]string, numBins+1)
+	)
+
+	row[0] = "time"
+
+	for bin := range numBins {
+		row[bin+1] = strconv.FormatFloat(float64(bin)*float64(e.rate())/float64(e.blockSize), 'f', 2, 64)
+	}
+
+	if err := w.Write(row); err != nil {
+		return err
+	}
+
+	for i := range values {
+		row[0] = strconv.FormatFloat(float64(e.frames[i].Offset)/float64(e.rate()), 'f', 6, 64)
+
+		for bin := range values[i] {
+			row[bin+1] = strconv.FormatFloat(values[i][bin], 'f', 2, 64)
+		}
+
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
+
+func (e *spectrogramExporter) writePNG() error {
+	var (
+		values  = e.decibels()
+		numBins = len(values[0])
+		img     = image.NewNRGBA(image.Rect(0, 0, len(values), numBins))
+	)
+
+	for x := range values {
+		for bin := range values[x] {
+			// place the lowest frequencies at the bottom of the image
+			img.SetNRGBA(x, numBins-1-bin, heat(1+values[x][bin]/e.config.dynamicRange))
+		}
+	}
+
+	return png.Encode(e.writer, img)
+}
+
+func (e *spectrogramExporter) rate() uint32 {
+	if e.sampleRate == 0 {
+		return defaultSampleRate
+	}
+
+	return e.sampleRate
+}
+
+// heat maps the normalized intensity `value` (from 0.0 to 1.0) to a color in the heat map.
+func heat(value float64) color.NRGBA {
+	value = math.Max(0, math.Min(1, value))
+
+	pos := value * float64(len(heatMap)-1)
+	idx := int(pos)
+
+	if idx >= len(heatMap)-1 {
+		return heatMap[len(heatMap)-1]
+	}
+
+	var (
+		ratio    = pos - float64(idx)
+		from, to = heatMap[idx], heatMap[idx+1]
+	)
+
+	return color.NRGBA{
+		R: lerp(from.R, to.R, ratio),
+		G: lerp(from.G, to.G, ratio),
+		B: lerp(from.B, to.B, ratio),
+		A: 255,
+	}
+}
+
+func lerp(from, to uint8, ratio float64) uint8 {
+	return uint8(math.Round(float64(from) + (float64(to)-float64(from))*ratio))
+}
@@ -0,0 +1,126 @@
+package exporters
+
+import (
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/audio/fft/window"
+)
+
+const (
+	defaultSpectrogramSize   = 1024
+	defaultSpectrogramHop    = 512
+	defaultSpectrogramFrames = 4096
+	defaultDynamicRange      = 90.0
+)
+
+// SpectrogramFormat describes the encoding of the spectrogram written by a spectrogram Exporter.
+type SpectrogramFormat uint8
+
+const (
+	// SpectrogramPNG encodes the spectrogram as a PNG image, with time in the horizontal axis and frequency
+	// in the vertical axis (low frequencies at the bottom).
+	SpectrogramPNG SpectrogramFormat = iota
+	// SpectrogramCSV encodes the spectrogram as CSV, with a row per time frame and a column per frequency bin.
+	SpectrogramCSV
+)
+
+type SpectrogramConfig struct {
+	format SpectrogramFormat
+
+	size   int
+	hop    int
+	window window.GeneratorFunc
+
+	maxFrames    int
+	dynamicRange float64
+}
+
+func defaultSpectrogramConfig() SpectrogramConfig {
+	return SpectrogramConfig{
+		format:       SpectrogramPNG,
+		size:         defaultSpectrogramSize,
+		hop:          defaultSpectrogramHop,
+		window:       window.Hann,
+		maxFrames:    defaultSpectrogramFrames,
+		dynamicRange: defaultDynamicRange,
+	}
+}
+
+// AsPNG encodes the spectrogram as a PNG image.
+func AsPNG() cfg.Option[SpectrogramConfig] {
+	return cfg.Register(func(config SpectrogramConfig) SpectrogramConfig {
+		config.format = SpectrogramPNG
+
+		return config
+	})
+}
+
+// AsCSV encodes the spectrogram as CSV.
+func AsCSV() cfg.Option[SpectrogramConfig] {
+	return cfg.Register(func(config SpectrogramConfig) SpectrogramConfig {
+		config.format = SpectrogramCSV
+
+		return config
+	})
+}
+
+// WithSTFT sets the block size and hop size (in samples) of the Short-Time Fourier Transform behind the
+// spectrogram. The block size sets the frequency resolution, while the hop size sets the time resolution.
+func WithSTFT(size, hop int) cfg.Option[SpectrogramConfig] {
+	if size <= 0 && hop <= 0 {
+		return cfg.NoOp[SpectrogramConfig]{}
+	}
+
+	return cfg.Register(func(config SpectrogramConfig) SpectrogramConfig {
+		if size > 0 {
+			config.size = size
+		}
+
+		if hop > 0 {
+			config.hop = hop
+		}
+
+		return config
+	})
+}
+
+// WithWindow sets the window function applied to each block of the Short-Time Fourier Transform.
+func WithWindow(gen window.GeneratorFunc) cfg.Option[SpectrogramConfig] {
+	if gen == nil {
+		return cfg.NoOp[SpectrogramConfig]{}
+	}
+
+	return cfg.Register(func(config SpectrogramConfig) SpectrogramConfig {
+		config.window = gen
+
+		return config
+	})
+}
+
+// WithMaxFrames sets the number of time frames in each spectrogram. Once reached, the spectrogram is written
+// and a new one is started.
+func WithMaxFrames(frames int) cfg.Option[SpectrogramConfig] {
+	if frames <= 0 {
+		return cfg.NoOp[SpectrogramConfig]{}
+	}
+
+	return cfg.Register(func(config SpectrogramConfig) SpectrogramConfig {
+		config.maxFrames = frames
+
+		return config
+	})
+}
+
+// WithDynamicRange sets the range (in decibels) below the loudest value in the spectrogram that is represented
+// in it; quieter values are clamped to this floor.
+func WithDynamicRange(decibels float64) cfg.Option[SpectrogramConfig] {
+	if decibels <= 0 {
+		return cfg.NoOp[SpectrogramConfig]{}
+	}
+
+	return cfg.Register(func(config SpectrogramConfig) SpectrogramConfig {
+		config.dynamicRange = decibels
+
+		return config
+	})
+}
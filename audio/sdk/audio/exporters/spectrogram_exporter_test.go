@@ -0,0 +1,106 @@
+package exporters_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"image/png"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/sdk/audio/exporters"
+)
+
+func sine(freq float64, sampleRate, size int) []float64 {
+	buf := make([]float64, size)
+
+	for i := range buf {
+		buf[i] = 0.5 * math.Sin(2*math.Pi*freq*float64(i)/float64(sampleRate))
+	}
+
+	return buf
+}
+
+func TestSpectrogramExporter(t *testing.T) {
+	header := &wav.Header{SampleRate: 44100, NumChannels: 1, BitsPerSample: 16}
+	data := sine(2000, 44100, 44100)
+
+	t.Run("CSV", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		e, err := exporters.NewSpectrogramExporter(buf, exporters.AsCSV(), exporters.WithSTFT(512, 256))
+		require.NoError(t, err)
+
+		for i := 0; i < len(data); i += 4096 {
+			require.NoError(t, e.Export(context.Background(), header, data[i:min(i+4096, len(data))]))
+		}
+
+		require.NoError(t, e.Shutdown(context.Background()))
+
+		records, err := csv.NewReader(buf).ReadAll()
+		require.NoError(t, err)
+
+		require.Len(t, records, (len(data)-512)/256+2)
+		require.Len(t, records[0], 512/2+2)
+		require.Equal(t, "time", records[0][0])
+		require.Equal(t, "0.00", records[0][1])
+		require.Equal(t, "22050.00", records[0][len(records[0])-1])
+
+		for _, row := range records[1:] {
+			var (
+				peak      int
+				peakValue = math.Inf(-1)
+			)
+
+			for bin, field := range row[1:] {
+				value, err := strconv.ParseFloat(field, 64)
+				require.NoError(t, err)
+
+				if value > peakValue {
+					peak, peakValue = bin, value
+				}
+			}
+
+			freq, err := strconv.ParseFloat(records[0][peak+1], 64)
+			require.NoError(t, err)
+			require.InDelta(t, 2000, freq, 44100.0/512)
+		}
+	})
+
+	t.Run("PNG", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		e, err := exporters.NewSpectrogramExporter(buf, exporters.WithSTFT(256, 128))
+		require.NoError(t, err)
+
+		require.NoError(t, e.Export(context.Background(), header, data))
+		require.NoError(t, e.ForceFlush())
+
+		img, err := png.Decode(buf)
+		require.NoError(t, err)
+
+		require.Equal(t, (len(data)-256)/128+1, img.Bounds().Dx())
+		require.Equal(t, 256/2+1, img.Bounds().Dy())
+	})
+
+	t.Run("MaxFrames", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		e, err := exporters.NewSpectrogramExporter(buf, exporters.WithSTFT(256, 128), exporters.WithMaxFrames(10))
+		require.NoError(t, err)
+
+		require.NoError(t, e.Export(context.Background(), header, data[:256+128*9]))
+
+		img, err := png.Decode(buf)
+		require.NoError(t, err)
+		require.Equal(t, 10, img.Bounds().Dx())
+
+		// nothing is left to flush
+		require.NoError(t, e.Shutdown(context.Background()))
+		require.Zero(t, buf.Len())
+	})
+}
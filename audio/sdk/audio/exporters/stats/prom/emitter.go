@@ -16,6 +16,7 @@ import (
 	"github.com/zalgonoise/cfg"
 
 	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/loudness"
 	"github.com/zalgonoise/x/audio/sdk/audio"
 	"github.com/zalgonoise/x/audio/sdk/audio/exporters"
 )
@@ -32,6 +33,12 @@ type emitter struct {
 	peaks   prometheus.Gauge
 	spectra *prometheus.HistogramVec
 
+	momentary  prometheus.Gauge
+	shortTerm  prometheus.Gauge
+	integrated prometheus.Gauge
+	lra        prometheus.Gauge
+	truePeak   prometheus.Gauge
+
 	server *http.Server
 }
 
@@ -47,6 +54,14 @@ func (e emitter) EmitSpectrum(values []fft.FrequencyPower) {
 	}
 }
 
+func (e emitter) EmitLoudness(value loudness.Measurement) {
+	e.momentary.Set(value.Momentary)
+	e.shortTerm.Set(value.ShortTerm)
+	e.integrated.Set(value.Integrated)
+	e.lra.Set(value.Range)
+	e.truePeak.Set(value.TruePeak)
+}
+
 func (e emitter) Shutdown(ctx context.Context) error {
 	return e.server.Shutdown(ctx)
 }
@@ -66,6 +81,31 @@ func ToProm(
 			Name:      "spectrum_value",
 			Help:      "input signal's frequency value",
 		}, []string{"frequency"}),
+		momentary: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "audio",
+			Name:      "loudness_momentary_lufs",
+			Help:      "input signal's momentary (400ms) loudness, in LUFS",
+		}),
+		shortTerm: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "audio",
+			Name:      "loudness_short_term_lufs",
+			Help:      "input signal's short-term (3s) loudness, in LUFS",
+		}),
+		integrated: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "audio",
+			Name:      "loudness_integrated_lufs",
+			Help:      "input signal's integrated loudness, in LUFS",
+		}),
+		lra: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "audio",
+			Name:      "loudness_range_lu",
+			Help:      "input signal's loudness range, in LU",
+		}),
+		truePeak: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "audio",
+			Name:      "true_peak_dbtp",
+			Help:      "input signal's true peak value, in dBTP",
+		}),
 	}
 
 	reg, err := newRegistry(e)
@@ -88,6 +128,11 @@ func newRegistry(exporter emitter) (*prometheus.Registry, error) {
 		}),
 		exporter.peaks,
 		exporter.spectra,
+		exporter.momentary,
+		exporter.shortTerm,
+		exporter.integrated,
+		exporter.lra,
+		exporter.truePeak,
 	} {
 		if err := reg.Register(metric); err != nil {
 			return nil, err
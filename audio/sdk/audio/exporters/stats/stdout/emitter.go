@@ -8,6 +8,7 @@ import (
 	"github.com/zalgonoise/cfg"
 
 	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/loudness"
 	"github.com/zalgonoise/x/audio/sdk/audio"
 	"github.com/zalgonoise/x/audio/sdk/audio/exporters"
 )
@@ -15,6 +16,7 @@ import (
 const (
 	peaksMessage    = "new peak registered"
 	spectrumMessage = "new spectrum peak registered"
+	loudnessMessage = "new loudness measurement registered"
 )
 
 type emitter struct {
@@ -32,6 +34,16 @@ func (e emitter) EmitSpectrum(values []fft.FrequencyPower) {
 	)
 }
 
+func (e emitter) EmitLoudness(value loudness.Measurement) {
+	e.logger.InfoContext(context.Background(), loudnessMessage,
+		slog.Float64("momentary_lufs", value.Momentary),
+		slog.Float64("short_term_lufs", value.ShortTerm),
+		slog.Float64("integrated_lufs", value.Integrated),
+		slog.Float64("range_lu", value.Range),
+		slog.Float64("true_peak_dbtp", value.TruePeak),
+	)
+}
+
 func (e emitter) Shutdown(context.Context) error {
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/loudness"
 	"github.com/zalgonoise/x/audio/sdk/audio"
 	"github.com/zalgonoise/x/audio/sdk/audio/extractors"
 	"github.com/zalgonoise/x/audio/sdk/audio/registries/batchreg"
@@ -26,7 +27,31 @@ func NewStatsExporter(
 		return audio.NoOpExporter(), err
 	}
 
-	return e, nil
+	if !config.withLoudness {
+		return e, nil
+	}
+
+	// loudness measurements are only exported if the emitter is able to publish them
+	loudnessEmitter, ok := emitter.(audio.LoudnessEmitter)
+	if !ok {
+		return e, nil
+	}
+
+	le, err := audio.NewLoudnessExporter(
+		loudnessEmitter, newLoudnessCollector(), logger, metrics, tracer,
+	)
+	if err != nil {
+		return audio.NoOpExporter(), err
+	}
+
+	return audio.MultiExporter(e, le), nil
+}
+
+func newLoudnessCollector() audio.Collector[loudness.Measurement] {
+	return audio.NewCollector[loudness.Measurement](
+		extractors.Loudness(),
+		unitreg.New[loudness.Measurement](0),
+	)
 }
 
 func newPeaksCollector(config *StatsConfig) audio.Collector[float64] {
@@ -24,6 +24,7 @@ type StatsConfig struct {
 	withPeaks         bool
 	withSpectrum      bool
 	spectrumBlockSize int
+	withLoudness      bool
 
 	batchedPeaks        bool
 	batchedPeaksOptions []cfg.Option[batchreg.Config[float64]]
@@ -84,6 +85,14 @@ func WithSpectrum(blockSize int) cfg.Option[*StatsConfig] {
 	})
 }
 
+func WithLoudness() cfg.Option[*StatsConfig] {
+	return cfg.Register(func(config *StatsConfig) *StatsConfig {
+		config.withLoudness = true
+
+		return config
+	})
+}
+
 func WithLogger(logger *slog.Logger) cfg.Option[*StatsConfig] {
 	if logger == nil {
 		return cfg.NoOp[*StatsConfig]{}
@@ -0,0 +1,119 @@
+package extractors
+
+import (
+	"context"
+	"sync"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/loudness"
+	"github.com/zalgonoise/x/audio/sdk/audio"
+)
+
+// Loudness returns a loudness.Measurement Extractor that measures the perceived loudness of an audio signal, as per
+// ITU-R BS.1770 and EBU R128: its momentary, short-term and integrated loudness (in LUFS), its loudness range
+// (in LU) and its true peak (in dBTP).
+//
+// The returned Extractor is stateful, as the measurements span across the audio chunks (the integrated loudness
+// covering the entire signal), and it must be used with a single audio stream. Its loudness.Meter is reset if the
+// stream's sample rate or number of channels change.
+func Loudness() audio.Extractor[loudness.Measurement] {
+	m := &meter{}
+
+	return audio.Extraction[loudness.Measurement](
+		func(_ context.Context, h *wav.Header, data []float64) loudness.Measurement {
+			return measure(m, h, data, (*loudness.Meter).Measure)
+		},
+	)
+}
+
+// MomentaryLoudness returns a float64 Extractor that measures the loudness (in LUFS) of the last 400ms of an audio
+// signal, as per ITU-R BS.1770.
+//
+// The returned Extractor is stateful and must be used with a single audio stream, just like Loudness.
+func MomentaryLoudness() audio.Extractor[float64] {
+	m := &meter{}
+
+	return audio.Extraction[float64](func(_ context.Context, h *wav.Header, data []float64) float64 {
+		return measure(m, h, data, (*loudness.Meter).Momentary)
+	})
+}
+
+// ShortTermLoudness returns a float64 Extractor that measures the loudness (in LUFS) of the last 3 seconds of an
+// audio signal, as per ITU-R BS.1770.
+//
+// The returned Extractor is stateful and must be used with a single audio stream, just like Loudness.
+func ShortTermLoudness() audio.Extractor[float64] {
+	m := &meter{}
+
+	return audio.Extraction[float64](func(_ context.Context, h *wav.Header, data []float64) float64 {
+		return measure(m, h, data, (*loudness.Meter).ShortTerm)
+	})
+}
+
+// IntegratedLoudness returns a float64 Extractor that measures the gated loudness (in LUFS) of an audio signal
+// since its start, as per ITU-R BS.1770.
+//
+// The returned Extractor is stateful and must be used with a single audio stream, just like Loudness.
+func IntegratedLoudness() audio.Extractor[float64] {
+	m := &meter{}
+
+	return audio.Extraction[float64](func(_ context.Context, h *wav.Header, data []float64) float64 {
+		return measure(m, h, data, (*loudness.Meter).Integrated)
+	})
+}
+
+// LoudnessRange returns a float64 Extractor that measures the loudness range (in LU) of an audio signal since its
+// start, as per EBU Tech 3342.
+//
+// The returned Extractor is stateful and must be used with a single audio stream, just like Loudness.
+func LoudnessRange() audio.Extractor[float64] {
+	m := &meter{}
+
+	return audio.Extraction[float64](func(_ context.Context, h *wav.Header, data []float64) float64 {
+		return measure(m, h, data, (*loudness.Meter).Range)
+	})
+}
+
+// TruePeak returns a float64 Extractor that measures the maximum (oversampled) peak level (in dBTP) of an audio
+// signal since its start, as per ITU-R BS.1770.
+//
+// The returned Extractor is stateful and must be used with a single audio stream, just like Loudness.
+func TruePeak() audio.Extractor[float64] {
+	m := &meter{}
+
+	return audio.Extraction[float64](func(_ context.Context, h *wav.Header, data []float64) float64 {
+		return measure(m, h, data, (*loudness.Meter).TruePeak)
+	})
+}
+
+type meter struct {
+	mu sync.Mutex
+
+	sampleRate  uint32
+	numChannels uint16
+	meter       *loudness.Meter
+}
+
+// measure pushes the audio data to the loudness.Meter, (re)creating it if the audio layout in the header changed,
+// and returns the measurement read from it by `fn`.
+func measure[T any](m *meter, h *wav.Header, data []float64, fn func(*loudness.Meter) T) T {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sampleRate := headerSampleRate(h)
+
+	numChannels := uint16(1)
+	if h != nil && h.NumChannels > 0 {
+		numChannels = h.NumChannels
+	}
+
+	if m.meter == nil || m.sampleRate != sampleRate || m.numChannels != numChannels {
+		m.sampleRate = sampleRate
+		m.numChannels = numChannels
+		m.meter = loudness.NewMeter(sampleRate, numChannels)
+	}
+
+	m.meter.Write(data)
+
+	return fn(m.meter)
+}
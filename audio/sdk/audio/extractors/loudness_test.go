@@ -0,0 +1,71 @@
+package extractors_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/sdk/audio/extractors"
+)
+
+// tone generates a mono 1kHz sine wave with a peak level of `dbfs`, lasting `seconds`.
+func tone(dbfs, seconds float64) []float64 {
+	var (
+		amplitude = math.Pow(10, dbfs/20)
+		buf       = make([]float64, int(seconds*sampleRate))
+	)
+
+	for i := range buf {
+		buf[i] = amplitude * math.Sin(2*math.Pi*1000*float64(i)/sampleRate)
+	}
+
+	return buf
+}
+
+func TestLoudness(t *testing.T) {
+	t.Run("Chunked", func(t *testing.T) {
+		var (
+			ctx       = context.Background()
+			extractor = extractors.Loudness()
+			data      = tone(-23, 4)
+		)
+
+		for i := 0; i < len(data)-sampleRate; i += sampleRate {
+			_ = extractor.Extract(ctx, header(1), data[i:i+sampleRate])
+		}
+
+		m := extractor.Extract(ctx, header(1), data[len(data)-sampleRate:])
+
+		// a mono signal is measured 3dB below the same signal on both stereo channels
+		require.InDelta(t, -26, m.Integrated, 0.1)
+		require.InDelta(t, -26, m.Momentary, 0.1)
+		require.InDelta(t, -26, m.ShortTerm, 0.1)
+		require.InDelta(t, 0, m.Range, 0.1)
+		require.InDelta(t, -23, m.TruePeak, 0.1)
+	})
+
+	t.Run("LayoutChange", func(t *testing.T) {
+		var (
+			ctx       = context.Background()
+			extractor = extractors.IntegratedLoudness()
+			mono      = tone(-10, 2)
+			data      = tone(-23, 2)
+			stereo    = make([]float64, 2*len(data))
+		)
+
+		for i := range data {
+			stereo[2*i] = data[i]
+			stereo[2*i+1] = data[i]
+		}
+
+		_ = extractor.Extract(ctx, header(1), mono)
+
+		require.InDelta(t, -23, extractor.Extract(ctx, header(2), stereo), 0.1)
+	})
+
+	t.Run("TruePeak", func(t *testing.T) {
+		require.InDelta(t, -6, extractors.TruePeak().Extract(context.Background(), header(1), tone(-6, 1)), 0.1)
+	})
+}
@@ -0,0 +1,386 @@
+package extractors
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/fft/window"
+	"github.com/zalgonoise/x/audio/sdk/audio"
+)
+
+const (
+	defaultSampleRate = 44100
+	defaultSTFTSize   = 1024
+	defaultSTFTHop    = 512
+
+	// fluxCompression is the log-compression factor applied to the magnitudes before measuring the spectral flux,
+	// which evens out the contribution of loud and quiet partials.
+	fluxCompression = 100
+	// onsetWindow is the duration of the (past) spectral flux considered for the adaptive onset threshold.
+	onsetWindow = 500 * time.Millisecond
+	// onsetMinGap is the minimum duration between consecutive onsets.
+	onsetMinGap = 50 * time.Millisecond
+	// defaultOnsetDelta is the default value (above the local mean of the spectral flux) that a peak needs to reach
+	// to be reported as an onset.
+	defaultOnsetDelta = 0.1
+
+	// tempoWindow is the duration of the (past) spectral flux used to estimate the tempo.
+	tempoWindow = 8 * time.Second
+	// tempoPeakRatio is the minimum autocorrelation (relative to the strongest one) for a shorter lag to be
+	// preferred as the beat period.
+	tempoPeakRatio  = 0.8
+	defaultMinBPM   = 60
+	defaultMaxBPM   = 200
+	secondsInMinute = 60
+)
+
+// Onset describes the start of a musical event (like a note or a drum hit) in the audio signal.
+type Onset struct {
+	// Time is the position of the onset within the signal.
+	Time time.Duration
+	// Strength is the spectral flux value of the onset, as a measure of its intensity.
+	Strength float64
+}
+
+// STFT returns a []fft.Frame Extractor that calculates the Short-Time Fourier Transform of an audio signal, with
+// blocks of `size` samples placed every `hop` samples, and shaped by the window generated by `gen`.
+//
+// Unlike Spectrum and MaxSpectrum, the returned Extractor is stateful: multichannel audio is mixed down to mono,
+// and the frames are placed every `hop` samples across the chunks' boundaries, with each Frame's Offset reflecting
+// its position in the signal. As such, it must be used with a single audio stream.
+func STFT(size, hop int, gen window.GeneratorFunc) audio.Extractor[[]fft.Frame] {
+	s := newSpectral(size, hop, gen)
+
+	return audio.Extraction[[]fft.Frame](func(_ context.Context, h *wav.Header, data []float64) []fft.Frame {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		return s.stft.Apply(mono(h, data))
+	})
+}
+
+// SpectralFlux returns a []float64 Extractor that calculates the (half-wave rectified, log-compressed) spectral
+// flux of an audio signal, as the increase in magnitude across the frequency bins from one STFT frame to the next.
+// It returns one value for each STFT frame completed by the audio chunk.
+//
+// The spectral flux is an onset detection function: its peaks point to the start of new musical events.
+//
+// The returned Extractor is stateful and must be used with a single audio stream, just like STFT.
+func SpectralFlux(size, hop int) audio.Extractor[[]float64] {
+	s := newSpectral(size, hop, nil)
+
+	return audio.Extraction[[]float64](func(_ context.Context, h *wav.Header, data []float64) []float64 {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		frames := s.stft.Apply(mono(h, data))
+		flux := make([]float64, len(frames))
+
+		for i := range frames {
+			flux[i] = s.flux(frames[i])
+		}
+
+		return flux
+	})
+}
+
+// Onsets returns an []Onset Extractor that detects onsets in an audio signal, by picking the peaks in its
+// spectral flux (see SpectralFlux) which exceed the flux's local mean by at least `delta`.
+//
+// A `delta` value of zero or below is replaced with a default of 0.1. Higher values report fewer, stronger onsets.
+//
+// Since a peak is only confirmed once the following STFT frame is analyzed, an onset may be reported on the chunk
+// following the one containing it. The returned Extractor is stateful and must be used with a single audio stream,
+// just like STFT.
+func Onsets(size, hop int, delta float64) audio.Extractor[[]Onset] {
+	if delta <= 0 {
+		delta = defaultOnsetDelta
+	}
+
+	s := newSpectral(size, hop, nil)
+	d := &onsetDetector{delta: delta}
+
+	return audio.Extraction[[]Onset](func(_ context.Context, h *wav.Header, data []float64) []Onset {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		sampleRate := headerSampleRate(h)
+		frames := s.stft.Apply(mono(h, data))
+		onsets := make([]Onset, 0, len(frames))
+
+		for i := range frames {
+			if onset, ok := d.push(s, sampleRate, frames[i]); ok {
+				onsets = append(onsets, onset)
+			}
+		}
+
+		return onsets
+	})
+}
+
+// Tempo returns a float64 Extractor that estimates the tempo of an audio signal (in beats per minute), within the
+// range of `minBPM` to `maxBPM`, from the periodicity in its spectral flux (see SpectralFlux) over the last 8 seconds.
+//
+// Values of zero or below for `minBPM` and `maxBPM` are replaced with defaults of 60 and 200 BPM, respectively. The
+// Extractor returns zero until it gathers enough of the signal to fit at least two beats at the slowest tempo.
+//
+// The returned Extractor is stateful and must be used with a single audio stream, just like STFT.
+func Tempo(size, hop int, minBPM, maxBPM float64) audio.Extractor[float64] {
+	if minBPM <= 0 {
+		minBPM = defaultMinBPM
+	}
+
+	if maxBPM <= 0 {
+		maxBPM = defaultMaxBPM
+	}
+
+	if minBPM > maxBPM {
+		minBPM, maxBPM = maxBPM, minBPM
+	}
+
+	s := newSpectral(size, hop, nil)
+	e := &tempoEstimator{minBPM: minBPM, maxBPM: maxBPM}
+
+	return audio.Extraction[float64](func(_ context.Context, h *wav.Header, data []float64) float64 {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		frameRate := float64(headerSampleRate(h)) / float64(s.stft.Hop())
+
+		for _, frame := range s.stft.Apply(mono(h, data)) {
+			e.push(s.flux(frame), frameRate)
+		}
+
+		return e.estimate(frameRate)
+	})
+}
+
+type spectral struct {
+	mu sync.Mutex
+
+	stft *fft.STFT
+	prev []float64
+}
+
+func newSpectral(size, hop int, gen window.GeneratorFunc) *spectral {
+	if size < minBlockSize {
+		size = defaultSTFTSize
+	}
+
+	if hop <= 0 {
+		hop = defaultSTFTHop
+	}
+
+	return &spectral{
+		stft: fft.NewSTFT(size, hop, gen),
+	}
+}
+
+// flux calculates the half-wave rectified, log-compressed spectral flux between the input Frame and the
+// previous one.
+func (s *spectral) flux(frame fft.Frame) (flux float64) {
+	if s.prev == nil {
+		s.prev = make([]float64, len(frame.Magnitudes))
+	}
+
+	for i := range frame.Magnitudes {
+		value := math.Log1p(fluxCompression * frame.Magnitudes[i])
+
+		if diff := value - s.prev[i]; diff > 0 {
+			flux += diff
+		}
+
+		s.prev[i] = value
+	}
+
+	return flux / float64(len(frame.Magnitudes))
+}
+
+type onsetDetector struct {
+	delta float64
+
+	// history holds the recent spectral flux values, for the adaptive threshold
+	history []float64
+	// candidate is the previous frame, which is confirmed as a peak once the following frame is analyzed
+	candidate     fft.Frame
+	candidateFlux float64
+	before        float64
+	hasCandidate  bool
+
+	lastOnset time.Duration
+	hasOnset  bool
+}
+
+func (d *onsetDetector) push(s *spectral, sampleRate uint32, frame fft.Frame) (onset Onset, ok bool) {
+	flux := s.flux(frame)
+
+	if d.hasCandidate {
+		onset, ok = d.pick(sampleRate, flux)
+
+		d.history = append(d.history, d.candidateFlux)
+		if maxLen := int(onsetWindow.Seconds() * float64(sampleRate) / float64(s.stft.Hop())); len(d.history) > maxLen {
+			d.history = append(d.history[:0], d.history[len(d.history)-maxLen:]...)
+		}
+	}
+
+	d.before = d.candidateFlux
+	d.candidate = frame
+	d.candidateFlux = flux
+	d.hasCandidate = true
+
+	return onset, ok
+}
+
+// pick evaluates whether the candidate frame is a peak in the spectral flux, above the adaptive threshold.
+func (d *onsetDetector) pick(sampleRate uint32, after float64) (Onset, bool) {
+	flux := d.candidateFlux
+
+	if flux <= d.before || flux < after {
+		return Onset{}, false
+	}
+
+	var mean float64
+
+	for i := range d.history {
+		mean += d.history[i]
+	}
+
+	if len(d.history) > 0 {
+		mean /= float64(len(d.history))
+	}
+
+	if flux < mean+d.delta {
+		return Onset{}, false
+	}
+
+	at := time.Duration(float64(d.candidate.Offset) / float64(sampleRate) * float64(time.Second))
+
+	if d.hasOnset && at-d.lastOnset < onsetMinGap {
+		return Onset{}, false
+	}
+
+	d.lastOnset = at
+	d.hasOnset = true
+
+	return Onset{Time: at, Strength: flux}, true
+}
+
+type tempoEstimator struct {
+	minBPM float64
+	maxBPM float64
+
+	history []float64
+}
+
+func (e *tempoEstimator) push(flux, frameRate float64) {
+	e.history = append(e.history, flux)
+
+	if maxLen := int(tempoWindow.Seconds() * frameRate); len(e.history) > maxLen {
+		e.history = append(e.history[:0], e.history[len(e.history)-maxLen:]...)
+	}
+}
+
+// estimate finds the lag (within the BPM range) with the strongest autocorrelation in the spectral flux history,
+// refining it with a parabolic interpolation over its neighbors.
+func (e *tempoEstimator) estimate(frameRate float64) float64 {
+	var (
+		minLag = int(math.Floor(secondsInMinute * frameRate / e.maxBPM))
+		maxLag = int(math.Ceil(secondsInMinute * frameRate / e.minBPM))
+	)
+
+	minLag = max(minLag, 1)
+
+	if len(e.history) < 2*maxLag+1 {
+		return 0
+	}
+
+	var mean float64
+
+	for i := range e.history {
+		mean += e.history[i]
+	}
+
+	mean /= float64(len(e.history))
+
+	centered := make([]float64, len(e.history))
+	for i := range e.history {
+		centered[i] = e.history[i] - mean
+	}
+
+	// compute one extra lag on each side of the range, for the interpolation
+	corr := make([]float64, maxLag+2)
+
+	for lag := max(minLag-1, 1); lag <= maxLag+1; lag++ {
+		for i := lag; i < len(centered); i++ {
+			corr[lag] += centered[i] * centered[i-lag]
+		}
+
+		corr[lag] /= float64(len(centered) - lag)
+	}
+
+	best := minLag
+
+	for lag := minLag + 1; lag <= maxLag; lag++ {
+		if corr[lag] > corr[best] {
+			best = lag
+		}
+	}
+
+	if corr[best] <= 0 {
+		return 0
+	}
+
+	// a periodic signal correlates just as well at multiples of its period; prefer the shortest lag
+	// (the fastest tempo) that peaks close enough to the strongest one
+	for lag := minLag; lag < best; lag++ {
+		if corr[lag] >= tempoPeakRatio*corr[best] && corr[lag] >= corr[lag-1] && corr[lag] >= corr[lag+1] {
+			best = lag
+
+			break
+		}
+	}
+
+	lag := float64(best)
+
+	if best > 1 {
+		prev, next := corr[best-1], corr[best+1]
+
+		if denom := prev - 2*corr[best] + next; denom != 0 {
+			lag += 0.5 * (prev - next) / denom
+		}
+	}
+
+	return secondsInMinute * frameRate / lag
+}
+
+// mono mixes down the interleaved audio data in `data` to a single channel, as described in the header `h`.
+func mono(h *wav.Header, data []float64) []float64 {
+	if h == nil || h.NumChannels <= 1 {
+		return data
+	}
+
+	channels := int(h.NumChannels)
+	out := make([]float64, len(data)/channels)
+
+	for i := range out {
+		for c := range channels {
+			out[i] += data[i*channels+c]
+		}
+
+		out[i] /= float64(channels)
+	}
+
+	return out
+}
+
+func headerSampleRate(h *wav.Header) uint32 {
+	if h == nil || h.SampleRate == 0 {
+		return defaultSampleRate
+	}
+
+	return h.SampleRate
+}
@@ -0,0 +1,141 @@
+package extractors_test
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/fft"
+	"github.com/zalgonoise/x/audio/sdk/audio/extractors"
+)
+
+const sampleRate = 44100
+
+// clicks generates a mono signal with a percussive hit every beat, at `bpm` beats per minute, over a faint
+// noise floor.
+func clicks(bpm float64, dur time.Duration) []float64 {
+	var (
+		rng  = rand.New(rand.NewSource(1))
+		buf  = make([]float64, int(dur.Seconds()*sampleRate))
+		beat = secondsToSamples(60 / bpm)
+	)
+
+	for i := range buf {
+		buf[i] = 0.001 * (rng.Float64()*2 - 1)
+	}
+
+	for start := 0.0; int(start) < len(buf); start += beat {
+		for i := 0; i < sampleRate/20 && int(start)+i < len(buf); i++ {
+			decay := math.Exp(-float64(i) / (sampleRate / 200))
+			buf[int(start)+i] += 0.8 * decay * (rng.Float64()*2 - 1)
+		}
+	}
+
+	return buf
+}
+
+func secondsToSamples(seconds float64) float64 {
+	return seconds * sampleRate
+}
+
+func header(numChannels uint16) *wav.Header {
+	return &wav.Header{SampleRate: sampleRate, NumChannels: numChannels, BitsPerSample: 16}
+}
+
+func TestSTFT(t *testing.T) {
+	data := clicks(120, time.Second)
+
+	t.Run("MixDown", func(t *testing.T) {
+		stereo := make([]float64, 2*len(data))
+		for i := range data {
+			stereo[2*i] = data[i]
+			stereo[2*i+1] = data[i]
+		}
+
+		mono := extractors.STFT(1024, 512, nil).Extract(context.Background(), header(1), data)
+		mixed := extractors.STFT(1024, 512, nil).Extract(context.Background(), header(2), stereo)
+
+		require.Equal(t, mono, mixed)
+	})
+
+	t.Run("Chunked", func(t *testing.T) {
+		whole := extractors.STFT(1024, 512, nil).Extract(context.Background(), header(1), data)
+
+		extractor := extractors.STFT(1024, 512, nil)
+		chunked := make([]fft.Frame, 0, len(whole))
+
+		for i := 0; i < len(data); i += 3000 {
+			chunked = append(chunked, extractor.Extract(context.Background(), header(1), data[i:min(i+3000, len(data))])...)
+		}
+
+		require.Equal(t, whole, chunked)
+	})
+}
+
+func TestSpectralFlux(t *testing.T) {
+	data := clicks(120, 2*time.Second)
+	flux := extractors.SpectralFlux(1024, 512).Extract(context.Background(), header(1), data)
+
+	require.Len(t, flux, (len(data)-1024)/512+1)
+
+	// the strongest values sit around the hits, every 0.5 seconds
+	var peak int
+
+	for i := 1; i < len(flux); i++ {
+		if flux[i] > flux[peak] {
+			peak = i
+		}
+	}
+
+	position := math.Mod(float64(peak*512+1024)/sampleRate, 0.5)
+	require.True(t, position < 0.05 || position > 0.45, "position: %v", position)
+}
+
+func TestOnsets(t *testing.T) {
+	data := clicks(120, 10*time.Second)
+	extractor := extractors.Onsets(1024, 256, 0)
+
+	onsets := make([]extractors.Onset, 0, 20)
+
+	for i := 0; i < len(data); i += 4096 {
+		onsets = append(onsets, extractor.Extract(context.Background(), header(1), data[i:min(i+4096, len(data))])...)
+	}
+
+	require.Len(t, onsets, 20)
+
+	for i := range onsets {
+		wants := time.Duration(i) * 500 * time.Millisecond
+
+		// the frame containing the hit starts up to one block before it
+		require.InDelta(t, wants, onsets[i].Time, float64(1024*time.Second/sampleRate), "index: %d", i)
+		require.Greater(t, onsets[i].Strength, 0.0)
+	}
+}
+
+func TestTempo(t *testing.T) {
+	for _, bpm := range []float64{80, 95, 120, 140, 174} {
+		t.Run("", func(t *testing.T) {
+			data := clicks(bpm, 10*time.Second)
+			extractor := extractors.Tempo(1024, 256, 0, 0)
+
+			var tempo float64
+
+			for i := 0; i < len(data); i += 4096 {
+				tempo = extractor.Extract(context.Background(), header(1), data[i:min(i+4096, len(data))])
+			}
+
+			require.InDelta(t, bpm, tempo, 2)
+		})
+	}
+
+	t.Run("NotEnoughData", func(t *testing.T) {
+		data := clicks(120, time.Second)
+
+		require.Zero(t, extractors.Tempo(1024, 256, 0, 0).Extract(context.Background(), header(1), data))
+	})
+}
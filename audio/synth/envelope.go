@@ -0,0 +1,167 @@
+// Package synth implements a small synthesizer for generating test signals, mixing band-limited oscillators
+// shaped by ADSR envelopes into mono or stereo audio, which can be rendered into a wav.Wav.
+package synth
+
+import (
+	"math"
+	"time"
+)
+
+// Stage describes the current stage of an Envelope.
+type Stage uint8
+
+const (
+	// IdleStage is the stage of an Envelope before a note is triggered, and after it is released.
+	IdleStage Stage = iota
+	// AttackStage is the stage where the Envelope's level rises from its current level to its peak (1.0).
+	AttackStage
+	// DecayStage is the stage where the Envelope's level falls from its peak to its sustain level.
+	DecayStage
+	// SustainStage is the stage where the Envelope's level is held at its sustain level, until the note is released.
+	SustainStage
+	// ReleaseStage is the stage where the Envelope's level falls from its current level to zero.
+	ReleaseStage
+)
+
+// ADSR describes the shape of an Envelope, with its attack, decay and release durations, and its sustain level
+// (from 0.0 to 1.0).
+type ADSR struct {
+	Attack  time.Duration
+	Decay   time.Duration
+	Sustain float64
+	Release time.Duration
+}
+
+// Envelope is a stateful, linear ADSR envelope generator, which shapes the amplitude of a signal over the course
+// of a note: once triggered with NoteOn, its level rises to its peak during the attack, falls to the sustain level
+// during the decay, and holds it until the note is released with NoteOff, falling to zero during the release.
+//
+// An Envelope must not be used concurrently.
+type Envelope struct {
+	adsr       ADSR
+	sampleRate float64
+
+	stage Stage
+	level float64
+	step  float64
+	// remaining is the number of samples left in the current stage, when it has a duration
+	remaining int
+}
+
+// NewEnvelope creates an Envelope with the shape `adsr`, for a signal with a sample rate of `sampleRate`.
+//
+// The sustain level is limited to the range between 0.0 and 1.0.
+func NewEnvelope(adsr ADSR, sampleRate int) *Envelope {
+	adsr.Sustain = min(max(adsr.Sustain, 0), 1)
+
+	return &Envelope{
+		adsr:       adsr,
+		sampleRate: float64(max(sampleRate, 1)),
+	}
+}
+
+// NoteOn triggers the Envelope, starting its attack from its current level.
+func (e *Envelope) NoteOn() {
+	e.enter(AttackStage)
+}
+
+// NoteOff releases the Envelope, starting its release from its current level.
+func (e *Envelope) NoteOff() {
+	if e.stage == IdleStage {
+		return
+	}
+
+	e.enter(ReleaseStage)
+}
+
+// Stage returns the Envelope's current Stage.
+func (e *Envelope) Stage() Stage {
+	return e.stage
+}
+
+// Level returns the Envelope's current level.
+func (e *Envelope) Level() float64 {
+	return e.level
+}
+
+// Reset returns the Envelope to its idle stage, with a zero level.
+func (e *Envelope) Reset() {
+	e.stage = IdleStage
+	e.level = 0
+	e.step = 0
+	e.remaining = 0
+}
+
+// Next returns the Envelope's level for the next sample, advancing it.
+func (e *Envelope) Next() float64 {
+	level := e.level
+
+	switch e.stage {
+	case AttackStage:
+		e.level += e.step
+
+		if e.remaining--; e.remaining <= 0 {
+			e.level = 1
+			e.enter(DecayStage)
+		}
+	case DecayStage:
+		e.level -= e.step
+
+		if e.remaining--; e.remaining <= 0 {
+			e.level = e.adsr.Sustain
+			e.enter(SustainStage)
+		}
+	case ReleaseStage:
+		e.level -= e.step
+
+		if e.remaining--; e.remaining <= 0 {
+			e.Reset()
+		}
+	}
+
+	return level
+}
+
+// Apply multiplies each value in `buffer` by the Envelope's level for that sample, in place.
+//
+// Its signature matches data.FilterFunc, so it can be passed directly to a Chunk's Apply method (for mono audio).
+func (e *Envelope) Apply(buffer []float64) {
+	for i := range buffer {
+		buffer[i] *= e.Next()
+	}
+}
+
+func (e *Envelope) enter(stage Stage) {
+	e.stage = stage
+
+	switch stage {
+	case AttackStage:
+		e.setRate(1-e.level, e.adsr.Attack)
+	case DecayStage:
+		e.setRate(1-e.adsr.Sustain, e.adsr.Decay)
+	case ReleaseStage:
+		e.setRate(e.level, e.adsr.Release)
+	default:
+		e.step = 0
+		e.remaining = 0
+	}
+
+	// zero-length stages are skipped right away
+	switch {
+	case stage == AttackStage && e.adsr.Attack <= 0:
+		e.level = 1
+		e.enter(DecayStage)
+	case stage == DecayStage && e.adsr.Decay <= 0:
+		e.level = e.adsr.Sustain
+		e.enter(SustainStage)
+	case stage == ReleaseStage && e.adsr.Release <= 0:
+		e.Reset()
+	}
+}
+
+// setRate sets the level change per sample to cover `delta` in the duration `dur`, as well as the number of
+// samples in the stage.
+func (e *Envelope) setRate(delta float64, dur time.Duration) {
+	e.remaining = max(int(math.Round(dur.Seconds()*e.sampleRate)), 1)
+	e.step = delta / float64(e.remaining)
+}
@@ -0,0 +1,97 @@
+package synth
+
+import (
+	"math"
+	"sync"
+)
+
+// Mixer sums the output of multiple Voice into mono or (interleaved) stereo audio data, placing each Voice in the
+// stereo field with a constant-power pan law.
+type Mixer struct {
+	mu sync.Mutex
+
+	sampleRate uint32
+	channels   uint16
+	gain       float64
+
+	voices []*Voice
+}
+
+// NewMixer creates a Mixer for audio data with a sample rate of `sampleRate` and `channels` channels, with the
+// input voices.
+//
+// A zero value for `sampleRate` is treated as 44.1kHz; and only mono and stereo audio are supported, where a
+// `channels` value other than 2 is treated as mono audio.
+func NewMixer(sampleRate uint32, channels uint16, voices ...*Voice) *Mixer {
+	if sampleRate == 0 {
+		sampleRate = 44100
+	}
+
+	if channels != 2 {
+		channels = 1
+	}
+
+	return &Mixer{
+		sampleRate: sampleRate,
+		channels:   channels,
+		gain:       1,
+		voices:     voices,
+	}
+}
+
+// Add adds the input voices to the Mixer.
+func (m *Mixer) Add(voices ...*Voice) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.voices = append(m.voices, voices...)
+}
+
+// SetGain sets the Mixer's master gain, as a linear multiplier of the summed voices.
+func (m *Mixer) SetGain(gain float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.gain = gain
+}
+
+// SampleRate returns the Mixer's sample rate.
+func (m *Mixer) SampleRate() uint32 {
+	return m.sampleRate
+}
+
+// Channels returns the Mixer's number of channels.
+func (m *Mixer) Channels() uint16 {
+	return m.channels
+}
+
+// Read writes the next frames of the mixed voices into `buffer`, as interleaved audio data for the Mixer's number
+// of channels. Any trailing values that do not complete a frame are zeroed.
+func (m *Mixer) Read(buffer []float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	clear(buffer)
+
+	channels := int(m.channels)
+	frames := len(buffer) / channels
+
+	for _, v := range m.voices {
+		// constant-power pan law, where a centered voice has a gain of -3dB on each channel
+		angle := (v.Pan() + 1) * math.Pi / 4
+		left, right := m.gain*math.Cos(angle), m.gain*math.Sin(angle)
+
+		for i := 0; i < frames; i++ {
+			value := v.Next()
+
+			if channels == 1 {
+				buffer[i] += m.gain * value
+
+				continue
+			}
+
+			buffer[2*i] += left * value
+			buffer[2*i+1] += right * value
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package synth
+
+import (
+	"time"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+)
+
+// Render triggers the Mixer's voices and renders `dur` of their mixed output into a new (PCM) wav.Wav, with a
+// bit depth of `bitDepth`, e.g. to generate test signals.
+//
+// The values of the mixed output are clipped to the range between -1.0 and 1.0. The voices are not released, so
+// a Voice with an Envelope holds its sustain level until the end of the rendered audio.
+func Render(m *Mixer, dur time.Duration, bitDepth uint16) (*wav.Wav, error) {
+	w, err := wav.New(m.SampleRate(), bitDepth, m.Channels(), wav.PCMFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	for _, v := range m.voices {
+		v.NoteOn()
+	}
+	m.mu.Unlock()
+
+	buffer := make([]float64, int(dur.Seconds()*float64(m.SampleRate()))*int(m.Channels()))
+
+	m.Read(buffer)
+
+	for i := range buffer {
+		buffer[i] = min(max(buffer[i], -1), 1)
+	}
+
+	w.Data.ParseFloat(buffer)
+
+	return w, nil
+}
@@ -0,0 +1,172 @@
+package synth_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/audio/encoding/wav"
+	"github.com/zalgonoise/x/audio/osc"
+	"github.com/zalgonoise/x/audio/synth"
+)
+
+const sampleRate = 1000
+
+func TestEnvelope(t *testing.T) {
+	t.Run("Stages", func(t *testing.T) {
+		e := synth.NewEnvelope(synth.ADSR{
+			Attack:  10 * time.Millisecond,
+			Decay:   10 * time.Millisecond,
+			Sustain: 0.5,
+			Release: 20 * time.Millisecond,
+		}, sampleRate)
+
+		require.Equal(t, synth.IdleStage, e.Stage())
+		require.Zero(t, e.Next())
+
+		e.NoteOn()
+		require.Equal(t, synth.AttackStage, e.Stage())
+
+		for i := 0; i < 10; i++ {
+			e.Next()
+		}
+
+		require.Equal(t, synth.DecayStage, e.Stage())
+		require.InDelta(t, 1.0, e.Level(), 1e-9)
+
+		for i := 0; i < 10; i++ {
+			e.Next()
+		}
+
+		require.Equal(t, synth.SustainStage, e.Stage())
+		require.InDelta(t, 0.5, e.Level(), 1e-9)
+
+		for i := 0; i < 100; i++ {
+			require.InDelta(t, 0.5, e.Next(), 1e-9)
+		}
+
+		e.NoteOff()
+		require.Equal(t, synth.ReleaseStage, e.Stage())
+
+		for i := 0; i < 10; i++ {
+			e.Next()
+		}
+
+		require.InDelta(t, 0.25, e.Level(), 1e-9)
+
+		for i := 0; i < 10; i++ {
+			e.Next()
+		}
+
+		require.Equal(t, synth.IdleStage, e.Stage())
+		require.Zero(t, e.Level())
+	})
+
+	t.Run("ZeroDurations", func(t *testing.T) {
+		e := synth.NewEnvelope(synth.ADSR{Sustain: 0.8}, sampleRate)
+
+		e.NoteOn()
+		require.Equal(t, synth.SustainStage, e.Stage())
+		require.InDelta(t, 0.8, e.Next(), 1e-9)
+
+		e.NoteOff()
+		require.Equal(t, synth.IdleStage, e.Stage())
+	})
+
+	t.Run("Retrigger", func(t *testing.T) {
+		e := synth.NewEnvelope(synth.ADSR{
+			Attack:  10 * time.Millisecond,
+			Sustain: 1,
+			Release: 10 * time.Millisecond,
+		}, sampleRate)
+
+		e.NoteOn()
+		for i := 0; i < 20; i++ {
+			e.Next()
+		}
+
+		e.NoteOff()
+		for i := 0; i < 5; i++ {
+			e.Next()
+		}
+
+		// the attack restarts from the current level, without jumping to zero
+		e.NoteOn()
+		require.InDelta(t, 0.5, e.Next(), 1e-9)
+		require.Greater(t, e.Next(), 0.5)
+	})
+}
+
+func TestMixer(t *testing.T) {
+	t.Run("Pan", func(t *testing.T) {
+		m := synth.NewMixer(sampleRate, 2,
+			synth.NewVoice(osc.SquareWave, 1, sampleRate, synth.WithPan(-1)),
+			synth.NewVoice(osc.SquareWave, 1, sampleRate, synth.WithPan(0), synth.WithGain(0.5)),
+		)
+
+		buffer := make([]float64, 20)
+		m.Read(buffer)
+
+		// the first voice is only on the left channel, the second is centered at -3dB on each channel
+		require.InDelta(t, 1+0.5*math.Sqrt2/2, buffer[10], 1e-9)
+		require.InDelta(t, 0.5*math.Sqrt2/2, buffer[11], 1e-9)
+	})
+
+	t.Run("Mono", func(t *testing.T) {
+		m := synth.NewMixer(sampleRate, 1,
+			synth.NewVoice(osc.SineWave, 10, sampleRate, synth.WithGain(0.25)),
+			synth.NewVoice(osc.SineWave, 10, sampleRate, synth.WithGain(0.25), synth.WithPan(1)),
+		)
+		m.SetGain(2)
+
+		buffer := make([]float64, sampleRate)
+		m.Read(buffer)
+
+		require.InDelta(t, 1.0, buffer[25], 1e-9)
+	})
+
+	t.Run("Envelope", func(t *testing.T) {
+		v := synth.NewVoice(osc.SquareWave, 1, sampleRate, synth.WithEnvelope(synth.ADSR{Sustain: 1}))
+		m := synth.NewMixer(sampleRate, 1, v)
+
+		buffer := make([]float64, 10)
+
+		m.Read(buffer)
+		require.False(t, v.Active())
+		require.Equal(t, make([]float64, 10), buffer)
+
+		v.NoteOn()
+		m.Read(buffer)
+		require.True(t, v.Active())
+		require.InDelta(t, 1.0, buffer[5], 1e-9)
+	})
+}
+
+func TestRender(t *testing.T) {
+	m := synth.NewMixer(wav.SampleRate44100, 2,
+		synth.NewVoice(osc.SawtoothUpWave, 440, int(wav.SampleRate44100), synth.WithGain(0.5), synth.WithPan(-0.5),
+			synth.WithEnvelope(synth.ADSR{Attack: 10 * time.Millisecond, Sustain: 0.8})),
+		synth.NewVoice(osc.SquareWave, 660, int(wav.SampleRate44100), synth.WithGain(0.5), synth.WithPan(0.5)),
+	)
+
+	w, err := synth.Render(m, 500*time.Millisecond, wav.BitDepth16)
+	require.NoError(t, err)
+	require.Equal(t, wav.ChannelsStereo, w.Header.NumChannels)
+	require.Len(t, w.Data.Float(), 2*int(wav.SampleRate44100)/2)
+
+	for _, value := range w.Data.Float() {
+		require.LessOrEqual(t, math.Abs(value), 1.0)
+	}
+
+	decoded, err := wav.Decode(w.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, w.Header.SampleRate, decoded.Header.SampleRate)
+	require.Len(t, decoded.Data.Float(), len(w.Data.Float()))
+
+	t.Run("InvalidBitDepth", func(t *testing.T) {
+		_, err := synth.Render(m, time.Second, 12)
+		require.ErrorIs(t, err, wav.ErrInvalidBitDepth)
+	})
+}
@@ -0,0 +1,80 @@
+package synth
+
+import (
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/audio/osc"
+)
+
+// Voice is a single sound source in a Mixer, composed of a band-limited oscillator and an optional Envelope,
+// with its own gain and pan.
+//
+// A Voice must not be used concurrently.
+type Voice struct {
+	oscillator *osc.PolyBLEP
+	envelope   *Envelope
+
+	gain float64
+	pan  float64
+}
+
+// NewVoice creates a Voice with a (band-limited) oscillator for the wave shape of `waveType`, with frequency `freq`
+// and sample rate `sampleRate`.
+//
+// A Voice with an Envelope is silent until triggered with NoteOn; otherwise, it plays continuously.
+func NewVoice(waveType osc.Type, freq float64, sampleRate int, options ...cfg.Option[VoiceConfig]) *Voice {
+	config := cfg.Set(DefaultVoiceConfig(), options...)
+
+	v := &Voice{
+		oscillator: osc.NewPolyBLEP(waveType, freq, sampleRate),
+		gain:       config.gain,
+		pan:        config.pan,
+	}
+
+	if config.envelope != nil {
+		v.envelope = NewEnvelope(*config.envelope, sampleRate)
+	}
+
+	return v
+}
+
+// NoteOn triggers the Voice's Envelope, if it has one.
+func (v *Voice) NoteOn() {
+	if v.envelope != nil {
+		v.envelope.NoteOn()
+	}
+}
+
+// NoteOff releases the Voice's Envelope, if it has one.
+func (v *Voice) NoteOff() {
+	if v.envelope != nil {
+		v.envelope.NoteOff()
+	}
+}
+
+// SetFrequency changes the frequency of the Voice's oscillator, keeping its phase.
+func (v *Voice) SetFrequency(freq float64) {
+	v.oscillator.SetFrequency(freq)
+}
+
+// Active returns true if the Voice is producing sound; that is, if it has no Envelope or if its Envelope is not
+// idle.
+func (v *Voice) Active() bool {
+	return v.envelope == nil || v.envelope.Stage() != IdleStage
+}
+
+// Next returns the Voice's next (mono) sample, with its gain and Envelope applied.
+func (v *Voice) Next() float64 {
+	value := v.gain * v.oscillator.Next()
+
+	if v.envelope != nil {
+		value *= v.envelope.Next()
+	}
+
+	return value
+}
+
+// Pan returns the Voice's position in the stereo field, from -1.0 (left) to 1.0 (right).
+func (v *Voice) Pan() float64 {
+	return v.pan
+}
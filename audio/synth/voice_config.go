@@ -0,0 +1,46 @@
+package synth
+
+import (
+	"github.com/zalgonoise/cfg"
+)
+
+// VoiceConfig defines a data structure for configurations and options related to a Voice.
+type VoiceConfig struct {
+	gain     float64
+	pan      float64
+	envelope *ADSR
+}
+
+func DefaultVoiceConfig() VoiceConfig {
+	return VoiceConfig{
+		gain: 1,
+	}
+}
+
+// WithGain sets the Voice's gain, as a linear multiplier of its oscillator's output.
+func WithGain(gain float64) cfg.Option[VoiceConfig] {
+	return cfg.Register(func(config VoiceConfig) VoiceConfig {
+		config.gain = gain
+
+		return config
+	})
+}
+
+// WithPan sets the Voice's position in the stereo field, from -1.0 (left) to 1.0 (right), where 0.0 is the center.
+func WithPan(pan float64) cfg.Option[VoiceConfig] {
+	return cfg.Register(func(config VoiceConfig) VoiceConfig {
+		config.pan = min(max(pan, -1), 1)
+
+		return config
+	})
+}
+
+// WithEnvelope shapes the Voice's amplitude with an Envelope described by `adsr`. Without it, the Voice's
+// amplitude is constant.
+func WithEnvelope(adsr ADSR) cfg.Option[VoiceConfig] {
+	return cfg.Register(func(config VoiceConfig) VoiceConfig {
+		config.envelope = &adsr
+
+		return config
+	})
+}
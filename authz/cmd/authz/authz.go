@@ -121,6 +121,8 @@ func ExecAuthz(ctx context.Context, logger *slog.Logger, args []string) (int, er
 		authz.WithDurMonth(conf.Authz.CertDurMonths),
 		authz.WithChallengeExpiry(conf.Authz.ChallengeDur),
 		authz.WithTokenExpiry(conf.Authz.TokenDur),
+		authz.WithRenewBefore(conf.Authz.RenewBefore),
+		authz.WithRenewalSchedule(conf.Authz.RenewalCron),
 		authz.WithCSR(&pb.CSR{
 			Subject: &pb.Subject{
 				CommonName: conf.Name,
@@ -158,6 +160,16 @@ func ExecAuthz(ctx context.Context, logger *slog.Logger, args []string) (int, er
 	}
 
 	logger.DebugContext(ctx, "metrics handler is ready", slog.String("endpoint", "/metrics"))
+	logger.DebugContext(ctx, "setting up token verification handlers")
+
+	if err = registerTokenVerification(authzService, httpServer); err != nil {
+		return 1, err
+	}
+
+	logger.DebugContext(ctx, "token verification handlers are ready",
+		slog.String("jwks_endpoint", authz.JWKSPath),
+		slog.String("introspection_endpoint", authz.IntrospectPath),
+	)
 	logger.DebugContext(ctx, "serving requests")
 
 	go runHTTPServer(ctx, conf.HTTPPort, httpServer, logger)
@@ -108,11 +108,19 @@ func ExecCertificateAuthority(ctx context.Context, logger *slog.Logger, args []s
 		ca.WithLogger(logger),
 		ca.WithMetrics(m),
 		ca.WithTracer(tracer),
+		ca.WithCRLSchedule(conf.CA.CRLSchedule),
+		ca.WithCRLValidity(conf.CA.CRLValidity),
+		ca.WithCRLURL(conf.CA.CRLURL),
+		ca.WithOCSPURL(conf.CA.OCSPURL),
+		ca.WithRenewalSkew(conf.CA.RenewalSkew),
 		ca.WithTemplate(
 			certs.WithName(pkix.Name{CommonName: conf.Name}),
 			certs.WithDurMonth(conf.CA.CertDurMonths),
 		),
 	)
+	if err != nil {
+		return 1, err
+	}
 
 	logger.DebugContext(ctx, "CA service is ready")
 	logger.DebugContext(ctx, "preparing HTTP server")
@@ -138,6 +146,14 @@ func ExecCertificateAuthority(ctx context.Context, logger *slog.Logger, args []s
 	}
 
 	logger.DebugContext(ctx, "metrics handler is ready", slog.String("endpoint", "/metrics"))
+	logger.DebugContext(ctx, "setting up revocation handlers")
+
+	if err = registerRevocation(caService, httpServer); err != nil {
+		return 1, err
+	}
+
+	logger.DebugContext(ctx, "revocation handlers are ready",
+		slog.String("crl_endpoint", ca.CRLPath), slog.String("ocsp_endpoint", ca.OCSPPath))
 	logger.DebugContext(ctx, "serving requests")
 
 	go runHTTPServer(ctx, conf.HTTPPort, httpServer, logger)
@@ -19,6 +19,8 @@ import (
 
 	"github.com/zalgonoise/x/cli/v2"
 
+	"github.com/zalgonoise/x/authz/internal/authz"
+	"github.com/zalgonoise/x/authz/internal/ca"
 	"github.com/zalgonoise/x/authz/internal/grpcserver"
 	"github.com/zalgonoise/x/authz/internal/httpserver"
 	"github.com/zalgonoise/x/authz/internal/keygen"
@@ -136,6 +138,22 @@ func registerMetrics(m *metrics.Metrics, httpServer *httpserver.Server) error {
 			promhttp.HandlerOpts{Registry: reg}))
 }
 
+func registerTokenVerification(authzService *authz.Authz, httpServer *httpserver.Server) error {
+	if err := httpServer.RegisterHTTP(http.MethodGet, authz.JWKSPath, http.HandlerFunc(authzService.JWKS)); err != nil {
+		return err
+	}
+
+	return httpServer.RegisterHTTP(http.MethodPost, authz.IntrospectPath, http.HandlerFunc(authzService.Introspect))
+}
+
+func registerRevocation(caService *ca.CertificateAuthority, httpServer *httpserver.Server) error {
+	if err := httpServer.RegisterHTTP(http.MethodGet, ca.CRLPath, http.HandlerFunc(caService.CRL)); err != nil {
+		return err
+	}
+
+	return httpServer.RegisterHTTP(http.MethodPost, ca.OCSPPath, http.HandlerFunc(caService.OCSP))
+}
+
 func runHTTPServer(ctx context.Context, port int, httpServer *httpserver.Server, logger *slog.Logger) {
 	logger.InfoContext(ctx, "listening on http", slog.Int("port", port))
 
@@ -151,7 +151,7 @@ func (a *Authz) DeleteCertificate(ctx context.Context, req *pb.CertificateDeleti
 		return nil, status.Error(codes.InvalidArgument, ErrInvalidPublicKey.Error())
 	}
 
-	if err := certs.Verify(req.Certificate, a.root, a.intermediates); err != nil {
+	if err := a.chain.Load().verify(req.Certificate); err != nil {
 		span.SetStatus(otelcodes.Error, err.Error())
 		span.RecordError(err)
 		a.metrics.IncCertificatesDeleteFailed(req.Service)
@@ -162,6 +162,30 @@ func (a *Authz) DeleteCertificate(ctx context.Context, req *pb.CertificateDeleti
 		return nil, status.Error(codes.InvalidArgument, ErrInvalidIDCertificate.Error())
 	}
 
+	cert, err := certs.Decode(req.Certificate)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesDeleteFailed(req.Service)
+
+		a.logger.WarnContext(ctx, "invalid certificate",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidIDCertificate.Error())
+	}
+
+	// a removed certificate is still valid until it expires, unless it is revoked
+	if _, err := a.revoke(ctx, req.Service, cert, pb.RevocationReason_REVOCATION_REASON_CESSATION_OF_OPERATION); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesDeleteFailed(req.Service)
+
+		a.logger.ErrorContext(ctx, "failed to revoke certificate",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	if err := a.services.DeleteCertificate(ctx, req.Service, req.Certificate); err != nil {
 		span.SetStatus(otelcodes.Error, err.Error())
 		span.RecordError(err)
@@ -203,7 +227,19 @@ func (a *Authz) VerifyCertificate(ctx context.Context, req *pb.VerificationReque
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := certs.Verify(req.Certificate, a.root, a.intermediates); err != nil {
+	if err := a.chain.Load().verify(req.Certificate); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificateVerificationFailed(req.Service)
+
+		a.logger.WarnContext(ctx, "invalid certificate",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidIDCertificate.Error())
+	}
+
+	cert, err := certs.Decode(req.Certificate)
+	if err != nil {
 		span.SetStatus(otelcodes.Error, err.Error())
 		span.RecordError(err)
 		a.metrics.IncCertificateVerificationFailed(req.Service)
@@ -214,6 +250,24 @@ func (a *Authz) VerifyCertificate(ctx context.Context, req *pb.VerificationReque
 		return nil, status.Error(codes.InvalidArgument, ErrInvalidIDCertificate.Error())
 	}
 
+	if err := a.checkRevocation(ctx, cert); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificateVerificationFailed(req.Service)
+
+		if errors.Is(err, ErrRevokedIDCertificate) {
+			a.logger.WarnContext(ctx, "revoked certificate",
+				slog.String("service", req.Service), slog.String("serial", cert.SerialNumber.String()))
+
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		a.logger.ErrorContext(ctx, "failed to check certificate revocation",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &pb.VerificationResponse{}, nil
 }
 
@@ -293,5 +347,7 @@ func (a *Authz) RootCertificate(ctx context.Context, _ *pb.RootCertificateReques
 	a.metrics.IncRootCertificateRequests()
 	a.logger.DebugContext(ctx, "authz service's root certificate request")
 
-	return &pb.RootCertificateResponse{Root: a.rootRaw, Intermediates: a.intermediatesRaw}, nil
+	current := a.chain.Load()
+
+	return &pb.RootCertificateResponse{Root: current.rootRaw, Intermediates: current.intermediatesRaw}, nil
 }
@@ -0,0 +1,211 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zalgonoise/x/authz/internal/keygen"
+	"github.com/zalgonoise/x/authz/internal/repository"
+	pb "github.com/zalgonoise/x/authz/pb/authz/v1"
+)
+
+const (
+	JWKSPath       = "/.well-known/jwks.json"
+	IntrospectPath = "/v1/introspect"
+
+	jwksCacheControl = "public, max-age=300"
+	introspectParam  = "token"
+	tokenTypeBearer  = "Bearer"
+	bearerPrefix     = tokenTypeBearer + " "
+)
+
+// Introspection is the RFC 7662 response for a token introspection request.
+//
+// Inactive tokens are described with the Active field alone, and no further detail.
+type Introspection struct {
+	Active       bool     `json:"active"`
+	TokenType    string   `json:"token_type,omitempty"`
+	ClientID     string   `json:"client_id,omitempty"`
+	Issuer       string   `json:"iss,omitempty"`
+	Subject      string   `json:"sub,omitempty"`
+	Audience     []string `json:"aud,omitempty"`
+	ID           string   `json:"jti,omitempty"`
+	Expiry       int64    `json:"exp,omitempty"`
+	NotBefore    int64    `json:"nbf,omitempty"`
+	Service      string   `json:"service,omitempty"`
+	AuthzService string   `json:"authz_service,omitempty"`
+}
+
+// JWKS serves the JSON Web Key Set containing the ES512 public keys that verify the tokens issued by this service.
+func (a *Authz) JWKS(w http.ResponseWriter, r *http.Request) {
+	_, span := a.tracer.Start(r.Context(), "Authz.JWKS")
+	defer span.End()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", jwksCacheControl)
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(a.jwks); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+
+		a.logger.WarnContext(r.Context(), "failed to write JWKS response", slog.String("error", err.Error()))
+	}
+}
+
+// Introspect handles RFC 7662 token introspection requests, where the token is provided as a form value.
+//
+// As required in RFC 7662 section 2.1, callers authenticate with a valid token of their own, issued by this service,
+// as a bearer token in the Authorization header. Unlike VerifyToken, an invalid, expired or unknown token to
+// introspect is not an error: it is reported as an inactive token.
+func (a *Authz) Introspect(w http.ResponseWriter, r *http.Request) {
+	ctx, span := a.tracer.Start(r.Context(), "Authz.Introspect")
+	defer span.End()
+
+	caller, err := a.authenticateCaller(ctx, r)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.logger.WarnContext(ctx, "unauthenticated introspection request", slog.String("error", err.Error()))
+
+		w.Header().Set("WWW-Authenticate", tokenTypeBearer)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	span.SetAttributes(attribute.String("caller", caller))
+
+	if err := r.ParseForm(); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncServiceTokenVerificationFailed("")
+		a.logger.WarnContext(ctx, "invalid introspection request", slog.String("error", err.Error()))
+
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	token := r.PostForm.Get(introspectParam)
+	if token == "" {
+		span.SetStatus(otelcodes.Error, ErrEmptyToken.Error())
+		span.RecordError(ErrEmptyToken)
+		a.metrics.IncServiceTokenVerificationFailed("")
+		a.logger.WarnContext(ctx, "invalid introspection request", slog.String("error", ErrEmptyToken.Error()))
+
+		http.Error(w, ErrEmptyToken.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	res := a.introspect(ctx, token)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+
+		a.logger.WarnContext(ctx, "failed to write introspection response", slog.String("error", err.Error()))
+	}
+}
+
+// authenticateCaller returns the name of the service calling the introspection endpoint, from its bearer token.
+func (a *Authz) authenticateCaller(ctx context.Context, r *http.Request) (string, error) {
+	raw, ok := strings.CutPrefix(r.Header.Get("Authorization"), bearerPrefix)
+	if !ok || raw == "" {
+		return "", ErrEmptyCallerToken
+	}
+
+	caller := a.introspect(ctx, raw)
+	if !caller.Active {
+		return "", ErrInvalidCallerToken
+	}
+
+	return caller.Service, nil
+}
+
+func (a *Authz) introspect(ctx context.Context, raw string) Introspection {
+	start := time.Now()
+
+	token, err := keygen.ParseToken([]byte(raw), &a.privateKey.PublicKey)
+	if err != nil {
+		a.metrics.IncServiceTokenVerificationFailed("")
+		a.logger.DebugContext(ctx, "introspected an invalid token", slog.String("error", err.Error()))
+
+		return Introspection{}
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("service", token.Claim.Service))
+
+	defer func() {
+		a.metrics.ObserveServiceTokenVerificationLatency(ctx, token.Claim.Service, time.Since(start))
+	}()
+
+	a.metrics.IncServiceTokenVerifications(token.Claim.Service)
+	a.logger.DebugContext(ctx, "new token introspection request", slog.String("service", token.Claim.Service))
+
+	if token.Expiry.Before(start) {
+		a.metrics.IncServiceTokenVerificationFailed(token.Claim.Service)
+		a.logger.DebugContext(ctx, "introspected an expired token", slog.String("service", token.Claim.Service))
+
+		return Introspection{}
+	}
+
+	tokens, err := a.tokens.ListTokens(ctx, token.Claim.Service)
+	if err != nil {
+		a.metrics.IncServiceTokenVerificationFailed(token.Claim.Service)
+
+		if !errors.Is(err, repository.ErrNotFound) {
+			span.SetStatus(otelcodes.Error, err.Error())
+			span.RecordError(err)
+
+			a.logger.ErrorContext(ctx, "failed to lookup tokens for this service in the database",
+				slog.String("service", token.Claim.Service), slog.String("error", err.Error()))
+		}
+
+		return Introspection{}
+	}
+
+	if !slices.ContainsFunc(tokens, func(response *pb.TokenResponse) bool {
+		return response.Token == raw
+	}) {
+		a.metrics.IncServiceTokenVerificationFailed(token.Claim.Service)
+		a.logger.DebugContext(ctx, "introspected an unknown token", slog.String("service", token.Claim.Service))
+
+		return Introspection{}
+	}
+
+	res := Introspection{
+		Active:       true,
+		TokenType:    tokenTypeBearer,
+		ClientID:     token.Claim.Service,
+		Issuer:       token.Issuer,
+		Subject:      token.Subject,
+		Audience:     token.Audience,
+		ID:           token.ID,
+		Expiry:       token.Expiry.Unix(),
+		Service:      token.Claim.Service,
+		AuthzService: token.Claim.Authz,
+	}
+
+	if !token.NotBefore.IsZero() {
+		res.NotBefore = token.NotBefore.Unix()
+	}
+
+	return res
+}
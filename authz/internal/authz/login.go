@@ -41,6 +41,13 @@ func (a *Authz) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginRespo
 			return nil, status.Error(codes.InvalidArgument, ErrInvalidIDPublicKey.Error())
 		}
 
+		if errors.Is(err, ErrRevokedIDCertificate) {
+			a.logger.WarnContext(ctx, "revoked ID certificate",
+				slog.String("service", service), slog.String("error", err.Error()))
+
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
 		a.logger.ErrorContext(ctx, "error validating certificate",
 			slog.String("service", service), slog.String("error", err.Error()))
 
@@ -83,7 +90,7 @@ func (a *Authz) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginRespo
 		return nil, status.Error(codes.InvalidArgument, ErrInvalidServiceCertificate.Error())
 	}
 
-	if !a.cert.Equal(serviceCert) {
+	if !a.chain.Load().isServiceCertificate(serviceCert) {
 		span.SetStatus(otelcodes.Error, ErrInvalidServiceCertificate.Error())
 		span.RecordError(ErrInvalidServiceCertificate)
 		a.metrics.IncServiceLoginFailed(service)
@@ -95,7 +102,7 @@ func (a *Authz) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginRespo
 	}
 
 	// validate client certificate
-	if err := certs.Verify(req.IdCertificate, a.root, a.intermediates); err != nil {
+	if err := a.chain.Load().verify(req.IdCertificate); err != nil {
 		span.SetStatus(otelcodes.Error, err.Error())
 		span.RecordError(err)
 		a.metrics.IncServiceLoginFailed(service)
@@ -179,6 +186,13 @@ func (a *Authz) Token(ctx context.Context, req *pb.TokenRequest) (*pb.TokenRespo
 			return nil, status.Error(codes.InvalidArgument, ErrInvalidIDPublicKey.Error())
 		}
 
+		if errors.Is(err, ErrRevokedIDCertificate) {
+			a.logger.WarnContext(ctx, "revoked ID certificate",
+				slog.String("service", service), slog.String("error", err.Error()))
+
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
 		a.logger.ErrorContext(ctx, "error validating certificate",
 			slog.String("service", service), slog.String("error", err.Error()))
 
@@ -374,6 +388,10 @@ func (a *Authz) validateCertificate(ctx context.Context, raw []byte) (*x509.Cert
 		return nil, cert.Subject.CommonName, err
 	}
 
+	if err := a.checkRevocation(ctx, cert); err != nil {
+		return nil, cert.Subject.CommonName, err
+	}
+
 	return cert, cert.Subject.CommonName, nil
 }
 
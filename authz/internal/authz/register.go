@@ -113,7 +113,7 @@ func (a *Authz) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.SignUpRe
 
 	return &pb.SignUpResponse{
 		Certificate:        certRes.Certificate,
-		ServiceCertificate: a.certRaw,
+		ServiceCertificate: a.chain.Load().certRaw,
 	}, nil
 }
 
@@ -216,8 +216,10 @@ func (a *Authz) newCertificate(ctx context.Context, req *pb.CertificateRequest)
 		return nil, time.Time{}, ErrInvalidPublicKey
 	}
 
+	current := a.chain.Load()
+
 	csr := certs.ToCSR(req.Service, pubKey, req.SigningRequest)
-	cert, err := certs.NewCertFromCSR(a.cert.Version, a.durMonth, a.cert.Subject, csr)
+	cert, err := certs.NewCertFromCSR(current.cert.Version, a.durMonth, current.cert.Subject, csr)
 	if err != nil {
 		a.logger.ErrorContext(ctx, "failed to generate new certificate",
 			slog.String("service", req.Service), slog.String("error", err.Error()))
@@ -225,7 +227,7 @@ func (a *Authz) newCertificate(ctx context.Context, req *pb.CertificateRequest)
 		return nil, time.Time{}, err
 	}
 
-	signedCert, err := certs.Encode(cert, a.cert, pubKey, a.privateKey)
+	signedCert, err := certs.Encode(cert, current.cert, pubKey, a.privateKey)
 	if err != nil {
 		a.logger.ErrorContext(ctx, "failed to encode the new certificate",
 			slog.String("service", req.Service), slog.String("error", err.Error()))
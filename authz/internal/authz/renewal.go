@@ -0,0 +1,137 @@
+package authz
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zalgonoise/x/authz/internal/certs"
+	"github.com/zalgonoise/x/authz/internal/keygen"
+	"github.com/zalgonoise/x/authz/internal/repository"
+	pb "github.com/zalgonoise/x/authz/pb/authz/v1"
+)
+
+const defaultRenewalSkew = 5 * time.Minute
+
+// RenewCertificate issues a new ID certificate for a registered client, from a request signed with the private key
+// of one of its current, valid ID certificates.
+func (a *Authz) RenewCertificate(ctx context.Context, req *pb.RenewalRequest) (*pb.CertificateResponse, error) {
+	ctx, span := a.tracer.Start(ctx, "Authz.RenewCertificate", trace.WithAttributes(
+		attribute.String("service", req.Service),
+		attribute.Bool("with_csr", req.SigningRequest != nil),
+	))
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		a.metrics.ObserveCertificatesRenewLatency(ctx, req.Service, time.Since(start))
+	}()
+
+	a.metrics.IncCertificatesRenewed(req.Service)
+	a.logger.DebugContext(ctx, "certificate renewal request",
+		slog.String("service", req.Service), slog.Bool("with_csr", req.SigningRequest != nil))
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesRenewFailed(req.Service)
+
+		a.logger.WarnContext(ctx, "invalid request",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pubKey, err := a.validateRenewal(ctx, req)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesRenewFailed(req.Service)
+
+		a.logger.WarnContext(ctx, "invalid renewal request",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		switch {
+		case errors.Is(err, ErrInvalidIDCertificate), errors.Is(err, ErrExpiredRequest):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, ErrInvalidPublicKey), errors.Is(err, ErrInvalidSignature),
+			errors.Is(err, ErrRevokedIDCertificate):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	certificate, expiry, err := a.newCertificate(ctx, &pb.CertificateRequest{
+		Service:        req.Service,
+		PublicKey:      pubKey,
+		SigningRequest: req.SigningRequest,
+	})
+	if err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesRenewFailed(req.Service)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := a.services.CreateCertificate(ctx, req.Service, certificate, expiry); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesRenewFailed(req.Service)
+
+		a.logger.ErrorContext(ctx, "failed to write certificate to DB",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CertificateResponse{
+		Certificate: certificate,
+		ExpiresOn:   expiry.UnixMilli(),
+	}, nil
+}
+
+func (a *Authz) validateRenewal(ctx context.Context, req *pb.RenewalRequest) ([]byte, error) {
+	if skew := time.Since(time.UnixMilli(req.Timestamp)); skew > defaultRenewalSkew || skew < -defaultRenewalSkew {
+		return nil, ErrExpiredRequest
+	}
+
+	if err := a.chain.Load().verify(req.Certificate); err != nil {
+		return nil, errors.Join(ErrInvalidIDCertificate, err)
+	}
+
+	cert, err := certs.Decode(req.Certificate)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidIDCertificate, err)
+	}
+
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, ErrInvalidPublicKey
+	}
+
+	if err = a.validatePublicKeys(ctx, req.Service, pub); err != nil {
+		return nil, err
+	}
+
+	if err = a.checkRevocation(ctx, cert); err != nil {
+		return nil, err
+	}
+
+	if !certs.VerifyRenewal(pub, req.Service, req.Certificate, req.Timestamp, req.Signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	return keygen.EncodePublic(pub)
+}
@@ -0,0 +1,376 @@
+package authz
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/zalgonoise/micron"
+	"github.com/zalgonoise/micron/executor"
+	"github.com/zalgonoise/micron/schedule"
+	"github.com/zalgonoise/micron/selector"
+
+	"github.com/zalgonoise/x/authz/internal/certs"
+	"github.com/zalgonoise/x/authz/internal/repository"
+	pb "github.com/zalgonoise/x/authz/pb/authz/v1"
+)
+
+const (
+	defaultRenewBefore     = 30 * 24 * time.Hour
+	defaultRenewalSchedule = "0 * * * *"
+
+	certificateService = "service"
+	certificateRoot    = "root"
+	certificateID      = "id"
+
+	// idCertificatePrefix is prepended to the service's name in its ID certificate's subject, as the chain-of-trust
+	// rejects a certificate with the same subject and key as its issuer.
+	idCertificatePrefix = "id."
+)
+
+// chain holds the CA's root certificate and this service's own certificates, as issued by the CA.
+type chain struct {
+	root    *x509.Certificate
+	rootRaw []byte
+
+	// cert is the latest certificate issued to this service, used to sign new certificates.
+	cert    *x509.Certificate
+	certRaw []byte
+
+	// certs lists all valid certificates issued to this service, including cert; as certificates signed with a
+	// renewed certificate are still in use until they expire.
+	certs []*x509.Certificate
+
+	intermediates    *x509.CertPool
+	intermediatesRaw [][]byte
+
+	// id is this service's own ID certificate, issued within its chain-of-trust like the ones clients log in with.
+	id    *x509.Certificate
+	idRaw []byte
+}
+
+// isServiceCertificate returns true if the input certificate is one of this service's valid certificates.
+func (c *chain) isServiceCertificate(cert *x509.Certificate) bool {
+	for i := range c.certs {
+		if c.certs[i].Equal(cert) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verify checks that the input (PEM-encoded) certificate was issued within this chain-of-trust.
+func (c *chain) verify(cert []byte) error {
+	return certs.Verify(cert, c.root, c.intermediates)
+}
+
+// refreshChain fetches the CA's root certificate and this service's valid certificates, replacing the service's
+// certificate chain, with the input certificate as the one to sign new certificates with.
+func (a *Authz) refreshChain(ctx context.Context, current []byte) error {
+	caID, err := a.caClient.RootCertificate(ctx, &pb.RootCertificateRequest{})
+	if err != nil {
+		return err
+	}
+
+	root, err := certs.Decode(caID.Root)
+	if err != nil {
+		return err
+	}
+
+	cert, err := certs.Decode(current)
+	if err != nil {
+		return err
+	}
+
+	issued := [][]byte{current}
+
+	list, err := a.caClient.ListCertificates(ctx, &pb.CertificateRequest{
+		Service:   a.name,
+		PublicKey: a.pubKey,
+	})
+	if err != nil {
+		// the chain is still usable with the current certificate only
+		a.logger.WarnContext(ctx, "failed to list service certificates", slog.String("error", err.Error()))
+	}
+
+	for _, c := range list.GetCertificates() {
+		if !bytes.Equal(c.Certificate, current) {
+			issued = append(issued, c.Certificate)
+		}
+	}
+
+	next := &chain{
+		root:             root,
+		rootRaw:          caID.Root,
+		cert:             cert,
+		certRaw:          current,
+		certs:            make([]*x509.Certificate, 0, len(issued)),
+		intermediates:    x509.NewCertPool(),
+		intermediatesRaw: make([][]byte, 0, len(caID.Intermediates)+len(issued)),
+	}
+
+	// the ID certificate is kept across refreshes, as renewID replaces it when needed
+	if previous := a.chain.Load(); previous != nil {
+		next.id = previous.id
+		next.idRaw = previous.idRaw
+	}
+
+	now := time.Now()
+
+	for _, raw := range append(caID.Intermediates, issued...) {
+		c, err := certs.Decode(raw)
+		if err != nil {
+			return err
+		}
+
+		if now.After(c.NotAfter) {
+			continue
+		}
+
+		next.intermediates.AddCert(c)
+		next.intermediatesRaw = append(next.intermediatesRaw, raw)
+	}
+
+	for _, raw := range issued {
+		c, err := certs.Decode(raw)
+		if err != nil {
+			return err
+		}
+
+		if now.After(c.NotAfter) {
+			continue
+		}
+
+		next.certs = append(next.certs, c)
+	}
+
+	a.chain.Store(next)
+
+	a.metrics.SetCertificateTimeToExpiry(certificateService, time.Until(next.cert.NotAfter))
+	a.metrics.SetCertificateTimeToExpiry(certificateRoot, time.Until(next.root.NotAfter))
+
+	return nil
+}
+
+// renew rotates this service's certificate if it expires within the configured renewal window, and refreshes the
+// CA's root certificate. The service's ID certificate is then rotated with renewID.
+func (a *Authz) renew(ctx context.Context) error {
+	ctx, done := context.WithTimeout(ctx, defaultConnTimeout)
+	defer done()
+
+	current := a.chain.Load()
+
+	ttl := time.Until(current.cert.NotAfter)
+	a.metrics.SetCertificateTimeToExpiry(certificateService, ttl)
+	a.metrics.SetCertificateTimeToExpiry(certificateRoot, time.Until(current.root.NotAfter))
+
+	if ttl > a.renewBefore {
+		// still refresh the chain, in case the CA's root certificate was replaced
+		if err := a.refreshChain(ctx, current.certRaw); err != nil {
+			return err
+		}
+
+		return a.renewID(ctx)
+	}
+
+	a.logger.InfoContext(ctx, "renewing service certificate", slog.Time("expiry", current.cert.NotAfter))
+
+	now := time.Now()
+
+	signature, err := certs.SignRenewal(a.privateKey, a.name, current.certRaw, now)
+	if err != nil {
+		a.metrics.IncCertificateRotationFailed()
+
+		return err
+	}
+
+	res, err := a.caClient.RenewCertificate(ctx, &pb.RenewalRequest{
+		Service:        a.name,
+		Certificate:    current.certRaw,
+		Timestamp:      now.UnixMilli(),
+		Signature:      signature,
+		SigningRequest: a.csr,
+	})
+	if err != nil {
+		a.metrics.IncCertificateRotationFailed()
+		a.logger.ErrorContext(ctx, "failed to renew service certificate", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	if err = a.refreshChain(ctx, res.Certificate); err != nil {
+		a.metrics.IncCertificateRotationFailed()
+		a.logger.ErrorContext(ctx, "failed to refresh certificate chain", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	a.metrics.IncCertificateRotations()
+	a.logger.InfoContext(ctx, "service certificate renewed", slog.Time("expiry", time.UnixMilli(res.ExpiresOn)))
+
+	return a.renewID(ctx)
+}
+
+// renewID issues a new ID certificate for this service if it has none, if it expires within the configured renewal
+// window, if it was revoked, or if it no longer verifies against the certificate chain.
+//
+// The ID certificate is registered under the service's ID name like the ones issued on SignUp, so that it can be used
+// to log in, and listed or revoked with the CA's RPCs.
+func (a *Authz) renewID(ctx context.Context) error {
+	if a.chain.Load().id == nil {
+		if err := a.loadID(ctx); err != nil {
+			return err
+		}
+	}
+
+	current := a.chain.Load()
+
+	if current.id != nil {
+		ttl := time.Until(current.id.NotAfter)
+		a.metrics.SetCertificateTimeToExpiry(certificateID, ttl)
+
+		if ttl > a.renewBefore && current.verify(current.idRaw) == nil && a.checkRevocation(ctx, current.id) == nil {
+			return nil
+		}
+
+		a.logger.InfoContext(ctx, "renewing ID certificate", slog.Time("expiry", current.id.NotAfter))
+	}
+
+	name := idCertificatePrefix + a.name
+
+	raw, expiry, err := a.newCertificate(ctx, &pb.CertificateRequest{
+		Service:        name,
+		PublicKey:      a.pubKey,
+		SigningRequest: a.csr,
+	})
+	if err != nil {
+		a.metrics.IncCertificateRotationFailed()
+		a.logger.ErrorContext(ctx, "failed to issue ID certificate", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	id, err := certs.Decode(raw)
+	if err != nil {
+		a.metrics.IncCertificateRotationFailed()
+
+		return err
+	}
+
+	if err = a.services.CreateCertificate(ctx, name, raw, expiry); err != nil {
+		a.metrics.IncCertificateRotationFailed()
+		a.logger.ErrorContext(ctx, "failed to write ID certificate to DB", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	next := *a.chain.Load()
+	next.id = id
+	next.idRaw = raw
+
+	a.chain.Store(&next)
+
+	a.metrics.SetCertificateTimeToExpiry(certificateID, time.Until(expiry))
+	a.metrics.IncCertificateRotations()
+	a.logger.InfoContext(ctx, "ID certificate issued", slog.Time("expiry", expiry))
+
+	return nil
+}
+
+// loadID registers this service's ID name if it isn't yet, and picks up the latest stored ID certificate that is still
+// valid, as issued before a restart.
+func (a *Authz) loadID(ctx context.Context) error {
+	name := idCertificatePrefix + a.name
+
+	err := a.validatePublicKeys(ctx, name, &a.privateKey.PublicKey)
+
+	switch {
+	case err == nil:
+	case errors.Is(err, repository.ErrNotFound):
+		if err = a.services.CreateService(ctx, name, a.pubKey); err != nil {
+			return err
+		}
+
+		a.logger.InfoContext(ctx, "registered ID service", slog.String("service", name))
+
+		return nil
+	default:
+		return err
+	}
+
+	stored, err := a.services.ListCertificates(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	current := a.chain.Load()
+	now := time.Now()
+
+	// certificates are listed with the latest expiry first
+	for i := range stored {
+		id, err := certs.Decode(stored[i].Certificate)
+		if err != nil || now.After(id.NotAfter) || current.verify(stored[i].Certificate) != nil {
+			continue
+		}
+
+		next := *current
+		next.id = id
+		next.idRaw = stored[i].Certificate
+
+		a.chain.Store(&next)
+
+		return nil
+	}
+
+	return nil
+}
+
+func (a *Authz) runRenewer(ctx context.Context, cronSchedule string) error {
+	s, err := schedule.New(
+		schedule.WithSchedule(cronSchedule),
+		schedule.WithLogger(a.logger),
+		schedule.WithTrace(a.tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	exec, err := executor.New("certificate_renewal",
+		executor.WithScheduler(s),
+		executor.WithRunners(executor.Runnable(a.renew)),
+		executor.WithLocation(time.UTC),
+		executor.WithLogger(a.logger),
+		executor.WithTrace(a.tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	sel, err := selector.New(
+		selector.WithExecutors(exec),
+		selector.WithTimeout(defaultConnTimeout),
+		selector.WithLogger(a.logger),
+		selector.WithTrace(a.tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	cron, err := micron.New(
+		micron.WithSelector(sel),
+		micron.WithErrorBufferSize(16),
+		micron.WithLogger(a.logger),
+		micron.WithTrace(a.tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	go cron.Run(ctx)
+
+	return nil
+}
@@ -0,0 +1,408 @@
+package authz
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/cfg"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+
+	"github.com/zalgonoise/x/authz/internal/certs"
+	"github.com/zalgonoise/x/authz/internal/keygen"
+	"github.com/zalgonoise/x/authz/internal/log"
+	"github.com/zalgonoise/x/authz/internal/metrics"
+	"github.com/zalgonoise/x/authz/internal/repository"
+	pb "github.com/zalgonoise/x/authz/pb/authz/v1"
+)
+
+const testServiceName = "authz.renewer.test"
+
+type fakeCA struct {
+	pb.CertificateAuthorityClient
+
+	root    []byte
+	renewed []byte
+	err     error
+
+	renewals int
+}
+
+func (c *fakeCA) RootCertificate(context.Context, *pb.RootCertificateRequest, ...grpc.CallOption) (*pb.RootCertificateResponse, error) {
+	return &pb.RootCertificateResponse{Root: c.root}, nil
+}
+
+func (c *fakeCA) ListCertificates(context.Context, *pb.CertificateRequest, ...grpc.CallOption) (*pb.ListCertificatesResponse, error) {
+	return &pb.ListCertificatesResponse{}, nil
+}
+
+func (c *fakeCA) RenewCertificate(context.Context, *pb.RenewalRequest, ...grpc.CallOption) (*pb.CertificateResponse, error) {
+	c.renewals++
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &pb.CertificateResponse{Certificate: c.renewed}, nil
+}
+
+type fakeServices struct {
+	ServiceRepository
+
+	services     map[string][]byte
+	certificates map[string][]*pb.CertificateResponse
+	revoked      map[string]bool
+}
+
+func (r *fakeServices) GetService(_ context.Context, service string) ([]byte, error) {
+	pubKey, ok := r.services[service]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+
+	return pubKey, nil
+}
+
+func (r *fakeServices) CreateService(_ context.Context, service string, pubKey []byte) error {
+	r.services[service] = pubKey
+
+	return nil
+}
+
+func (r *fakeServices) ListCertificates(_ context.Context, service string) ([]*pb.CertificateResponse, error) {
+	return r.certificates[service], nil
+}
+
+func (r *fakeServices) CreateCertificate(_ context.Context, service string, cert []byte, expiry time.Time) error {
+	// listed with the latest expiry first
+	r.certificates[service] = append([]*pb.CertificateResponse{{
+		Certificate: cert,
+		ExpiresOn:   expiry.UnixMilli(),
+	}}, r.certificates[service]...)
+
+	return nil
+}
+
+func (r *fakeServices) GetRevocation(_ context.Context, serial *big.Int) (repository.Revocation, error) {
+	if !r.revoked[serial.String()] {
+		return repository.Revocation{}, repository.ErrNotFound
+	}
+
+	return repository.Revocation{Serial: serial}, nil
+}
+
+type fakeMetrics struct {
+	Metrics
+
+	rotations int
+	failures  int
+	expiry    map[string]time.Duration
+}
+
+func (m *fakeMetrics) IncCertificateRotations() { m.rotations++ }
+
+func (m *fakeMetrics) IncCertificateRotationFailed() { m.failures++ }
+
+func (m *fakeMetrics) SetCertificateTimeToExpiry(certificate string, duration time.Duration) {
+	m.expiry[certificate] = duration
+}
+
+func TestRenew(t *testing.T) {
+	rootKeyPEM, err := os.ReadFile("./testdata/ca.testkey_private.pem")
+	require.NoError(t, err)
+
+	rootKey, err := keygen.DecodePrivate(rootKeyPEM)
+	require.NoError(t, err)
+
+	keyPEM, err := os.ReadFile("./testdata/authz.testkey_private.pem")
+	require.NoError(t, err)
+
+	key, err := keygen.DecodePrivate(keyPEM)
+	require.NoError(t, err)
+
+	pubKey, err := keygen.EncodePublic(&key.PublicKey)
+	require.NoError(t, err)
+
+	rootRaw, err := certs.NewCACertificate(cfg.Set(certs.DefaultTemplate(),
+		certs.WithName(pkix.Name{CommonName: "authz.ca.test"}),
+		certs.WithDurMonth(24),
+		certs.WithPrivateKey(rootKey),
+	))
+	require.NoError(t, err)
+
+	root, err := certs.Decode(rootRaw)
+	require.NoError(t, err)
+
+	const (
+		year = 365 * 24 * time.Hour
+		week = 7 * 24 * time.Hour
+	)
+
+	for _, testcase := range []struct {
+		name        string
+		serviceTTL  time.Duration
+		idTTL       time.Duration
+		idUntrusted bool
+		idRevoked   bool
+		err         error
+
+		wantErr            error
+		wantRenewals       int
+		wantRotations      int
+		wantFailures       int
+		wantServiceRotated bool
+		wantIDRotated      bool
+	}{
+		{
+			name:       "OutsideTheWindow",
+			serviceTTL: year,
+			idTTL:      year,
+		},
+		{
+			name:               "ServiceCertificateInsideTheWindow",
+			serviceTTL:         week,
+			idTTL:              year,
+			wantRenewals:       1,
+			wantRotations:      1,
+			wantServiceRotated: true,
+		},
+		{
+			name:          "IDCertificateInsideTheWindow",
+			serviceTTL:    year,
+			idTTL:         week,
+			wantRotations: 1,
+			wantIDRotated: true,
+		},
+		{
+			name:          "IDCertificateOutsideTheChain",
+			serviceTTL:    year,
+			idTTL:         year,
+			idUntrusted:   true,
+			wantRotations: 1,
+			wantIDRotated: true,
+		},
+		{
+			name:          "IDCertificateRevoked",
+			serviceTTL:    year,
+			idTTL:         year,
+			idRevoked:     true,
+			wantRotations: 1,
+			wantIDRotated: true,
+		},
+		{
+			name:         "FailedRenewal",
+			serviceTTL:   week,
+			idTTL:        year,
+			err:          errors.New("unavailable"),
+			wantErr:      errors.New("unavailable"),
+			wantRenewals: 1,
+			wantFailures: 1,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			serviceRaw := newTestCertificate(t, testServiceName, root, rootKey, &key.PublicKey, testcase.serviceTTL)
+			renewedRaw := newTestCertificate(t, testServiceName, root, rootKey, &key.PublicKey, year)
+
+			ca := &fakeCA{root: rootRaw, renewed: renewedRaw, err: testcase.err}
+			m := &fakeMetrics{Metrics: metrics.NoOp(), expiry: make(map[string]time.Duration)}
+			services := newFakeServices()
+
+			a := &Authz{
+				caClient:    ca,
+				name:        testServiceName,
+				privateKey:  key,
+				pubKey:      pubKey,
+				durMonth:    defaultDurMonth,
+				renewBefore: defaultRenewBefore,
+				services:    services,
+				metrics:     m,
+				logger:      log.NoOp(),
+				tracer:      noop.NewTracerProvider().Tracer("authz"),
+			}
+
+			require.NoError(t, a.refreshChain(ctx, serviceRaw))
+
+			current := a.chain.Load()
+			idRaw := newTestCertificate(t, idCertificatePrefix+testServiceName, current.cert, key, &key.PublicKey,
+				testcase.idTTL)
+			if testcase.idUntrusted {
+				// issued within another chain, as if the CA's root certificate was replaced
+				otherKey, err := keygen.New()
+				require.NoError(t, err)
+
+				otherRootRaw, err := certs.NewCACertificate(cfg.Set(certs.DefaultTemplate(),
+					certs.WithName(pkix.Name{CommonName: "authz.other-ca.test"}),
+					certs.WithPrivateKey(otherKey),
+				))
+				require.NoError(t, err)
+
+				otherRoot, err := certs.Decode(otherRootRaw)
+				require.NoError(t, err)
+
+				idRaw = newTestCertificate(t, idCertificatePrefix+testServiceName, otherRoot, otherKey, &key.PublicKey,
+					testcase.idTTL)
+			}
+
+			id, err := certs.Decode(idRaw)
+			require.NoError(t, err)
+
+			current.id = id
+			current.idRaw = idRaw
+
+			services.revoked[id.SerialNumber.String()] = testcase.idRevoked
+
+			err = a.renew(ctx)
+			if testcase.wantErr != nil {
+				require.EqualError(t, err, testcase.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testcase.wantRenewals, ca.renewals)
+			require.Equal(t, testcase.wantRotations, m.rotations)
+			require.Equal(t, testcase.wantFailures, m.failures)
+
+			next := a.chain.Load()
+
+			if testcase.wantServiceRotated {
+				require.Equal(t, renewedRaw, next.certRaw)
+			} else {
+				require.Equal(t, serviceRaw, next.certRaw)
+			}
+
+			if testcase.wantIDRotated {
+				require.NotEqual(t, idRaw, next.idRaw)
+				require.Equal(t, idCertificatePrefix+testServiceName, next.id.Subject.CommonName)
+				require.NoError(t, next.verify(next.idRaw))
+
+				// the new ID certificate is stored, so it can be used to log in and be revoked
+				stored := services.certificates[idCertificatePrefix+testServiceName]
+				require.Len(t, stored, 1)
+				require.Equal(t, next.idRaw, stored[0].Certificate)
+			} else {
+				require.Equal(t, idRaw, next.idRaw)
+			}
+
+			require.Contains(t, m.expiry, certificateRoot)
+			require.Contains(t, m.expiry, certificateService)
+
+			if testcase.wantErr == nil {
+				require.Greater(t, m.expiry[certificateID], defaultRenewBefore)
+			}
+		})
+	}
+}
+
+func TestRenewID(t *testing.T) {
+	ctx := context.Background()
+
+	rootKeyPEM, err := os.ReadFile("./testdata/ca.testkey_private.pem")
+	require.NoError(t, err)
+
+	rootKey, err := keygen.DecodePrivate(rootKeyPEM)
+	require.NoError(t, err)
+
+	keyPEM, err := os.ReadFile("./testdata/authz.testkey_private.pem")
+	require.NoError(t, err)
+
+	key, err := keygen.DecodePrivate(keyPEM)
+	require.NoError(t, err)
+
+	pubKey, err := keygen.EncodePublic(&key.PublicKey)
+	require.NoError(t, err)
+
+	rootRaw, err := certs.NewCACertificate(cfg.Set(certs.DefaultTemplate(),
+		certs.WithName(pkix.Name{CommonName: "authz.ca.test"}),
+		certs.WithDurMonth(24),
+		certs.WithPrivateKey(rootKey),
+	))
+	require.NoError(t, err)
+
+	root, err := certs.Decode(rootRaw)
+	require.NoError(t, err)
+
+	serviceRaw := newTestCertificate(t, testServiceName, root, rootKey, &key.PublicKey, 365*24*time.Hour)
+	services := newFakeServices()
+
+	newAuthz := func() *Authz {
+		a := &Authz{
+			caClient:    &fakeCA{root: rootRaw},
+			name:        testServiceName,
+			privateKey:  key,
+			pubKey:      pubKey,
+			durMonth:    defaultDurMonth,
+			renewBefore: defaultRenewBefore,
+			services:    services,
+			metrics:     &fakeMetrics{Metrics: metrics.NoOp(), expiry: make(map[string]time.Duration)},
+			logger:      log.NoOp(),
+			tracer:      noop.NewTracerProvider().Tracer("authz"),
+		}
+
+		require.NoError(t, a.refreshChain(ctx, serviceRaw))
+
+		return a
+	}
+
+	// the first run registers the ID service and stores its certificate
+	a := newAuthz()
+	require.NoError(t, a.renewID(ctx))
+
+	idName := idCertificatePrefix + testServiceName
+	require.Equal(t, pubKey, services.services[idName])
+	require.Len(t, services.certificates[idName], 1)
+
+	idRaw := a.chain.Load().idRaw
+	require.Equal(t, idRaw, services.certificates[idName][0].Certificate)
+
+	// the service can log in with it, as its public key is registered
+	id, err := certs.Decode(idRaw)
+	require.NoError(t, err)
+	require.NoError(t, a.validatePublicKeys(ctx, idName, id.PublicKey.(*ecdsa.PublicKey)))
+
+	// a restart picks up the stored certificate instead of issuing a new one
+	restarted := newAuthz()
+	require.NoError(t, restarted.renewID(ctx))
+	require.Equal(t, idRaw, restarted.chain.Load().idRaw)
+	require.Len(t, services.certificates[idName], 1)
+
+	// unless it was revoked in the meantime
+	services.revoked[id.SerialNumber.String()] = true
+
+	restarted = newAuthz()
+	require.NoError(t, restarted.renewID(ctx))
+	require.NotEqual(t, idRaw, restarted.chain.Load().idRaw)
+	require.Len(t, services.certificates[idName], 2)
+}
+
+func newFakeServices() *fakeServices {
+	return &fakeServices{
+		services:     make(map[string][]byte),
+		certificates: make(map[string][]*pb.CertificateResponse),
+		revoked:      make(map[string]bool),
+	}
+}
+
+func newTestCertificate(
+	t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, pub *ecdsa.PublicKey, ttl time.Duration,
+) []byte {
+	template, err := certs.NewCertFromCSR(parent.Version, defaultDurMonth, parent.Subject,
+		certs.ToCSR(name, pub, nil))
+	require.NoError(t, err)
+
+	template.NotAfter = time.Now().Add(ttl)
+
+	raw, err := certs.Encode(template, parent, pub, parentKey)
+	require.NoError(t, err)
+
+	return raw
+}
@@ -0,0 +1,160 @@
+package authz
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zalgonoise/x/authz/internal/certs"
+	"github.com/zalgonoise/x/authz/internal/keygen"
+	"github.com/zalgonoise/x/authz/internal/repository"
+	pb "github.com/zalgonoise/x/authz/pb/authz/v1"
+)
+
+func (a *Authz) RevokeCertificate(ctx context.Context, req *pb.RevocationRequest) (*pb.RevocationResponse, error) {
+	ctx, span := a.tracer.Start(ctx, "Authz.RevokeCertificate", trace.WithAttributes(
+		attribute.String("service", req.Service),
+		attribute.String("reason", req.Reason.String()),
+	))
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		a.metrics.ObserveCertificatesRevokeLatency(ctx, req.Service, time.Since(start))
+	}()
+
+	a.metrics.IncCertificatesRevoked(req.Service)
+	a.logger.DebugContext(ctx, "certificate revocation request",
+		slog.String("service", req.Service), slog.String("reason", req.Reason.String()))
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesRevokeFailed(req.Service)
+
+		a.logger.WarnContext(ctx, "invalid request",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pubKey, err := keygen.DecodePublic(req.PublicKey)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesRevokeFailed(req.Service)
+
+		a.logger.WarnContext(ctx, "invalid public key",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidPublicKey.Error())
+	}
+
+	if err := a.validatePublicKeys(ctx, req.Service, pubKey); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesRevokeFailed(req.Service)
+
+		if errors.Is(err, ErrInvalidPublicKey) {
+			a.logger.WarnContext(ctx, "mismatching public keys",
+				slog.String("service", req.Service), slog.String("error", err.Error()))
+
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		a.logger.ErrorContext(ctx, "failed to validate public keys",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	cert, err := a.verifyOwnership(req.Certificate, pubKey)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesRevokeFailed(req.Service)
+
+		a.logger.WarnContext(ctx, "invalid certificate",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidIDCertificate.Error())
+	}
+
+	revokedAt, err := a.revoke(ctx, req.Service, cert, req.Reason)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.RecordError(err)
+		a.metrics.IncCertificatesRevokeFailed(req.Service)
+
+		a.logger.ErrorContext(ctx, "failed to revoke certificate",
+			slog.String("service", req.Service), slog.String("error", err.Error()))
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RevocationResponse{RevokedOn: revokedAt.UnixMilli()}, nil
+}
+
+// verifyOwnership ensures that the input certificate was issued by this service for the input public key.
+func (a *Authz) verifyOwnership(raw []byte, pubKey *ecdsa.PublicKey) (*x509.Certificate, error) {
+	if err := a.chain.Load().verify(raw); err != nil {
+		return nil, err
+	}
+
+	cert, err := certs.Decode(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	certPub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok || !pubKey.Equal(certPub) {
+		return nil, ErrInvalidPublicKey
+	}
+
+	return cert, nil
+}
+
+func (a *Authz) revoke(
+	ctx context.Context, service string, cert *x509.Certificate, reason pb.RevocationReason,
+) (time.Time, error) {
+	if err := a.services.RevokeCertificate(ctx, repository.Revocation{
+		Serial:    cert.SerialNumber,
+		Service:   service,
+		Reason:    int(reason),
+		RevokedAt: time.Now(),
+		Expiry:    cert.NotAfter,
+	}); err != nil {
+		return time.Time{}, err
+	}
+
+	// revoking an already revoked certificate keeps the original revocation
+	revocation, err := a.services.GetRevocation(ctx, cert.SerialNumber)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return revocation.RevokedAt, nil
+}
+
+// checkRevocation returns ErrRevokedIDCertificate if the input certificate is revoked.
+func (a *Authz) checkRevocation(ctx context.Context, cert *x509.Certificate) error {
+	_, err := a.services.GetRevocation(ctx, cert.SerialNumber)
+
+	switch {
+	case err == nil:
+		return ErrRevokedIDCertificate
+	case errors.Is(err, repository.ErrNotFound):
+		return nil
+	default:
+		return err
+	}
+}
@@ -3,9 +3,10 @@ package authz
 import (
 	"context"
 	"crypto/ecdsa"
-	"crypto/x509"
 	"errors"
 	"log/slog"
+	"math/big"
+	"sync/atomic"
 	"time"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -17,9 +18,9 @@ import (
 	"github.com/zalgonoise/cfg"
 	"github.com/zalgonoise/x/errs"
 
-	"github.com/zalgonoise/x/authz/internal/certs"
 	"github.com/zalgonoise/x/authz/internal/keygen"
 	"github.com/zalgonoise/x/authz/internal/randomizer"
+	"github.com/zalgonoise/x/authz/internal/repository"
 	pb "github.com/zalgonoise/x/authz/pb/authz/v1"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -39,6 +40,7 @@ const (
 	ErrInvalid = errs.Kind("invalid")
 	ErrExpired = errs.Kind("expired")
 	ErrEmpty   = errs.Kind("empty")
+	ErrRevoked = errs.Kind("revoked")
 
 	ErrCAAddress          = errs.Entity("CA address")
 	ErrPublicKey          = errs.Entity("public key")
@@ -50,6 +52,9 @@ const (
 	ErrTokensRepo         = errs.Entity("tokens repository")
 	ErrChallenge          = errs.Entity("challenge")
 	ErrToken              = errs.Entity("token")
+	ErrCallerToken        = errs.Entity("caller token")
+	ErrSignature          = errs.Entity("signature")
+	ErrRequest            = errs.Entity("request")
 )
 
 var (
@@ -61,8 +66,14 @@ var (
 	ErrInvalidServiceCertificate = errs.WithDomain(errDomain, ErrInvalid, ErrServiceCertificate)
 	ErrInvalidIDPublicKey        = errs.WithDomain(errDomain, ErrInvalid, ErrIDPublicKey)
 	ErrInvalidIDCertificate      = errs.WithDomain(errDomain, ErrInvalid, ErrIDCertificate)
+	ErrRevokedIDCertificate      = errs.WithDomain(errDomain, ErrRevoked, ErrIDCertificate)
 	ErrInvalidChallenge          = errs.WithDomain(errDomain, ErrInvalid, ErrChallenge)
 	ErrExpiredToken              = errs.WithDomain(errDomain, ErrExpired, ErrToken)
+	ErrEmptyToken                = errs.WithDomain(errDomain, ErrEmpty, ErrToken)
+	ErrEmptyCallerToken          = errs.WithDomain(errDomain, ErrEmpty, ErrCallerToken)
+	ErrInvalidCallerToken        = errs.WithDomain(errDomain, ErrInvalid, ErrCallerToken)
+	ErrInvalidSignature          = errs.WithDomain(errDomain, ErrInvalid, ErrSignature)
+	ErrExpiredRequest            = errs.WithDomain(errDomain, ErrExpired, ErrRequest)
 )
 
 type ServiceRepository interface {
@@ -74,6 +85,9 @@ type ServiceRepository interface {
 	CreateCertificate(ctx context.Context, service string, cert []byte, expiry time.Time) error
 	DeleteCertificate(ctx context.Context, service string, cert []byte) error
 
+	RevokeCertificate(ctx context.Context, revocation repository.Revocation) error
+	GetRevocation(ctx context.Context, serial *big.Int) (repository.Revocation, error)
+
 	Shutdown(ctx context.Context) error
 }
 
@@ -121,6 +135,15 @@ type Metrics interface {
 	IncCertificatesDeleted(service string)
 	IncCertificatesDeleteFailed(service string)
 	ObserveCertificatesDeleteLatency(ctx context.Context, service string, duration time.Duration)
+	IncCertificatesRevoked(service string)
+	IncCertificatesRevokeFailed(service string)
+	ObserveCertificatesRevokeLatency(ctx context.Context, service string, duration time.Duration)
+	IncCertificatesRenewed(service string)
+	IncCertificatesRenewFailed(service string)
+	ObserveCertificatesRenewLatency(ctx context.Context, service string, duration time.Duration)
+	IncCertificateRotations()
+	IncCertificateRotationFailed()
+	SetCertificateTimeToExpiry(certificate string, duration time.Duration)
 	IncCertificatesVerified(service string)
 	IncCertificateVerificationFailed(service string)
 	ObserveCertificateVerificationLatency(ctx context.Context, service string, duration time.Duration)
@@ -139,22 +162,24 @@ type Authz struct {
 
 	name       string
 	privateKey *ecdsa.PrivateKey
-	cert       *x509.Certificate
-	certRaw    []byte
+	pubKey     []byte
+	csr        *pb.CSR
+	jwks       []byte
 
-	root             *x509.Certificate
-	rootRaw          []byte
-	intermediates    *x509.CertPool
-	intermediatesRaw [][]byte
+	// chain is replaced whenever this service's certificate is renewed
+	chain atomic.Pointer[chain]
 
 	durMonth        int
 	challengeExpiry time.Duration
 	tokenExpiry     time.Duration
+	renewBefore     time.Duration
 
 	services ServiceRepository
 	tokens   TokensRepository
 	random   Randomizer
 
+	done context.CancelFunc
+
 	metrics Metrics
 	logger  *slog.Logger
 	tracer  trace.Tracer
@@ -205,6 +230,11 @@ func NewAuthz(
 		return nil, err
 	}
 
+	jwks, err := keygen.NewJWKS(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, done := context.WithTimeout(context.Background(), defaultConnTimeout)
 	defer done()
 
@@ -219,63 +249,62 @@ func NewAuthz(
 
 	logger.InfoContext(ctx, "service registered in CA")
 
-	caID, err := caClient.RootCertificate(ctx, &pb.RootCertificateRequest{})
-	if err != nil {
-		return nil, err
+	a := &Authz{
+		caClient:        caClient,
+		name:            name,
+		privateKey:      privateKey,
+		pubKey:          pub,
+		csr:             config.csr,
+		jwks:            jwks,
+		durMonth:        config.durMonth,
+		challengeExpiry: config.challengeExpiry,
+		tokenExpiry:     config.tokenExpiry,
+		renewBefore:     config.renewBefore,
+		services:        services,
+		tokens:          tokens,
+		random:          random,
+		metrics:         config.m,
+		logger:          logger,
+		tracer:          config.tracer,
 	}
 
-	rootCert, err := certs.Decode(caID.Root)
-	if err != nil {
+	if err = a.refreshChain(ctx, registry.Certificate); err != nil {
 		return nil, err
 	}
 
-	if len(caID.Intermediates) == 0 {
-		caID.Intermediates = make([][]byte, 0, 1)
-	}
-
-	caID.Intermediates = append(caID.Intermediates, registry.Certificate)
+	logger.InfoContext(ctx, "retrieved certificate chain from CA")
 
-	pool := x509.NewCertPool()
+	if time.Until(a.chain.Load().cert.NotAfter) <= a.renewBefore {
+		if err = a.renew(ctx); err != nil {
+			// the current certificate is still valid; the renewer retries on its next run
+			logger.WarnContext(ctx, "failed to renew service certificate", slog.String("error", err.Error()))
+		}
+	}
 
-	for i := range caID.Intermediates {
-		cert, err := certs.Decode(caID.Intermediates[i])
-		if err != nil {
+	if a.chain.Load().id == nil {
+		if err = a.renewID(ctx); err != nil {
 			return nil, err
 		}
-
-		pool.AddCert(cert)
 	}
 
-	logger.InfoContext(ctx, "retrieved certificate chain from CA")
+	cronCtx, cancel := context.WithCancel(context.Background())
+
+	if err = a.runRenewer(cronCtx, config.renewalSchedule); err != nil {
+		cancel()
 
-	cert, err := certs.Decode(registry.Certificate)
-	if err != nil {
 		return nil, err
 	}
 
-	return &Authz{
-		caClient:         caClient,
-		name:             name,
-		privateKey:       privateKey,
-		cert:             cert,
-		certRaw:          registry.Certificate,
-		root:             rootCert,
-		rootRaw:          caID.Root,
-		intermediates:    pool,
-		intermediatesRaw: caID.Intermediates,
-		durMonth:         config.durMonth,
-		challengeExpiry:  config.challengeExpiry,
-		tokenExpiry:      config.tokenExpiry,
-		services:         services,
-		tokens:           tokens,
-		random:           random,
-		metrics:          config.m,
-		logger:           logger,
-		tracer:           config.tracer,
-	}, nil
+	a.done = cancel
+
+	return a, nil
 }
 
 func (a *Authz) Shutdown(ctx context.Context) error {
+	if a.done != nil {
+		a.done()
+	}
+
 	return errors.Join(
 		a.services.Shutdown(ctx),
 		a.tokens.Shutdown(ctx),
@@ -19,6 +19,8 @@ type Config struct {
 	durMonth        int
 	challengeExpiry time.Duration
 	tokenExpiry     time.Duration
+	renewBefore     time.Duration
+	renewalSchedule string
 
 	m      Metrics
 	logger slog.Handler
@@ -30,6 +32,8 @@ func defaultConfig() Config {
 		durMonth:        defaultDurMonth,
 		challengeExpiry: defaultChallengeExpiry,
 		tokenExpiry:     defaultTokenExpiry,
+		renewBefore:     defaultRenewBefore,
+		renewalSchedule: defaultRenewalSchedule,
 		m:               metrics.NoOp(),
 		logger:          log.NoOp().Handler(),
 		tracer:          noop.NewTracerProvider().Tracer("authz"),
@@ -72,6 +76,32 @@ func WithTokenExpiry(dur time.Duration) cfg.Option[Config] {
 	})
 }
 
+// WithRenewBefore sets how long before its expiry the service's certificate is renewed.
+func WithRenewBefore(dur time.Duration) cfg.Option[Config] {
+	if dur <= 0 {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.renewBefore = dur
+
+		return config
+	})
+}
+
+// WithRenewalSchedule sets the cron schedule for checking the service's certificate expiry.
+func WithRenewalSchedule(schedule string) cfg.Option[Config] {
+	if schedule == "" {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.renewalSchedule = schedule
+
+		return config
+	})
+}
+
 func WithCSR(csr *pb.CSR) cfg.Option[Config] {
 	if csr == nil {
 		return cfg.NoOp[Config]{}
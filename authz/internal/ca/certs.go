@@ -238,6 +238,31 @@ func (ca *CertificateAuthority) DeleteCertificate(ctx context.Context, req *pb.C
 		return nil, status.Error(codes.InvalidArgument, ErrInvalidCertificate.Error())
 	}
 
+	cert, err := certs.Decode(req.Certificate)
+	if err != nil {
+		ca.r.Event(ctx, "decoding certificate",
+			reg.WithError(err),
+			reg.WithLogLevel(slog.LevelWarn),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesDeleteFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidCertificate.Error())
+	}
+
+	// a removed certificate is still valid until it expires, unless it is revoked
+	if _, err := ca.revoke(ctx, req.Service, cert, pb.RevocationReason_REVOCATION_REASON_CESSATION_OF_OPERATION); err != nil {
+		ca.r.Event(ctx, "revoking certificate",
+			reg.WithError(err),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesDeleteFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	if err := ca.repository.DeleteCertificate(ctx, req.Service, req.Certificate); err != nil {
 		ca.r.Event(ctx, "removing stored certificate",
 			reg.WithError(err),
@@ -292,6 +317,29 @@ func (ca *CertificateAuthority) VerifyCertificate(ctx context.Context, req *pb.V
 		return nil, status.Error(codes.InvalidArgument, ErrInvalidCertificate.Error())
 	}
 
+	if err := ca.checkRevocation(ctx, req.Certificate); err != nil {
+		if errors.Is(err, ErrRevokedCertificate) {
+			ca.r.Event(ctx, "revoked certificate",
+				reg.WithError(err),
+				reg.WithLogLevel(slog.LevelWarn),
+				reg.WithLogAttributes(slog.String("service", req.Service)),
+				reg.WithSpan(span),
+				reg.WithMetric(func() { ca.metrics.IncCertificateVerificationFailed(req.Service) }),
+			)
+
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		ca.r.Event(ctx, "checking certificate revocation",
+			reg.WithError(err),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificateVerificationFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &pb.VerificationResponse{}, nil
 }
 
@@ -327,6 +375,14 @@ func (ca *CertificateAuthority) newCertificate(ctx context.Context, req *pb.Cert
 		return nil, time.Time{}, err
 	}
 
+	if ca.crlURL != "" {
+		cert.CRLDistributionPoints = []string{ca.crlURL}
+	}
+
+	if ca.ocspURL != "" {
+		cert.OCSPServer = []string{ca.ocspURL}
+	}
+
 	signedCert, err := certs.Encode(cert, ca.ca, pubKey, ca.privateKey)
 	if err != nil {
 		ca.logger.ErrorContext(ctx, "encoding the new certificate",
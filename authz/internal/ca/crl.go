@@ -0,0 +1,144 @@
+package ca
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zalgonoise/micron"
+	"github.com/zalgonoise/micron/executor"
+	"github.com/zalgonoise/micron/schedule"
+	"github.com/zalgonoise/micron/selector"
+)
+
+const (
+	defaultCRLValidity = 24 * time.Hour
+	defaultCRLSchedule = "0 * * * *"
+
+	// CRLPath is the HTTP path serving the CA's DER-encoded certificate revocation list.
+	CRLPath = "/v1/ca/crl"
+
+	crlContentType = "application/pkix-crl"
+)
+
+// CRL serves the latest certificate revocation list signed by this CA.
+func (ca *CertificateAuthority) CRL(w http.ResponseWriter, r *http.Request) {
+	ca.crlMu.RLock()
+	crl, expiry := ca.crl, ca.crlExpiry
+	ca.crlMu.RUnlock()
+
+	if time.Now().After(expiry) {
+		// the scheduled regeneration did not run in time, avoid serving a stale list
+		if err := ca.generateRevocationList(r.Context()); err != nil {
+			ca.logger.ErrorContext(r.Context(), "failed to regenerate revocation list", slog.String("error", err.Error()))
+
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		ca.crlMu.RLock()
+		crl, expiry = ca.crl, ca.crlExpiry
+		ca.crlMu.RUnlock()
+	}
+
+	w.Header().Set("Content-Type", crlContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(crl)))
+	w.Header().Set("Expires", expiry.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = w.Write(crl)
+}
+
+func (ca *CertificateAuthority) generateRevocationList(ctx context.Context) error {
+	revocations, err := ca.repository.ListRevocations(ctx)
+	if err != nil {
+		ca.metrics.IncRevocationListGenerationFailed()
+
+		return err
+	}
+
+	entries := make([]x509.RevocationListEntry, 0, len(revocations))
+	for i := range revocations {
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   revocations[i].Serial,
+			RevocationTime: revocations[i].RevokedAt,
+			ReasonCode:     revocations[i].Reason,
+		})
+	}
+
+	now := time.Now()
+	expiry := now.Add(ca.crlValidity)
+
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		Number:                    big.NewInt(now.UnixMilli()),
+		ThisUpdate:                now,
+		NextUpdate:                expiry,
+	}, ca.ca, ca.privateKey)
+	if err != nil {
+		ca.metrics.IncRevocationListGenerationFailed()
+
+		return err
+	}
+
+	ca.crlMu.Lock()
+	ca.crl = crl
+	ca.crlExpiry = expiry
+	ca.crlMu.Unlock()
+
+	ca.metrics.IncRevocationListsGenerated()
+
+	return nil
+}
+
+func (ca *CertificateAuthority) runCron(ctx context.Context, cronSchedule string) error {
+	s, err := schedule.New(
+		schedule.WithSchedule(cronSchedule),
+		schedule.WithLogger(ca.logger),
+		schedule.WithTrace(ca.tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	exec, err := executor.New("revocation_list",
+		executor.WithScheduler(s),
+		executor.WithRunners(executor.Runnable(ca.generateRevocationList)),
+		executor.WithLocation(time.UTC),
+		executor.WithLogger(ca.logger),
+		executor.WithTrace(ca.tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	sel, err := selector.New(
+		selector.WithExecutors(exec),
+		selector.WithTimeout(time.Minute),
+		selector.WithLogger(ca.logger),
+		selector.WithTrace(ca.tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	cron, err := micron.New(
+		micron.WithSelector(sel),
+		micron.WithErrorBufferSize(16),
+		micron.WithLogger(ca.logger),
+		micron.WithTrace(ca.tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	go cron.Run(ctx)
+
+	return nil
+}
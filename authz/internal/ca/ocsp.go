@@ -0,0 +1,137 @@
+package ca
+
+import (
+	"bytes"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/zalgonoise/x/authz/internal/repository"
+)
+
+const (
+	// OCSPPath is the HTTP path for the CA's OCSP responder, accepting POST requests as described in RFC 6960.
+	OCSPPath = "/v1/ca/ocsp"
+
+	ocspResponseContentType = "application/ocsp-response"
+	maxOCSPRequestSize      = 4096
+
+	ocspStatusGood      = "good"
+	ocspStatusRevoked   = "revoked"
+	ocspStatusUnknown   = "unknown"
+	ocspStatusMalformed = "malformed"
+	ocspStatusError     = "error"
+)
+
+// OCSP responds to OCSP requests for certificates issued by this CA, with a response signed by the CA's key.
+func (ca *CertificateAuthority) OCSP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	buf, err := io.ReadAll(io.LimitReader(r.Body, maxOCSPRequestSize))
+	if err != nil {
+		ca.writeOCSP(w, ocspStatusMalformed, ocsp.MalformedRequestErrorResponse)
+
+		return
+	}
+
+	req, err := ocsp.ParseRequest(buf)
+	if err != nil {
+		ca.logger.WarnContext(ctx, "invalid OCSP request", slog.String("error", err.Error()))
+		ca.writeOCSP(w, ocspStatusMalformed, ocsp.MalformedRequestErrorResponse)
+
+		return
+	}
+
+	now := time.Now()
+	template := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: req.SerialNumber,
+		IssuerHash:   req.HashAlgorithm,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(ca.crlValidity),
+	}
+
+	statusLabel := ocspStatusGood
+
+	isIssuer, err := ca.isIssuer(req)
+	if err != nil {
+		ca.logger.WarnContext(ctx, "invalid OCSP request issuer", slog.String("error", err.Error()))
+		ca.writeOCSP(w, ocspStatusMalformed, ocsp.MalformedRequestErrorResponse)
+
+		return
+	}
+
+	if isIssuer {
+		revocation, err := ca.repository.GetRevocation(ctx, req.SerialNumber)
+		switch {
+		case err == nil:
+			template.Status = ocsp.Revoked
+			template.RevokedAt = revocation.RevokedAt
+			template.RevocationReason = revocation.Reason
+			statusLabel = ocspStatusRevoked
+		case errors.Is(err, repository.ErrNotFound):
+		default:
+			ca.logger.ErrorContext(ctx, "failed to get revocation",
+				slog.String("serial", req.SerialNumber.String()), slog.String("error", err.Error()))
+			ca.writeOCSP(w, ocspStatusError, ocsp.InternalErrorErrorResponse)
+
+			return
+		}
+	} else {
+		template.Status = ocsp.Unknown
+		statusLabel = ocspStatusUnknown
+	}
+
+	res, err := ocsp.CreateResponse(ca.ca, ca.ca, template, ca.privateKey)
+	if err != nil {
+		ca.logger.ErrorContext(ctx, "failed to create OCSP response",
+			slog.String("serial", req.SerialNumber.String()), slog.String("error", err.Error()))
+		ca.writeOCSP(w, ocspStatusError, ocsp.InternalErrorErrorResponse)
+
+		return
+	}
+
+	ca.writeOCSP(w, statusLabel, res)
+}
+
+// isIssuer checks if the OCSP request targets a certificate issued by this CA, by comparing the issuer's name and
+// public key hashes.
+func (ca *CertificateAuthority) isIssuer(req *ocsp.Request) (bool, error) {
+	if !req.HashAlgorithm.Available() {
+		return false, ocsp.ParseError("unsupported hash algorithm")
+	}
+
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+
+	if _, err := asn1.Unmarshal(ca.ca.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return false, err
+	}
+
+	h := req.HashAlgorithm.New()
+	h.Write(publicKeyInfo.PublicKey.RightAlign())
+	keyHash := h.Sum(nil)
+
+	h.Reset()
+	h.Write(ca.ca.RawSubject)
+	nameHash := h.Sum(nil)
+
+	return bytes.Equal(keyHash, req.IssuerKeyHash) && bytes.Equal(nameHash, req.IssuerNameHash), nil
+}
+
+func (ca *CertificateAuthority) writeOCSP(w http.ResponseWriter, status string, res []byte) {
+	ca.metrics.IncOCSPResponses(status)
+
+	w.Header().Set("Content-Type", ocspResponseContentType)
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = w.Write(res)
+}
@@ -130,6 +130,17 @@ func (ca *CertificateAuthority) DeleteService(ctx context.Context, req *pb.Delet
 		return nil, status.Error(codes.PermissionDenied, ErrInvalidPublicKey.Error())
 	}
 
+	if err := ca.revokeAll(ctx, req.Service, pb.RevocationReason_REVOCATION_REASON_CESSATION_OF_OPERATION); err != nil {
+		ca.r.Event(ctx, "revoking service certificates",
+			reg.WithError(err),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(ca.metrics.IncServiceDeletionFailed),
+		)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	if err := ca.repository.DeleteService(ctx, req.Service); err != nil {
 		span.SetStatus(otelcodes.Error, err.Error())
 		span.RecordError(err)
@@ -0,0 +1,153 @@
+package ca
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zalgonoise/x/reg"
+
+	"github.com/zalgonoise/x/authz/internal/certs"
+	"github.com/zalgonoise/x/authz/internal/keygen"
+	"github.com/zalgonoise/x/authz/internal/repository"
+	pb "github.com/zalgonoise/x/authz/pb/authz/v1"
+)
+
+const defaultRenewalSkew = 5 * time.Minute
+
+// RenewCertificate issues a new certificate for a service's public key, from a request signed with the private key of
+// one of its current, valid certificates.
+//
+// The certificate being renewed is not revoked, as it may still be in use until the new one is deployed.
+func (ca *CertificateAuthority) RenewCertificate(ctx context.Context, req *pb.RenewalRequest) (*pb.CertificateResponse, error) {
+	ctx, span := ca.tracer.Start(ctx, "CertificateAuthority.RenewCertificate", trace.WithAttributes(
+		attribute.String("service", req.Service),
+		attribute.Bool("with_csr", req.SigningRequest != nil),
+	))
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		ca.metrics.ObserveCertificatesRenewLatency(ctx, req.Service, time.Since(start))
+	}()
+
+	ca.metrics.IncCertificatesRenewed(req.Service)
+	ca.logger.DebugContext(ctx, "certificate renewal request",
+		slog.String("service", req.Service), slog.Bool("with_csr", req.SigningRequest != nil))
+
+	if err := req.ValidateAll(); err != nil {
+		ca.r.Event(ctx, "invalid request",
+			reg.WithError(err),
+			reg.WithLogLevel(slog.LevelWarn),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesRenewFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pubKey, err := ca.validateRenewal(ctx, req)
+	if err != nil {
+		ca.r.Event(ctx, "validating renewal request",
+			reg.WithError(err),
+			reg.WithLogLevel(slog.LevelWarn),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesRenewFailed(req.Service) }),
+		)
+
+		switch {
+		case errors.Is(err, ErrInvalidCertificate), errors.Is(err, ErrExpiredRequest):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, ErrInvalidPublicKey), errors.Is(err, ErrInvalidSignature),
+			errors.Is(err, ErrRevokedCertificate):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	certificate, expiry, err := ca.newCertificate(ctx, &pb.CertificateRequest{
+		Service:        req.Service,
+		PublicKey:      pubKey,
+		SigningRequest: req.SigningRequest,
+	})
+	if err != nil {
+		ca.r.Event(ctx, "creating certificate",
+			reg.WithError(err),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesRenewFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := ca.repository.CreateCertificate(ctx, req.Service, certificate, expiry); err != nil {
+		ca.r.Event(ctx, "writing certificate to database",
+			reg.WithError(err),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesRenewFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.CertificateResponse{
+		Certificate: certificate,
+		ExpiresOn:   expiry.UnixMilli(),
+	}, nil
+}
+
+// validateRenewal checks that the renewal request is recent, that its certificate was issued by this CA for the
+// service's stored public key and is not revoked, and that the request is signed by that key. It returns the
+// PEM-encoded public key.
+func (ca *CertificateAuthority) validateRenewal(ctx context.Context, req *pb.RenewalRequest) ([]byte, error) {
+	if skew := time.Since(time.UnixMilli(req.Timestamp)); skew > ca.renewalSkew || skew < -ca.renewalSkew {
+		return nil, ErrExpiredRequest
+	}
+
+	if err := certs.Verify(req.Certificate, ca.ca, nil); err != nil {
+		return nil, errors.Join(ErrInvalidCertificate, err)
+	}
+
+	cert, err := certs.Decode(req.Certificate)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidCertificate, err)
+	}
+
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, ErrInvalidPublicKey
+	}
+
+	pubKey, err := keygen.EncodePublic(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ca.validatePublicKeys(ctx, req.Service, pubKey); err != nil {
+		return nil, err
+	}
+
+	if err = ca.checkRevocation(ctx, req.Certificate); err != nil {
+		return nil, err
+	}
+
+	if !certs.VerifyRenewal(pub, req.Service, req.Certificate, req.Timestamp, req.Signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	return pubKey, nil
+}
@@ -0,0 +1,198 @@
+package ca
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zalgonoise/x/reg"
+
+	"github.com/zalgonoise/x/authz/internal/certs"
+	"github.com/zalgonoise/x/authz/internal/keygen"
+	"github.com/zalgonoise/x/authz/internal/repository"
+	pb "github.com/zalgonoise/x/authz/pb/authz/v1"
+)
+
+func (ca *CertificateAuthority) RevokeCertificate(ctx context.Context, req *pb.RevocationRequest) (*pb.RevocationResponse, error) {
+	ctx, span := ca.tracer.Start(ctx, "CertificateAuthority.RevokeCertificate", trace.WithAttributes(
+		attribute.String("service", req.Service),
+		attribute.String("reason", req.Reason.String()),
+	))
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		ca.metrics.ObserveCertificatesRevokeLatency(ctx, req.Service, time.Since(start))
+	}()
+
+	ca.metrics.IncCertificatesRevoked(req.Service)
+	ca.logger.DebugContext(ctx, "certificate revocation request",
+		slog.String("service", req.Service), slog.String("reason", req.Reason.String()))
+
+	if err := req.ValidateAll(); err != nil {
+		ca.r.Event(ctx, "invalid request",
+			reg.WithError(err),
+			reg.WithLogLevel(slog.LevelWarn),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesRevokeFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := ca.validatePublicKeys(ctx, req.Service, req.PublicKey)
+	switch {
+	case errors.Is(err, ErrInvalidPublicKey):
+		ca.r.Event(ctx, "mismatching public keys",
+			reg.WithError(err),
+			reg.WithLogLevel(slog.LevelWarn),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesRevokeFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		ca.r.Event(ctx, "validating public keys",
+			reg.WithError(err),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesRevokeFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	default:
+	}
+
+	cert, err := ca.verifyOwnership(req.Certificate, req.PublicKey)
+	if err != nil {
+		ca.r.Event(ctx, "validating certificate",
+			reg.WithError(err),
+			reg.WithLogLevel(slog.LevelWarn),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesRevokeFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidCertificate.Error())
+	}
+
+	revokedAt, err := ca.revoke(ctx, req.Service, cert, req.Reason)
+	if err != nil {
+		ca.r.Event(ctx, "revoking certificate",
+			reg.WithError(err),
+			reg.WithLogAttributes(slog.String("service", req.Service)),
+			reg.WithSpan(span),
+			reg.WithMetric(func() { ca.metrics.IncCertificatesRevokeFailed(req.Service) }),
+		)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RevocationResponse{RevokedOn: revokedAt.UnixMilli()}, nil
+}
+
+// verifyOwnership ensures that the input certificate was issued by this CA for the input public key.
+func (ca *CertificateAuthority) verifyOwnership(raw, pubKey []byte) (*x509.Certificate, error) {
+	if err := certs.Verify(raw, ca.ca, nil); err != nil {
+		return nil, err
+	}
+
+	cert, err := certs.Decode(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := keygen.DecodePublic(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	certPub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok || !pub.Equal(certPub) {
+		return nil, ErrInvalidPublicKey
+	}
+
+	return cert, nil
+}
+
+// revoke records the revocation of the input certificate and regenerates the revocation list, so that it is
+// published right away.
+//
+// Revoking an already revoked certificate keeps the original revocation time and reason.
+func (ca *CertificateAuthority) revoke(
+	ctx context.Context, service string, cert *x509.Certificate, reason pb.RevocationReason,
+) (time.Time, error) {
+	now := time.Now()
+
+	if err := ca.repository.RevokeCertificate(ctx, repository.Revocation{
+		Serial:    cert.SerialNumber,
+		Service:   service,
+		Reason:    int(reason),
+		RevokedAt: now,
+		Expiry:    cert.NotAfter,
+	}); err != nil {
+		return time.Time{}, err
+	}
+
+	revocation, err := ca.repository.GetRevocation(ctx, cert.SerialNumber)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := ca.generateRevocationList(ctx); err != nil {
+		// the revocation is stored and will be listed on the next scheduled run
+		ca.logger.WarnContext(ctx, "failed to regenerate revocation list",
+			slog.String("service", service), slog.String("error", err.Error()))
+	}
+
+	return revocation.RevokedAt, nil
+}
+
+// revokeAll revokes all stored certificates for the input service.
+func (ca *CertificateAuthority) revokeAll(ctx context.Context, service string, reason pb.RevocationReason) error {
+	certificates, err := ca.repository.ListCertificates(ctx, service)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+
+	for i := range certificates {
+		cert, err := certs.Decode(certificates[i].Certificate)
+		if err != nil {
+			return err
+		}
+
+		if _, err := ca.revoke(ctx, service, cert, reason); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRevocation returns ErrRevokedCertificate if the input certificate is revoked.
+func (ca *CertificateAuthority) checkRevocation(ctx context.Context, raw []byte) error {
+	cert, err := certs.Decode(raw)
+	if err != nil {
+		return err
+	}
+
+	_, err = ca.repository.GetRevocation(ctx, cert.SerialNumber)
+	switch {
+	case err == nil:
+		return ErrRevokedCertificate
+	case errors.Is(err, repository.ErrNotFound):
+		return nil
+	default:
+		return err
+	}
+}
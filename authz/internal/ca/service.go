@@ -5,6 +5,8 @@ import (
 	"crypto/ecdsa"
 	"crypto/x509"
 	"log/slog"
+	"math/big"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -17,6 +19,7 @@ import (
 
 	"github.com/zalgonoise/x/authz/internal/certs"
 	"github.com/zalgonoise/x/authz/internal/keygen"
+	"github.com/zalgonoise/x/authz/internal/repository"
 	pb "github.com/zalgonoise/x/authz/pb/authz/v1"
 )
 
@@ -27,11 +30,15 @@ const (
 
 	ErrNil     = errs.Kind("nil")
 	ErrInvalid = errs.Kind("invalid")
+	ErrRevoked = errs.Kind("revoked")
+	ErrExpired = errs.Kind("expired")
 
 	ErrPublicKey   = errs.Entity("public key")
 	ErrPrivateKey  = errs.Entity("private key")
 	ErrCertificate = errs.Entity("certificate")
 	ErrRepository  = errs.Entity("repository")
+	ErrSignature   = errs.Entity("signature")
+	ErrRequest     = errs.Entity("request")
 )
 
 var (
@@ -39,6 +46,9 @@ var (
 	ErrNilPrivateKey      = errs.WithDomain(errDomain, ErrNil, ErrPrivateKey)
 	ErrInvalidPublicKey   = errs.WithDomain(errDomain, ErrInvalid, ErrPublicKey)
 	ErrInvalidCertificate = errs.WithDomain(errDomain, ErrInvalid, ErrCertificate)
+	ErrRevokedCertificate = errs.WithDomain(errDomain, ErrRevoked, ErrCertificate)
+	ErrInvalidSignature   = errs.WithDomain(errDomain, ErrInvalid, ErrSignature)
+	ErrExpiredRequest     = errs.WithDomain(errDomain, ErrExpired, ErrRequest)
 )
 
 type Repository interface {
@@ -50,6 +60,10 @@ type Repository interface {
 	CreateCertificate(ctx context.Context, service string, cert []byte, expiry time.Time) error
 	DeleteCertificate(ctx context.Context, service string, cert []byte) error
 
+	RevokeCertificate(ctx context.Context, revocation repository.Revocation) error
+	GetRevocation(ctx context.Context, serial *big.Int) (repository.Revocation, error)
+	ListRevocations(ctx context.Context) ([]repository.Revocation, error)
+
 	Shutdown(ctx context.Context) error
 }
 
@@ -69,6 +83,15 @@ type Metrics interface {
 	IncCertificatesDeleted(service string)
 	IncCertificatesDeleteFailed(service string)
 	ObserveCertificatesDeleteLatency(ctx context.Context, service string, duration time.Duration)
+	IncCertificatesRevoked(service string)
+	IncCertificatesRevokeFailed(service string)
+	ObserveCertificatesRevokeLatency(ctx context.Context, service string, duration time.Duration)
+	IncCertificatesRenewed(service string)
+	IncCertificatesRenewFailed(service string)
+	ObserveCertificatesRenewLatency(ctx context.Context, service string, duration time.Duration)
+	IncRevocationListsGenerated()
+	IncRevocationListGenerationFailed()
+	IncOCSPResponses(status string)
 	IncCertificatesVerified(service string)
 	IncCertificateVerificationFailed(service string)
 	ObserveCertificateVerificationLatency(ctx context.Context, service string, duration time.Duration)
@@ -85,7 +108,17 @@ type CertificateAuthority struct {
 	raw        []byte
 	durMonth   int
 
+	crlURL      string
+	ocspURL     string
+	crlValidity time.Duration
+	renewalSkew time.Duration
+
+	crlMu     sync.RWMutex
+	crl       []byte
+	crlExpiry time.Time
+
 	repository Repository
+	done       context.CancelFunc
 
 	r       *reg.Registrar
 	logger  *slog.Logger
@@ -123,24 +156,60 @@ func NewCertificateAuthority(
 		return nil, err
 	}
 
-	logger := slog.New(config.logHandler)
+	if config.crlValidity <= 0 {
+		config.crlValidity = defaultCRLValidity
+	}
 
-	return &CertificateAuthority{
-		privateKey: privateKey,
-		ca:         ca,
-		raw:        cert,
-		durMonth:   template.DurMonth,
-		repository: repo,
+	if config.renewalSkew <= 0 {
+		config.renewalSkew = defaultRenewalSkew
+	}
+
+	if config.crlSchedule == "" {
+		config.crlSchedule = defaultCRLSchedule
+	}
+
+	logger := slog.New(config.logHandler)
+	ctx, done := context.WithCancel(context.Background())
+
+	authority := &CertificateAuthority{
+		privateKey:  privateKey,
+		ca:          ca,
+		raw:         cert,
+		durMonth:    template.DurMonth,
+		crlURL:      config.crlURL,
+		ocspURL:     config.ocspURL,
+		crlValidity: config.crlValidity,
+		renewalSkew: config.renewalSkew,
+		repository:  repo,
+		done:        done,
 		r: reg.New(logger,
 			[]attribute.KeyValue{attribute.Int("dur_months", template.DurMonth)},
 			[]any{slog.Int("dur_months", template.DurMonth)}),
 		logger:  logger,
 		tracer:  config.tracer,
 		metrics: config.metrics,
-	}, nil
+	}
+
+	if err := authority.generateRevocationList(ctx); err != nil {
+		done()
+
+		return nil, err
+	}
+
+	if err := authority.runCron(ctx, config.crlSchedule); err != nil {
+		done()
+
+		return nil, err
+	}
+
+	return authority, nil
 }
 
 func (ca *CertificateAuthority) Shutdown(ctx context.Context) error {
+	if ca.done != nil {
+		ca.done()
+	}
+
 	return ca.repository.Shutdown(ctx)
 }
 
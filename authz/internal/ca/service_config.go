@@ -2,6 +2,7 @@ package ca
 
 import (
 	"log/slog"
+	"time"
 
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
@@ -19,6 +20,13 @@ type Config struct {
 	tracer     trace.Tracer
 
 	template []cfg.Option[certs.Template]
+
+	crlSchedule string
+	crlValidity time.Duration
+	crlURL      string
+	ocspURL     string
+
+	renewalSkew time.Duration
 }
 
 func defaultConfig() Config {
@@ -88,3 +96,68 @@ func WithTemplate(opts ...cfg.Option[certs.Template]) cfg.Option[Config] {
 		return config
 	})
 }
+
+// WithCRLSchedule sets the cron schedule for regenerating the certificate revocation list.
+func WithCRLSchedule(schedule string) cfg.Option[Config] {
+	if schedule == "" {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.crlSchedule = schedule
+
+		return config
+	})
+}
+
+// WithCRLValidity sets how long a generated certificate revocation list is valid for, before its next update.
+func WithCRLValidity(dur time.Duration) cfg.Option[Config] {
+	if dur <= 0 {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.crlValidity = dur
+
+		return config
+	})
+}
+
+// WithCRLURL sets the CRL distribution point included in new certificates.
+func WithCRLURL(url string) cfg.Option[Config] {
+	if url == "" {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.crlURL = url
+
+		return config
+	})
+}
+
+// WithOCSPURL sets the OCSP responder address included in new certificates.
+func WithOCSPURL(url string) cfg.Option[Config] {
+	if url == "" {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.ocspURL = url
+
+		return config
+	})
+}
+
+// WithRenewalSkew sets the maximum difference between a renewal request's timestamp and the CA's clock.
+func WithRenewalSkew(dur time.Duration) cfg.Option[Config] {
+	if dur <= 0 {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.renewalSkew = dur
+
+		return config
+	})
+}
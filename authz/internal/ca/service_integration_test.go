@@ -1,18 +1,25 @@
 package ca
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
 	"io"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace/noop"
+	"golang.org/x/crypto/ocsp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/zalgonoise/x/authz/internal/certs"
 	"github.com/zalgonoise/x/authz/internal/config"
@@ -195,6 +202,161 @@ func TestCertificateAuthority(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("Revocation", func(t *testing.T) {
+		key, err := keygen.New()
+		require.NoError(t, err)
+
+		pubPEM, err := keygen.EncodePublic(&key.PublicKey)
+		require.NoError(t, err)
+
+		req := &pb.CertificateRequest{
+			Service:   "test.revocation.simple",
+			PublicKey: pubPEM,
+		}
+
+		registerRes, err := service.RegisterService(ctx, req)
+		require.NoError(t, err)
+
+		cert, err := certs.Decode(registerRes.Certificate)
+		require.NoError(t, err)
+
+		revokeRes, err := service.RevokeCertificate(ctx, &pb.RevocationRequest{
+			Service:     req.Service,
+			PublicKey:   req.PublicKey,
+			Certificate: registerRes.Certificate,
+			Reason:      pb.RevocationReason_REVOCATION_REASON_KEY_COMPROMISE,
+		})
+		require.NoError(t, err)
+		require.NotZero(t, revokeRes.RevokedOn)
+
+		_, err = service.VerifyCertificate(ctx, &pb.VerificationRequest{
+			Service:     req.Service,
+			Certificate: registerRes.Certificate,
+		})
+		require.Error(t, err)
+
+		t.Run("CRL", func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			service.CRL(rec, httptest.NewRequest(http.MethodGet, CRLPath, http.NoBody))
+
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			crl, err := x509.ParseRevocationList(rec.Body.Bytes())
+			require.NoError(t, err)
+			require.NoError(t, crl.CheckSignatureFrom(service.ca))
+
+			var found bool
+
+			for _, entry := range crl.RevokedCertificateEntries {
+				if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+					found = true
+
+					require.Equal(t, ocsp.KeyCompromise, entry.ReasonCode)
+				}
+			}
+
+			require.True(t, found)
+		})
+
+		t.Run("OCSP", func(t *testing.T) {
+			ocspReq, err := ocsp.CreateRequest(cert, service.ca, nil)
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			service.OCSP(rec, httptest.NewRequest(http.MethodPost, OCSPPath, bytes.NewReader(ocspReq)))
+
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			res, err := ocsp.ParseResponseForCert(rec.Body.Bytes(), cert, service.ca)
+			require.NoError(t, err)
+			require.Equal(t, ocsp.Revoked, res.Status)
+			require.Equal(t, ocsp.KeyCompromise, res.RevocationReason)
+		})
+
+		_, err = service.DeleteService(ctx, &pb.DeletionRequest{
+			Service:   req.Service,
+			PublicKey: req.PublicKey,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Renewal", func(t *testing.T) {
+		key, err := keygen.New()
+		require.NoError(t, err)
+
+		pubPEM, err := keygen.EncodePublic(&key.PublicKey)
+		require.NoError(t, err)
+
+		otherKey, err := keygen.New()
+		require.NoError(t, err)
+
+		req := &pb.CertificateRequest{
+			Service:   "test.renewal.simple",
+			PublicKey: pubPEM,
+		}
+
+		registerRes, err := service.RegisterService(ctx, req)
+		require.NoError(t, err)
+
+		for _, testcase := range []struct {
+			name      string
+			key       *ecdsa.PrivateKey
+			timestamp time.Time
+			code      codes.Code
+		}{
+			{
+				name:      "Success/Simple",
+				key:       key,
+				timestamp: time.Now(),
+			},
+			{
+				name:      "Fail/OtherKey",
+				key:       otherKey,
+				timestamp: time.Now(),
+				code:      codes.PermissionDenied,
+			},
+			{
+				name:      "Fail/StaleRequest",
+				key:       key,
+				timestamp: time.Now().Add(-time.Hour),
+				code:      codes.InvalidArgument,
+			},
+		} {
+			t.Run(testcase.name, func(t *testing.T) {
+				signature, err := certs.SignRenewal(testcase.key, req.Service, registerRes.Certificate, testcase.timestamp)
+				require.NoError(t, err)
+
+				res, err := service.RenewCertificate(ctx, &pb.RenewalRequest{
+					Service:     req.Service,
+					Certificate: registerRes.Certificate,
+					Timestamp:   testcase.timestamp.UnixMilli(),
+					Signature:   signature,
+				})
+				if testcase.code != codes.OK {
+					require.Equal(t, testcase.code, status.Code(err))
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.NotEqual(t, registerRes.Certificate, res.Certificate)
+
+				_, err = service.VerifyCertificate(ctx, &pb.VerificationRequest{
+					Service:     req.Service,
+					Certificate: res.Certificate,
+				})
+				require.NoError(t, err)
+
+				// the renewed certificate is still valid
+				_, err = service.VerifyCertificate(ctx, &pb.VerificationRequest{
+					Service:     req.Service,
+					Certificate: registerRes.Certificate,
+				})
+				require.NoError(t, err)
+			})
+		}
+	})
 }
 
 func cleanup() error {
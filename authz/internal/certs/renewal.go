@@ -0,0 +1,34 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"time"
+)
+
+// SignRenewal signs a certificate renewal request for the input service and (PEM-encoded) certificate, issued at
+// the input time, with the private key that the certificate was issued for.
+func SignRenewal(priv *ecdsa.PrivateKey, service string, cert []byte, timestamp time.Time) ([]byte, error) {
+	h := renewalDigest(service, cert, timestamp.UnixMilli())
+
+	return ecdsa.SignASN1(rand.Reader, priv, h[:])
+}
+
+// VerifyRenewal checks if the renewal request's signature was created by the private key matching the input
+// public key.
+func VerifyRenewal(pub *ecdsa.PublicKey, service string, cert []byte, timestamp int64, signature []byte) bool {
+	h := renewalDigest(service, cert, timestamp)
+
+	return ecdsa.VerifyASN1(pub, h[:], signature)
+}
+
+func renewalDigest(service string, cert []byte, timestamp int64) [sha512.Size]byte {
+	buf := make([]byte, 0, len(service)+len(cert)+8)
+	buf = append(buf, service...)
+	buf = append(buf, cert...)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(timestamp))
+
+	return sha512.Sum512(buf)
+}
@@ -45,7 +45,7 @@ func NewCACertificate(t Template) (cert []byte, err error) {
 		NotAfter:              time.Now().AddDate(0, t.DurMonth, 0),
 		IsCA:                  true,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		BasicConstraintsValid: true,
 		Issuer:                t.Name,
 	}
@@ -91,7 +91,7 @@ func NewCertFromCSR(version, durMonth int, issuer pkix.Name, csr *x509.Certifica
 			x509.ExtKeyUsageOCSPSigning,
 			x509.ExtKeyUsageTimeStamping,
 		},
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		BasicConstraintsValid: true,
 		Issuer:                issuer,
 	}, nil
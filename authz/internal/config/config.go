@@ -30,7 +30,12 @@ type Config struct {
 }
 
 type CA struct {
-	CertDurMonths int `envconfig:"AUTHZ_CA_CERT_DUR_MOTNHS"`
+	CertDurMonths int           `envconfig:"AUTHZ_CA_CERT_DUR_MOTNHS"`
+	CRLSchedule   string        `envconfig:"AUTHZ_CA_CRL_SCHEDULE"`
+	CRLValidity   time.Duration `envconfig:"AUTHZ_CA_CRL_VALIDITY"`
+	CRLURL        string        `envconfig:"AUTHZ_CA_CRL_URL"`
+	OCSPURL       string        `envconfig:"AUTHZ_CA_OCSP_URL"`
+	RenewalSkew   time.Duration `envconfig:"AUTHZ_CA_RENEWAL_SKEW"`
 }
 
 type Authz struct {
@@ -39,6 +44,8 @@ type Authz struct {
 	CertDurMonths int           `envconfig:"AUTHZ_SERVICE_CERT_DUR_MOTNHS"`
 	ChallengeDur  time.Duration `envconfig:"AUTHZ_CHALLENGE_DURATION"`
 	TokenDur      time.Duration `envconfig:"AUTHZ_TOKEN_DURATION"`
+	RenewBefore   time.Duration `envconfig:"AUTHZ_CERT_RENEW_BEFORE"`
+	RenewalCron   string        `envconfig:"AUTHZ_CERT_RENEWAL_SCHEDULE"`
 }
 
 type Database struct {
@@ -119,6 +126,26 @@ func Merge(cur, next *Config) *Config {
 		cur.CA.CertDurMonths = next.CA.CertDurMonths
 	}
 
+	if next.CA.CRLSchedule != "" {
+		cur.CA.CRLSchedule = next.CA.CRLSchedule
+	}
+
+	if next.CA.CRLValidity > 0 {
+		cur.CA.CRLValidity = next.CA.CRLValidity
+	}
+
+	if next.CA.CRLURL != "" {
+		cur.CA.CRLURL = next.CA.CRLURL
+	}
+
+	if next.CA.OCSPURL != "" {
+		cur.CA.OCSPURL = next.CA.OCSPURL
+	}
+
+	if next.CA.RenewalSkew > 0 {
+		cur.CA.RenewalSkew = next.CA.RenewalSkew
+	}
+
 	// Authz
 	if next.Authz.CAURL != "" {
 		cur.Authz.CAURL = next.Authz.CAURL
@@ -140,6 +167,14 @@ func Merge(cur, next *Config) *Config {
 		cur.Authz.TokenDur = next.Authz.TokenDur
 	}
 
+	if next.Authz.RenewBefore > 0 {
+		cur.Authz.RenewBefore = next.Authz.RenewBefore
+	}
+
+	if next.Authz.RenewalCron != "" {
+		cur.Authz.RenewalCron = next.Authz.RenewalCron
+	}
+
 	// Database
 	if next.Database.URI != "" {
 		cur.Database.URI = next.Database.URI
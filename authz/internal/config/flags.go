@@ -19,6 +19,13 @@ func Parse(args []string) (*Config, error) {
 	tokenDur := fs.Duration("token-dur", 0, "duration for emitted tokens before they expire")
 	cleanupTimeout := fs.Duration("cleanup-timeout", 0, "timeout duration when running DB cleanup on expired certificates")
 	cleanupSchedule := fs.String("cleanup-cron", "", "cron schedule to run DB cleanup on expired certificates")
+	crlSchedule := fs.String("crl-cron", "", "cron schedule to regenerate the CA's certificate revocation list")
+	crlValidity := fs.Duration("crl-validity", 0, "duration for the CA's certificate revocation list before its next update")
+	crlURL := fs.String("crl-url", "", "CRL distribution point to include in certificates issued by the CA")
+	ocspURL := fs.String("ocsp-url", "", "OCSP responder address to include in certificates issued by the CA")
+	renewalSkew := fs.Duration("renewal-skew", 0, "maximum clock difference accepted in certificate renewal requests")
+	renewBefore := fs.Duration("renew-before", 0, "how long before expiry the Authz service renews its certificate")
+	renewalCron := fs.String("renewal-cron", "", "cron schedule to check the Authz service's certificate expiry")
 	tracerURL := fs.String("tracer-url", "", "URL for the tracing backend")
 	tracerUsername := fs.String("tracer-username", "", "username for the tracing backend, if required")
 	tracerPassword := fs.String("tracer-password", "", "password for the tracing backend, if required")
@@ -35,6 +42,11 @@ func Parse(args []string) (*Config, error) {
 		Name:       *serviceName,
 		CA: CA{
 			CertDurMonths: *dur,
+			CRLSchedule:   *crlSchedule,
+			CRLValidity:   *crlValidity,
+			CRLURL:        *crlURL,
+			OCSPURL:       *ocspURL,
+			RenewalSkew:   *renewalSkew,
 		},
 		Authz: Authz{
 			CAURL:         *caURL,
@@ -42,6 +54,8 @@ func Parse(args []string) (*Config, error) {
 			CertDurMonths: *dur,
 			ChallengeDur:  *challengeDur,
 			TokenDur:      *tokenDur,
+			RenewBefore:   *renewBefore,
+			RenewalCron:   *renewalCron,
 		},
 		Database: Database{
 			URI:             *dbURI,
@@ -52,6 +52,25 @@ CREATE TABLE certificates
 );
 
 CREATE INDEX idx_certificates_service_id ON certificates (service_id);
+`
+
+	checkRevocationsTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='revocations');
+`
+
+	createRevocationsTable = `
+CREATE TABLE revocations
+(
+    serial        TEXT    PRIMARY KEY NOT NULL,
+    service       TEXT                NOT NULL,
+    reason        INTEGER             NOT NULL,
+    revoked_at    INTEGER             NOT NULL,
+    expiry        INTEGER             NOT NULL
+);
+
+CREATE INDEX idx_revocations_expiry ON revocations (expiry);
 `
 
 	checkChallengesTableExists = `
@@ -156,6 +175,7 @@ func Migrate(ctx context.Context, db *sql.DB, service Service, log *slog.Logger)
 		return runMigrations(ctx, db,
 			migration{checkServicesTableExists, createServicesTable},
 			migration{checkCertificatesTableExists, createCertificatesTable},
+			migration{checkRevocationsTableExists, createRevocationsTable},
 			migration{checkChallengesTableExists, createChallengesTable},
 			migration{checkTokensTableExists, createTokensTable},
 		)
@@ -163,6 +183,7 @@ func Migrate(ctx context.Context, db *sql.DB, service Service, log *slog.Logger)
 		return runMigrations(ctx, db,
 			migration{checkServicesTableExists, createServicesTable},
 			migration{checkCertificatesTableExists, createCertificatesTable},
+			migration{checkRevocationsTableExists, createRevocationsTable},
 		)
 	default:
 		return fmt.Errorf("%w: %q", ErrInvalidServiceType, service)
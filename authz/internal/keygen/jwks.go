@@ -0,0 +1,94 @@
+package keygen
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+const keyUsageSignature = "sig"
+
+// KeyID returns the RFC 7638 thumbprint of the input public key, base64url-encoded, which is used as the `kid`
+// header in signed tokens and as the key ID in JWKS documents.
+func KeyID(publicKey *ecdsa.PublicKey) (string, error) {
+	if publicKey == nil {
+		return "", ErrNilPublicKey
+	}
+
+	key, err := jwk.FromRaw(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// NewJWK converts the input ECDSA public key into a JSON Web Key, with its key ID, algorithm and usage set.
+func NewJWK(publicKey *ecdsa.PublicKey) (jwk.Key, error) {
+	kid, err := KeyID(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := jwk.FromRaw(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range map[string]any{
+		jwk.KeyIDKey:     kid,
+		jwk.AlgorithmKey: jwa.ES512,
+		jwk.KeyUsageKey:  keyUsageSignature,
+	} {
+		if err = key.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// NewJWKS encodes the input public keys as a JSON Web Key Set document.
+func NewJWKS(publicKeys ...*ecdsa.PublicKey) ([]byte, error) {
+	set := jwk.NewSet()
+
+	for i := range publicKeys {
+		key, err := NewJWK(publicKeys[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if err = set.AddKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(set)
+}
+
+func signingKey(privateKey *ecdsa.PrivateKey) (jwk.Key, error) {
+	kid, err := KeyID(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := jwk.FromRaw(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = key.Set(jwk.KeyIDKey, kid); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
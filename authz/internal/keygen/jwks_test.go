@@ -0,0 +1,55 @@
+package keygen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJWKS(t *testing.T) {
+	priv, err := DecodePrivate(privateKey)
+	require.NoError(t, err)
+
+	pub, err := DecodePublic(publicKey)
+	require.NoError(t, err)
+
+	kid, err := KeyID(pub)
+	require.NoError(t, err)
+	require.NotEmpty(t, kid)
+
+	doc, err := NewJWKS(pub)
+	require.NoError(t, err)
+
+	set, err := jwk.Parse(doc)
+	require.NoError(t, err)
+	require.Equal(t, 1, set.Len())
+
+	key, ok := set.LookupKeyID(kid)
+	require.True(t, ok)
+	require.Equal(t, "ES512", key.Algorithm().String())
+	require.Equal(t, keyUsageSignature, key.KeyUsage())
+
+	isPrivate, err := jwk.IsPrivateKey(key)
+	require.NoError(t, err)
+	require.False(t, isPrivate)
+
+	token, err := NewToken(priv, "authz_test", time.Now().Add(time.Hour), WithClaim(Claim{
+		Service: "test",
+		Authz:   "authz.test",
+	}))
+	require.NoError(t, err)
+
+	msg, err := jws.Parse(token)
+	require.NoError(t, err)
+	require.Equal(t, kid, msg.Signatures()[0].ProtectedHeaders().KeyID())
+
+	_, err = jwt.Parse(token, jwt.WithKeySet(set))
+	require.NoError(t, err)
+
+	_, err = KeyID(nil)
+	require.ErrorIs(t, err, ErrNilPublicKey)
+}
@@ -19,6 +19,7 @@ const (
 	ErrInvalid = errs.Kind("invalid")
 
 	ErrPrivateKey = errs.Entity("private key")
+	ErrPublicKey  = errs.Entity("public key")
 	ErrPEM        = errs.Entity("PEM key bytes")
 	ErrSignature  = errs.Entity("signature")
 	ErrClaim      = errs.Entity("claim")
@@ -28,6 +29,7 @@ var (
 	ErrInvalidPEM       = errs.WithDomain(errDomain, ErrInvalid, ErrPEM)
 	ErrInvalidSignature = errs.WithDomain(errDomain, ErrInvalid, ErrSignature)
 	ErrNilPrivateKey    = errs.WithDomain(errDomain, ErrNil, ErrPrivateKey)
+	ErrNilPublicKey     = errs.WithDomain(errDomain, ErrNil, ErrPublicKey)
 	ErrInvalidClaim     = errs.WithDomain(errDomain, ErrInvalid, ErrClaim)
 )
 
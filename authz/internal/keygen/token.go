@@ -45,7 +45,12 @@ func NewToken(privateKey *ecdsa.PrivateKey, issuer string, expiry time.Time, opt
 		return nil, err
 	}
 
-	signedToken, err := jwt.Sign(token, jwt.WithKey(jwa.ES512, privateKey))
+	key, err := signingKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signedToken, err := jwt.Sign(token, jwt.WithKey(jwa.ES512, key))
 	if err != nil {
 		return nil, err
 	}
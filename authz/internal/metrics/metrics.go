@@ -44,6 +44,22 @@ type Metrics struct {
 	certificatesVerifiedFailed         *prometheus.CounterVec
 	certificatesVerifiedLatencySeconds *prometheus.HistogramVec
 
+	certificatesRevokedTotal          *prometheus.CounterVec
+	certificatesRevokedFailed         *prometheus.CounterVec
+	certificatesRevokedLatencySeconds *prometheus.HistogramVec
+
+	certificatesRenewedTotal          *prometheus.CounterVec
+	certificatesRenewedFailed         *prometheus.CounterVec
+	certificatesRenewedLatencySeconds *prometheus.HistogramVec
+
+	certificateRotationsTotal      prometheus.Counter
+	certificateRotationsFailed     prometheus.Counter
+	certificateTimeToExpirySeconds *prometheus.GaugeVec
+
+	revocationListsGeneratedTotal  prometheus.Counter
+	revocationListsGeneratedFailed prometheus.Counter
+	ocspResponsesTotal             *prometheus.CounterVec
+
 	rootCertificateRequestsTotal          prometheus.Counter
 	rootCertificateRequestsFailed         prometheus.Counter
 	rootCertificateRequestsLatencySeconds prometheus.Histogram
@@ -160,6 +176,60 @@ func NewMetrics() *Metrics {
 			Buckets: []float64{.00001, .00005, .0001, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
 		}, []string{"service"}),
 
+		certificatesRevokedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "certificates_revoked_total",
+			Help: "Count of service certificate revocation requests",
+		}, []string{"service"}),
+		certificatesRevokedFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "certificates_revoked_failed",
+			Help: "Count of service certificate revocation requests that failed",
+		}, []string{"service"}),
+		certificatesRevokedLatencySeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "certificates_revoked_latency_seconds",
+			Help:    "Histogram of service certificate revocation processing times",
+			Buckets: []float64{.00001, .00005, .0001, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"service"}),
+
+		certificatesRenewedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "certificates_renewed_total",
+			Help: "Count of service certificate renewal requests",
+		}, []string{"service"}),
+		certificatesRenewedFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "certificates_renewed_failed",
+			Help: "Count of service certificate renewal requests that failed",
+		}, []string{"service"}),
+		certificatesRenewedLatencySeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "certificates_renewed_latency_seconds",
+			Help:    "Histogram of service certificate renewal processing times",
+			Buckets: []float64{.00001, .00005, .0001, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"service"}),
+
+		certificateRotationsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "certificate_rotations_total",
+			Help: "Count of rotations of this service's own certificate",
+		}),
+		certificateRotationsFailed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "certificate_rotations_failed",
+			Help: "Count of rotations of this service's own certificate that failed",
+		}),
+		certificateTimeToExpirySeconds: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "certificate_time_to_expiry_seconds",
+			Help: "Time left until this service's own certificates expire",
+		}, []string{"certificate"}),
+
+		revocationListsGeneratedTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "revocation_lists_generated_total",
+			Help: "Count of certificate revocation lists generated by this CA",
+		}),
+		revocationListsGeneratedFailed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "revocation_lists_generated_failed",
+			Help: "Count of certificate revocation list generations that failed",
+		}),
+		ocspResponsesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ocsp_responses_total",
+			Help: "Count of OCSP responses served by this CA, by certificate status",
+		}, []string{"status"}),
+
 		rootCertificateRequestsTotal: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "root_certificate_requests_total",
 			Help: "Count of root certificate requests",
@@ -376,6 +446,74 @@ func (m *Metrics) ObserveCertificateVerificationLatency(ctx context.Context, ser
 	m.certificatesVerifiedLatencySeconds.WithLabelValues(service).Observe(duration.Seconds())
 }
 
+func (m *Metrics) IncCertificatesRevoked(service string) {
+	m.certificatesRevokedTotal.WithLabelValues(service).Inc()
+}
+
+func (m *Metrics) IncCertificatesRevokeFailed(service string) {
+	m.certificatesRevokedFailed.WithLabelValues(service).Inc()
+}
+
+func (m *Metrics) ObserveCertificatesRevokeLatency(ctx context.Context, service string, duration time.Duration) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		if eo, ok := m.certificatesRevokedLatencySeconds.WithLabelValues(service).(prometheus.ExemplarObserver); ok {
+			eo.ObserveWithExemplar(duration.Seconds(), prometheus.Labels{
+				traceIDKey: sc.TraceID().String(),
+			})
+
+			return
+		}
+	}
+
+	m.certificatesRevokedLatencySeconds.WithLabelValues(service).Observe(duration.Seconds())
+}
+
+func (m *Metrics) IncCertificatesRenewed(service string) {
+	m.certificatesRenewedTotal.WithLabelValues(service).Inc()
+}
+
+func (m *Metrics) IncCertificatesRenewFailed(service string) {
+	m.certificatesRenewedFailed.WithLabelValues(service).Inc()
+}
+
+func (m *Metrics) ObserveCertificatesRenewLatency(ctx context.Context, service string, duration time.Duration) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		if eo, ok := m.certificatesRenewedLatencySeconds.WithLabelValues(service).(prometheus.ExemplarObserver); ok {
+			eo.ObserveWithExemplar(duration.Seconds(), prometheus.Labels{
+				traceIDKey: sc.TraceID().String(),
+			})
+
+			return
+		}
+	}
+
+	m.certificatesRenewedLatencySeconds.WithLabelValues(service).Observe(duration.Seconds())
+}
+
+func (m *Metrics) IncCertificateRotations() {
+	m.certificateRotationsTotal.Inc()
+}
+
+func (m *Metrics) IncCertificateRotationFailed() {
+	m.certificateRotationsFailed.Inc()
+}
+
+func (m *Metrics) SetCertificateTimeToExpiry(certificate string, duration time.Duration) {
+	m.certificateTimeToExpirySeconds.WithLabelValues(certificate).Set(duration.Seconds())
+}
+
+func (m *Metrics) IncRevocationListsGenerated() {
+	m.revocationListsGeneratedTotal.Inc()
+}
+
+func (m *Metrics) IncRevocationListGenerationFailed() {
+	m.revocationListsGeneratedFailed.Inc()
+}
+
+func (m *Metrics) IncOCSPResponses(status string) {
+	m.ocspResponsesTotal.WithLabelValues(status).Inc()
+}
+
 func (m *Metrics) IncRootCertificateRequests() {
 	m.rootCertificateRequestsTotal.Inc()
 }
@@ -528,6 +666,20 @@ func (m *Metrics) Registry() (*prometheus.Registry, error) {
 		m.certificatesVerifiedTotal,
 		m.certificatesVerifiedFailed,
 		m.certificatesVerifiedLatencySeconds,
+		m.certificatesRevokedTotal,
+		m.certificatesRevokedFailed,
+		m.certificatesRevokedLatencySeconds,
+		m.certificatesRenewedTotal,
+		m.certificatesRenewedFailed,
+		m.certificatesRenewedLatencySeconds,
+
+		m.certificateRotationsTotal,
+		m.certificateRotationsFailed,
+		m.certificateTimeToExpirySeconds,
+
+		m.revocationListsGeneratedTotal,
+		m.revocationListsGeneratedFailed,
+		m.ocspResponsesTotal,
 
 		m.rootCertificateRequestsTotal,
 		m.rootCertificateRequestsFailed,
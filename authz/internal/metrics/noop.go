@@ -31,6 +31,18 @@ func (noOp) ObserveCertificatesDeleteLatency(context.Context, string, time.Durat
 func (noOp) IncCertificatesVerified(string)                                                {}
 func (noOp) IncCertificateVerificationFailed(string)                                       {}
 func (noOp) ObserveCertificateVerificationLatency(context.Context, string, time.Duration)  {}
+func (noOp) IncCertificatesRevoked(string)                                                 {}
+func (noOp) IncCertificatesRevokeFailed(string)                                            {}
+func (noOp) ObserveCertificatesRevokeLatency(context.Context, string, time.Duration)       {}
+func (noOp) IncCertificatesRenewed(string)                                                 {}
+func (noOp) IncCertificatesRenewFailed(string)                                             {}
+func (noOp) ObserveCertificatesRenewLatency(context.Context, string, time.Duration)        {}
+func (noOp) IncCertificateRotations()                                                      {}
+func (noOp) IncCertificateRotationFailed()                                                 {}
+func (noOp) SetCertificateTimeToExpiry(string, time.Duration)                              {}
+func (noOp) IncRevocationListsGenerated()                                                  {}
+func (noOp) IncRevocationListGenerationFailed()                                            {}
+func (noOp) IncOCSPResponses(string)                                                       {}
 func (noOp) IncRootCertificateRequests()                                                   {}
 func (noOp) IncRootCertificateRequestFailed()                                              {}
 func (noOp) ObserveRootCertificateRequestLatency(context.Context, time.Duration)           {}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const (
+	minAllocRevocations = 16
+	serialBase          = 16
+
+	queryRevocationsCreate = `
+INSERT INTO revocations (serial, service, reason, revoked_at, expiry)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (serial) DO NOTHING
+`
+
+	queryRevocationsGet = `
+SELECT service, reason, revoked_at, expiry FROM revocations
+WHERE serial = ?
+`
+
+	queryRevocationsList = `
+SELECT serial, service, reason, revoked_at, expiry FROM revocations
+WHERE expiry > ?
+ORDER BY revoked_at ASC
+`
+
+	queryRevocationsCleanup = `
+DELETE FROM revocations WHERE expiry < ?
+`
+)
+
+// Revocation describes a revoked certificate, identified by its serial number.
+//
+// Revocations are kept until the certificate expires, as they are no longer required in a revocation list afterward.
+type Revocation struct {
+	Serial    *big.Int
+	Service   string
+	Reason    int
+	RevokedAt time.Time
+	Expiry    time.Time
+}
+
+// RevokeCertificate records the revocation of the certificate with the input serial number. Revoking an already
+// revoked certificate preserves the original revocation.
+func (r *Services) RevokeCertificate(ctx context.Context, revocation Revocation) error {
+	if revocation.Serial == nil {
+		return fmt.Errorf("%w: missing serial number", ErrFailedDBWrite)
+	}
+
+	_, err := r.db.ExecContext(ctx, queryRevocationsCreate,
+		revocation.Serial.Text(serialBase),
+		revocation.Service,
+		revocation.Reason,
+		revocation.RevokedAt.UnixMilli(),
+		revocation.Expiry.UnixMilli(),
+	)
+
+	return err
+}
+
+// GetRevocation returns the revocation for the certificate with the input serial number, or ErrNotFound if the
+// certificate is not revoked.
+func (r *Services) GetRevocation(ctx context.Context, serial *big.Int) (Revocation, error) {
+	var (
+		revocation = Revocation{Serial: serial}
+		revokedAt  int64
+		expiry     int64
+	)
+
+	if serial == nil {
+		return Revocation{}, ErrNotFound
+	}
+
+	if err := r.db.QueryRowContext(ctx, queryRevocationsGet, serial.Text(serialBase)).Scan(
+		&revocation.Service, &revocation.Reason, &revokedAt, &expiry,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Revocation{}, ErrNotFound
+		}
+
+		return Revocation{}, err
+	}
+
+	revocation.RevokedAt = time.UnixMilli(revokedAt)
+	revocation.Expiry = time.UnixMilli(expiry)
+
+	return revocation, nil
+}
+
+// ListRevocations returns all revocations for certificates which haven't expired yet.
+func (r *Services) ListRevocations(ctx context.Context) ([]Revocation, error) {
+	rows, err := r.db.QueryContext(ctx, queryRevocationsList, time.Now().UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	revocations := make([]Revocation, 0, minAllocRevocations)
+
+	for rows.Next() {
+		var (
+			revocation Revocation
+			serial     string
+			revokedAt  int64
+			expiry     int64
+		)
+
+		if err = rows.Scan(&serial, &revocation.Service, &revocation.Reason, &revokedAt, &expiry); err != nil {
+			return nil, err
+		}
+
+		n, ok := new(big.Int).SetString(serial, serialBase)
+		if !ok {
+			return nil, fmt.Errorf("invalid serial number: %q", serial)
+		}
+
+		revocation.Serial = n
+		revocation.RevokedAt = time.UnixMilli(revokedAt)
+		revocation.Expiry = time.UnixMilli(expiry)
+
+		revocations = append(revocations, revocation)
+	}
+
+	if err = rows.Close(); err != nil {
+		return nil, err
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revocations, nil
+}
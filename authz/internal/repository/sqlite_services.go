@@ -271,7 +271,13 @@ func (r *Services) cleanup(ctx context.Context) error {
 	ctx, done := context.WithTimeout(context.Background(), r.cleanupTimeout)
 	defer done()
 
-	_, err := r.db.ExecContext(ctx, queryServicesCleanup, time.Now().UnixMilli())
+	now := time.Now().UnixMilli()
+
+	if _, err := r.db.ExecContext(ctx, queryServicesCleanup, now); err != nil {
+		return err
+	}
+
+	_, err := r.db.ExecContext(ctx, queryRevocationsCleanup, now)
 
 	return err
 }
@@ -1884,6 +1884,429 @@ var _ interface {
 	ErrorName() string
 } = CertificateDeletionResponseValidationError{}
 
+// Validate checks the field values on RevocationRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RevocationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevocationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevocationRequestMultiError, or nil if none found.
+func (m *RevocationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevocationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetService()) < 1 {
+		err := RevocationRequestValidationError{
+			field:  "Service",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPublicKey()) < 1 {
+		err := RevocationRequestValidationError{
+			field:  "PublicKey",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCertificate()) < 1 {
+		err := RevocationRequestValidationError{
+			field:  "Certificate",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := RevocationReason_name[int32(m.GetReason())]; !ok {
+		err := RevocationRequestValidationError{
+			field:  "Reason",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevocationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevocationRequestMultiError is an error wrapping multiple validation errors
+// returned by RevocationRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RevocationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevocationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevocationRequestMultiError) AllErrors() []error { return m }
+
+// RevocationRequestValidationError is the validation error returned by
+// RevocationRequest.Validate if the designated constraints aren't met.
+type RevocationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevocationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevocationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevocationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevocationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevocationRequestValidationError) ErrorName() string {
+	return "RevocationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevocationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevocationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevocationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevocationRequestValidationError{}
+
+// Validate checks the field values on RevocationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevocationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevocationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevocationResponseMultiError, or nil if none found.
+func (m *RevocationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevocationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RevokedOn
+
+	if len(errors) > 0 {
+		return RevocationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevocationResponseMultiError is an error wrapping multiple validation errors
+// returned by RevocationResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RevocationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevocationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevocationResponseMultiError) AllErrors() []error { return m }
+
+// RevocationResponseValidationError is the validation error returned by
+// RevocationResponse.Validate if the designated constraints aren't met.
+type RevocationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevocationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevocationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevocationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevocationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevocationResponseValidationError) ErrorName() string {
+	return "RevocationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevocationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevocationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevocationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevocationResponseValidationError{}
+
+// Validate checks the field values on RenewalRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RenewalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenewalRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RenewalRequestMultiError,
+// or nil if none found.
+func (m *RenewalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenewalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetService()) < 1 {
+		err := RenewalRequestValidationError{
+			field:  "Service",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCertificate()) < 1 {
+		err := RenewalRequestValidationError{
+			field:  "Certificate",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTimestamp() <= 0 {
+		err := RenewalRequestValidationError{
+			field:  "Timestamp",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSignature()) < 1 {
+		err := RenewalRequestValidationError{
+			field:  "Signature",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSigningRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenewalRequestValidationError{
+					field:  "SigningRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenewalRequestValidationError{
+					field:  "SigningRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSigningRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenewalRequestValidationError{
+				field:  "SigningRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenewalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenewalRequestMultiError is an error wrapping multiple validation errors
+// returned by RenewalRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenewalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewalRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewalRequestMultiError) AllErrors() []error { return m }
+
+// RenewalRequestValidationError is the validation error returned by
+// RenewalRequest.Validate if the designated constraints aren't met.
+type RenewalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewalRequestValidationError) ErrorName() string { return "RenewalRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RenewalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenewalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewalRequestValidationError{}
+
 // Validate checks the field values on DeletionRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
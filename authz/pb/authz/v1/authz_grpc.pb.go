@@ -23,6 +23,8 @@ const (
 	CertificateAuthority_CreateCertificate_FullMethodName = "/authz.v1.CertificateAuthority/CreateCertificate"
 	CertificateAuthority_ListCertificates_FullMethodName  = "/authz.v1.CertificateAuthority/ListCertificates"
 	CertificateAuthority_DeleteCertificate_FullMethodName = "/authz.v1.CertificateAuthority/DeleteCertificate"
+	CertificateAuthority_RevokeCertificate_FullMethodName = "/authz.v1.CertificateAuthority/RevokeCertificate"
+	CertificateAuthority_RenewCertificate_FullMethodName  = "/authz.v1.CertificateAuthority/RenewCertificate"
 	CertificateAuthority_VerifyCertificate_FullMethodName = "/authz.v1.CertificateAuthority/VerifyCertificate"
 	CertificateAuthority_DeleteService_FullMethodName     = "/authz.v1.CertificateAuthority/DeleteService"
 	CertificateAuthority_RootCertificate_FullMethodName   = "/authz.v1.CertificateAuthority/RootCertificate"
@@ -36,6 +38,8 @@ type CertificateAuthorityClient interface {
 	CreateCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
 	ListCertificates(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*ListCertificatesResponse, error)
 	DeleteCertificate(ctx context.Context, in *CertificateDeletionRequest, opts ...grpc.CallOption) (*CertificateDeletionResponse, error)
+	RevokeCertificate(ctx context.Context, in *RevocationRequest, opts ...grpc.CallOption) (*RevocationResponse, error)
+	RenewCertificate(ctx context.Context, in *RenewalRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
 	VerifyCertificate(ctx context.Context, in *VerificationRequest, opts ...grpc.CallOption) (*VerificationResponse, error)
 	DeleteService(ctx context.Context, in *DeletionRequest, opts ...grpc.CallOption) (*DeletionResponse, error)
 	RootCertificate(ctx context.Context, in *RootCertificateRequest, opts ...grpc.CallOption) (*RootCertificateResponse, error)
@@ -85,6 +89,24 @@ func (c *certificateAuthorityClient) DeleteCertificate(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *certificateAuthorityClient) RevokeCertificate(ctx context.Context, in *RevocationRequest, opts ...grpc.CallOption) (*RevocationResponse, error) {
+	out := new(RevocationResponse)
+	err := c.cc.Invoke(ctx, CertificateAuthority_RevokeCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateAuthorityClient) RenewCertificate(ctx context.Context, in *RenewalRequest, opts ...grpc.CallOption) (*CertificateResponse, error) {
+	out := new(CertificateResponse)
+	err := c.cc.Invoke(ctx, CertificateAuthority_RenewCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certificateAuthorityClient) VerifyCertificate(ctx context.Context, in *VerificationRequest, opts ...grpc.CallOption) (*VerificationResponse, error) {
 	out := new(VerificationResponse)
 	err := c.cc.Invoke(ctx, CertificateAuthority_VerifyCertificate_FullMethodName, in, out, opts...)
@@ -120,6 +142,8 @@ type CertificateAuthorityServer interface {
 	CreateCertificate(context.Context, *CertificateRequest) (*CertificateResponse, error)
 	ListCertificates(context.Context, *CertificateRequest) (*ListCertificatesResponse, error)
 	DeleteCertificate(context.Context, *CertificateDeletionRequest) (*CertificateDeletionResponse, error)
+	RevokeCertificate(context.Context, *RevocationRequest) (*RevocationResponse, error)
+	RenewCertificate(context.Context, *RenewalRequest) (*CertificateResponse, error)
 	VerifyCertificate(context.Context, *VerificationRequest) (*VerificationResponse, error)
 	DeleteService(context.Context, *DeletionRequest) (*DeletionResponse, error)
 	RootCertificate(context.Context, *RootCertificateRequest) (*RootCertificateResponse, error)
@@ -142,6 +166,12 @@ func (UnimplementedCertificateAuthorityServer) ListCertificates(context.Context,
 func (UnimplementedCertificateAuthorityServer) DeleteCertificate(context.Context, *CertificateDeletionRequest) (*CertificateDeletionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCertificate not implemented")
 }
+func (UnimplementedCertificateAuthorityServer) RevokeCertificate(context.Context, *RevocationRequest) (*RevocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
+}
+func (UnimplementedCertificateAuthorityServer) RenewCertificate(context.Context, *RenewalRequest) (*CertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCertificate not implemented")
+}
 func (UnimplementedCertificateAuthorityServer) VerifyCertificate(context.Context, *VerificationRequest) (*VerificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyCertificate not implemented")
 }
@@ -236,6 +266,42 @@ func _CertificateAuthority_DeleteCertificate_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CertificateAuthority_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateAuthorityServer).RevokeCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateAuthority_RevokeCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateAuthorityServer).RevokeCertificate(ctx, req.(*RevocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CertificateAuthority_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateAuthorityServer).RenewCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateAuthority_RenewCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateAuthorityServer).RenewCertificate(ctx, req.(*RenewalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CertificateAuthority_VerifyCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerificationRequest)
 	if err := dec(in); err != nil {
@@ -313,6 +379,14 @@ var CertificateAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCertificate",
 			Handler:    _CertificateAuthority_DeleteCertificate_Handler,
 		},
+		{
+			MethodName: "RevokeCertificate",
+			Handler:    _CertificateAuthority_RevokeCertificate_Handler,
+		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _CertificateAuthority_RenewCertificate_Handler,
+		},
 		{
 			MethodName: "VerifyCertificate",
 			Handler:    _CertificateAuthority_VerifyCertificate_Handler,
@@ -0,0 +1,253 @@
+// Package verifier validates tokens issued by an authz service locally, using the ES512 public keys that the
+// service publishes as a JWKS document, instead of calling its VerifyToken RPC on every request.
+//
+// The JWKS document is cached, refreshed periodically, and re-fetched whenever a token is signed by a key that is
+// not (yet) in the cached set.
+//
+// Local verification checks the token's signature, expiry and issuer; it does not know about tokens removed from the
+// authz service's database. Use the service's introspection endpoint when that guarantee is required.
+package verifier
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/zalgonoise/cfg"
+	"github.com/zalgonoise/x/errs"
+)
+
+const (
+	defaultTimeout    = 10 * time.Second
+	defaultRefresh    = 15 * time.Minute
+	defaultMinRefresh = 30 * time.Second
+
+	authzClaim = "authz"
+)
+
+const (
+	errDomain = errs.Domain("x/authz/verifier")
+
+	ErrEmpty   = errs.Kind("empty")
+	ErrInvalid = errs.Kind("invalid")
+	ErrUnknown = errs.Kind("unknown")
+
+	ErrURL      = errs.Entity("JWKS URL")
+	ErrResponse = errs.Entity("JWKS response")
+	ErrKeyID    = errs.Entity("key ID")
+	ErrClaim    = errs.Entity("claim")
+	ErrToken    = errs.Entity("token")
+)
+
+var (
+	ErrEmptyURL        = errs.WithDomain(errDomain, ErrEmpty, ErrURL)
+	ErrEmptyToken      = errs.WithDomain(errDomain, ErrEmpty, ErrToken)
+	ErrInvalidResponse = errs.WithDomain(errDomain, ErrInvalid, ErrResponse)
+	ErrInvalidClaim    = errs.WithDomain(errDomain, ErrInvalid, ErrClaim)
+	ErrUnknownKeyID    = errs.WithDomain(errDomain, ErrUnknown, ErrKeyID)
+)
+
+// Claims describes a verified token.
+type Claims struct {
+	Issuer    string
+	Subject   string
+	Audience  []string
+	ID        string
+	Expiry    time.Time
+	NotBefore time.Time
+
+	// Service is the name of the service that the token was issued to.
+	Service string
+	// Authz is the name of the authz service that issued the token.
+	Authz string
+}
+
+// Verifier validates authz tokens against a cached JWKS document.
+type Verifier struct {
+	url    string
+	config Config
+
+	mu        sync.RWMutex
+	set       jwk.Set
+	fetchedAt time.Time
+}
+
+// New creates a Verifier for the JWKS document served in the input URL, such as
+// `http://authz:8080/.well-known/jwks.json`.
+//
+// The document is only fetched when the first token is verified, or when calling Refresh.
+func New(url string, opts ...cfg.Option[Config]) (*Verifier, error) {
+	if url == "" {
+		return nil, ErrEmptyURL
+	}
+
+	return &Verifier{
+		url:    url,
+		config: cfg.Set(defaultConfig(), opts...),
+	}, nil
+}
+
+// Verify validates the input token's signature, expiry and issuer, returning its claims if valid.
+func (v *Verifier) Verify(ctx context.Context, token string) (Claims, error) {
+	if token == "" {
+		return Claims{}, ErrEmptyToken
+	}
+
+	msg, err := jws.Parse([]byte(token))
+	if err != nil {
+		return Claims{}, err
+	}
+
+	if len(msg.Signatures()) == 0 {
+		return Claims{}, fmt.Errorf("%w: missing signature", ErrInvalidClaim)
+	}
+
+	kid := msg.Signatures()[0].ProtectedHeaders().KeyID()
+
+	set, err := v.keySet(ctx, kid)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	opts := []jwt.ParseOption{
+		jwt.WithKeySet(set, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithValidate(true),
+		jwt.WithAcceptableSkew(v.config.acceptableSkew),
+	}
+
+	if v.config.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(v.config.issuer))
+	}
+
+	t, err := jwt.Parse([]byte(token), opts...)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	return newClaims(t)
+}
+
+// Refresh fetches the JWKS document, replacing the cached key set.
+func (v *Verifier) Refresh(ctx context.Context) error {
+	set, err := v.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	v.set = set
+	v.fetchedAt = time.Now()
+	v.mu.Unlock()
+
+	return nil
+}
+
+func (v *Verifier) keySet(ctx context.Context, kid string) (jwk.Set, error) {
+	v.mu.RLock()
+	set, fetchedAt := v.set, v.fetchedAt
+	v.mu.RUnlock()
+
+	switch {
+	case set == nil, time.Since(fetchedAt) > v.config.refresh:
+		// no keys yet or stale keys
+	case kid == "":
+		return set, nil
+	default:
+		if _, ok := set.LookupKeyID(kid); ok {
+			return set, nil
+		}
+
+		// avoid hammering the authz service with tokens signed by unknown keys
+		if time.Since(fetchedAt) < v.config.minRefresh {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownKeyID, kid)
+		}
+	}
+
+	if err := v.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	v.mu.RLock()
+	set = v.set
+	v.mu.RUnlock()
+
+	if kid == "" {
+		return set, nil
+	}
+
+	if _, ok := set.LookupKeyID(kid); !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKeyID, kid)
+	}
+
+	return set, nil
+}
+
+func (v *Verifier) fetch(ctx context.Context) (jwk.Set, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	res, err := v.config.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status code %d", ErrInvalidResponse, res.StatusCode)
+	}
+
+	buf, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := jwk.Parse(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	return set, nil
+}
+
+func newClaims(t jwt.Token) (Claims, error) {
+	c, ok := t.Get(authzClaim)
+	if !ok {
+		return Claims{}, ErrInvalidClaim
+	}
+
+	claimMap, ok := c.(map[string]any)
+	if !ok {
+		return Claims{}, ErrInvalidClaim
+	}
+
+	claims := Claims{
+		Issuer:    t.Issuer(),
+		Subject:   t.Subject(),
+		Audience:  t.Audience(),
+		ID:        t.JwtID(),
+		Expiry:    t.Expiration(),
+		NotBefore: t.NotBefore(),
+	}
+
+	if value, ok := claimMap["service"].(string); ok {
+		claims.Service = value
+	}
+
+	if value, ok := claimMap["authz_service"].(string); ok {
+		claims.Authz = value
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,89 @@
+package verifier
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+)
+
+type Config struct {
+	client         *http.Client
+	refresh        time.Duration
+	minRefresh     time.Duration
+	issuer         string
+	acceptableSkew time.Duration
+}
+
+func defaultConfig() Config {
+	return Config{
+		client:     &http.Client{Timeout: defaultTimeout},
+		refresh:    defaultRefresh,
+		minRefresh: defaultMinRefresh,
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to fetch the JWKS document.
+func WithHTTPClient(client *http.Client) cfg.Option[Config] {
+	if client == nil {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.client = client
+
+		return config
+	})
+}
+
+// WithRefresh sets the interval after which the cached JWKS document is considered stale and is fetched again.
+func WithRefresh(dur time.Duration) cfg.Option[Config] {
+	if dur <= 0 {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.refresh = dur
+
+		return config
+	})
+}
+
+// WithMinRefresh sets the minimum interval between two JWKS fetches triggered by tokens signed with an unknown key.
+func WithMinRefresh(dur time.Duration) cfg.Option[Config] {
+	if dur <= 0 {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.minRefresh = dur
+
+		return config
+	})
+}
+
+// WithIssuer requires the tokens to be issued by the authz service with the input name.
+func WithIssuer(issuer string) cfg.Option[Config] {
+	if issuer == "" {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.issuer = issuer
+
+		return config
+	})
+}
+
+// WithAcceptableSkew sets the clock skew tolerated when validating the token's expiry and not-before claims.
+func WithAcceptableSkew(dur time.Duration) cfg.Option[Config] {
+	if dur <= 0 {
+		return cfg.NoOp[Config]{}
+	}
+
+	return cfg.Register[Config](func(config Config) Config {
+		config.acceptableSkew = dur
+
+		return config
+	})
+}
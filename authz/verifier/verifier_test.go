@@ -0,0 +1,191 @@
+package verifier
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/cfg"
+
+	"github.com/zalgonoise/x/authz/internal/keygen"
+)
+
+type jwksServer struct {
+	mu    sync.Mutex
+	keys  []*ecdsa.PublicKey
+	count atomic.Int32
+}
+
+func (s *jwksServer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.count.Add(1)
+
+	s.mu.Lock()
+	doc, err := keygen.NewJWKS(s.keys...)
+	s.mu.Unlock()
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	_, _ = w.Write(doc)
+}
+
+func (s *jwksServer) setKeys(keys ...*ecdsa.PublicKey) {
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+}
+
+func newToken(t *testing.T, key *ecdsa.PrivateKey, issuer string, expiry time.Time) string {
+	token, err := keygen.NewToken(key, issuer, expiry, keygen.WithClaim(keygen.Claim{
+		Service: "test.service",
+		Authz:   issuer,
+	}))
+	require.NoError(t, err)
+
+	return string(token)
+}
+
+func TestVerifier(t *testing.T) {
+	key, err := keygen.New()
+	require.NoError(t, err)
+
+	rotated, err := keygen.New()
+	require.NoError(t, err)
+
+	unknown, err := keygen.New()
+	require.NoError(t, err)
+
+	for _, testcase := range []struct {
+		name    string
+		token   func(t *testing.T) string
+		opts    []cfg.Option[Config]
+		rotate  bool
+		fetches int32
+		err     error
+		fails   bool
+	}{
+		{
+			name: "Success/Simple",
+			token: func(t *testing.T) string {
+				return newToken(t, key, "authz.test", time.Now().Add(time.Hour))
+			},
+			fetches: 1,
+		},
+		{
+			name: "Success/WithIssuer",
+			token: func(t *testing.T) string {
+				return newToken(t, key, "authz.test", time.Now().Add(time.Hour))
+			},
+			opts:    []cfg.Option[Config]{WithIssuer("authz.test")},
+			fetches: 1,
+		},
+		{
+			name: "Success/RotatedKey",
+			token: func(t *testing.T) string {
+				return newToken(t, rotated, "authz.test", time.Now().Add(time.Hour))
+			},
+			opts:    []cfg.Option[Config]{WithMinRefresh(time.Nanosecond)},
+			rotate:  true,
+			fetches: 2,
+		},
+		{
+			name: "Fail/UnknownKey",
+			token: func(t *testing.T) string {
+				return newToken(t, unknown, "authz.test", time.Now().Add(time.Hour))
+			},
+			fetches: 1,
+			err:     ErrUnknownKeyID,
+		},
+		{
+			name: "Fail/Expired",
+			token: func(t *testing.T) string {
+				return newToken(t, key, "authz.test", time.Now().Add(-time.Hour))
+			},
+			fetches: 1,
+			fails:   true,
+		},
+		{
+			name: "Fail/WrongIssuer",
+			token: func(t *testing.T) string {
+				return newToken(t, key, "authz.other", time.Now().Add(time.Hour))
+			},
+			opts:    []cfg.Option[Config]{WithIssuer("authz.test")},
+			fetches: 1,
+			fails:   true,
+		},
+		{
+			name: "Fail/Empty",
+			token: func(*testing.T) string {
+				return ""
+			},
+			err: ErrEmptyToken,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			jwks := &jwksServer{}
+			jwks.setKeys(&key.PublicKey)
+
+			server := httptest.NewServer(jwks)
+			defer server.Close()
+
+			v, err := New(server.URL, testcase.opts...)
+			require.NoError(t, err)
+
+			if testcase.rotate {
+				require.NoError(t, v.Refresh(ctx))
+
+				jwks.setKeys(&key.PublicKey, &rotated.PublicKey)
+			}
+
+			claims, err := v.Verify(ctx, testcase.token(t))
+
+			require.Equal(t, testcase.fetches, jwks.count.Load())
+
+			switch {
+			case testcase.err != nil:
+				require.ErrorIs(t, err, testcase.err)
+
+				return
+			case testcase.fails:
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "test.service", claims.Service)
+			require.Equal(t, "authz.test", claims.Authz)
+			require.Equal(t, "authz.test", claims.Issuer)
+		})
+	}
+
+	t.Run("Fail/EmptyURL", func(t *testing.T) {
+		_, err := New("")
+		require.ErrorIs(t, err, ErrEmptyURL)
+	})
+
+	t.Run("Fail/Unavailable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		v, err := New(server.URL)
+		require.NoError(t, err)
+
+		_, err = v.Verify(context.Background(), newToken(t, key, "authz.test", time.Now().Add(time.Hour)))
+		require.ErrorIs(t, err, ErrInvalidResponse)
+	})
+}
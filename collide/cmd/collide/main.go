@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
 	"net"
 	"net/http"
@@ -15,6 +14,7 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/automaxprocs/maxprocs"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -22,17 +22,25 @@ import (
 	"github.com/zalgonoise/x/cli/v2"
 
 	"github.com/zalgonoise/x/collide/internal/config"
+	"github.com/zalgonoise/x/collide/internal/database"
 	"github.com/zalgonoise/x/collide/internal/grpcserver"
 	"github.com/zalgonoise/x/collide/internal/httpserver"
 	"github.com/zalgonoise/x/collide/internal/log"
 	"github.com/zalgonoise/x/collide/internal/metrics"
 	"github.com/zalgonoise/x/collide/internal/profiling"
 	"github.com/zalgonoise/x/collide/internal/repository/memory"
+	"github.com/zalgonoise/x/collide/internal/repository/sqlite"
 	"github.com/zalgonoise/x/collide/internal/service"
 	"github.com/zalgonoise/x/collide/internal/tracing"
+	"github.com/zalgonoise/x/collide/internal/watcher"
 	pb "github.com/zalgonoise/x/collide/pkg/api/pb/collide/v1"
 )
 
+const (
+	backendMemory = "memory"
+	backendSQLite = "sqlite"
+)
+
 type Metrics interface {
 	IncListDistricts(ctx context.Context)
 	IncListDistrictsFailed(ctx context.Context)
@@ -49,9 +57,21 @@ type Metrics interface {
 	IncGetCollisionsByDistrictAndTrack(ctx context.Context, district string, track string)
 	IncGetCollisionsByDistrictAndTrackFailed(ctx context.Context, district string, track string)
 	ObserveGetCollisionsByDistrictAndTrackLatency(ctx context.Context, duration time.Duration, district string, track string)
+	IncPlanEvent(ctx context.Context, districts []string)
+	IncPlanEventFailed(ctx context.Context, districts []string)
+	ObservePlanEventLatency(ctx context.Context, duration time.Duration, districts []string)
+	IncUpsertTrack(ctx context.Context, track string)
+	IncUpsertTrackFailed(ctx context.Context, track string)
+	ObserveUpsertTrackLatency(ctx context.Context, duration time.Duration, track string)
+	IncDeleteTrack(ctx context.Context, track string)
+	IncDeleteTrackFailed(ctx context.Context, track string)
+	ObserveDeleteTrackLatency(ctx context.Context, duration time.Duration, track string)
 }
 
-var ErrInvalidMetricsType = errors.New("invalid metrics type")
+var (
+	ErrInvalidMetricsType   = errors.New("invalid metrics type")
+	ErrInvalidTracksBackend = errors.New("invalid tracks backend")
+)
 
 func main() {
 	logger := log.New("debug", true, true)
@@ -152,27 +172,15 @@ func ExecServe(ctx context.Context, logger *slog.Logger, _ []string) (int, error
 	}
 
 	// setup service
-	f, err := os.Open(cfg.Tracks.Path)
-	if err != nil {
-		return 1, err
-	}
+	repoCtx, repoCancel := context.WithCancel(ctx)
+	defer repoCancel()
 
-	buf, err := io.ReadAll(f)
+	repo, repoDone, err := initRepository(repoCtx, cfg.Tracks, logger, tracer)
 	if err != nil {
-		return 1, errors.Join(err, f.Close())
-	}
-
-	if err := f.Close(); err != nil {
-		return 1, err
-	}
-
-	repo := memory.New(logger, tracer)
-
-	if err := repo.FromBytes(buf); err != nil {
 		return 1, err
 	}
 
-	collideService := service.New(repo, m, logger, tracer)
+	collideService := service.New(repo, m, logger, tracer, service.WithAdminToken(cfg.Admin.Token))
 
 	// init gRPC server
 	grpcServer, err := initGRPCServer(ctx, cfg.HTTP.GRPCPort, collideService, httpServer, logger, m)
@@ -183,7 +191,82 @@ func ExecServe(ctx context.Context, logger *slog.Logger, _ []string) (int, error
 	go runHTTPServer(ctx, cfg.HTTP.Port, httpServer, logger)
 
 	// handle graceful shutdown on exit signal
-	return handleGracefulShutdown(ctx, logger, httpServer, grpcServer, tracerDone, []context.CancelFunc{})
+	return handleGracefulShutdown(ctx, logger, httpServer, grpcServer, tracerDone, []context.CancelFunc{repoCancel, repoDone})
+}
+
+// initRepository sets up the tracks repository for the configured backend, returning it along with a function to
+// release its resources on shutdown.
+//
+// The memory backend reloads the tracks file whenever it changes, while the sqlite backend persists admin edits and is
+// only seeded from the tracks file when its database is empty.
+func initRepository(
+	ctx context.Context,
+	cfg config.Tracks,
+	logger *slog.Logger,
+	tracer trace.Tracer,
+) (service.Repository, context.CancelFunc, error) {
+	switch cfg.Backend {
+	case backendMemory, "":
+		repo := memory.New(logger, tracer)
+
+		w, err := watcher.New(cfg.Path, cfg.ReloadInterval, repo, logger, tracer)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		go w.Run(ctx)
+
+		return repo, func() {}, nil
+
+	case backendSQLite:
+		db, err := database.Open(cfg.SQLitePath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		done := func() {
+			if err := db.Close(); err != nil {
+				logger.ErrorContext(ctx, "closing tracks database", slog.String("error", err.Error()))
+			}
+		}
+
+		if err = database.Migrate(ctx, db); err != nil {
+			done()
+
+			return nil, nil, err
+		}
+
+		repo := sqlite.New(db, logger, tracer)
+
+		n, err := repo.Load(ctx)
+		if err != nil {
+			done()
+
+			return nil, nil, err
+		}
+
+		if n == 0 && cfg.Path != "" {
+			logger.InfoContext(ctx, "seeding tracks database", slog.String("path", cfg.Path))
+
+			buf, err := os.ReadFile(cfg.Path)
+			if err != nil {
+				done()
+
+				return nil, nil, err
+			}
+
+			if err = repo.FromBytes(buf); err != nil {
+				done()
+
+				return nil, nil, err
+			}
+		}
+
+		return repo, done, nil
+
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidTracksBackend, cfg.Backend)
+	}
 }
 
 func initGRPCServer(
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/caarlos0/env/v11"
 )
 
@@ -8,6 +10,7 @@ type Config struct {
 	HTTP      HTTP
 	Frontend  Frontend
 	Tracks    Tracks
+	Admin     Admin
 	Metrics   Metrics
 	Logging   Logging
 	Tracing   Tracing
@@ -25,7 +28,14 @@ type Frontend struct {
 }
 
 type Tracks struct {
-	Path string `env:"COLLIDE_TRACKS_PATH"`
+	Path           string        `env:"COLLIDE_TRACKS_PATH"`
+	ReloadInterval time.Duration `env:"COLLIDE_TRACKS_RELOAD_INTERVAL" envDefault:"30s"`
+	Backend        string        `env:"COLLIDE_TRACKS_BACKEND" envDefault:"memory"`
+	SQLitePath     string        `env:"COLLIDE_TRACKS_SQLITE_PATH"`
+}
+
+type Admin struct {
+	Token string `env:"COLLIDE_ADMIN_TOKEN"`
 }
 
 type Metrics struct {
@@ -1,8 +1,10 @@
 package config
 
 import (
-	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestNew(t *testing.T) {
@@ -23,7 +25,9 @@ func TestNew(t *testing.T) {
 					BackendURI: "http://api.fallenpetals.com:8083",
 				},
 				Tracks: Tracks{
-					Path: "",
+					Path:           "",
+					ReloadInterval: 30 * time.Second,
+					Backend:        "memory",
 				},
 				Metrics: Metrics{
 					URI: "collector:4318",
@@ -53,21 +57,25 @@ func TestNew(t *testing.T) {
 		{
 			name: "Custom",
 			env: map[string]string{
-				"COLLIDE_HTTP_PORT":        "8088",
-				"COLLIDE_GRPC_PORT":        "8089",
-				"COLLIDE_FE_HTTP_PORT":     "8090",
-				"COLLIDE_BE_URI":           "http://localhost:8084",
-				"COLLIDE_TRACKS_PATH":      "tracks.yaml",
-				"COLLIDE_METRICS_URI":      "collector:14318",
-				"COLLIDE_LOG_LEVEL":        "DEBUG",
-				"COLLIDE_LOG_WITH_SOURCE":  "false",
-				"COLLIDE_LOG_WITH_SPAN_ID": "false",
-				"COLLIDE_TRACING_URI":      "localhost:8000",
-				"COLLIDE_TRACING_USERNAME": "user",
-				"COLLIDE_TRACING_PASSWORD": "pass",
-				"COLLIDE_PROFILING_NAME":   "collide-dev",
-				"COLLIDE_PROFILING_URI":    "http://pyroscope:14040",
-				"COLLIDE_PROFILING_TAGS":   "hostname:api.dev.fallenpetals.com,service:collide,version:v2",
+				"COLLIDE_HTTP_PORT":              "8088",
+				"COLLIDE_GRPC_PORT":              "8089",
+				"COLLIDE_FE_HTTP_PORT":           "8090",
+				"COLLIDE_BE_URI":                 "http://localhost:8084",
+				"COLLIDE_TRACKS_PATH":            "tracks.yaml",
+				"COLLIDE_TRACKS_RELOAD_INTERVAL": "1m",
+				"COLLIDE_TRACKS_BACKEND":         "sqlite",
+				"COLLIDE_TRACKS_SQLITE_PATH":     "collide.db",
+				"COLLIDE_ADMIN_TOKEN":            "secret",
+				"COLLIDE_METRICS_URI":            "collector:14318",
+				"COLLIDE_LOG_LEVEL":              "DEBUG",
+				"COLLIDE_LOG_WITH_SOURCE":        "false",
+				"COLLIDE_LOG_WITH_SPAN_ID":       "false",
+				"COLLIDE_TRACING_URI":            "localhost:8000",
+				"COLLIDE_TRACING_USERNAME":       "user",
+				"COLLIDE_TRACING_PASSWORD":       "pass",
+				"COLLIDE_PROFILING_NAME":         "collide-dev",
+				"COLLIDE_PROFILING_URI":          "http://pyroscope:14040",
+				"COLLIDE_PROFILING_TAGS":         "hostname:api.dev.fallenpetals.com,service:collide,version:v2",
 			},
 			wants: Config{
 				HTTP: HTTP{
@@ -79,7 +87,13 @@ func TestNew(t *testing.T) {
 					BackendURI: "http://localhost:8084",
 				},
 				Tracks: Tracks{
-					Path: "tracks.yaml",
+					Path:           "tracks.yaml",
+					ReloadInterval: time.Minute,
+					Backend:        "sqlite",
+					SQLitePath:     "collide.db",
+				},
+				Admin: Admin{
+					Token: "secret",
 				},
 				Metrics: Metrics{
 					URI: "collector:14318",
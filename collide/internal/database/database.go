@@ -0,0 +1,144 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+
+	_ "modernc.org/sqlite" // Database driver
+)
+
+const (
+	uriFormat = "file:%s?_pragma=busy_timeout(5000)"
+	inMemory  = ":memory:"
+
+	checkDistrictsTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='districts');
+`
+
+	createDistrictsTable = `
+CREATE TABLE districts
+(
+    name         TEXT PRIMARY KEY NOT NULL
+);
+`
+
+	checkTracksTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='tracks');
+`
+
+	createTracksTable = `
+CREATE TABLE tracks
+(
+    id             TEXT PRIMARY KEY NOT NULL,
+    name           TEXT             NOT NULL,
+    district       TEXT             NOT NULL,
+    is_drift_track INTEGER          NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_tracks_district ON tracks (district);
+`
+
+	checkCollisionsTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='collisions');
+`
+
+	createCollisionsTable = `
+CREATE TABLE collisions
+(
+    track_id      TEXT NOT NULL,
+    collides_with TEXT NOT NULL,
+    PRIMARY KEY (track_id, collides_with)
+);
+
+CREATE INDEX idx_collisions_collides_with ON collisions (collides_with);
+`
+)
+
+// Open opens a SQLite database in the file at uri, creating it if it does not exist. An empty uri or ":memory:" open
+// an in-memory database instead.
+func Open(uri string) (*sql.DB, error) {
+	switch uri {
+	case inMemory:
+	case "":
+		uri = inMemory
+	default:
+		if err := validateURI(uri); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := sql.Open("sqlite", fmt.Sprintf(uriFormat, uri))
+	if err != nil {
+		return nil, err
+	}
+
+	// writes are serialized, and an in-memory database only lives within a single connection
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
+
+func validateURI(uri string) error {
+	stat, err := os.Stat(uri)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			f, err := os.Create(uri)
+			if err != nil {
+				return err
+			}
+
+			return f.Close()
+		}
+
+		return err
+	}
+
+	if stat.IsDir() {
+		return fmt.Errorf("%s is a directory", uri)
+	}
+
+	return nil
+}
+
+// Migrate creates the tables for the track data in the database, if they do not exist yet.
+func Migrate(ctx context.Context, db *sql.DB) error {
+	return runMigrations(ctx, db,
+		migration{checkDistrictsTableExists, createDistrictsTable},
+		migration{checkTracksTableExists, createTracksTable},
+		migration{checkCollisionsTableExists, createCollisionsTable},
+	)
+}
+
+type migration struct {
+	check  string
+	create string
+}
+
+func runMigrations(ctx context.Context, db *sql.DB, migrations ...migration) error {
+	for i := range migrations {
+		var exists bool
+
+		if err := db.QueryRowContext(ctx, migrations[i].check).Scan(&exists); err != nil {
+			return err
+		}
+
+		if exists {
+			continue
+		}
+
+		if _, err := db.ExecContext(ctx, migrations[i].create); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
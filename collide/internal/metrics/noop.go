@@ -28,3 +28,12 @@ func (noOp) IncGetCollisionsByDistrictAndTrack(context.Context, string, string)
 func (noOp) IncGetCollisionsByDistrictAndTrackFailed(context.Context, string, string) {}
 func (noOp) ObserveGetCollisionsByDistrictAndTrackLatency(context.Context, time.Duration, string, string) {
 }
+func (noOp) IncPlanEvent(context.Context, []string)                           {}
+func (noOp) IncPlanEventFailed(context.Context, []string)                     {}
+func (noOp) ObservePlanEventLatency(context.Context, time.Duration, []string) {}
+func (noOp) IncUpsertTrack(context.Context, string)                           {}
+func (noOp) IncUpsertTrackFailed(context.Context, string)                     {}
+func (noOp) ObserveUpsertTrackLatency(context.Context, time.Duration, string) {}
+func (noOp) IncDeleteTrack(context.Context, string)                           {}
+func (noOp) IncDeleteTrackFailed(context.Context, string)                     {}
+func (noOp) ObserveDeleteTrackLatency(context.Context, time.Duration, string) {}
@@ -47,6 +47,18 @@ type Otel struct {
 	getCollisionsByDistrictAndTrackTotal          metric.Int64Counter
 	getCollisionsByDistrictAndTrackFailed         metric.Int64Counter
 	getCollisionsByDistrictAndTrackLatencySeconds metric.Float64Histogram
+
+	planEventTotal          metric.Int64Counter
+	planEventFailed         metric.Int64Counter
+	planEventLatencySeconds metric.Float64Histogram
+
+	upsertTrackTotal          metric.Int64Counter
+	upsertTrackFailed         metric.Int64Counter
+	upsertTrackLatencySeconds metric.Float64Histogram
+
+	deleteTrackTotal          metric.Int64Counter
+	deleteTrackFailed         metric.Int64Counter
+	deleteTrackLatencySeconds metric.Float64Histogram
 }
 
 func NewOtel() (*Otel, error) {
@@ -185,6 +197,87 @@ func NewOtel() (*Otel, error) {
 		return nil, err
 	}
 
+	planEventTotal, err := Meter().Int64Counter(
+		"plan_event_total",
+		metric.WithUnit("req"),
+		metric.WithDescription("Count of requests to plan an event with non-colliding tracks in a set of districts"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	planEventFailed, err := Meter().Int64Counter(
+		"plan_event_failed",
+		metric.WithUnit("req"),
+		metric.WithDescription("Count of failed requests to plan an event with non-colliding tracks in a set of districts"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	planEventLatencySeconds, err := Meter().Float64Histogram(
+		"plan_event_latency_seconds",
+		metric.WithUnit("s"),
+		metric.WithDescription("Latency of requests to plan an event with non-colliding tracks in a set of districts"),
+		metric.WithExplicitBucketBoundaries(bucketBoundaries...))
+	if err != nil {
+		return nil, err
+	}
+
+	upsertTrackTotal, err := Meter().Int64Counter(
+		"upsert_track_total",
+		metric.WithUnit("req"),
+		metric.WithDescription("Count of requests to upsert a track"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	upsertTrackFailed, err := Meter().Int64Counter(
+		"upsert_track_failed",
+		metric.WithUnit("req"),
+		metric.WithDescription("Count of failed requests to upsert a track"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	upsertTrackLatencySeconds, err := Meter().Float64Histogram(
+		"upsert_track_latency_seconds",
+		metric.WithUnit("s"),
+		metric.WithDescription("Latency of requests to upsert a track"),
+		metric.WithExplicitBucketBoundaries(bucketBoundaries...))
+	if err != nil {
+		return nil, err
+	}
+
+	deleteTrackTotal, err := Meter().Int64Counter(
+		"delete_track_total",
+		metric.WithUnit("req"),
+		metric.WithDescription("Count of requests to delete a track"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteTrackFailed, err := Meter().Int64Counter(
+		"delete_track_failed",
+		metric.WithUnit("req"),
+		metric.WithDescription("Count of failed requests to delete a track"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteTrackLatencySeconds, err := Meter().Float64Histogram(
+		"delete_track_latency_seconds",
+		metric.WithUnit("s"),
+		metric.WithDescription("Latency of requests to delete a track"),
+		metric.WithExplicitBucketBoundaries(bucketBoundaries...))
+	if err != nil {
+		return nil, err
+	}
+
 	return &Otel{
 		listDistrictsTotal:          listDistrictsTotal,
 		listDistrictsFailed:         listDistrictsFailed,
@@ -205,6 +298,18 @@ func NewOtel() (*Otel, error) {
 		getCollisionsByDistrictAndTrackTotal:          getCollisionsByDistrictAndTrackTotal,
 		getCollisionsByDistrictAndTrackFailed:         getCollisionsByDistrictAndTrackFailed,
 		getCollisionsByDistrictAndTrackLatencySeconds: getCollisionsByDistrictAndTrackLatencySeconds,
+
+		planEventTotal:          planEventTotal,
+		planEventFailed:         planEventFailed,
+		planEventLatencySeconds: planEventLatencySeconds,
+
+		upsertTrackTotal:          upsertTrackTotal,
+		upsertTrackFailed:         upsertTrackFailed,
+		upsertTrackLatencySeconds: upsertTrackLatencySeconds,
+
+		deleteTrackTotal:          deleteTrackTotal,
+		deleteTrackFailed:         deleteTrackFailed,
+		deleteTrackLatencySeconds: deleteTrackLatencySeconds,
 	}, nil
 }
 
@@ -275,6 +380,36 @@ func (m *Otel) ObserveGetCollisionsByDistrictAndTrackLatency(ctx context.Context
 		attribute.String("track", track),
 	))
 }
+func (m *Otel) IncPlanEvent(ctx context.Context, districts []string) {
+	m.planEventTotal.Add(ctx, 1, metric.WithAttributes(attribute.StringSlice("districts", districts)))
+}
+func (m *Otel) IncPlanEventFailed(ctx context.Context, districts []string) {
+	m.planEventFailed.Add(ctx, 1, metric.WithAttributes(attribute.StringSlice("districts", districts)))
+}
+func (m *Otel) ObservePlanEventLatency(ctx context.Context, duration time.Duration, districts []string) {
+	m.planEventLatencySeconds.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.StringSlice("districts", districts)))
+}
+func (m *Otel) IncUpsertTrack(ctx context.Context, track string) {
+	m.upsertTrackTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("track", track)))
+}
+func (m *Otel) IncUpsertTrackFailed(ctx context.Context, track string) {
+	m.upsertTrackFailed.Add(ctx, 1, metric.WithAttributes(attribute.String("track", track)))
+}
+func (m *Otel) ObserveUpsertTrackLatency(ctx context.Context, duration time.Duration, track string) {
+	m.upsertTrackLatencySeconds.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String("track", track)))
+}
+func (m *Otel) IncDeleteTrack(ctx context.Context, track string) {
+	m.deleteTrackTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("track", track)))
+}
+func (m *Otel) IncDeleteTrackFailed(ctx context.Context, track string) {
+	m.deleteTrackFailed.Add(ctx, 1, metric.WithAttributes(attribute.String("track", track)))
+}
+func (m *Otel) ObserveDeleteTrackLatency(ctx context.Context, duration time.Duration, track string) {
+	m.deleteTrackLatencySeconds.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String("track", track)))
+}
 
 func Init(ctx context.Context, uri string) (ShutdownFunc, error) {
 	res, err := resource.New(ctx,
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -39,6 +40,18 @@ type Prometheus struct {
 	getCollisionsByDistrictAndTrackFailed         *prometheus.CounterVec
 	getCollisionsByDistrictAndTrackLatencySeconds *prometheus.HistogramVec
 
+	planEventTotal          *prometheus.CounterVec
+	planEventFailed         *prometheus.CounterVec
+	planEventLatencySeconds *prometheus.HistogramVec
+
+	upsertTrackTotal          *prometheus.CounterVec
+	upsertTrackFailed         *prometheus.CounterVec
+	upsertTrackLatencySeconds *prometheus.HistogramVec
+
+	deleteTrackTotal          *prometheus.CounterVec
+	deleteTrackFailed         *prometheus.CounterVec
+	deleteTrackLatencySeconds *prometheus.HistogramVec
+
 	// Third party metrics
 	collectors []prometheus.Collector
 }
@@ -114,6 +127,48 @@ func NewPrometheus() *Prometheus {
 			Help:    "Latency of request to get collisions for a certain district, with a target track",
 			Buckets: []float64{.00001, .00005, .0001, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
 		}, []string{"district", "track"}),
+
+		planEventTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "plan_event_total",
+			Help: "Count of requests to plan an event with non-colliding tracks in a set of districts",
+		}, []string{"districts"}),
+		planEventFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "plan_event_failed",
+			Help: "Count of failed requests to plan an event with non-colliding tracks in a set of districts",
+		}, []string{"districts"}),
+		planEventLatencySeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "plan_event_latency_seconds",
+			Help:    "Latency of requests to plan an event with non-colliding tracks in a set of districts",
+			Buckets: []float64{.00001, .00005, .0001, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"districts"}),
+
+		upsertTrackTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "upsert_track_total",
+			Help: "Count of requests to upsert a track",
+		}, []string{"track"}),
+		upsertTrackFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "upsert_track_failed",
+			Help: "Count of failed requests to upsert a track",
+		}, []string{"track"}),
+		upsertTrackLatencySeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "upsert_track_latency_seconds",
+			Help:    "Latency of requests to upsert a track",
+			Buckets: []float64{.00001, .00005, .0001, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"track"}),
+
+		deleteTrackTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "delete_track_total",
+			Help: "Count of requests to delete a track",
+		}, []string{"track"}),
+		deleteTrackFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "delete_track_failed",
+			Help: "Count of failed requests to delete a track",
+		}, []string{"track"}),
+		deleteTrackLatencySeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "delete_track_latency_seconds",
+			Help:    "Latency of requests to delete a track",
+			Buckets: []float64{.00001, .00005, .0001, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"track"}),
 	}
 }
 
@@ -212,6 +267,66 @@ func (m *Prometheus) ObserveGetCollisionsByDistrictAndTrackLatency(ctx context.C
 
 	m.getCollisionsByDistrictAndTrackLatencySeconds.WithLabelValues(district, track).Observe(duration.Seconds())
 }
+func (m *Prometheus) IncPlanEvent(_ context.Context, districts []string) {
+	m.planEventTotal.WithLabelValues(strings.Join(districts, ",")).Inc()
+}
+func (m *Prometheus) IncPlanEventFailed(_ context.Context, districts []string) {
+	m.planEventFailed.WithLabelValues(strings.Join(districts, ",")).Inc()
+}
+func (m *Prometheus) ObservePlanEventLatency(ctx context.Context, duration time.Duration, districts []string) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		if eo, ok := m.planEventLatencySeconds.
+			WithLabelValues(strings.Join(districts, ",")).(prometheus.ExemplarObserver); ok {
+			eo.ObserveWithExemplar(duration.Seconds(), prometheus.Labels{
+				traceIDKey: sc.TraceID().String(),
+			})
+
+			return
+		}
+	}
+
+	m.planEventLatencySeconds.WithLabelValues(strings.Join(districts, ",")).Observe(duration.Seconds())
+}
+func (m *Prometheus) IncUpsertTrack(_ context.Context, track string) {
+	m.upsertTrackTotal.WithLabelValues(track).Inc()
+}
+func (m *Prometheus) IncUpsertTrackFailed(_ context.Context, track string) {
+	m.upsertTrackFailed.WithLabelValues(track).Inc()
+}
+func (m *Prometheus) ObserveUpsertTrackLatency(ctx context.Context, duration time.Duration, track string) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		if eo, ok := m.upsertTrackLatencySeconds.
+			WithLabelValues(track).(prometheus.ExemplarObserver); ok {
+			eo.ObserveWithExemplar(duration.Seconds(), prometheus.Labels{
+				traceIDKey: sc.TraceID().String(),
+			})
+
+			return
+		}
+	}
+
+	m.upsertTrackLatencySeconds.WithLabelValues(track).Observe(duration.Seconds())
+}
+func (m *Prometheus) IncDeleteTrack(_ context.Context, track string) {
+	m.deleteTrackTotal.WithLabelValues(track).Inc()
+}
+func (m *Prometheus) IncDeleteTrackFailed(_ context.Context, track string) {
+	m.deleteTrackFailed.WithLabelValues(track).Inc()
+}
+func (m *Prometheus) ObserveDeleteTrackLatency(ctx context.Context, duration time.Duration, track string) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		if eo, ok := m.deleteTrackLatencySeconds.
+			WithLabelValues(track).(prometheus.ExemplarObserver); ok {
+			eo.ObserveWithExemplar(duration.Seconds(), prometheus.Labels{
+				traceIDKey: sc.TraceID().String(),
+			})
+
+			return
+		}
+	}
+
+	m.deleteTrackLatencySeconds.WithLabelValues(track).Observe(duration.Seconds())
+}
 
 func RegisterCollector(m *Prometheus, collector prometheus.Collector) {
 	m.collectors = append(m.collectors, collector)
@@ -244,6 +359,18 @@ func Registry(m *Prometheus) (*prometheus.Registry, error) {
 		m.getCollisionsByDistrictAndTrackTotal,
 		m.getCollisionsByDistrictAndTrackFailed,
 		m.getCollisionsByDistrictAndTrackLatencySeconds,
+
+		m.planEventTotal,
+		m.planEventFailed,
+		m.planEventLatencySeconds,
+
+		m.upsertTrackTotal,
+		m.upsertTrackFailed,
+		m.upsertTrackLatencySeconds,
+
+		m.deleteTrackTotal,
+		m.deleteTrackFailed,
+		m.deleteTrackLatencySeconds,
 	} {
 		err := reg.Register(metric)
 		if err != nil {
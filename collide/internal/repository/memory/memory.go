@@ -8,6 +8,7 @@ import (
 	otelcodes "go.opentelemetry.io/otel/codes"
 	"log/slog"
 	"slices"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/trace"
 
@@ -18,10 +19,11 @@ var (
 	ErrDistrictNotFound = errors.New("district not found")
 	ErrNoDistricts      = errors.New("no districts found")
 	ErrNoTracks         = errors.New("no tracks found")
+	ErrReadOnly         = errors.New("repository is read-only")
 )
 
 type Repository struct {
-	tracks *tracks.TrackList
+	tracks atomic.Pointer[tracks.TrackList]
 
 	logger *slog.Logger
 	tracer trace.Tracer
@@ -31,22 +33,38 @@ func New(logger *slog.Logger, tracer trace.Tracer) *Repository {
 	return &Repository{logger: logger, tracer: tracer}
 }
 
+// FromBytes parses the input YAML track list, replacing the Repository's data with it if it is valid.
 func (r *Repository) FromBytes(buf []byte) error {
-	r.tracks = &tracks.TrackList{}
+	list := &tracks.TrackList{}
 
-	if _, err := r.tracks.Read(buf); err != nil {
+	if _, err := list.Read(buf); err != nil {
 		return err
 	}
 
+	return r.Store(list)
+}
+
+// Store validates the input TrackList, atomically replacing the Repository's data with it if it is valid.
+//
+// In-flight calls keep working on the TrackList they started with.
+func (r *Repository) Store(list *tracks.TrackList) error {
+	if err := tracks.Validate(list); err != nil {
+		return err
+	}
+
+	r.tracks.Store(list)
+
 	return nil
 }
 
 func (r *Repository) ListDistricts(ctx context.Context) ([]string, error) {
+	list := r.tracks.Load()
+
 	ctx, span := r.tracer.Start(ctx, "Repository.ListDistricts", trace.WithAttributes(
-		attribute.Int("track_count", len(r.tracks.Tracks))))
+		attribute.Int("track_count", len(list.Tracks))))
 	defer span.End()
 
-	districts, err := tracks.GetDistricts(r.tracks)
+	districts, err := tracks.GetDistricts(list)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, err.Error())
@@ -73,13 +91,15 @@ func (r *Repository) ListDistricts(ctx context.Context) ([]string, error) {
 }
 
 func (r *Repository) ListAllTracksByDistrict(ctx context.Context, district string) ([]string, error) {
+	list := r.tracks.Load()
+
 	ctx, span := r.tracer.Start(ctx, "Repository.ListAllTracksByDistrict", trace.WithAttributes(
-		attribute.Int("track_count", len(r.tracks.Tracks)),
+		attribute.Int("track_count", len(list.Tracks)),
 		attribute.String("district", district),
 		attribute.Bool("drift_only", false)))
 	defer span.End()
 
-	t, err := tracks.GetTracksByDistrict(r.tracks, district, false)
+	t, err := tracks.GetTracksByDistrict(list, district, false)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, err.Error())
@@ -130,17 +150,19 @@ func (r *Repository) ListAllTracksByDistrict(ctx context.Context, district strin
 		attribute.Int("track_count", len(t))))
 	span.SetStatus(otelcodes.Ok, "")
 
-	return tracks.GetNamesFromIDs(r.tracks, t)
+	return tracks.GetNamesFromIDs(list, t)
 }
 
 func (r *Repository) ListDriftTracksByDistrict(ctx context.Context, district string) ([]string, error) {
+	list := r.tracks.Load()
+
 	ctx, span := r.tracer.Start(ctx, "Repository.ListDriftTracksByDistrict", trace.WithAttributes(
-		attribute.Int("track_count", len(r.tracks.Tracks)),
+		attribute.Int("track_count", len(list.Tracks)),
 		attribute.String("district", district),
 		attribute.Bool("drift_only", true)))
 	defer span.End()
 
-	t, err := tracks.GetTracksByDistrict(r.tracks, district, true)
+	t, err := tracks.GetTracksByDistrict(list, district, true)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, err.Error())
@@ -191,17 +213,19 @@ func (r *Repository) ListDriftTracksByDistrict(ctx context.Context, district str
 		attribute.Int("track_count", len(t))))
 	span.SetStatus(otelcodes.Ok, "")
 
-	return tracks.GetNamesFromIDs(r.tracks, t)
+	return tracks.GetNamesFromIDs(list, t)
 }
 
 func (r *Repository) GetAlternativesByDistrictAndTrack(ctx context.Context, district, track string) ([]string, error) {
+	list := r.tracks.Load()
+
 	ctx, span := r.tracer.Start(ctx, "Repository.GetAlternativesByDistrictAndTrack", trace.WithAttributes(
-		attribute.Int("track_count", len(r.tracks.Tracks)),
+		attribute.Int("track_count", len(list.Tracks)),
 		attribute.String("district", district),
 		attribute.String("track", track)))
 	defer span.End()
 
-	trackID, err := tracks.GetIDFromName(r.tracks, track)
+	trackID, err := tracks.GetIDFromName(list, track)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, err.Error())
@@ -216,7 +240,7 @@ func (r *Repository) GetAlternativesByDistrictAndTrack(ctx context.Context, dist
 		attribute.String("track", track),
 		attribute.String("track_id", trackID)))
 
-	t, err := tracks.GetOpenTracks(r.tracks, trackID)
+	t, err := tracks.GetOpenTracks(list, trackID)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, err.Error())
@@ -272,17 +296,19 @@ func (r *Repository) GetAlternativesByDistrictAndTrack(ctx context.Context, dist
 		attribute.Int("track_count", len(t))))
 	span.SetStatus(otelcodes.Ok, "")
 
-	return tracks.GetNamesFromIDs(r.tracks, t)
+	return tracks.GetNamesFromIDs(list, t)
 }
 
 func (r *Repository) GetCollisionsByDistrictAndTrack(ctx context.Context, district, track string) ([]string, error) {
+	list := r.tracks.Load()
+
 	ctx, span := r.tracer.Start(ctx, "Repository.GetCollisionsByDistrictAndTrack", trace.WithAttributes(
-		attribute.Int("track_count", len(r.tracks.Tracks)),
+		attribute.Int("track_count", len(list.Tracks)),
 		attribute.String("district", district),
 		attribute.String("track", track)))
 	defer span.End()
 
-	trackID, err := tracks.GetIDFromName(r.tracks, track)
+	trackID, err := tracks.GetIDFromName(list, track)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, err.Error())
@@ -297,7 +323,7 @@ func (r *Repository) GetCollisionsByDistrictAndTrack(ctx context.Context, distri
 		attribute.String("track", track),
 		attribute.String("track_id", trackID)))
 
-	t, err := tracks.GetCollisions(r.tracks, trackID)
+	t, err := tracks.GetCollisions(list, trackID)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, err.Error())
@@ -353,5 +379,136 @@ func (r *Repository) GetCollisionsByDistrictAndTrack(ctx context.Context, distri
 		attribute.Int("track_count", len(t))))
 	span.SetStatus(otelcodes.Ok, "")
 
-	return tracks.GetNamesFromIDs(r.tracks, t)
+	return tracks.GetNamesFromIDs(list, t)
+}
+
+func (r *Repository) PlanEvent(ctx context.Context, districts []string, driftOnly bool, mustInclude []string) (tracks.Plan, error) {
+	list := r.tracks.Load()
+
+	ctx, span := r.tracer.Start(ctx, "Repository.PlanEvent", trace.WithAttributes(
+		attribute.Int("track_count", len(list.Tracks)),
+		attribute.StringSlice("districts", districts),
+		attribute.Bool("drift_only", driftOnly),
+		attribute.StringSlice("must_include", mustInclude)))
+	defer span.End()
+
+	knownDistricts, err := r.ListDistricts(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent("failed to fetch district list for verification", trace.WithAttributes(
+			attribute.StringSlice("districts", districts),
+			attribute.String("error", err.Error())))
+
+		return tracks.Plan{}, fmt.Errorf("planning event in districts %q: %w", districts, err)
+	}
+
+	for _, district := range districts {
+		if !slices.Contains(knownDistricts, district) {
+			span.RecordError(ErrDistrictNotFound)
+			span.SetStatus(otelcodes.Error, ErrDistrictNotFound.Error())
+			span.AddEvent("district does not exist", trace.WithAttributes(
+				attribute.String("target_district", district),
+				attribute.StringSlice("districts", knownDistricts),
+				attribute.String("error", ErrDistrictNotFound.Error())))
+
+			return tracks.Plan{}, ErrDistrictNotFound
+		}
+	}
+
+	mustIncludeIDs := make([]string, 0, len(mustInclude))
+
+	for _, track := range mustInclude {
+		trackID, err := tracks.GetIDFromName(list, track)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+			span.AddEvent("getting track ID from name", trace.WithAttributes(
+				attribute.String("track", track),
+				attribute.String("error", err.Error())))
+
+			return tracks.Plan{}, err
+		}
+
+		mustIncludeIDs = append(mustIncludeIDs, trackID)
+	}
+
+	plan, err := tracks.PlanEvent(list, districts, driftOnly, mustIncludeIDs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent("planning event in districts", trace.WithAttributes(
+			attribute.StringSlice("districts", districts),
+			attribute.String("error", err.Error())))
+
+		return tracks.Plan{}, err
+	}
+
+	if len(plan.Tracks) == 0 {
+		span.RecordError(ErrNoTracks)
+		span.SetStatus(otelcodes.Error, ErrNoTracks.Error())
+		span.AddEvent("districts do not contain any tracks to plan an event with", trace.WithAttributes(
+			attribute.StringSlice("districts", districts),
+			attribute.Bool("drift_only", driftOnly),
+			attribute.String("error", ErrNoTracks.Error())))
+
+		return tracks.Plan{}, ErrNoTracks
+	}
+
+	names := tracks.Plan{
+		Rounds: make([][]string, 0, len(plan.Rounds)),
+	}
+
+	if names.Tracks, err = tracks.GetNamesFromIDs(list, plan.Tracks); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+
+		return tracks.Plan{}, err
+	}
+
+	for i := range plan.Rounds {
+		round, err := tracks.GetNamesFromIDs(list, plan.Rounds[i])
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+
+			return tracks.Plan{}, err
+		}
+
+		names.Rounds = append(names.Rounds, round)
+	}
+
+	span.AddEvent("planned event in districts successfully", trace.WithAttributes(
+		attribute.StringSlice("districts", districts),
+		attribute.Int("track_count", len(names.Tracks)),
+		attribute.Int("round_count", len(names.Rounds))))
+	span.SetStatus(otelcodes.Ok, "")
+
+	return names, nil
+}
+
+// UpsertTrack is not supported by the in-memory Repository, whose data is only replaced as a whole, and returns
+// ErrReadOnly.
+func (r *Repository) UpsertTrack(ctx context.Context, track tracks.Track) error {
+	_, span := r.tracer.Start(ctx, "Repository.UpsertTrack", trace.WithAttributes(
+		attribute.String("track_id", track.ID)))
+	defer span.End()
+
+	span.RecordError(ErrReadOnly)
+	span.SetStatus(otelcodes.Error, ErrReadOnly.Error())
+
+	return ErrReadOnly
+}
+
+// DeleteTrack is not supported by the in-memory Repository, whose data is only replaced as a whole, and returns
+// ErrReadOnly.
+func (r *Repository) DeleteTrack(ctx context.Context, id string) error {
+	_, span := r.tracer.Start(ctx, "Repository.DeleteTrack", trace.WithAttributes(
+		attribute.String("track_id", id)))
+	defer span.End()
+
+	span.RecordError(ErrReadOnly)
+	span.SetStatus(otelcodes.Error, ErrReadOnly.Error())
+
+	return ErrReadOnly
 }
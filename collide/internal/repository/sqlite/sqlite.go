@@ -0,0 +1,328 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zalgonoise/x/collide/internal/repository/memory"
+	"github.com/zalgonoise/x/collide/internal/tracks"
+)
+
+const (
+	queryDistrictsList = `
+SELECT name FROM districts
+ORDER BY rowid
+`
+
+	queryTracksList = `
+SELECT id, name, district, is_drift_track FROM tracks
+ORDER BY rowid
+`
+
+	queryCollisionsList = `
+SELECT track_id, collides_with FROM collisions
+ORDER BY rowid
+`
+
+	queryDistrictsCreate = `
+INSERT INTO districts (name)
+VALUES (?)
+ON CONFLICT (name) DO NOTHING
+`
+
+	queryTracksUpsert = `
+INSERT INTO tracks (id, name, district, is_drift_track)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET
+    name = excluded.name,
+    district = excluded.district,
+    is_drift_track = excluded.is_drift_track
+`
+
+	queryCollisionsCreate = `
+INSERT INTO collisions (track_id, collides_with)
+VALUES (?, ?)
+ON CONFLICT (track_id, collides_with) DO NOTHING
+`
+
+	queryTracksDelete = `
+DELETE FROM tracks WHERE id = ?
+`
+
+	queryCollisionsDelete = `
+DELETE FROM collisions WHERE track_id = ? OR collides_with = ?
+`
+
+	queryDistrictsDeleteAll  = `DELETE FROM districts`
+	queryTracksDeleteAll     = `DELETE FROM tracks`
+	queryCollisionsDeleteAll = `DELETE FROM collisions`
+)
+
+// Repository is a SQLite-backed track repository that supports edits to individual tracks.
+//
+// Reads are served by an in-memory copy of the data, which is replaced whenever a write is committed. Every write is
+// validated as a whole (with tracks.Validate) before being committed.
+type Repository struct {
+	*memory.Repository
+
+	db *sql.DB
+
+	tracer trace.Tracer
+}
+
+func New(db *sql.DB, logger *slog.Logger, tracer trace.Tracer) *Repository {
+	return &Repository{
+		Repository: memory.New(logger, tracer),
+		db:         db,
+		tracer:     tracer,
+	}
+}
+
+// Load reads the track data from the database, returning the number of tracks loaded.
+func (r *Repository) Load(ctx context.Context) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "Repository.Load")
+	defer span.End()
+
+	list, err := load(ctx, r.db)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+
+		return 0, err
+	}
+
+	if err = r.Store(list); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+
+		return 0, err
+	}
+
+	span.AddEvent("loaded tracks successfully", trace.WithAttributes(
+		attribute.Int("track_count", len(list.Tracks))))
+	span.SetStatus(otelcodes.Ok, "")
+
+	return len(list.Tracks), nil
+}
+
+// FromBytes parses the input YAML track list, replacing all the data in the database with it if it is valid.
+func (r *Repository) FromBytes(buf []byte) error {
+	list := &tracks.TrackList{}
+
+	if _, err := list.Read(buf); err != nil {
+		return err
+	}
+
+	// collisions are stored in both directions, which would otherwise hide asymmetric entries in the input
+	if err := tracks.Validate(list); err != nil {
+		return err
+	}
+
+	return r.write(context.Background(), "Repository.FromBytes", func(ctx context.Context, tx *sql.Tx) error {
+		for _, query := range []string{queryCollisionsDeleteAll, queryTracksDeleteAll, queryDistrictsDeleteAll} {
+			if _, err := tx.ExecContext(ctx, query); err != nil {
+				return err
+			}
+		}
+
+		for _, district := range list.Districts {
+			if _, err := tx.ExecContext(ctx, queryDistrictsCreate, district); err != nil {
+				return err
+			}
+		}
+
+		for i := range list.Tracks {
+			if err := upsert(ctx, tx, list.Tracks[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// UpsertTrack creates or replaces a track. Its collisions are replaced with the ones in the input track, which are
+// mirrored on the colliding tracks.
+func (r *Repository) UpsertTrack(ctx context.Context, track tracks.Track) error {
+	return r.write(ctx, "Repository.UpsertTrack", func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, queryCollisionsDelete, track.ID, track.ID); err != nil {
+			return err
+		}
+
+		return upsert(ctx, tx, track)
+	}, attribute.String("track_id", track.ID))
+}
+
+// DeleteTrack removes a track, as well as any collisions referencing it.
+func (r *Repository) DeleteTrack(ctx context.Context, id string) error {
+	return r.write(ctx, "Repository.DeleteTrack", func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, queryTracksDelete, id)
+		if err != nil {
+			return err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if n == 0 {
+			return fmt.Errorf("%w: %s", tracks.ErrNotFound, id)
+		}
+
+		_, err = tx.ExecContext(ctx, queryCollisionsDelete, id, id)
+
+		return err
+	}, attribute.String("track_id", id))
+}
+
+// write runs fn in a transaction, which is only committed if the resulting track data is valid. The in-memory copy
+// of the data is replaced once the transaction is committed.
+func (r *Repository) write(
+	ctx context.Context, name string, fn func(context.Context, *sql.Tx) error, attrs ...attribute.KeyValue,
+) (err error) {
+	ctx, span := r.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+	defer span.End()
+
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+	}()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = fn(ctx, tx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	list, err := load(ctx, tx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = tracks.Validate(list); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	if err = r.Store(list); err != nil {
+		return err
+	}
+
+	span.AddEvent("committed tracks successfully", trace.WithAttributes(
+		attribute.Int("track_count", len(list.Tracks))))
+	span.SetStatus(otelcodes.Ok, "")
+
+	return nil
+}
+
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func load(ctx context.Context, q querier) (*tracks.TrackList, error) {
+	list := &tracks.TrackList{}
+
+	if err := scan(ctx, q, queryDistrictsList, func(rows *sql.Rows) error {
+		var district string
+
+		if err := rows.Scan(&district); err != nil {
+			return err
+		}
+
+		list.Districts = append(list.Districts, district)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int)
+
+	if err := scan(ctx, q, queryTracksList, func(rows *sql.Rows) error {
+		var track tracks.Track
+
+		if err := rows.Scan(&track.ID, &track.Name, &track.District, &track.IsDriftTrack); err != nil {
+			return err
+		}
+
+		index[track.ID] = len(list.Tracks)
+		list.Tracks = append(list.Tracks, track)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := scan(ctx, q, queryCollisionsList, func(rows *sql.Rows) error {
+		var trackID, collidesWith string
+
+		if err := rows.Scan(&trackID, &collidesWith); err != nil {
+			return err
+		}
+
+		// collisions for tracks that do not exist are left for tracks.Validate to report, from the other side
+		if i, ok := index[trackID]; ok {
+			list.Tracks[i].CollidesWith = append(list.Tracks[i].CollidesWith, collidesWith)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func scan(ctx context.Context, q querier, query string, fn func(rows *sql.Rows) error) (err error) {
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errors.Join(err, rows.Close())
+	}()
+
+	for rows.Next() {
+		if err = fn(rows); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func upsert(ctx context.Context, tx *sql.Tx, track tracks.Track) error {
+	if _, err := tx.ExecContext(ctx, queryTracksUpsert,
+		track.ID, track.Name, track.District, track.IsDriftTrack); err != nil {
+		return err
+	}
+
+	for _, collision := range track.CollidesWith {
+		if _, err := tx.ExecContext(ctx, queryCollisionsCreate, track.ID, collision); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, queryCollisionsCreate, collision, track.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/zalgonoise/x/collide/internal/database"
+	"github.com/zalgonoise/x/collide/internal/tracks"
+)
+
+const testTracks = `districts:
+  - X
+  - Y
+tracks:
+  - id: A
+    name: Track A
+    district: X
+    is_drift_track: true
+    collides_with:
+      - B
+  - id: B
+    name: Track B
+    district: X
+    collides_with:
+      - A
+  - id: C
+    name: Track C
+    district: Y
+`
+
+func newRepository(t *testing.T, path string) *Repository {
+	t.Helper()
+
+	db, err := database.Open(path)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	require.NoError(t, database.Migrate(context.Background(), db))
+
+	return New(db, slog.New(slog.DiscardHandler), noop.NewTracerProvider().Tracer("sqlite"))
+}
+
+func TestRepository(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "collide.db")
+	repo := newRepository(t, path)
+
+	n, err := repo.Load(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	require.NoError(t, repo.FromBytes([]byte(testTracks)))
+
+	t.Run("Read", func(t *testing.T) {
+		districts, err := repo.ListDistricts(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"X", "Y"}, districts)
+
+		drift, err := repo.ListDriftTracksByDistrict(ctx, "X")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Track A"}, drift)
+	})
+
+	t.Run("UpsertTrack/Create", func(t *testing.T) {
+		require.NoError(t, repo.UpsertTrack(ctx, tracks.Track{
+			ID: "D", Name: "Track D", District: "X", IsDriftTrack: true, CollidesWith: []string{"A"},
+		}))
+
+		// collisions are mirrored on the colliding track
+		collisions, err := repo.GetCollisionsByDistrictAndTrack(ctx, "X", "Track A")
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"Track B", "Track D"}, collisions)
+	})
+
+	t.Run("UpsertTrack/Update", func(t *testing.T) {
+		require.NoError(t, repo.UpsertTrack(ctx, tracks.Track{
+			ID: "D", Name: "Track D", District: "X", CollidesWith: []string{"B"},
+		}))
+
+		collisions, err := repo.GetCollisionsByDistrictAndTrack(ctx, "X", "Track A")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Track B"}, collisions)
+
+		drift, err := repo.ListDriftTracksByDistrict(ctx, "X")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Track A"}, drift)
+	})
+
+	t.Run("UpsertTrack/ErrDanglingCollision", func(t *testing.T) {
+		err := repo.UpsertTrack(ctx, tracks.Track{
+			ID: "E", Name: "Track E", District: "X", CollidesWith: []string{"Z"},
+		})
+		require.ErrorIs(t, err, tracks.ErrDanglingCollision)
+
+		// the transaction is rolled back
+		all, err := repo.ListAllTracksByDistrict(ctx, "X")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Track A", "Track B", "Track D"}, all)
+	})
+
+	t.Run("UpsertTrack/ErrUnknownDistrict", func(t *testing.T) {
+		err := repo.UpsertTrack(ctx, tracks.Track{ID: "E", Name: "Track E", District: "Z"})
+		require.ErrorIs(t, err, tracks.ErrUnknownDistrict)
+	})
+
+	t.Run("DeleteTrack", func(t *testing.T) {
+		require.NoError(t, repo.DeleteTrack(ctx, "A"))
+
+		collisions, err := repo.GetCollisionsByDistrictAndTrack(ctx, "X", "Track B")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Track D"}, collisions)
+
+		require.ErrorIs(t, repo.DeleteTrack(ctx, "A"), tracks.ErrNotFound)
+	})
+
+	t.Run("FromBytes/ErrAsymmetricCollision", func(t *testing.T) {
+		err := repo.FromBytes([]byte("tracks:\n  - id: A\n    name: A\n    collides_with: [B]\n  - id: B\n    name: B\n"))
+		require.ErrorIs(t, err, tracks.ErrAsymmetricCollision)
+	})
+
+	t.Run("Persistence", func(t *testing.T) {
+		reopened := newRepository(t, path)
+
+		n, err := reopened.Load(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, n)
+
+		all, err := reopened.ListAllTracksByDistrict(ctx, "X")
+		require.NoError(t, err)
+		require.Equal(t, []string{"Track B", "Track D"}, all)
+	})
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zalgonoise/x/collide/internal/repository/memory"
+	"github.com/zalgonoise/x/collide/internal/tracks"
+	pb "github.com/zalgonoise/x/collide/pkg/api/pb/collide/v1"
+)
+
+const (
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+)
+
+var (
+	ErrAdminDisabled = errors.New("admin API is disabled")
+	ErrMissingToken  = errors.New("missing admin token")
+	ErrInvalidToken  = errors.New("invalid admin token")
+)
+
+func (s *Service) UpsertTrack(ctx context.Context, req *pb.UpsertTrackRequest) (*pb.UpsertTrackResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "Service.UpsertTrack", trace.WithAttributes(
+		attribute.String("track_id", req.GetTrack().GetId())))
+	defer span.End()
+
+	trackID := req.GetTrack().GetId()
+
+	s.metrics.IncUpsertTrack(ctx, trackID)
+	start := time.Now()
+	defer func() {
+		s.metrics.ObserveUpsertTrackLatency(ctx, time.Since(start), trackID)
+	}()
+
+	err := s.authorize(ctx)
+	if err == nil {
+		if err = req.Validate(); err != nil {
+			err = errors.Join(tracks.ErrInvalidTrackList, err)
+		}
+	}
+
+	if err == nil {
+		err = s.repo.UpsertTrack(ctx, tracks.Track{
+			ID:           req.GetTrack().GetId(),
+			Name:         req.GetTrack().GetName(),
+			District:     req.GetTrack().GetDistrict(),
+			IsDriftTrack: req.GetTrack().GetIsDriftTrack(),
+			CollidesWith: req.GetTrack().GetCollidesWith(),
+		})
+	}
+
+	if err != nil {
+		s.metrics.IncUpsertTrackFailed(ctx, trackID)
+		s.logger.ErrorContext(ctx, "upserting track",
+			slog.String("error", err.Error()), slog.String("track_id", trackID))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent("upserting track", trace.WithAttributes(
+			attribute.String("error", err.Error()),
+			attribute.String("track_id", trackID)))
+
+		return nil, adminStatus(err)
+	}
+
+	s.logger.DebugContext(ctx, "upserted track successfully", slog.String("track_id", trackID))
+	span.AddEvent("upserted track successfully", trace.WithAttributes(
+		attribute.String("track_id", trackID)))
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.UpsertTrackResponse{Track: req.GetTrack()}, nil
+}
+
+func (s *Service) DeleteTrack(ctx context.Context, req *pb.DeleteTrackRequest) (*pb.DeleteTrackResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "Service.DeleteTrack", trace.WithAttributes(
+		attribute.String("track_id", req.GetId())))
+	defer span.End()
+
+	trackID := req.GetId()
+
+	s.metrics.IncDeleteTrack(ctx, trackID)
+	start := time.Now()
+	defer func() {
+		s.metrics.ObserveDeleteTrackLatency(ctx, time.Since(start), trackID)
+	}()
+
+	err := s.authorize(ctx)
+	if err == nil {
+		err = s.repo.DeleteTrack(ctx, trackID)
+	}
+
+	if err != nil {
+		s.metrics.IncDeleteTrackFailed(ctx, trackID)
+		s.logger.ErrorContext(ctx, "deleting track",
+			slog.String("error", err.Error()), slog.String("track_id", trackID))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent("deleting track", trace.WithAttributes(
+			attribute.String("error", err.Error()),
+			attribute.String("track_id", trackID)))
+
+		return nil, adminStatus(err)
+	}
+
+	s.logger.DebugContext(ctx, "deleted track successfully", slog.String("track_id", trackID))
+	span.AddEvent("deleted track successfully", trace.WithAttributes(
+		attribute.String("track_id", trackID)))
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.DeleteTrackResponse{}, nil
+}
+
+// authorize verifies that the incoming call carries the configured admin token as a bearer token.
+func (s *Service) authorize(ctx context.Context) error {
+	if s.adminToken == "" {
+		return ErrAdminDisabled
+	}
+
+	values := metadata.ValueFromIncomingContext(ctx, authorizationKey)
+	if len(values) == 0 {
+		return ErrMissingToken
+	}
+
+	for _, value := range values {
+		token, ok := strings.CutPrefix(value, bearerPrefix)
+		if ok && subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) == 1 {
+			return nil
+		}
+	}
+
+	return ErrInvalidToken
+}
+
+func adminStatus(err error) error {
+	switch {
+	case errors.Is(err, ErrMissingToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrAdminDisabled), errors.Is(err, ErrInvalidToken):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, memory.ErrReadOnly):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, tracks.ErrInvalidTrackList):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, tracks.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/zalgonoise/x/collide/internal/repository/memory"
+	"github.com/zalgonoise/x/collide/internal/tracks"
 	pb "github.com/zalgonoise/x/collide/pkg/api/pb/collide/v1"
 	"go.opentelemetry.io/otel/attribute"
 	otelcodes "go.opentelemetry.io/otel/codes"
@@ -20,6 +21,9 @@ type Repository interface {
 	ListDriftTracksByDistrict(ctx context.Context, district string) ([]string, error)
 	GetAlternativesByDistrictAndTrack(ctx context.Context, district string, track string) ([]string, error)
 	GetCollisionsByDistrictAndTrack(ctx context.Context, district string, track string) ([]string, error)
+	PlanEvent(ctx context.Context, districts []string, driftOnly bool, mustInclude []string) (tracks.Plan, error)
+	UpsertTrack(ctx context.Context, track tracks.Track) error
+	DeleteTrack(ctx context.Context, id string) error
 }
 
 type Metrics interface {
@@ -38,6 +42,15 @@ type Metrics interface {
 	IncGetCollisionsByDistrictAndTrack(ctx context.Context, district string, track string)
 	IncGetCollisionsByDistrictAndTrackFailed(ctx context.Context, district string, track string)
 	ObserveGetCollisionsByDistrictAndTrackLatency(ctx context.Context, duration time.Duration, district string, track string)
+	IncPlanEvent(ctx context.Context, districts []string)
+	IncPlanEventFailed(ctx context.Context, districts []string)
+	ObservePlanEventLatency(ctx context.Context, duration time.Duration, districts []string)
+	IncUpsertTrack(ctx context.Context, track string)
+	IncUpsertTrackFailed(ctx context.Context, track string)
+	ObserveUpsertTrackLatency(ctx context.Context, duration time.Duration, track string)
+	IncDeleteTrack(ctx context.Context, track string)
+	IncDeleteTrackFailed(ctx context.Context, track string)
+	ObserveDeleteTrackLatency(ctx context.Context, duration time.Duration, track string)
 }
 
 type Service struct {
@@ -45,18 +58,37 @@ type Service struct {
 
 	repo Repository
 
+	adminToken string
+
 	metrics Metrics
 	logger  *slog.Logger
 	tracer  trace.Tracer
 }
 
-func New(repo Repository, metrics Metrics, logger *slog.Logger, tracer trace.Tracer) *Service {
-	return &Service{
+// Option configures optional features in a Service.
+type Option func(*Service)
+
+// WithAdminToken enables the admin RPCs (to edit tracks), for calls carrying the input token as a bearer token in
+// their authorization metadata. Admin RPCs are rejected if the token is empty.
+func WithAdminToken(token string) Option {
+	return func(s *Service) {
+		s.adminToken = token
+	}
+}
+
+func New(repo Repository, metrics Metrics, logger *slog.Logger, tracer trace.Tracer, opts ...Option) *Service {
+	s := &Service{
 		repo:    repo,
 		metrics: metrics,
 		logger:  logger,
 		tracer:  tracer,
 	}
+
+	for i := range opts {
+		opts[i](s)
+	}
+
+	return s
 }
 
 func (s *Service) ListDistricts(ctx context.Context, _ *pb.ListDistrictsRequest) (*pb.ListDistrictsResponse, error) {
@@ -355,3 +387,83 @@ func (s *Service) GetCollisionsByDistrictAndTrack(ctx context.Context, req *pb.G
 
 	return &pb.GetCollisionsByDistrictAndTrackResponse{Tracks: tracks}, nil
 }
+
+func (s *Service) PlanEvent(ctx context.Context, req *pb.PlanEventRequest) (*pb.PlanEventResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "Service.PlanEvent", trace.WithAttributes(
+		attribute.StringSlice("districts", req.GetDistricts()),
+		attribute.Bool("drift_only", req.GetDriftOnly()),
+		attribute.StringSlice("must_include", req.GetMustInclude())))
+	defer span.End()
+
+	districts := req.GetDistricts()
+
+	s.metrics.IncPlanEvent(ctx, districts)
+	start := time.Now()
+	defer func() {
+		s.metrics.ObservePlanEventLatency(ctx, time.Since(start), districts)
+	}()
+
+	plan, err := s.repo.PlanEvent(ctx, districts, req.GetDriftOnly(), req.GetMustInclude())
+
+	switch {
+	case errors.Is(err, memory.ErrNoTracks), errors.Is(err, memory.ErrNoDistricts):
+		s.metrics.IncPlanEventFailed(ctx, districts)
+		s.logger.ErrorContext(ctx, "planning event in districts got zero results",
+			slog.String("error", err.Error()), slog.Any("districts", districts))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent("planning event in districts got zero results", trace.WithAttributes(
+			attribute.String("error", err.Error()),
+			attribute.StringSlice("districts", districts)))
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, memory.ErrDistrictNotFound), errors.Is(err, tracks.ErrNotFound):
+		s.metrics.IncPlanEventFailed(ctx, districts)
+		s.logger.ErrorContext(ctx, "planning event with unknown districts or tracks",
+			slog.String("error", err.Error()), slog.Any("districts", districts))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent("planning event with unknown districts or tracks", trace.WithAttributes(
+			attribute.String("error", err.Error()),
+			attribute.StringSlice("districts", districts)))
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, tracks.ErrCollidingTracks):
+		s.metrics.IncPlanEventFailed(ctx, districts)
+		s.logger.ErrorContext(ctx, "planning event with colliding required tracks",
+			slog.String("error", err.Error()), slog.Any("districts", districts))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent("planning event with colliding required tracks", trace.WithAttributes(
+			attribute.String("error", err.Error()),
+			attribute.StringSlice("districts", districts)))
+
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		s.metrics.IncPlanEventFailed(ctx, districts)
+		s.logger.ErrorContext(ctx, "planning event in districts",
+			slog.String("error", err.Error()), slog.Any("districts", districts))
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent("planning event in districts", trace.WithAttributes(
+			attribute.String("error", err.Error()),
+			attribute.StringSlice("districts", districts)))
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	rounds := make([]*pb.Round, 0, len(plan.Rounds))
+	for i := range plan.Rounds {
+		rounds = append(rounds, &pb.Round{Tracks: plan.Rounds[i]})
+	}
+
+	s.logger.DebugContext(ctx, "planned event in districts successfully",
+		slog.Any("districts", districts), slog.Int("track_count", len(plan.Tracks)), slog.Int("round_count", len(rounds)))
+	span.AddEvent("planned event in districts successfully", trace.WithAttributes(
+		attribute.StringSlice("districts", districts),
+		attribute.Int("track_count", len(plan.Tracks)),
+		attribute.Int("round_count", len(rounds))))
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.PlanEventResponse{Tracks: plan.Tracks, Rounds: rounds}, nil
+}
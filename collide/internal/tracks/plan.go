@@ -0,0 +1,219 @@
+package tracks
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrCollidingTracks = errors.New("required tracks collide with each other")
+
+// Plan describes an event over a set of tracks: the largest set of tracks that can run simultaneously, and a
+// schedule of rounds where each round is a set of mutually non-colliding tracks, covering every eligible track.
+type Plan struct {
+	Tracks []string
+	Rounds [][]string
+}
+
+// PlanEvent computes a Plan over the collision graph of the tracks in the input districts, optionally limited to drift
+// tracks.
+//
+// The tracks in mustInclude (as IDs) are always part of the returned Plan.Tracks, and are placed in the first round.
+// An error is returned if any of them is not eligible, or if they collide with one another.
+//
+// Collisions are treated as symmetric: if either track lists the other in CollidesWith, they cannot run together.
+func PlanEvent(list *TrackList, districts []string, driftOnly bool, mustInclude []string) (Plan, error) {
+	if list == nil {
+		return Plan{}, ErrNilList
+	}
+
+	g := newGraph(list, districts, driftOnly)
+
+	required := make([]int, 0, len(mustInclude))
+
+	for i := range mustInclude {
+		idx := slices.Index(g.ids, mustInclude[i])
+		if idx < 0 {
+			return Plan{}, fmt.Errorf("%w: %s", ErrNotFound, mustInclude[i])
+		}
+
+		for _, r := range required {
+			if g.adj[idx][r] {
+				return Plan{}, fmt.Errorf("%w: %s, %s", ErrCollidingTracks, g.ids[r], g.ids[idx])
+			}
+		}
+
+		if !slices.Contains(required, idx) {
+			required = append(required, idx)
+		}
+	}
+
+	remaining := make([]int, 0, len(g.ids))
+	for i := range g.ids {
+		remaining = append(remaining, i)
+	}
+
+	rounds := make([][]int, 0, len(g.ids))
+
+	// the first round is the maximum set built around the required tracks; each following round is the maximum set of
+	// whichever tracks are left, until every track is scheduled.
+	first := slices.Concat(required, g.maxIndependentSet(g.without(remaining, required, true)))
+
+	for set := first; len(set) > 0; set = g.maxIndependentSet(remaining) {
+		slices.Sort(set)
+		rounds = append(rounds, set)
+		remaining = g.without(remaining, set, false)
+	}
+
+	plan := Plan{
+		Rounds: make([][]string, 0, len(rounds)),
+	}
+
+	for i := range rounds {
+		plan.Rounds = append(plan.Rounds, g.idsOf(rounds[i]))
+	}
+
+	if len(plan.Rounds) > 0 {
+		plan.Tracks = plan.Rounds[0]
+	}
+
+	return plan, nil
+}
+
+// graph is an undirected collision graph over a subset of a TrackList, indexed by position in ids.
+type graph struct {
+	ids []string
+	adj [][]bool
+}
+
+func newGraph(list *TrackList, districts []string, driftOnly bool) graph {
+	g := graph{ids: make([]string, 0, len(list.Tracks))}
+
+	for idx := range list.Tracks {
+		if !slices.Contains(districts, list.Tracks[idx].District) ||
+			(driftOnly && !list.Tracks[idx].IsDriftTrack) {
+			continue
+		}
+
+		g.ids = append(g.ids, list.Tracks[idx].ID)
+	}
+
+	g.adj = make([][]bool, len(g.ids))
+	for i := range g.adj {
+		g.adj[i] = make([]bool, len(g.ids))
+	}
+
+	for idx := range list.Tracks {
+		i := slices.Index(g.ids, list.Tracks[idx].ID)
+		if i < 0 {
+			continue
+		}
+
+		for _, collision := range list.Tracks[idx].CollidesWith {
+			if j := slices.Index(g.ids, collision); j >= 0 && j != i {
+				g.adj[i][j] = true
+				g.adj[j][i] = true
+			}
+		}
+	}
+
+	return g
+}
+
+// without returns the vertices in set that are not in exclude, also leaving out their neighbors if withNeighbors is
+// true.
+func (g graph) without(set, exclude []int, withNeighbors bool) []int {
+	return slices.DeleteFunc(slices.Clone(set), func(v int) bool {
+		for _, e := range exclude {
+			if v == e || (withNeighbors && g.adj[v][e]) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+func (g graph) degree(v int, set []int) (n int) {
+	for _, u := range set {
+		if g.adj[v][u] {
+			n++
+		}
+	}
+
+	return n
+}
+
+// maxIndependentSet returns a maximum set of mutually non-adjacent vertices within set.
+//
+// It is an exact branch-and-bound search: disconnected components (such as different districts) are solved on their
+// own; vertices with at most one neighbor are always safe to take; otherwise the vertex with the most neighbors is
+// either excluded, or taken along with the removal of its neighbors.
+func (g graph) maxIndependentSet(set []int) []int {
+	if len(set) == 0 {
+		return nil
+	}
+
+	if component := g.component(set); len(component) < len(set) {
+		return append(g.maxIndependentSet(component), g.maxIndependentSet(g.without(set, component, false))...)
+	}
+
+	var (
+		minV, maxV     = set[0], set[0]
+		minDeg, maxDeg = g.degree(set[0], set), g.degree(set[0], set)
+	)
+
+	for _, v := range set[1:] {
+		d := g.degree(v, set)
+
+		if d < minDeg {
+			minV, minDeg = v, d
+		}
+
+		if d > maxDeg {
+			maxV, maxDeg = v, d
+		}
+	}
+
+	if minDeg <= 1 {
+		return append([]int{minV}, g.maxIndependentSet(g.without(set, []int{minV}, true))...)
+	}
+
+	with := append([]int{maxV}, g.maxIndependentSet(g.without(set, []int{maxV}, true))...)
+
+	// excluding maxV leaves len(set)-1 vertices, which cannot beat a set of that size already
+	if len(with) >= len(set)-1 {
+		return with
+	}
+
+	if without := g.maxIndependentSet(g.without(set, []int{maxV}, false)); len(without) > len(with) {
+		return without
+	}
+
+	return with
+}
+
+// component returns the vertices in set that are reachable from its first vertex.
+func (g graph) component(set []int) []int {
+	component := []int{set[0]}
+
+	for i := 0; i < len(component); i++ {
+		for _, v := range set {
+			if g.adj[component[i]][v] && !slices.Contains(component, v) {
+				component = append(component, v)
+			}
+		}
+	}
+
+	return component
+}
+
+func (g graph) idsOf(set []int) []string {
+	ids := make([]string, 0, len(set))
+
+	for _, v := range set {
+		ids = append(ids, g.ids[v])
+	}
+
+	return ids
+}
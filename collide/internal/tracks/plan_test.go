@@ -0,0 +1,150 @@
+package tracks
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var planList = &TrackList{
+	Tracks: []Track{
+		{ID: "A", Name: "A", District: "X", IsDriftTrack: true, CollidesWith: []string{"B", "C"}},
+		{ID: "B", Name: "B", District: "X", CollidesWith: []string{"A", "C"}},
+		{ID: "C", Name: "C", District: "X", CollidesWith: []string{"A", "B"}},
+		// collisions only listed on one side still apply to both tracks
+		{ID: "D", Name: "D", District: "X", IsDriftTrack: true, CollidesWith: []string{"E"}},
+		{ID: "E", Name: "E", District: "X", IsDriftTrack: true},
+		{ID: "F", Name: "F", District: "Y"},
+	},
+}
+
+func TestPlanEvent(t *testing.T) {
+	for _, testcase := range []struct {
+		name        string
+		list        *TrackList
+		districts   []string
+		driftOnly   bool
+		mustInclude []string
+		wants       Plan
+		err         error
+	}{
+		{
+			name:      "Success/SingleDistrict",
+			list:      planList,
+			districts: []string{"X"},
+			wants: Plan{
+				Tracks: []string{"A", "D"},
+				Rounds: [][]string{{"A", "D"}, {"B", "E"}, {"C"}},
+			},
+		},
+		{
+			name:      "Success/MultipleDistricts",
+			list:      planList,
+			districts: []string{"X", "Y"},
+			wants: Plan{
+				Tracks: []string{"A", "D", "F"},
+				Rounds: [][]string{{"A", "D", "F"}, {"B", "E"}, {"C"}},
+			},
+		},
+		{
+			name:      "Success/DriftOnly",
+			list:      planList,
+			districts: []string{"X"},
+			driftOnly: true,
+			wants: Plan{
+				Tracks: []string{"A", "D"},
+				Rounds: [][]string{{"A", "D"}, {"E"}},
+			},
+		},
+		{
+			name:        "Success/MustInclude",
+			list:        planList,
+			districts:   []string{"X"},
+			mustInclude: []string{"C"},
+			wants: Plan{
+				Tracks: []string{"C", "D"},
+				Rounds: [][]string{{"C", "D"}, {"A", "E"}, {"B"}},
+			},
+		},
+		{
+			name:      "Success/NoTracks",
+			list:      planList,
+			districts: []string{"Z"},
+			wants: Plan{
+				Rounds: [][]string{},
+			},
+		},
+		{
+			name:        "Fail/ErrCollidingTracks",
+			list:        planList,
+			districts:   []string{"X"},
+			mustInclude: []string{"A", "B"},
+			err:         ErrCollidingTracks,
+		},
+		{
+			name:        "Fail/ErrNotFound",
+			list:        planList,
+			districts:   []string{"X"},
+			mustInclude: []string{"F"},
+			err:         ErrNotFound,
+		},
+		{
+			name: "Fail/ErrNilList",
+			err:  ErrNilList,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			plan, err := PlanEvent(testcase.list, testcase.districts, testcase.driftOnly, testcase.mustInclude)
+			if err != nil {
+				require.ErrorIs(t, err, testcase.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testcase.wants, plan)
+		})
+	}
+
+	t.Run("Success/TrackList", func(t *testing.T) {
+		list := &TrackList{}
+
+		_, err := list.Read(trackBytes)
+		require.NoError(t, err)
+
+		districts, err := GetDistricts(list)
+		require.NoError(t, err)
+
+		plan, err := PlanEvent(list, districts, false, nil)
+		require.NoError(t, err)
+
+		scheduled := make([]string, 0, len(list.Tracks))
+
+		for _, round := range plan.Rounds {
+			require.LessOrEqual(t, len(round), len(plan.Tracks))
+
+			for _, id := range round {
+				collisions, err := GetCollisions(list, id)
+				require.NoError(t, err)
+
+				for _, other := range round {
+					require.NotContains(t, collisions, other)
+				}
+			}
+
+			scheduled = append(scheduled, round...)
+		}
+
+		slices.Sort(scheduled)
+
+		ids := make([]string, 0, len(list.Tracks))
+		for i := range list.Tracks {
+			ids = append(ids, list.Tracks[i].ID)
+		}
+
+		slices.Sort(ids)
+
+		require.Equal(t, ids, scheduled)
+	})
+}
@@ -23,7 +23,8 @@ type Track struct {
 }
 
 type TrackList struct {
-	Tracks []Track `yaml:"tracks"`
+	Districts []string `yaml:"districts"`
+	Tracks    []Track  `yaml:"tracks"`
 }
 
 func (t *TrackList) Read(b []byte) (n int, err error) {
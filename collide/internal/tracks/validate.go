@@ -0,0 +1,81 @@
+package tracks
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrInvalidTrackList    = errors.New("invalid track list")
+	ErrEmptyID             = errors.New("track ID cannot be empty")
+	ErrEmptyName           = errors.New("track name cannot be empty")
+	ErrDuplicateID         = errors.New("duplicate track ID")
+	ErrUnknownDistrict     = errors.New("unknown district")
+	ErrSelfCollision       = errors.New("track collides with itself")
+	ErrDanglingCollision   = errors.New("collision references an unknown track")
+	ErrAsymmetricCollision = errors.New("collision is not listed on both tracks")
+)
+
+// Validate verifies the consistency of a TrackList, returning an ErrInvalidTrackList error joining every issue found.
+//
+// Besides empty or duplicate IDs, it reports tracks in districts not declared in TrackList.Districts (when any are
+// declared), as well as collisions referencing unknown tracks, the track itself, or tracks which do not list the
+// collision back.
+func Validate(list *TrackList) error {
+	if list == nil {
+		return ErrNilList
+	}
+
+	issues := make([]error, 0, len(list.Tracks))
+	ids := make(map[string]int, len(list.Tracks))
+
+	for idx := range list.Tracks {
+		switch id := list.Tracks[idx].ID; {
+		case id == "":
+			issues = append(issues, fmt.Errorf("%w: track #%d", ErrEmptyID, idx))
+		case ids[id] > 0:
+			issues = append(issues, fmt.Errorf("%w: %s", ErrDuplicateID, id))
+		}
+
+		ids[list.Tracks[idx].ID]++
+
+		if list.Tracks[idx].Name == "" {
+			issues = append(issues, fmt.Errorf("%w: %s", ErrEmptyName, list.Tracks[idx].ID))
+		}
+
+		if len(list.Districts) > 0 && !slices.Contains(list.Districts, list.Tracks[idx].District) {
+			issues = append(issues, fmt.Errorf("%w: %q in track %s",
+				ErrUnknownDistrict, list.Tracks[idx].District, list.Tracks[idx].ID))
+		}
+	}
+
+	for idx := range list.Tracks {
+		track := list.Tracks[idx]
+
+		for _, collision := range track.CollidesWith {
+			if collision == track.ID {
+				issues = append(issues, fmt.Errorf("%w: %s", ErrSelfCollision, track.ID))
+
+				continue
+			}
+
+			i := slices.IndexFunc(list.Tracks, func(t Track) bool {
+				return t.ID == collision
+			})
+
+			switch {
+			case i < 0:
+				issues = append(issues, fmt.Errorf("%w: %s -> %s", ErrDanglingCollision, track.ID, collision))
+			case !slices.Contains(list.Tracks[i].CollidesWith, track.ID):
+				issues = append(issues, fmt.Errorf("%w: %s -> %s", ErrAsymmetricCollision, track.ID, collision))
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidTrackList, errors.Join(issues...))
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package tracks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	for _, testcase := range []struct {
+		name string
+		list *TrackList
+		errs []error
+	}{
+		{
+			name: "Success",
+			list: &TrackList{
+				Districts: []string{"X"},
+				Tracks: []Track{
+					{ID: "A", Name: "A", District: "X", CollidesWith: []string{"B"}},
+					{ID: "B", Name: "B", District: "X", CollidesWith: []string{"A"}},
+				},
+			},
+		},
+		{
+			name: "Success/NoDeclaredDistricts",
+			list: &TrackList{
+				Tracks: []Track{
+					{ID: "A", Name: "A", District: "X"},
+					{ID: "B", Name: "B", District: "Y"},
+				},
+			},
+		},
+		{
+			name: "Fail/Collisions",
+			list: &TrackList{
+				Tracks: []Track{
+					{ID: "A", Name: "A", District: "X", CollidesWith: []string{"B", "C"}},
+					{ID: "B", Name: "B", District: "X"},
+					{ID: "D", Name: "D", District: "X", CollidesWith: []string{"D"}},
+				},
+			},
+			errs: []error{ErrInvalidTrackList, ErrAsymmetricCollision, ErrDanglingCollision, ErrSelfCollision},
+		},
+		{
+			name: "Fail/Tracks",
+			list: &TrackList{
+				Districts: []string{"X"},
+				Tracks: []Track{
+					{ID: "A", Name: "A", District: "X"},
+					{ID: "A", Name: "A", District: "X"},
+					{Name: "B", District: "X"},
+					{ID: "C", District: "Y"},
+				},
+			},
+			errs: []error{ErrInvalidTrackList, ErrDuplicateID, ErrEmptyID, ErrEmptyName, ErrUnknownDistrict},
+		},
+		{
+			name: "Fail/ErrNilList",
+			errs: []error{ErrNilList},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			err := Validate(testcase.list)
+			if len(testcase.errs) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			for i := range testcase.errs {
+				require.ErrorIs(t, err, testcase.errs[i])
+			}
+		})
+	}
+
+	t.Run("Success/TrackList", func(t *testing.T) {
+		list := &TrackList{}
+
+		_, err := list.Read(trackBytes)
+		require.NoError(t, err)
+		require.NoError(t, Validate(list))
+	})
+}
@@ -0,0 +1,128 @@
+package watcher
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrEmptyPath = errors.New("path cannot be empty")
+
+// Loader consumes the contents of a watched file, returning an error if they are invalid.
+type Loader interface {
+	FromBytes(buf []byte) error
+}
+
+// Watcher polls a file for changes in its contents, passing them to a Loader whenever they change.
+//
+// Contents rejected by the Loader are not retried until the file changes again, so the Loader keeps serving its
+// previous data in the meantime.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	loader   Loader
+
+	sum [sha256.Size]byte
+
+	logger *slog.Logger
+	tracer trace.Tracer
+}
+
+// New creates a Watcher for the file in path, loading its contents for the first time before returning.
+func New(path string, interval time.Duration, loader Loader, logger *slog.Logger, tracer trace.Tracer) (*Watcher, error) {
+	if path == "" {
+		return nil, ErrEmptyPath
+	}
+
+	w := &Watcher{
+		path:     path,
+		interval: interval,
+		loader:   loader,
+		logger:   logger,
+		tracer:   tracer,
+	}
+
+	if _, err := w.Reload(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Run polls the watched file on every interval until the context is done. It returns immediately if the interval is
+// not a positive value.
+func (w *Watcher) Run(ctx context.Context) {
+	if w.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := w.Reload(ctx)
+			if err != nil {
+				w.logger.WarnContext(ctx, "reloading watched file",
+					slog.String("path", w.path), slog.String("error", err.Error()))
+
+				continue
+			}
+
+			if reloaded {
+				w.logger.InfoContext(ctx, "reloaded watched file", slog.String("path", w.path))
+			}
+		}
+	}
+}
+
+// Reload reads the watched file, passing its contents to the Loader if they changed since the last call. It returns
+// whether the contents were loaded, and an error if reading or loading them failed.
+func (w *Watcher) Reload(ctx context.Context) (bool, error) {
+	_, span := w.tracer.Start(ctx, "Watcher.Reload", trace.WithAttributes(
+		attribute.String("path", w.path)))
+	defer span.End()
+
+	buf, err := os.ReadFile(w.path)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+
+		return false, err
+	}
+
+	sum := sha256.Sum256(buf)
+	if sum == w.sum {
+		span.AddEvent("watched file is unchanged")
+		span.SetStatus(otelcodes.Ok, "")
+
+		return false, nil
+	}
+
+	w.sum = sum
+
+	if err = w.loader.FromBytes(buf); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent("loading watched file", trace.WithAttributes(
+			attribute.String("error", err.Error())))
+
+		return false, err
+	}
+
+	span.AddEvent("loaded watched file successfully", trace.WithAttributes(
+		attribute.Int("size", len(buf))))
+	span.SetStatus(otelcodes.Ok, "")
+
+	return true, nil
+}
@@ -0,0 +1,119 @@
+package watcher
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/zalgonoise/x/collide/internal/repository/memory"
+	"github.com/zalgonoise/x/collide/internal/tracks"
+)
+
+const (
+	validTracks = `districts:
+  - X
+tracks:
+  - id: A
+    name: A
+    district: X
+    collides_with:
+      - B
+  - id: B
+    name: B
+    district: X
+    collides_with:
+      - A
+`
+	updatedTracks = validTracks + `  - id: C
+    name: C
+    district: X
+`
+	asymmetricTracks = validTracks + `  - id: C
+    name: C
+    district: X
+    collides_with:
+      - A
+`
+)
+
+func TestWatcher(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		logger = slog.New(slog.DiscardHandler)
+		tracer = noop.NewTracerProvider().Tracer("watcher")
+		path   = filepath.Join(t.TempDir(), "tracks.yaml")
+		repo   = memory.New(logger, tracer)
+	)
+
+	require.NoError(t, os.WriteFile(path, []byte(validTracks), 0o600))
+
+	w, err := New(path, 10*time.Millisecond, repo, logger, tracer)
+	require.NoError(t, err)
+
+	listTracks := func() []string {
+		t.Helper()
+
+		names, err := repo.ListAllTracksByDistrict(ctx, "X")
+		require.NoError(t, err)
+
+		return names
+	}
+
+	require.Equal(t, []string{"A", "B"}, listTracks())
+
+	t.Run("Unchanged", func(t *testing.T) {
+		reloaded, err := w.Reload(ctx)
+		require.NoError(t, err)
+		require.False(t, reloaded)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte(asymmetricTracks), 0o600))
+
+		reloaded, err := w.Reload(ctx)
+		require.ErrorIs(t, err, tracks.ErrAsymmetricCollision)
+		require.False(t, reloaded)
+		require.Equal(t, []string{"A", "B"}, listTracks())
+
+		// rejected contents are not loaded again until they change
+		reloaded, err = w.Reload(ctx)
+		require.NoError(t, err)
+		require.False(t, reloaded)
+	})
+
+	t.Run("Run", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+
+		go func() {
+			w.Run(ctx)
+			close(done)
+		}()
+
+		require.NoError(t, os.WriteFile(path, []byte(updatedTracks), 0o600))
+		require.Eventually(t, func() bool {
+			names, err := repo.ListAllTracksByDistrict(ctx, "X")
+
+			return err == nil && len(names) == 3
+		}, time.Second, 10*time.Millisecond)
+
+		cancel()
+		<-done
+	})
+
+	t.Run("Fail/ErrEmptyPath", func(t *testing.T) {
+		_, err := New("", time.Second, repo, logger, tracer)
+		require.ErrorIs(t, err, ErrEmptyPath)
+	})
+
+	t.Run("Fail/NotExist", func(t *testing.T) {
+		_, err := New(filepath.Join(t.TempDir(), "missing.yaml"), time.Second, repo, logger, tracer)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -495,6 +495,429 @@ func (x *GetCollisionsByDistrictAndTrackResponse) GetTracks() []string {
 	return nil
 }
 
+// PlanEventRequest describes the target districts and constraints to plan an event with.
+type PlanEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The target districts to look up.
+	Districts []string `protobuf:"bytes,1,rep,name=districts,proto3" json:"districts,omitempty"`
+	// Whether to plan the event with drift tracks only.
+	DriftOnly bool `protobuf:"varint,2,opt,name=drift_only,proto3" json:"drift_only,omitempty"`
+	// The tracks which must be part of the event.
+	MustInclude   []string `protobuf:"bytes,3,rep,name=must_include,proto3" json:"must_include,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanEventRequest) Reset() {
+	*x = PlanEventRequest{}
+	mi := &file_collide_v1_collide_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanEventRequest) ProtoMessage() {}
+
+func (x *PlanEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collide_v1_collide_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanEventRequest.ProtoReflect.Descriptor instead.
+func (*PlanEventRequest) Descriptor() ([]byte, []int) {
+	return file_collide_v1_collide_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlanEventRequest) GetDistricts() []string {
+	if x != nil {
+		return x.Districts
+	}
+	return nil
+}
+
+func (x *PlanEventRequest) GetDriftOnly() bool {
+	if x != nil {
+		return x.DriftOnly
+	}
+	return false
+}
+
+func (x *PlanEventRequest) GetMustInclude() []string {
+	if x != nil {
+		return x.MustInclude
+	}
+	return nil
+}
+
+// Round lists tracks which do not collide with each other, and can run simultaneously.
+type Round struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The non-colliding tracks in this round.
+	Tracks        []string `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Round) Reset() {
+	*x = Round{}
+	mi := &file_collide_v1_collide_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Round) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Round) ProtoMessage() {}
+
+func (x *Round) ProtoReflect() protoreflect.Message {
+	mi := &file_collide_v1_collide_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Round.ProtoReflect.Descriptor instead.
+func (*Round) Descriptor() ([]byte, []int) {
+	return file_collide_v1_collide_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Round) GetTracks() []string {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+// PlanEventResponse lists the largest set of non-colliding tracks in the target districts, and a schedule of rounds
+// covering every track.
+type PlanEventResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The largest set of non-colliding tracks, including any required tracks.
+	Tracks []string `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	// The rounds of non-colliding tracks, covering every track in the target districts.
+	Rounds        []*Round `protobuf:"bytes,2,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanEventResponse) Reset() {
+	*x = PlanEventResponse{}
+	mi := &file_collide_v1_collide_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanEventResponse) ProtoMessage() {}
+
+func (x *PlanEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collide_v1_collide_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanEventResponse.ProtoReflect.Descriptor instead.
+func (*PlanEventResponse) Descriptor() ([]byte, []int) {
+	return file_collide_v1_collide_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlanEventResponse) GetTracks() []string {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *PlanEventResponse) GetRounds() []*Round {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+// Track describes a track in a district, and the tracks it collides with.
+type Track struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the track.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the track.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The district the track is in.
+	District string `protobuf:"bytes,3,opt,name=district,proto3" json:"district,omitempty"`
+	// Whether the track is a drift track.
+	IsDriftTrack bool `protobuf:"varint,4,opt,name=is_drift_track,proto3" json:"is_drift_track,omitempty"`
+	// The IDs of the tracks this track collides with.
+	CollidesWith  []string `protobuf:"bytes,5,rep,name=collides_with,proto3" json:"collides_with,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Track) Reset() {
+	*x = Track{}
+	mi := &file_collide_v1_collide_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Track) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Track) ProtoMessage() {}
+
+func (x *Track) ProtoReflect() protoreflect.Message {
+	mi := &file_collide_v1_collide_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
+	return file_collide_v1_collide_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Track) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Track) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Track) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *Track) GetIsDriftTrack() bool {
+	if x != nil {
+		return x.IsDriftTrack
+	}
+	return false
+}
+
+func (x *Track) GetCollidesWith() []string {
+	if x != nil {
+		return x.CollidesWith
+	}
+	return nil
+}
+
+// UpsertTrackRequest describes the track to create or replace.
+type UpsertTrackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The track to create or replace.
+	Track         *Track `protobuf:"bytes,1,opt,name=track,proto3" json:"track,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertTrackRequest) Reset() {
+	*x = UpsertTrackRequest{}
+	mi := &file_collide_v1_collide_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertTrackRequest) ProtoMessage() {}
+
+func (x *UpsertTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collide_v1_collide_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertTrackRequest.ProtoReflect.Descriptor instead.
+func (*UpsertTrackRequest) Descriptor() ([]byte, []int) {
+	return file_collide_v1_collide_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpsertTrackRequest) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+// UpsertTrackResponse returns the created or replaced track.
+type UpsertTrackResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created or replaced track.
+	Track         *Track `protobuf:"bytes,1,opt,name=track,proto3" json:"track,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertTrackResponse) Reset() {
+	*x = UpsertTrackResponse{}
+	mi := &file_collide_v1_collide_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertTrackResponse) ProtoMessage() {}
+
+func (x *UpsertTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collide_v1_collide_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertTrackResponse.ProtoReflect.Descriptor instead.
+func (*UpsertTrackResponse) Descriptor() ([]byte, []int) {
+	return file_collide_v1_collide_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpsertTrackResponse) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+// DeleteTrackRequest describes the track to remove.
+type DeleteTrackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the track to remove.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTrackRequest) Reset() {
+	*x = DeleteTrackRequest{}
+	mi := &file_collide_v1_collide_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrackRequest) ProtoMessage() {}
+
+func (x *DeleteTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collide_v1_collide_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTrackRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTrackRequest) Descriptor() ([]byte, []int) {
+	return file_collide_v1_collide_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteTrackRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteTrackResponse is returned when a track is removed.
+type DeleteTrackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTrackResponse) Reset() {
+	*x = DeleteTrackResponse{}
+	mi := &file_collide_v1_collide_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrackResponse) ProtoMessage() {}
+
+func (x *DeleteTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collide_v1_collide_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTrackResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTrackResponse) Descriptor() ([]byte, []int) {
+	return file_collide_v1_collide_proto_rawDescGZIP(), []int{17}
+}
+
 var File_collide_v1_collide_proto protoreflect.FileDescriptor
 
 const file_collide_v1_collide_proto_rawDesc = "" +
@@ -521,7 +944,31 @@ const file_collide_v1_collide_proto_rawDesc = "" +
 	"\bdistrict\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bdistrict\x12\x1d\n" +
 	"\x05track\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05track\"A\n" +
 	"'GetCollisionsByDistrictAndTrackResponse\x12\x16\n" +
-	"\x06tracks\x18\x01 \x03(\tR\x06tracks2\xc6\f\n" +
+	"\x06tracks\x18\x01 \x03(\tR\x06tracks\"\x92\x01\n" +
+	"\x10PlanEventRequest\x12,\n" +
+	"\tdistricts\x18\x01 \x03(\tB\x0e\xfaB\v\x92\x01\b\b\x01\"\x04r\x02\x10\x01R\tdistricts\x12\x1e\n" +
+	"\n" +
+	"drift_only\x18\x02 \x01(\bR\n" +
+	"drift_only\x120\n" +
+	"\fmust_include\x18\x03 \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\fmust_include\"\x1f\n" +
+	"\x05Round\x12\x16\n" +
+	"\x06tracks\x18\x01 \x03(\tR\x06tracks\"V\n" +
+	"\x11PlanEventResponse\x12\x16\n" +
+	"\x06tracks\x18\x01 \x03(\tR\x06tracks\x12)\n" +
+	"\x06rounds\x18\x02 \x03(\v2\x11.collide.v1.RoundR\x06rounds\"\xbe\x01\n" +
+	"\x05Track\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12#\n" +
+	"\bdistrict\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bdistrict\x12&\n" +
+	"\x0eis_drift_track\x18\x04 \x01(\bR\x0eis_drift_track\x122\n" +
+	"\rcollides_with\x18\x05 \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\rcollides_with\"G\n" +
+	"\x12UpsertTrackRequest\x121\n" +
+	"\x05track\x18\x01 \x01(\v2\x11.collide.v1.TrackB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05track\">\n" +
+	"\x13UpsertTrackResponse\x12'\n" +
+	"\x05track\x18\x01 \x01(\v2\x11.collide.v1.TrackR\x05track\"-\n" +
+	"\x12DeleteTrackRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"\x15\n" +
+	"\x13DeleteTrackResponse2\x8f\x13\n" +
 	"\x0eCollideService\x12\xcf\x01\n" +
 	"\rListDistricts\x12 .collide.v1.ListDistrictsRequest\x1a!.collide.v1.ListDistrictsResponse\"y\x92AY\n" +
 	"\aCollide\x12\x0eList Districts\x1a>Returns a list of all districts from the configured track list\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/collide/districts\x12\xa0\x02\n" +
@@ -532,7 +979,14 @@ const file_collide_v1_collide_proto_rawDesc = "" +
 	"!GetAlternativesByDistrictAndTrack\x124.collide.v1.GetAlternativesByDistrictAndTrackRequest\x1a5.collide.v1.GetAlternativesByDistrictAndTrackResponse\"\x83\x02\x92A\xbe\x01\n" +
 	"\aCollide\x12&Get Alternatives By District And Track\x1a\x8a\x01Returns a list of alternative drift tracks in a certain district, which should not collide with the target, from the configured track list\x82\xd3\xe4\x93\x02;\x129/v1/collide/districts/{district}/all/{track}/alternatives\x12\xf5\x02\n" +
 	"\x1fGetCollisionsByDistrictAndTrack\x122.collide.v1.GetCollisionsByDistrictAndTrackRequest\x1a3.collide.v1.GetCollisionsByDistrictAndTrackResponse\"\xe8\x01\x92A\xa5\x01\n" +
-	"\aCollide\x12&Get Alternatives By District And Track\x1arReturns a list of tracks which would collide with the target in a certain district, from the configured track list\x82\xd3\xe4\x93\x029\x127/v1/collide/districts/{district}/all/{track}/collisionsB\xf2\x03\x92A\xc6\x03\n" +
+	"\aCollide\x12&Get Alternatives By District And Track\x1arReturns a list of tracks which would collide with the target in a certain district, from the configured track list\x82\xd3\xe4\x93\x029\x127/v1/collide/districts/{district}/all/{track}/collisions\x12\xac\x02\n" +
+	"\tPlanEvent\x12\x1c.collide.v1.PlanEventRequest\x1a\x1d.collide.v1.PlanEventResponse\"\xe1\x01\x92A\xc5\x01\n" +
+	"\aCollide\x12\n" +
+	"Plan Event\x1a\xad\x01Returns the largest set of tracks which do not collide with each other in the target districts, and a schedule of rounds covering every track, from the configured track list\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/collide/plan\x12\xa6\x02\n" +
+	"\vUpsertTrack\x12\x1e.collide.v1.UpsertTrackRequest\x1a\x1f.collide.v1.UpsertTrackResponse\"\xd5\x01\x92A\x9f\x01\n" +
+	"\aCollide\x12\fUpsert Track\x1a\x85\x01Creates or replaces a track and its collisions, which are mirrored on the colliding tracks. Requires an admin token as a bearer token\x82\xd3\xe4\x93\x02,:\x05track\x1a#/v1/collide/admin/tracks/{track.id}\x12\xee\x01\n" +
+	"\vDeleteTrack\x12\x1e.collide.v1.DeleteTrackRequest\x1a\x1f.collide.v1.DeleteTrackResponse\"\x9d\x01\x92Au\n" +
+	"\aCollide\x12\fDelete Track\x1a\\Removes a track and any collisions referencing it. Requires an admin token as a bearer token\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/collide/admin/tracks/{id}B\xf2\x03\x92A\xc6\x03\n" +
 	"\x032.0\x12x\n" +
 	"\vcollide-api\x12dCollide is an application which provides track alternatives and collisions within a certain district2\x031.0\x1a\x19api.fallenpetals.com:8083*\x01\x01R5\n" +
 	"\x03401\x12.\n" +
@@ -562,7 +1016,7 @@ func file_collide_v1_collide_proto_rawDescGZIP() []byte {
 	return file_collide_v1_collide_proto_rawDescData
 }
 
-var file_collide_v1_collide_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_collide_v1_collide_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_collide_v1_collide_proto_goTypes = []any{
 	(*ListDistrictsRequest)(nil),                      // 0: collide.v1.ListDistrictsRequest
 	(*ListDistrictsResponse)(nil),                     // 1: collide.v1.ListDistrictsResponse
@@ -574,23 +1028,40 @@ var file_collide_v1_collide_proto_goTypes = []any{
 	(*GetAlternativesByDistrictAndTrackResponse)(nil), // 7: collide.v1.GetAlternativesByDistrictAndTrackResponse
 	(*GetCollisionsByDistrictAndTrackRequest)(nil),    // 8: collide.v1.GetCollisionsByDistrictAndTrackRequest
 	(*GetCollisionsByDistrictAndTrackResponse)(nil),   // 9: collide.v1.GetCollisionsByDistrictAndTrackResponse
+	(*PlanEventRequest)(nil),                          // 10: collide.v1.PlanEventRequest
+	(*Round)(nil),                                     // 11: collide.v1.Round
+	(*PlanEventResponse)(nil),                         // 12: collide.v1.PlanEventResponse
+	(*Track)(nil),                                     // 13: collide.v1.Track
+	(*UpsertTrackRequest)(nil),                        // 14: collide.v1.UpsertTrackRequest
+	(*UpsertTrackResponse)(nil),                       // 15: collide.v1.UpsertTrackResponse
+	(*DeleteTrackRequest)(nil),                        // 16: collide.v1.DeleteTrackRequest
+	(*DeleteTrackResponse)(nil),                       // 17: collide.v1.DeleteTrackResponse
 }
 var file_collide_v1_collide_proto_depIdxs = []int32{
-	0, // 0: collide.v1.CollideService.ListDistricts:input_type -> collide.v1.ListDistrictsRequest
-	2, // 1: collide.v1.CollideService.ListAllTracksByDistrict:input_type -> collide.v1.ListAllTracksByDistrictRequest
-	4, // 2: collide.v1.CollideService.ListDriftTracksByDistrict:input_type -> collide.v1.ListDriftTracksByDistrictRequest
-	6, // 3: collide.v1.CollideService.GetAlternativesByDistrictAndTrack:input_type -> collide.v1.GetAlternativesByDistrictAndTrackRequest
-	8, // 4: collide.v1.CollideService.GetCollisionsByDistrictAndTrack:input_type -> collide.v1.GetCollisionsByDistrictAndTrackRequest
-	1, // 5: collide.v1.CollideService.ListDistricts:output_type -> collide.v1.ListDistrictsResponse
-	3, // 6: collide.v1.CollideService.ListAllTracksByDistrict:output_type -> collide.v1.ListAllTracksByDistrictResponse
-	5, // 7: collide.v1.CollideService.ListDriftTracksByDistrict:output_type -> collide.v1.ListDriftTracksByDistrictResponse
-	7, // 8: collide.v1.CollideService.GetAlternativesByDistrictAndTrack:output_type -> collide.v1.GetAlternativesByDistrictAndTrackResponse
-	9, // 9: collide.v1.CollideService.GetCollisionsByDistrictAndTrack:output_type -> collide.v1.GetCollisionsByDistrictAndTrackResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	11, // 0: collide.v1.PlanEventResponse.rounds:type_name -> collide.v1.Round
+	13, // 1: collide.v1.UpsertTrackRequest.track:type_name -> collide.v1.Track
+	13, // 2: collide.v1.UpsertTrackResponse.track:type_name -> collide.v1.Track
+	0,  // 3: collide.v1.CollideService.ListDistricts:input_type -> collide.v1.ListDistrictsRequest
+	2,  // 4: collide.v1.CollideService.ListAllTracksByDistrict:input_type -> collide.v1.ListAllTracksByDistrictRequest
+	4,  // 5: collide.v1.CollideService.ListDriftTracksByDistrict:input_type -> collide.v1.ListDriftTracksByDistrictRequest
+	6,  // 6: collide.v1.CollideService.GetAlternativesByDistrictAndTrack:input_type -> collide.v1.GetAlternativesByDistrictAndTrackRequest
+	8,  // 7: collide.v1.CollideService.GetCollisionsByDistrictAndTrack:input_type -> collide.v1.GetCollisionsByDistrictAndTrackRequest
+	10, // 8: collide.v1.CollideService.PlanEvent:input_type -> collide.v1.PlanEventRequest
+	14, // 9: collide.v1.CollideService.UpsertTrack:input_type -> collide.v1.UpsertTrackRequest
+	16, // 10: collide.v1.CollideService.DeleteTrack:input_type -> collide.v1.DeleteTrackRequest
+	1,  // 11: collide.v1.CollideService.ListDistricts:output_type -> collide.v1.ListDistrictsResponse
+	3,  // 12: collide.v1.CollideService.ListAllTracksByDistrict:output_type -> collide.v1.ListAllTracksByDistrictResponse
+	5,  // 13: collide.v1.CollideService.ListDriftTracksByDistrict:output_type -> collide.v1.ListDriftTracksByDistrictResponse
+	7,  // 14: collide.v1.CollideService.GetAlternativesByDistrictAndTrack:output_type -> collide.v1.GetAlternativesByDistrictAndTrackResponse
+	9,  // 15: collide.v1.CollideService.GetCollisionsByDistrictAndTrack:output_type -> collide.v1.GetCollisionsByDistrictAndTrackResponse
+	12, // 16: collide.v1.CollideService.PlanEvent:output_type -> collide.v1.PlanEventResponse
+	15, // 17: collide.v1.CollideService.UpsertTrack:output_type -> collide.v1.UpsertTrackResponse
+	17, // 18: collide.v1.CollideService.DeleteTrack:output_type -> collide.v1.DeleteTrackResponse
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_collide_v1_collide_proto_init() }
@@ -604,7 +1075,7 @@ func file_collide_v1_collide_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_collide_v1_collide_proto_rawDesc), len(file_collide_v1_collide_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
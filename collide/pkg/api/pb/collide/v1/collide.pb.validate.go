@@ -1144,3 +1144,1019 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetCollisionsByDistrictAndTrackResponseValidationError{}
+
+// Validate checks the field values on PlanEventRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlanEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanEventRequestMultiError, or nil if none found.
+func (m *PlanEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetDistricts()) < 1 {
+		err := PlanEventRequestValidationError{
+			field:  "Districts",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDistricts() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := PlanEventRequestValidationError{
+				field:  fmt.Sprintf("Districts[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for DriftOnly
+
+	for idx, item := range m.GetMustInclude() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := PlanEventRequestValidationError{
+				field:  fmt.Sprintf("MustInclude[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlanEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanEventRequestMultiError is an error wrapping multiple validation errors
+// returned by PlanEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PlanEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanEventRequestMultiError) AllErrors() []error { return m }
+
+// PlanEventRequestValidationError is the validation error returned by
+// PlanEventRequest.Validate if the designated constraints aren't met.
+type PlanEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanEventRequestValidationError) ErrorName() string { return "PlanEventRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlanEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanEventRequestValidationError{}
+
+// Validate checks the field values on Round with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Round) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Round with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RoundMultiError, or nil if none found.
+func (m *Round) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Round) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RoundMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoundMultiError is an error wrapping multiple validation errors returned by
+// Round.ValidateAll() if the designated constraints aren't met.
+type RoundMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoundMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoundMultiError) AllErrors() []error { return m }
+
+// RoundValidationError is the validation error returned by Round.Validate if
+// the designated constraints aren't met.
+type RoundValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoundValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoundValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoundValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoundValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoundValidationError) ErrorName() string { return "RoundValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoundValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRound.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoundValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoundValidationError{}
+
+// Validate checks the field values on PlanEventResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlanEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanEventResponseMultiError, or nil if none found.
+func (m *PlanEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRounds() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanEventResponseValidationError{
+						field:  fmt.Sprintf("Rounds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanEventResponseValidationError{
+						field:  fmt.Sprintf("Rounds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanEventResponseValidationError{
+					field:  fmt.Sprintf("Rounds[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlanEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanEventResponseMultiError is an error wrapping multiple validation errors
+// returned by PlanEventResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PlanEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanEventResponseMultiError) AllErrors() []error { return m }
+
+// PlanEventResponseValidationError is the validation error returned by
+// PlanEventResponse.Validate if the designated constraints aren't met.
+type PlanEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanEventResponseValidationError) ErrorName() string {
+	return "PlanEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanEventResponseValidationError{}
+
+// Validate checks the field values on Track with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Track) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Track with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TrackMultiError, or nil if none found.
+func (m *Track) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Track) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := TrackValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := TrackValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDistrict()) < 1 {
+		err := TrackValidationError{
+			field:  "District",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsDriftTrack
+
+	for idx, item := range m.GetCollidesWith() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := TrackValidationError{
+				field:  fmt.Sprintf("CollidesWith[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TrackMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrackMultiError is an error wrapping multiple validation errors returned by
+// Track.ValidateAll() if the designated constraints aren't met.
+type TrackMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrackMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrackMultiError) AllErrors() []error { return m }
+
+// TrackValidationError is the validation error returned by Track.Validate if
+// the designated constraints aren't met.
+type TrackValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrackValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrackValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrackValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrackValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrackValidationError) ErrorName() string { return "TrackValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrackValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrack.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrackValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrackValidationError{}
+
+// Validate checks the field values on UpsertTrackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertTrackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertTrackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertTrackRequestMultiError, or nil if none found.
+func (m *UpsertTrackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertTrackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTrack() == nil {
+		err := UpsertTrackRequestValidationError{
+			field:  "Track",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTrack()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertTrackRequestValidationError{
+					field:  "Track",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertTrackRequestValidationError{
+					field:  "Track",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTrack()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertTrackRequestValidationError{
+				field:  "Track",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpsertTrackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertTrackRequestMultiError is an error wrapping multiple validation errors
+// returned by UpsertTrackRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpsertTrackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertTrackRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertTrackRequestMultiError) AllErrors() []error { return m }
+
+// UpsertTrackRequestValidationError is the validation error returned by
+// UpsertTrackRequest.Validate if the designated constraints aren't met.
+type UpsertTrackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTrackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTrackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTrackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTrackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTrackRequestValidationError) ErrorName() string {
+	return "UpsertTrackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTrackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTrackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTrackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTrackRequestValidationError{}
+
+// Validate checks the field values on UpsertTrackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertTrackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertTrackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertTrackResponseMultiError, or nil if none found.
+func (m *UpsertTrackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertTrackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTrack()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertTrackResponseValidationError{
+					field:  "Track",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertTrackResponseValidationError{
+					field:  "Track",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTrack()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertTrackResponseValidationError{
+				field:  "Track",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpsertTrackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertTrackResponseMultiError is an error wrapping multiple validation
+// errors returned by UpsertTrackResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertTrackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertTrackResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertTrackResponseMultiError) AllErrors() []error { return m }
+
+// UpsertTrackResponseValidationError is the validation error returned by
+// UpsertTrackResponse.Validate if the designated constraints aren't met.
+type UpsertTrackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTrackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTrackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTrackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTrackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTrackResponseValidationError) ErrorName() string {
+	return "UpsertTrackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTrackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTrackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTrackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTrackResponseValidationError{}
+
+// Validate checks the field values on DeleteTrackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTrackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTrackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTrackRequestMultiError, or nil if none found.
+func (m *DeleteTrackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTrackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DeleteTrackRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTrackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTrackRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTrackRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTrackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTrackRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTrackRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTrackRequestValidationError is the validation error returned by
+// DeleteTrackRequest.Validate if the designated constraints aren't met.
+type DeleteTrackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTrackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTrackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTrackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTrackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTrackRequestValidationError) ErrorName() string {
+	return "DeleteTrackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTrackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTrackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTrackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTrackRequestValidationError{}
+
+// Validate checks the field values on DeleteTrackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTrackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTrackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTrackResponseMultiError, or nil if none found.
+func (m *DeleteTrackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTrackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTrackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTrackResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTrackResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTrackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTrackResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTrackResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTrackResponseValidationError is the validation error returned by
+// DeleteTrackResponse.Validate if the designated constraints aren't met.
+type DeleteTrackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTrackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTrackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTrackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTrackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTrackResponseValidationError) ErrorName() string {
+	return "DeleteTrackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTrackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTrackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTrackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTrackResponseValidationError{}
@@ -24,6 +24,9 @@ const (
 	CollideService_ListDriftTracksByDistrict_FullMethodName         = "/collide.v1.CollideService/ListDriftTracksByDistrict"
 	CollideService_GetAlternativesByDistrictAndTrack_FullMethodName = "/collide.v1.CollideService/GetAlternativesByDistrictAndTrack"
 	CollideService_GetCollisionsByDistrictAndTrack_FullMethodName   = "/collide.v1.CollideService/GetCollisionsByDistrictAndTrack"
+	CollideService_PlanEvent_FullMethodName                         = "/collide.v1.CollideService/PlanEvent"
+	CollideService_UpsertTrack_FullMethodName                       = "/collide.v1.CollideService/UpsertTrack"
+	CollideService_DeleteTrack_FullMethodName                       = "/collide.v1.CollideService/DeleteTrack"
 )
 
 // CollideServiceClient is the client API for CollideService service.
@@ -44,6 +47,15 @@ type CollideServiceClient interface {
 	// GetCollisionsByDistrictAndTrack returns a list of tracks within a certain district, which will collide with the
 	// target track.
 	GetCollisionsByDistrictAndTrack(ctx context.Context, in *GetCollisionsByDistrictAndTrackRequest, opts ...grpc.CallOption) (*GetCollisionsByDistrictAndTrackResponse, error)
+	// PlanEvent returns the largest set of mutually non-colliding tracks within one or more districts, as well as a
+	// schedule of rounds of non-colliding tracks that covers every track.
+	PlanEvent(ctx context.Context, in *PlanEventRequest, opts ...grpc.CallOption) (*PlanEventResponse, error)
+	// UpsertTrack creates or replaces a track, along with its collisions. It requires an admin token, and a repository
+	// which supports edits.
+	UpsertTrack(ctx context.Context, in *UpsertTrackRequest, opts ...grpc.CallOption) (*UpsertTrackResponse, error)
+	// DeleteTrack removes a track, along with any collisions referencing it. It requires an admin token, and a
+	// repository which supports edits.
+	DeleteTrack(ctx context.Context, in *DeleteTrackRequest, opts ...grpc.CallOption) (*DeleteTrackResponse, error)
 }
 
 type collideServiceClient struct {
@@ -104,6 +116,36 @@ func (c *collideServiceClient) GetCollisionsByDistrictAndTrack(ctx context.Conte
 	return out, nil
 }
 
+func (c *collideServiceClient) PlanEvent(ctx context.Context, in *PlanEventRequest, opts ...grpc.CallOption) (*PlanEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanEventResponse)
+	err := c.cc.Invoke(ctx, CollideService_PlanEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collideServiceClient) UpsertTrack(ctx context.Context, in *UpsertTrackRequest, opts ...grpc.CallOption) (*UpsertTrackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertTrackResponse)
+	err := c.cc.Invoke(ctx, CollideService_UpsertTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collideServiceClient) DeleteTrack(ctx context.Context, in *DeleteTrackRequest, opts ...grpc.CallOption) (*DeleteTrackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTrackResponse)
+	err := c.cc.Invoke(ctx, CollideService_DeleteTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollideServiceServer is the server API for CollideService service.
 // All implementations must embed UnimplementedCollideServiceServer
 // for forward compatibility.
@@ -122,6 +164,15 @@ type CollideServiceServer interface {
 	// GetCollisionsByDistrictAndTrack returns a list of tracks within a certain district, which will collide with the
 	// target track.
 	GetCollisionsByDistrictAndTrack(context.Context, *GetCollisionsByDistrictAndTrackRequest) (*GetCollisionsByDistrictAndTrackResponse, error)
+	// PlanEvent returns the largest set of mutually non-colliding tracks within one or more districts, as well as a
+	// schedule of rounds of non-colliding tracks that covers every track.
+	PlanEvent(context.Context, *PlanEventRequest) (*PlanEventResponse, error)
+	// UpsertTrack creates or replaces a track, along with its collisions. It requires an admin token, and a repository
+	// which supports edits.
+	UpsertTrack(context.Context, *UpsertTrackRequest) (*UpsertTrackResponse, error)
+	// DeleteTrack removes a track, along with any collisions referencing it. It requires an admin token, and a
+	// repository which supports edits.
+	DeleteTrack(context.Context, *DeleteTrackRequest) (*DeleteTrackResponse, error)
 	mustEmbedUnimplementedCollideServiceServer()
 }
 
@@ -147,6 +198,15 @@ func (UnimplementedCollideServiceServer) GetAlternativesByDistrictAndTrack(conte
 func (UnimplementedCollideServiceServer) GetCollisionsByDistrictAndTrack(context.Context, *GetCollisionsByDistrictAndTrackRequest) (*GetCollisionsByDistrictAndTrackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCollisionsByDistrictAndTrack not implemented")
 }
+func (UnimplementedCollideServiceServer) PlanEvent(context.Context, *PlanEventRequest) (*PlanEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanEvent not implemented")
+}
+func (UnimplementedCollideServiceServer) UpsertTrack(context.Context, *UpsertTrackRequest) (*UpsertTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTrack not implemented")
+}
+func (UnimplementedCollideServiceServer) DeleteTrack(context.Context, *DeleteTrackRequest) (*DeleteTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrack not implemented")
+}
 func (UnimplementedCollideServiceServer) mustEmbedUnimplementedCollideServiceServer() {}
 func (UnimplementedCollideServiceServer) testEmbeddedByValue()                        {}
 
@@ -258,6 +318,60 @@ func _CollideService_GetCollisionsByDistrictAndTrack_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollideService_PlanEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollideServiceServer).PlanEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollideService_PlanEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollideServiceServer).PlanEvent(ctx, req.(*PlanEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollideService_UpsertTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollideServiceServer).UpsertTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollideService_UpsertTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollideServiceServer).UpsertTrack(ctx, req.(*UpsertTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollideService_DeleteTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollideServiceServer).DeleteTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollideService_DeleteTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollideServiceServer).DeleteTrack(ctx, req.(*DeleteTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CollideService_ServiceDesc is the grpc.ServiceDesc for CollideService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -285,6 +399,18 @@ var CollideService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCollisionsByDistrictAndTrack",
 			Handler:    _CollideService_GetCollisionsByDistrictAndTrack_Handler,
 		},
+		{
+			MethodName: "PlanEvent",
+			Handler:    _CollideService_PlanEvent_Handler,
+		},
+		{
+			MethodName: "UpsertTrack",
+			Handler:    _CollideService_UpsertTrack_Handler,
+		},
+		{
+			MethodName: "DeleteTrack",
+			Handler:    _CollideService_DeleteTrack_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "collide/v1/collide.proto",
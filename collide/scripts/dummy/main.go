@@ -38,6 +38,7 @@ func ExecLoad(ctx context.Context, logger *slog.Logger, args []string) (int, err
 		"http://localhost:8083/v1/collide/districts/Waterfront/drift",
 		"http://localhost:8083/v1/collide/districts/Waterfront/all/Container/alternatives",
 		"http://localhost:8083/v1/collide/districts/Waterfront/all/Container/collisions",
+		"http://localhost:8083/v1/collide/plan?districts=Waterfront&drift_only=true",
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, *dur)
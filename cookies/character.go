@@ -0,0 +1,204 @@
+package cookies
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// CrumbsPerCookie is the amount of crumbs a character needs to gather to bake a cookie. Characters gather as many
+	// crumbs as their INT on each Tick.
+	CrumbsPerCookie = 100
+
+	baseHP              = 50
+	hpPerDEF            = 10
+	baseStamina         = 20
+	staminaPerINT       = 5
+	baseHPRecovery      = 1
+	baseStaminaRecovery = 1
+	criticalPerAGI      = 2
+	dodgePerLUK         = 2
+	maxPercentage       = 75
+)
+
+var ErrInvalidClass = errors.New("invalid class")
+
+type Class uint8
+
+const (
+	ClassNone Class = iota
+	ClassCrumbSnatcher
+	ClassGrandBaker
+	ClassJarWarden
+	ClassSugarAlchemist
+)
+
+// Classes lists the classes a character can pick.
+func Classes() []Class {
+	return []Class{ClassCrumbSnatcher, ClassGrandBaker, ClassJarWarden, ClassSugarAlchemist}
+}
+
+func (c Class) String() string {
+	switch c {
+	case ClassCrumbSnatcher:
+		return "Crumb Snatcher"
+	case ClassGrandBaker:
+		return "Grand Baker"
+	case ClassJarWarden:
+		return "Jar Warden"
+	case ClassSugarAlchemist:
+		return "Sugar Alchemist"
+	default:
+		return "None"
+	}
+}
+
+func (c Class) Description() string {
+	switch c {
+	case ClassCrumbSnatcher:
+		return "Quick and lucky, landing critical hits and dodging the ones coming their way."
+	case ClassGrandBaker:
+		return "A master of the oven, baking the most cookies while idle."
+	case ClassJarWarden:
+		return "Sturdy guardian of the cookie jar, with the most health and the fastest recovery."
+	case ClassSugarAlchemist:
+		return "Turns sugar into power, balancing passive baking with luck and stamina."
+	default:
+		return "No class selected yet."
+	}
+}
+
+// BaseStats returns the stats a character of this class starts with.
+func (c Class) BaseStats() Stats {
+	switch c {
+	case ClassCrumbSnatcher:
+		return Stats{STR: 4, DEF: 3, AGI: 8, INT: 3, LUK: 7}
+	case ClassGrandBaker:
+		return Stats{STR: 3, DEF: 4, AGI: 3, INT: 9, LUK: 6}
+	case ClassJarWarden:
+		return Stats{STR: 7, DEF: 9, AGI: 3, INT: 3, LUK: 3}
+	case ClassSugarAlchemist:
+		return Stats{STR: 3, DEF: 3, AGI: 5, INT: 7, LUK: 7}
+	default:
+		return Stats{}
+	}
+}
+
+// ParseClass returns the Class matching the input name, case-insensitive.
+func ParseClass(name string) (Class, error) {
+	for _, class := range Classes() {
+		if strings.EqualFold(class.String(), strings.TrimSpace(name)) {
+			return class, nil
+		}
+	}
+
+	return ClassNone, ErrInvalidClass
+}
+
+// Stats are a character's attributes, as set by their class.
+type Stats struct {
+	STR int
+	AGI int
+	INT int
+	LUK int
+	DEF int
+}
+
+// Critical is the percentage by which a skill's attack is improved, from AGI.
+func (s Stats) Critical() int {
+	return min(s.AGI*criticalPerAGI, maxPercentage)
+}
+
+// Dodge is the percentage chance of escaping a hit without taking any damage, from LUK.
+func (s Stats) Dodge() int {
+	return min(s.LUK*dodgePerLUK, maxPercentage)
+}
+
+// Vitals are a character's current HP and Stamina. Their maximum values and recovery rates are derived from Stats.
+type Vitals struct {
+	HP      int
+	Stamina int
+}
+
+// Character is a user's instance in the game, with a class, its stats and vitals.
+type Character struct {
+	User   string
+	Class  Class
+	Stats  Stats
+	Vitals Vitals
+
+	// Crumbs are gathered on each Tick, and turned into cookies once there are CrumbsPerCookie of them.
+	Crumbs int
+	// LastTick is the time of the last Tick applied to the character.
+	LastTick time.Time
+}
+
+// NewCharacter creates a character with the input class, with full vitals.
+func NewCharacter(user string, class Class, now time.Time) (Character, error) {
+	if class == ClassNone || class > ClassSugarAlchemist {
+		return Character{}, ErrInvalidClass
+	}
+
+	c := Character{
+		User:     user,
+		Class:    class,
+		Stats:    class.BaseStats(),
+		LastTick: now,
+	}
+
+	c.Vitals = Vitals{
+		HP:      c.MaxHP(),
+		Stamina: c.MaxStamina(),
+	}
+
+	return c, nil
+}
+
+// MaxHP is the character's total health points, from DEF.
+func (c Character) MaxHP() int {
+	return baseHP + c.Stats.DEF*hpPerDEF
+}
+
+// MaxStamina is the character's total stamina, from INT.
+func (c Character) MaxStamina() int {
+	return baseStamina + c.Stats.INT*staminaPerINT
+}
+
+// HPRecoveryRate is the amount of HP recovered per Tick, from STR.
+func (c Character) HPRecoveryRate() int {
+	return baseHPRecovery + c.Stats.STR/2
+}
+
+// StaminaRecoveryRate is the amount of Stamina recovered per Tick, from AGI.
+func (c Character) StaminaRecoveryRate() int {
+	return baseStaminaRecovery + c.Stats.AGI/2
+}
+
+// Fainted reports whether the character has run out of HP. Fainted characters do not recover HP nor gather crumbs
+// until they are revived with consumables.
+func (c Character) Fainted() bool {
+	return c.Vitals.HP <= 0
+}
+
+// Tick applies n ticks to the character, recovering its vitals and gathering crumbs. It returns the number of
+// cookies baked from the gathered crumbs.
+func (c *Character) Tick(n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	c.Vitals.Stamina = min(c.Vitals.Stamina+n*c.StaminaRecoveryRate(), c.MaxStamina())
+
+	if c.Fainted() {
+		return 0
+	}
+
+	c.Vitals.HP = min(c.Vitals.HP+n*c.HPRecoveryRate(), c.MaxHP())
+
+	c.Crumbs += n * c.Stats.INT
+	cookies := c.Crumbs / CrumbsPerCookie
+	c.Crumbs %= CrumbsPerCookie
+
+	return cookies
+}
@@ -0,0 +1,173 @@
+package cookies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCharacter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name  string
+		class Class
+
+		wantVitals Vitals
+		wantErr    error
+	}{
+		{
+			name:       "CrumbSnatcher",
+			class:      ClassCrumbSnatcher,
+			wantVitals: Vitals{HP: 80, Stamina: 35},
+		},
+		{
+			name:       "GrandBaker",
+			class:      ClassGrandBaker,
+			wantVitals: Vitals{HP: 90, Stamina: 65},
+		},
+		{
+			name:       "JarWarden",
+			class:      ClassJarWarden,
+			wantVitals: Vitals{HP: 140, Stamina: 35},
+		},
+		{
+			name:       "SugarAlchemist",
+			class:      ClassSugarAlchemist,
+			wantVitals: Vitals{HP: 80, Stamina: 55},
+		},
+		{
+			name:    "None",
+			class:   ClassNone,
+			wantErr: ErrInvalidClass,
+		},
+		{
+			name:    "OutOfRange",
+			class:   ClassSugarAlchemist + 1,
+			wantErr: ErrInvalidClass,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			character, err := NewCharacter("user", testcase.class, now)
+			if testcase.wantErr != nil {
+				require.ErrorIs(t, err, testcase.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testcase.class.BaseStats(), character.Stats)
+			require.Equal(t, testcase.wantVitals, character.Vitals)
+			require.Equal(t, now, character.LastTick)
+		})
+	}
+}
+
+func TestCharacter_Tick(t *testing.T) {
+	for _, testcase := range []struct {
+		name   string
+		class  Class
+		vitals *Vitals
+		crumbs int
+		n      int
+
+		wantVitals  Vitals
+		wantCrumbs  int
+		wantCookies int
+	}{
+		{
+			name:       "NoTicks",
+			class:      ClassGrandBaker,
+			vitals:     &Vitals{HP: 10, Stamina: 10},
+			n:          0,
+			wantVitals: Vitals{HP: 10, Stamina: 10},
+		},
+		{
+			name:       "Recovery",
+			class:      ClassGrandBaker,
+			vitals:     &Vitals{HP: 10, Stamina: 0},
+			n:          2,
+			wantVitals: Vitals{HP: 14, Stamina: 4},
+			wantCrumbs: 18,
+		},
+		{
+			name:       "CappedAtMax",
+			class:      ClassJarWarden,
+			vitals:     &Vitals{HP: 130, Stamina: 30},
+			n:          10,
+			wantVitals: Vitals{HP: 140, Stamina: 35},
+			wantCrumbs: 30,
+		},
+		{
+			name:       "AlreadyAtMax",
+			class:      ClassCrumbSnatcher,
+			n:          1,
+			wantVitals: Vitals{HP: 80, Stamina: 35},
+			wantCrumbs: 3,
+		},
+		{
+			name:       "Fainted",
+			class:      ClassGrandBaker,
+			vitals:     &Vitals{HP: 0, Stamina: 0},
+			crumbs:     50,
+			n:          5,
+			wantVitals: Vitals{HP: 0, Stamina: 10},
+			wantCrumbs: 50,
+		},
+		{
+			name:        "CrumbSnatcherYield",
+			class:       ClassCrumbSnatcher,
+			n:           100,
+			wantVitals:  Vitals{HP: 80, Stamina: 35},
+			wantCookies: 3,
+		},
+		{
+			name:        "GrandBakerYield",
+			class:       ClassGrandBaker,
+			n:           100,
+			wantVitals:  Vitals{HP: 90, Stamina: 65},
+			wantCookies: 9,
+		},
+		{
+			name:        "JarWardenYield",
+			class:       ClassJarWarden,
+			n:           100,
+			wantVitals:  Vitals{HP: 140, Stamina: 35},
+			wantCookies: 3,
+		},
+		{
+			name:        "SugarAlchemistYield",
+			class:       ClassSugarAlchemist,
+			n:           100,
+			wantVitals:  Vitals{HP: 80, Stamina: 55},
+			wantCookies: 7,
+		},
+		{
+			name:        "LeftoverCrumbs",
+			class:       ClassSugarAlchemist,
+			crumbs:      90,
+			n:           3,
+			wantVitals:  Vitals{HP: 80, Stamina: 55},
+			wantCrumbs:  11,
+			wantCookies: 1,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			character, err := NewCharacter("user", testcase.class, time.Now())
+			require.NoError(t, err)
+
+			if testcase.vitals != nil {
+				character.Vitals = *testcase.vitals
+			}
+
+			character.Crumbs = testcase.crumbs
+
+			cookies := character.Tick(testcase.n)
+
+			require.Equal(t, testcase.wantCookies, cookies)
+			require.Equal(t, testcase.wantVitals, character.Vitals)
+			require.Equal(t, testcase.wantCrumbs, character.Crumbs)
+		})
+	}
+}
@@ -28,6 +28,12 @@ var (
 	ErrNoDatabasePath = errors.New("no database path provided")
 )
 
+type Repository interface {
+	cookies.Repository
+	cookies.CharacterRepository
+	cookies.LedgerRepository
+}
+
 type Command interface {
 	Name() string
 	Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error)
@@ -64,6 +70,7 @@ func ExecBot(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 	role := fs.String("role", "", "discord role ID to allow users to give cookies")
 	nonAdminMaxCookies := fs.Int("max-cookies", 1, "maximum number of cookies regular users can give")
 	thresh := fs.Duration("thresh", time.Hour*24, "duration between adding and sharing cookies")
+	tick := fs.Duration("tick", cookies.DefaultTickInterval, "duration of a game tick, when characters recover and bake cookies")
 
 	if err := fs.Parse(args); err != nil {
 		return 1, err
@@ -117,7 +124,7 @@ func ExecBot(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 		logger.InfoContext(ctx, "disconnected from discord")
 	}()
 
-	var repo cookies.Repository
+	var repo Repository
 	switch {
 	case *inMemory:
 		repo = memory.NewInMemory(realClock{})
@@ -150,6 +157,11 @@ func ExecBot(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 		cookies.NewListCommand(adminList, *logChannelID, repo, logger),
 		cookies.NewSwapCommand(adminList, *logChannelID, *role, *nonAdminMaxCookies, *thresh, repo, realClock{}, logger),
 		cookies.NewEatCommand(adminList, *logChannelID, repo, logger),
+		cookies.NewClassCommand(*logChannelID, repo, realClock{}, logger),
+		cookies.NewCharacterCommand(*logChannelID, repo, logger),
+		cookies.NewLeaderboardCommand(*logChannelID, repo, logger),
+		cookies.NewHistoryCommand(*logChannelID, repo, logger),
+		cookies.NewSeasonCommand(adminList, *logChannelID, repo, logger),
 	}
 
 	cmds := make([]*discordgo.ApplicationCommand, 0, len(commands))
@@ -181,8 +193,14 @@ func ExecBot(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 		slog.String("role", *role),
 		slog.String("threshold", thresh.String()),
 		slog.Int("non_admin_max_cookies", *nonAdminMaxCookies),
+		slog.String("tick", tick.String()),
 	)
 
+	tickCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go cookies.NewTickEngine(*tick, repo, realClock{}, logger).Run(tickCtx)
+
 	sc := make(chan os.Signal, 1)
 	signal.Notify(sc, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
 	<-sc
@@ -3,6 +3,7 @@ package cookies
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
@@ -253,3 +254,212 @@ func EmbedEatingCookie(label, requester string, n int) *discordgo.MessageEmbed {
 		},
 	}
 }
+
+func EmbedCharacter(title string, character Character) *discordgo.MessageEmbed {
+	status := "Ready to bake"
+	if character.Fainted() {
+		status = "Fainted, needs to be revived"
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: character.Class.Description(),
+		Color:       0xaa7733, // https://www.color-hex.com/color-palette/9176
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "User",
+				Value:  fmt.Sprintf("<@%s> (`%s`)", character.User, character.User),
+				Inline: false,
+			}, {
+				Name:   "Class",
+				Value:  character.Class.String(),
+				Inline: true,
+			}, {
+				Name:   "Status",
+				Value:  status,
+				Inline: true,
+			}, {
+				Name: "Stats",
+				Value: fmt.Sprintf("STR `%d` · AGI `%d` · INT `%d` · LUK `%d` · DEF `%d`",
+					character.Stats.STR, character.Stats.AGI, character.Stats.INT, character.Stats.LUK, character.Stats.DEF),
+				Inline: false,
+			}, {
+				Name:   "Critical",
+				Value:  fmt.Sprintf("%d%%", character.Stats.Critical()),
+				Inline: true,
+			}, {
+				Name:   "Dodge",
+				Value:  fmt.Sprintf("%d%%", character.Stats.Dodge()),
+				Inline: true,
+			}, {
+				Name:   "HP",
+				Value:  fmt.Sprintf("%d / %d (+%d per tick)", character.Vitals.HP, character.MaxHP(), character.HPRecoveryRate()),
+				Inline: true,
+			}, {
+				Name: "Stamina",
+				Value: fmt.Sprintf("%d / %d (+%d per tick)",
+					character.Vitals.Stamina, character.MaxStamina(), character.StaminaRecoveryRate()),
+				Inline: true,
+			}, {
+				Name:   "Crumbs",
+				Value:  fmt.Sprintf("%d / %d (+%d per tick)", character.Crumbs, CrumbsPerCookie, character.Stats.INT),
+				Inline: true,
+			},
+		},
+	}
+}
+
+func EmbedCharacterNotFound(user string) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title: "🔒 No character found",
+		Color: 0xff0000, // red
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "User",
+				Value:  fmt.Sprintf("<@%s> (`%s`)", user, user),
+				Inline: false,
+			}, {
+				Name:   "Hint",
+				Value:  fmt.Sprintf("choose a class with `/%s` first", commandClass),
+				Inline: true,
+			},
+		},
+	}
+}
+
+func EmbedClassAlreadySelected(character Character) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title: "🔒 You already chose a class",
+		Color: 0xff0000, // red
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "User",
+				Value:  fmt.Sprintf("<@%s> (`%s`)", character.User, character.User),
+				Inline: false,
+			}, {
+				Name:   "Class",
+				Value:  character.Class.String(),
+				Inline: true,
+			},
+		},
+	}
+}
+
+func EmbedLeaderboard(season Season, standings []Standing) *discordgo.MessageEmbed {
+	title := fmt.Sprintf("🏆 Leaderboard for season %d", season.ID)
+	if season.Current() {
+		title = fmt.Sprintf("🏆 Leaderboard for the current season (%d)", season.ID)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: formatStandings(standings),
+		Color:       0xaa7733, // https://www.color-hex.com/color-palette/9176
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Started",
+				Value:  season.StartedAt.Format(time.DateTime),
+				Inline: true,
+			}, {
+				Name:   "Ended",
+				Value:  formatSeasonEnd(season),
+				Inline: true,
+			},
+		},
+	}
+}
+
+func EmbedHistory(user string, transactions []Transaction) *discordgo.MessageEmbed {
+	description := "no transactions, yet"
+
+	if len(transactions) > 0 {
+		sb := &strings.Builder{}
+
+		for i := range transactions {
+			// imported balances have no actor
+			actor := adminLabel
+			if transactions[i].Actor != "" {
+				actor = fmt.Sprintf("<@%s>", transactions[i].Actor)
+			}
+
+			fmt.Fprintf(sb, "`%s` **%+d** %s by %s (season %d)\n",
+				transactions[i].Timestamp.Format(time.DateTime), transactions[i].Amount,
+				transactions[i].Reason, actor, transactions[i].Season)
+		}
+
+		description = sb.String()
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       "📜 Cookie history",
+		Description: description,
+		Color:       0xaa7733, // https://www.color-hex.com/color-palette/9176
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "User",
+				Value:  fmt.Sprintf("<@%s> (`%s`)", user, user),
+				Inline: false,
+			},
+		},
+	}
+}
+
+func EmbedNoPermissionsToStartSeason(requester string) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title: "🔒 You wish you could start a new season",
+		Color: 0xff0000, // red
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "User",
+				Value:  fmt.Sprintf("<@%s> (`%s`)", requester, requester),
+				Inline: false,
+			}, {
+				Name:   "Tries to reset everyone's cookies",
+				Value:  "*total cinema*",
+				Inline: true,
+			},
+		},
+	}
+}
+
+func EmbedNewSeason(requester string, season Season, standings []Standing) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏁 Season %d has started", season.ID),
+		Description: formatStandings(standings),
+		Color:       0xaa7733, // https://www.color-hex.com/color-palette/9176
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   adminLabel,
+				Value:  fmt.Sprintf("<@%s> (`%s`)", requester, requester),
+				Inline: false,
+			}, {
+				Name:   "Operation",
+				Value:  fmt.Sprintf("Archived season %d and reset all cookies", season.ID-1),
+				Inline: true,
+			},
+		},
+	}
+}
+
+func formatStandings(standings []Standing) string {
+	if len(standings) == 0 {
+		return "no users were assigned cookies, yet"
+	}
+
+	sb := &strings.Builder{}
+
+	for i := range standings {
+		fmt.Fprintf(sb, "**#%d** <@%s> — %d cookies (%s)\n",
+			standings[i].Position, standings[i].User, standings[i].Cookies, GetRank(standings[i].Cookies))
+	}
+
+	return sb.String()
+}
+
+func formatSeasonEnd(season Season) string {
+	if season.Current() {
+		return "ongoing"
+	}
+
+	return season.EndedAt.Format(time.DateTime)
+}
@@ -2,4 +2,9 @@ package repository
 
 import "errors"
 
-var ErrNotFound = errors.New("user not found")
+var (
+	ErrNotFound          = errors.New("user not found")
+	ErrCharacterNotFound = errors.New("character not found")
+	ErrCharacterExists   = errors.New("character already exists")
+	ErrSeasonNotFound    = errors.New("season not found")
+)
@@ -2,134 +2,272 @@ package memory
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/zalgonoise/x/cookies"
 	"github.com/zalgonoise/x/cookies/internal/repository"
 )
 
+const minAlloc = 64
+
 type Clock interface {
 	Now() time.Time
 }
 
 type InMemory struct {
-	mu             *sync.RWMutex
-	userCookiesMap map[string]Entry
+	mu         *sync.RWMutex
+	ledger     []cookies.Transaction
+	seasons    []cookies.Season
+	standings  map[int][]cookies.Standing
+	characters map[string]cookies.Character
 
 	clock Clock
 }
 
-type Entry struct {
-	cookies    int
-	lastUpdate time.Time
-}
-
 func (m *InMemory) GetCookies(ctx context.Context, user string) (int, time.Time, error) {
 	m.mu.RLock()
-	entry, ok := m.userCookiesMap[user]
-	m.mu.RUnlock()
+	defer m.mu.RUnlock()
 
-	if !ok {
-		return 0, time.Time{}, nil
+	var lastGift time.Time
+
+	for i := range m.ledger {
+		if m.ledger[i].Actor == user && isGift(m.ledger[i].Reason) && m.ledger[i].Timestamp.After(lastGift) {
+			lastGift = m.ledger[i].Timestamp
+		}
 	}
 
-	return entry.cookies, entry.lastUpdate, nil
+	return m.balance(user), lastGift, nil
 }
 
 func (m *InMemory) ListCookies(ctx context.Context) (map[string]int, error) {
 	m.mu.RLock()
-	if len(m.userCookiesMap) == 0 {
-		m.mu.RUnlock()
+	dst := m.balances()
+	m.mu.RUnlock()
 
+	if len(dst) == 0 {
 		return nil, repository.ErrNotFound
 	}
 
-	dst := make(map[string]int, len(m.userCookiesMap))
-	for k, v := range m.userCookiesMap {
-		dst[k] = v.cookies
-	}
-
-	m.mu.RUnlock()
-
 	return dst, nil
 }
 
-func (m *InMemory) AddCookie(ctx context.Context, user string, n int) (int, error) {
+func (m *InMemory) AddCookie(ctx context.Context, actor, user string, n int) (int, error) {
 	m.mu.Lock()
-	current := m.userCookiesMap[user]
-	entry := Entry{
-		cookies:    current.cookies + n,
-		lastUpdate: m.clock.Now(),
-	}
-	m.userCookiesMap[user] = entry
-	m.mu.Unlock()
+	defer m.mu.Unlock()
+
+	m.append(user, actor, cookies.ReasonDeal, n)
 
-	return entry.cookies, nil
+	return m.balance(user), nil
 }
 
 func (m *InMemory) SwapCookies(ctx context.Context, from, to string, n int) (int, int, error) {
 	m.mu.Lock()
-	entryFrom, ok := m.userCookiesMap[from]
-	if !ok {
-		m.mu.Unlock()
+	defer m.mu.Unlock()
+
+	if !m.exists(from) {
 		return 0, 0, repository.ErrNotFound
 	}
-	entryTo, ok := m.userCookiesMap[to]
-	if !ok {
-		m.userCookiesMap[to] = Entry{
-			cookies:    n,
-			lastUpdate: m.clock.Now(),
-		}
 
-		requesterCurrent := entryFrom.cookies - n
-		m.userCookiesMap[from] = Entry{
-			cookies:    requesterCurrent,
-			lastUpdate: m.clock.Now(),
+	m.append(from, from, cookies.ReasonShare, -n)
+	m.append(to, from, cookies.ReasonShare, n)
+
+	return m.balance(from), m.balance(to), nil
+}
+
+func (m *InMemory) EatCookie(ctx context.Context, user string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.exists(user) {
+		return 0, repository.ErrNotFound
+	}
+
+	m.append(user, user, cookies.ReasonEat, -1)
+
+	return m.balance(user), nil
+}
+
+func (m *InMemory) ListTransactions(ctx context.Context, user string, limit int) ([]cookies.Transaction, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	transactions := make([]cookies.Transaction, 0, minAlloc)
+
+	for i := len(m.ledger) - 1; i >= 0 && (limit <= 0 || len(transactions) < limit); i-- {
+		if m.ledger[i].User == user {
+			transactions = append(transactions, m.ledger[i])
 		}
-		m.mu.Unlock()
+	}
+
+	return transactions, nil
+}
 
-		return requesterCurrent, n, nil
+func (m *InMemory) GetSeason(ctx context.Context, id int) (cookies.Season, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if id == 0 {
+		return m.seasons[len(m.seasons)-1], nil
 	}
 
-	targetCurrent := entryTo.cookies + n
-	m.userCookiesMap[to] = Entry{
-		cookies:    targetCurrent,
-		lastUpdate: entryTo.lastUpdate,
+	if id < 0 || id > len(m.seasons) {
+		return cookies.Season{}, repository.ErrSeasonNotFound
 	}
 
-	requesterCurrent := entryFrom.cookies - n
-	m.userCookiesMap[from] = Entry{
-		cookies:    requesterCurrent,
-		lastUpdate: m.clock.Now(),
+	return m.seasons[id-1], nil
+}
+
+func (m *InMemory) Leaderboard(ctx context.Context, season, limit int) ([]cookies.Standing, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var standings []cookies.Standing
+
+	switch {
+	case season <= 0 || season > len(m.seasons):
+		return nil, repository.ErrSeasonNotFound
+	case season == len(m.seasons):
+		standings = cookies.NewStandings(m.balances())
+	default:
+		standings = m.standings[season]
+	}
+
+	if limit <= 0 || limit > len(standings) {
+		limit = len(standings)
 	}
-	m.mu.Unlock()
 
-	return requesterCurrent, targetCurrent, nil
+	return slices.Clone(standings[:limit]), nil
 }
 
-func (m *InMemory) EatCookie(ctx context.Context, user string) (int, error) {
+func (m *InMemory) NewSeason(ctx context.Context) (cookies.Season, []cookies.Standing, error) {
 	m.mu.Lock()
-	entry, ok := m.userCookiesMap[user]
+	defer m.mu.Unlock()
+
+	now := m.clock.Now()
+	current := len(m.seasons)
+	standings := cookies.NewStandings(m.balances())
+
+	m.standings[current] = standings
+	m.seasons[current-1].EndedAt = now
+	m.seasons = append(m.seasons, cookies.Season{ID: current + 1, StartedAt: now})
+
+	return m.seasons[current], standings, nil
+}
+
+// append adds a transaction to the ledger in the current season. It must be called while holding the write lock.
+func (m *InMemory) append(user, actor string, reason cookies.Reason, n int) {
+	m.ledger = append(m.ledger, cookies.Transaction{
+		ID:        int64(len(m.ledger) + 1),
+		Season:    len(m.seasons),
+		User:      user,
+		Actor:     actor,
+		Reason:    reason,
+		Amount:    n,
+		Timestamp: m.clock.Now(),
+	})
+}
+
+func (m *InMemory) exists(user string) bool {
+	return slices.ContainsFunc(m.ledger, func(t cookies.Transaction) bool {
+		return t.User == user
+	})
+}
+
+func (m *InMemory) balance(user string) int {
+	var n int
+
+	for i := range m.ledger {
+		if m.ledger[i].User == user && m.ledger[i].Season == len(m.seasons) {
+			n += m.ledger[i].Amount
+		}
+	}
+
+	return n
+}
+
+func (m *InMemory) balances() map[string]int {
+	dst := make(map[string]int, minAlloc)
+
+	for i := range m.ledger {
+		if m.ledger[i].Season == len(m.seasons) {
+			dst[m.ledger[i].User] += m.ledger[i].Amount
+		}
+	}
+
+	return dst
+}
+
+func isGift(reason cookies.Reason) bool {
+	return reason == cookies.ReasonDeal || reason == cookies.ReasonShare
+}
+
+func (m *InMemory) GetCharacter(ctx context.Context, user string) (cookies.Character, error) {
+	m.mu.RLock()
+	character, ok := m.characters[user]
+	m.mu.RUnlock()
+
 	if !ok {
-		m.mu.Unlock()
+		return cookies.Character{}, repository.ErrCharacterNotFound
+	}
 
-		return 0, repository.ErrNotFound
+	return character, nil
+}
+
+func (m *InMemory) CreateCharacter(ctx context.Context, character cookies.Character) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.characters[character.User]; ok {
+		return repository.ErrCharacterExists
 	}
-	current := entry.cookies - 1
-	m.userCookiesMap[user] = Entry{
-		cookies:    current,
-		lastUpdate: entry.lastUpdate,
+
+	m.characters[character.User] = character
+
+	return nil
+}
+
+func (m *InMemory) ListCharacters(ctx context.Context) ([]cookies.Character, error) {
+	m.mu.RLock()
+	characters := make([]cookies.Character, 0, len(m.characters))
+	for _, character := range m.characters {
+		characters = append(characters, character)
 	}
+	m.mu.RUnlock()
+
+	slices.SortFunc(characters, func(a, b cookies.Character) int {
+		return strings.Compare(a.User, b.User)
+	})
 
-	m.mu.Unlock()
+	return characters, nil
+}
+
+func (m *InMemory) TickCharacter(ctx context.Context, character cookies.Character, n int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.characters[character.User]; !ok {
+		return repository.ErrCharacterNotFound
+	}
+
+	m.characters[character.User] = character
+
+	if n > 0 {
+		m.append(character.User, character.User, cookies.ReasonBake, n)
+	}
 
-	return current, nil
+	return nil
 }
 
 func NewInMemory(clock Clock) *InMemory {
 	return &InMemory{
-		userCookiesMap: make(map[string]Entry, 64),
-		mu:             new(sync.RWMutex),
-		clock:          clock,
+		ledger:     make([]cookies.Transaction, 0, minAlloc),
+		seasons:    []cookies.Season{{ID: 1, StartedAt: clock.Now()}},
+		standings:  make(map[int][]cookies.Standing, 4),
+		characters: make(map[string]cookies.Character, minAlloc),
+		mu:         new(sync.RWMutex),
+		clock:      clock,
 	}
 }
@@ -0,0 +1,159 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zalgonoise/x/cookies"
+	"github.com/zalgonoise/x/cookies/internal/repository"
+)
+
+const (
+	getCharacterQuery    = `SELECT userID, class, str, agi, int, luk, def, hp, stamina, crumbs, lastTick FROM characters WHERE userID = ?`
+	listCharactersQuery  = `SELECT userID, class, str, agi, int, luk, def, hp, stamina, crumbs, lastTick FROM characters ORDER BY userID`
+	insertCharacterQuery = `INSERT INTO characters (userID, class, str, agi, int, luk, def, hp, stamina, crumbs, lastTick) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	tickCharacterQuery   = `UPDATE characters SET hp = ?, stamina = ?, crumbs = ?, lastTick = ? WHERE userID = ?`
+)
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCharacter(row rowScanner) (cookies.Character, error) {
+	var (
+		character cookies.Character
+		class     int
+		lastTick  int
+	)
+
+	if err := row.Scan(
+		&character.User, &class,
+		&character.Stats.STR, &character.Stats.AGI, &character.Stats.INT, &character.Stats.LUK, &character.Stats.DEF,
+		&character.Vitals.HP, &character.Vitals.Stamina, &character.Crumbs, &lastTick,
+	); err != nil {
+		return cookies.Character{}, err
+	}
+
+	character.Class = cookies.Class(class)
+	character.LastTick = time.UnixMilli(int64(lastTick))
+
+	return character, nil
+}
+
+func (r *SQLite) GetCharacter(ctx context.Context, user string) (cookies.Character, error) {
+	character, err := scanCharacter(r.db.QueryRowContext(ctx, getCharacterQuery, user))
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return cookies.Character{}, repository.ErrCharacterNotFound
+		default:
+			return cookies.Character{}, err
+		}
+	}
+
+	return character, nil
+}
+
+func (r *SQLite) CreateCharacter(ctx context.Context, character cookies.Character) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = scanCharacter(tx.QueryRowContext(ctx, getCharacterQuery, character.User))
+
+	switch {
+	case err == nil:
+		return repository.ErrCharacterExists
+	case !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx, insertCharacterQuery,
+		character.User, int(character.Class),
+		character.Stats.STR, character.Stats.AGI, character.Stats.INT, character.Stats.LUK, character.Stats.DEF,
+		character.Vitals.HP, character.Vitals.Stamina, character.Crumbs, int(character.LastTick.UnixMilli()),
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		return ErrUnexpectedRowsAffected
+	}
+
+	return tx.Commit()
+}
+
+func (r *SQLite) ListCharacters(ctx context.Context) ([]cookies.Character, error) {
+	rows, err := r.db.QueryContext(ctx, listCharactersQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := make([]cookies.Character, 0, minAlloc)
+
+	for rows.Next() {
+		character, err := scanCharacter(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, character)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *SQLite) TickCharacter(ctx context.Context, character cookies.Character, n int) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, tickCharacterQuery,
+		character.Vitals.HP, character.Vitals.Stamina, character.Crumbs, int(character.LastTick.UnixMilli()),
+		character.User,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		return repository.ErrCharacterNotFound
+	}
+
+	if n > 0 {
+		if err := insertTransaction(ctx, tx, character.User, character.User, cookies.ReasonBake, n, character.LastTick); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,116 @@
+package sqlite
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/cookies"
+	"github.com/zalgonoise/x/cookies/internal/repository"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func newTestSQLite(t *testing.T, clock Clock) *SQLite {
+	t.Helper()
+
+	logger := slog.New(slog.DiscardHandler)
+
+	// each test gets its own database file, as in-memory databases are shared within the process
+	db, err := OpenSQLite(filepath.Join(t.TempDir(), "cookies.db"), ReadWritePragmas(), logger)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	require.NoError(t, MigrateSQLite(context.Background(), db, logger))
+
+	return NewSQLite(db, clock)
+}
+
+func TestSQLite_CreateCharacter(t *testing.T) {
+	// timestamps are stored with millisecond precision
+	now := time.UnixMilli(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+
+	for _, testcase := range []struct {
+		name     string
+		existing []cookies.Character
+		input    cookies.Character
+
+		wantErr error
+	}{
+		{
+			name:  "New",
+			input: cookies.Character{User: "a", Class: cookies.ClassGrandBaker, LastTick: now},
+		},
+		{
+			name:     "AnotherUser",
+			existing: []cookies.Character{{User: "b", Class: cookies.ClassJarWarden, LastTick: now}},
+			input:    cookies.Character{User: "a", Class: cookies.ClassGrandBaker, LastTick: now},
+		},
+		{
+			name:     "Exists",
+			existing: []cookies.Character{{User: "a", Class: cookies.ClassJarWarden, LastTick: now}},
+			input:    cookies.Character{User: "a", Class: cookies.ClassGrandBaker, LastTick: now},
+			wantErr:  repository.ErrCharacterExists,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := newTestSQLite(t, &fakeClock{now: now})
+
+			for i := range testcase.existing {
+				require.NoError(t, repo.CreateCharacter(ctx, testcase.existing[i]))
+			}
+
+			err := repo.CreateCharacter(ctx, testcase.input)
+			if testcase.wantErr != nil {
+				require.ErrorIs(t, err, testcase.wantErr)
+
+				// the existing character is left untouched
+				character, err := repo.GetCharacter(ctx, testcase.input.User)
+				require.NoError(t, err)
+				require.Equal(t, testcase.existing[0], character)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			character, err := repo.GetCharacter(ctx, testcase.input.User)
+			require.NoError(t, err)
+			require.Equal(t, testcase.input, character)
+		})
+	}
+}
+
+func TestSQLite_TickCharacter(t *testing.T) {
+	ctx := context.Background()
+	now := time.UnixMilli(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+	repo := newTestSQLite(t, &fakeClock{now: now})
+
+	character, err := cookies.NewCharacter("a", cookies.ClassGrandBaker, now)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, repo.TickCharacter(ctx, character, 1), repository.ErrCharacterNotFound)
+	require.NoError(t, repo.CreateCharacter(ctx, character))
+
+	character.Crumbs = 8
+	character.LastTick = now.Add(time.Hour)
+	require.NoError(t, repo.TickCharacter(ctx, character, 2))
+
+	characters, err := repo.ListCharacters(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []cookies.Character{character}, characters)
+
+	balance, _, err := repo.GetCookies(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, 2, balance)
+}
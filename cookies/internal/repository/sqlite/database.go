@@ -30,6 +30,61 @@ const (
 	) STRICT;
 `
 
+	createCharactersTableQuery = `
+	CREATE TABLE characters (
+    userID TEXT PRIMARY KEY NOT NULL,
+    class INTEGER NOT NULL,
+    str INTEGER NOT NULL,
+    agi INTEGER NOT NULL,
+    int INTEGER NOT NULL,
+    luk INTEGER NOT NULL,
+    def INTEGER NOT NULL,
+    hp INTEGER NOT NULL,
+    stamina INTEGER NOT NULL,
+    crumbs INTEGER NOT NULL,
+	lastTick INTEGER NOT NULL
+	) STRICT;
+`
+
+	createSeasonsTableQuery = `
+	CREATE TABLE seasons (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    startedAt INTEGER NOT NULL,
+	endedAt INTEGER NOT NULL
+	) STRICT;
+
+	INSERT INTO seasons (startedAt, endedAt) VALUES (CAST(strftime('%s', 'now') AS INTEGER) * 1000, 0);
+`
+
+	// the ledger replaces the cookies table as the source of the users' balances, importing them when created
+	createLedgerTableQuery = `
+	CREATE TABLE ledger (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    season INTEGER NOT NULL,
+    userID TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    amount INTEGER NOT NULL,
+	timestamp INTEGER NOT NULL
+	) STRICT;
+
+	CREATE INDEX idx_ledger_userID ON ledger (userID, season);
+	CREATE INDEX idx_ledger_actor ON ledger (actor, reason);
+
+	INSERT INTO ledger (season, userID, actor, reason, amount, timestamp)
+	SELECT (SELECT MAX(id) FROM seasons), userID, '', 'import', cookies, lastGift FROM cookies;
+`
+
+	createStandingsTableQuery = `
+	CREATE TABLE standings (
+    season INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    userID TEXT NOT NULL,
+	cookies INTEGER NOT NULL,
+	PRIMARY KEY (season, userID)
+	) STRICT;
+`
+
 	checkTableExists = `
 SELECT EXISTS(SELECT 1 FROM sqlite_master 
 	WHERE type='table' 
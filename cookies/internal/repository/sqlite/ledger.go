@@ -0,0 +1,227 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zalgonoise/x/cookies"
+	"github.com/zalgonoise/x/cookies/internal/repository"
+)
+
+const (
+	listTransactionsQuery = `
+SELECT id, season, userID, actor, reason, amount, timestamp FROM ledger
+	WHERE userID = ? ORDER BY id DESC LIMIT ?`
+	getCurrentSeasonQuery = `SELECT id, startedAt, endedAt FROM seasons ORDER BY id DESC LIMIT 1`
+	getSeasonQuery        = `SELECT id, startedAt, endedAt FROM seasons WHERE id = ?`
+	listStandingsQuery    = `
+SELECT position, userID, cookies FROM standings
+	WHERE season = ? ORDER BY position, userID LIMIT ?`
+	insertStandingQuery = `INSERT INTO standings (season, position, userID, cookies) VALUES (?, ?, ?, ?)`
+	endSeasonQuery      = `UPDATE seasons SET endedAt = ? WHERE id = ?`
+	insertSeasonQuery   = `INSERT INTO seasons (startedAt, endedAt) VALUES (?, 0)`
+
+	// noLimit is SQLite's value for an unbounded LIMIT clause
+	noLimit = -1
+)
+
+func (r *SQLite) ListTransactions(ctx context.Context, user string, limit int) ([]cookies.Transaction, error) {
+	if limit <= 0 {
+		limit = noLimit
+	}
+
+	rows, err := r.db.QueryContext(ctx, listTransactionsQuery, user, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := make([]cookies.Transaction, 0, minAlloc)
+
+	for rows.Next() {
+		var (
+			transaction cookies.Transaction
+			reason      string
+			timestamp   int
+		)
+
+		if err := rows.Scan(
+			&transaction.ID, &transaction.Season, &transaction.User, &transaction.Actor,
+			&reason, &transaction.Amount, &timestamp,
+		); err != nil {
+			return nil, err
+		}
+
+		transaction.Reason = cookies.Reason(reason)
+		transaction.Timestamp = time.UnixMilli(int64(timestamp))
+
+		res = append(res, transaction)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *SQLite) GetSeason(ctx context.Context, id int) (cookies.Season, error) {
+	if id == 0 {
+		return scanSeason(r.db.QueryRowContext(ctx, getCurrentSeasonQuery))
+	}
+
+	return scanSeason(r.db.QueryRowContext(ctx, getSeasonQuery, id))
+}
+
+func (r *SQLite) Leaderboard(ctx context.Context, season, limit int) ([]cookies.Standing, error) {
+	if season <= 0 {
+		return nil, repository.ErrSeasonNotFound
+	}
+
+	s, err := r.GetSeason(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+
+	// the current season's standings are derived from the ledger, while past ones are archived
+	if s.Current() {
+		balances, err := listBalances(ctx, r.db)
+		if err != nil {
+			return nil, err
+		}
+
+		standings := cookies.NewStandings(balances)
+
+		if limit <= 0 || limit > len(standings) {
+			limit = len(standings)
+		}
+
+		return standings[:limit], nil
+	}
+
+	if limit <= 0 {
+		limit = noLimit
+	}
+
+	rows, err := r.db.QueryContext(ctx, listStandingsQuery, season, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := make([]cookies.Standing, 0, minAlloc)
+
+	for rows.Next() {
+		var standing cookies.Standing
+
+		if err := rows.Scan(&standing.Position, &standing.User, &standing.Cookies); err != nil {
+			return nil, err
+		}
+
+		res = append(res, standing)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *SQLite) NewSeason(ctx context.Context) (cookies.Season, []cookies.Standing, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return cookies.Season{}, nil, err
+	}
+
+	defer tx.Rollback()
+
+	current, err := scanSeason(tx.QueryRowContext(ctx, getCurrentSeasonQuery))
+	if err != nil {
+		return cookies.Season{}, nil, err
+	}
+
+	balances, err := listBalances(ctx, tx)
+	if err != nil {
+		return cookies.Season{}, nil, err
+	}
+
+	standings := cookies.NewStandings(balances)
+
+	for i := range standings {
+		if _, err := tx.ExecContext(ctx, insertStandingQuery,
+			current.ID, standings[i].Position, standings[i].User, standings[i].Cookies,
+		); err != nil {
+			return cookies.Season{}, nil, err
+		}
+	}
+
+	now := r.clock.Now()
+
+	res, err := tx.ExecContext(ctx, endSeasonQuery, int(now.UnixMilli()), current.ID)
+	if err != nil {
+		return cookies.Season{}, nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return cookies.Season{}, nil, err
+	}
+
+	if rowsAffected != 1 {
+		return cookies.Season{}, nil, ErrUnexpectedRowsAffected
+	}
+
+	res, err = tx.ExecContext(ctx, insertSeasonQuery, int(now.UnixMilli()))
+	if err != nil {
+		return cookies.Season{}, nil, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return cookies.Season{}, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return cookies.Season{}, nil, err
+	}
+
+	return cookies.Season{ID: int(id), StartedAt: time.UnixMilli(now.UnixMilli())}, standings, nil
+}
+
+func scanSeason(row rowScanner) (cookies.Season, error) {
+	var (
+		season    cookies.Season
+		startedAt int
+		endedAt   int
+	)
+
+	if err := row.Scan(&season.ID, &startedAt, &endedAt); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return cookies.Season{}, repository.ErrSeasonNotFound
+		default:
+			return cookies.Season{}, err
+		}
+	}
+
+	season.StartedAt = time.UnixMilli(int64(startedAt))
+
+	if endedAt > 0 {
+		season.EndedAt = time.UnixMilli(int64(endedAt))
+	}
+
+	return season, nil
+}
@@ -0,0 +1,231 @@
+package sqlite
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/cookies"
+	"github.com/zalgonoise/x/cookies/internal/repository"
+)
+
+func TestSQLite_Balances(t *testing.T) {
+	ctx := context.Background()
+	now := time.UnixMilli(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+	repo := newTestSQLite(t, &fakeClock{now: now})
+
+	n, err := repo.AddCookie(ctx, "admin", "a", 10)
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+
+	n, err = repo.AddCookie(ctx, "admin", "b", 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+
+	from, to, err := repo.SwapCookies(ctx, "a", "b", 3)
+	require.NoError(t, err)
+	require.Equal(t, 7, from)
+	require.Equal(t, 8, to)
+
+	n, err = repo.EatCookie(ctx, "b")
+	require.NoError(t, err)
+	require.Equal(t, 7, n)
+
+	character := cookies.Character{User: "c", Class: cookies.ClassGrandBaker, LastTick: now}
+	require.NoError(t, repo.CreateCharacter(ctx, character))
+	require.NoError(t, repo.TickCharacter(ctx, character, 9))
+
+	_, _, err = repo.SwapCookies(ctx, "unknown", "a", 1)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	_, err = repo.EatCookie(ctx, "unknown")
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	balances, err := repo.ListCookies(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"a": 7, "b": 7, "c": 9}, balances)
+
+	n, lastGift, err := repo.GetCookies(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, 7, n)
+	require.Equal(t, now, lastGift)
+
+	transactions, err := repo.ListTransactions(ctx, "b", 0)
+	require.NoError(t, err)
+	require.Equal(t, []cookies.Transaction{
+		{ID: 5, Season: 1, User: "b", Actor: "b", Reason: cookies.ReasonEat, Amount: -1, Timestamp: now},
+		{ID: 4, Season: 1, User: "b", Actor: "a", Reason: cookies.ReasonShare, Amount: 3, Timestamp: now},
+		{ID: 2, Season: 1, User: "b", Actor: "admin", Reason: cookies.ReasonDeal, Amount: 5, Timestamp: now},
+	}, transactions)
+
+	transactions, err = repo.ListTransactions(ctx, "b", 1)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	require.Equal(t, cookies.ReasonEat, transactions[0].Reason)
+}
+
+func TestSQLite_NewSeason(t *testing.T) {
+	ctx := context.Background()
+	start := time.UnixMilli(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+	end := start.Add(30 * 24 * time.Hour)
+	clock := &fakeClock{now: start}
+	repo := newTestSQLite(t, clock)
+
+	for user, n := range map[string]int{"a": 7, "b": 7, "c": 9, "d": 1} {
+		_, err := repo.AddCookie(ctx, "admin", user, n)
+		require.NoError(t, err)
+	}
+
+	wantStandings := []cookies.Standing{
+		{Position: 1, User: "c", Cookies: 9},
+		{Position: 2, User: "a", Cookies: 7},
+		{Position: 2, User: "b", Cookies: 7},
+		{Position: 4, User: "d", Cookies: 1},
+	}
+
+	standings, err := repo.Leaderboard(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, wantStandings, standings)
+
+	clock.now = end
+
+	season, archived, err := repo.NewSeason(ctx)
+	require.NoError(t, err)
+	require.Equal(t, cookies.Season{ID: 2, StartedAt: end}, season)
+	require.Equal(t, wantStandings, archived)
+
+	previous, err := repo.GetSeason(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, end, previous.EndedAt)
+	require.False(t, previous.Current())
+
+	current, err := repo.GetSeason(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, season, current)
+	require.True(t, current.Current())
+
+	_, err = repo.GetSeason(ctx, 3)
+	require.ErrorIs(t, err, repository.ErrSeasonNotFound)
+
+	// balances are reset, while the users are still known
+	n, _, err := repo.GetCookies(ctx, "a")
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	_, err = repo.EatCookie(ctx, "a")
+	require.NoError(t, err)
+
+	_, err = repo.AddCookie(ctx, "admin", "b", 2)
+	require.NoError(t, err)
+
+	for _, testcase := range []struct {
+		name   string
+		season int
+		limit  int
+
+		wants   []cookies.Standing
+		wantErr error
+	}{
+		{
+			name:   "PastSeason",
+			season: 1,
+			wants:  wantStandings,
+		},
+		{
+			name:   "PastSeasonWithLimit",
+			season: 1,
+			limit:  2,
+			wants:  wantStandings[:2],
+		},
+		{
+			name:   "CurrentSeason",
+			season: 2,
+			wants: []cookies.Standing{
+				{Position: 1, User: "b", Cookies: 2},
+				{Position: 2, User: "a", Cookies: -1},
+			},
+		},
+		{
+			name:   "CurrentSeasonWithLimit",
+			season: 2,
+			limit:  1,
+			wants:  []cookies.Standing{{Position: 1, User: "b", Cookies: 2}},
+		},
+		{
+			name:    "UnknownSeason",
+			season:  3,
+			wantErr: repository.ErrSeasonNotFound,
+		},
+		{
+			name:    "ZeroSeason",
+			season:  0,
+			wantErr: repository.ErrSeasonNotFound,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			standings, err := repo.Leaderboard(ctx, testcase.season, testcase.limit)
+			if testcase.wantErr != nil {
+				require.ErrorIs(t, err, testcase.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testcase.wants, standings)
+		})
+	}
+}
+
+func TestMigrateSQLite_Import(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.DiscardHandler)
+	lastGift := time.UnixMilli(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+
+	db, err := OpenSQLite(filepath.Join(t.TempDir(), "cookies.db"), ReadWritePragmas(), logger)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	// a database from before the ledger existed, with the users' counters
+	require.NoError(t, runMigrations(ctx, db, migration{table: "cookies", create: createTableQuery}))
+
+	for user, n := range map[string]int{"a": 12, "b": 3} {
+		_, err := db.ExecContext(ctx, `INSERT INTO cookies (userID, cookies, lastGift) VALUES (?, ?, ?)`,
+			user, n, int(lastGift.UnixMilli()))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, MigrateSQLite(ctx, db, logger))
+
+	// migrating again does not import the counters twice
+	require.NoError(t, MigrateSQLite(ctx, db, logger))
+
+	repo := NewSQLite(db, &fakeClock{now: time.Now()})
+
+	balances, err := repo.ListCookies(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"a": 12, "b": 3}, balances)
+
+	transactions, err := repo.ListTransactions(ctx, "a", 0)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	require.Equal(t, []cookies.Transaction{{
+		ID:        transactions[0].ID,
+		Season:    1,
+		User:      "a",
+		Reason:    cookies.ReasonImport,
+		Amount:    12,
+		Timestamp: lastGift,
+	}}, transactions)
+
+	standings, err := repo.Leaderboard(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, []cookies.Standing{
+		{Position: 1, User: "a", Cookies: 12},
+		{Position: 2, User: "b", Cookies: 3},
+	}, standings)
+}
@@ -18,6 +18,10 @@ func MigrateSQLite(ctx context.Context, db *sql.DB, logger *slog.Logger) error {
 
 	if err := runMigrations(ctx, db,
 		migration{table: "cookies", create: createTableQuery},
+		migration{table: "characters", create: createCharactersTableQuery},
+		migration{table: "seasons", create: createSeasonsTableQuery},
+		migration{table: "ledger", create: createLedgerTableQuery},
+		migration{table: "standings", create: createStandingsTableQuery},
 	); err != nil {
 		return err
 	}
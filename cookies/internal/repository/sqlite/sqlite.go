@@ -6,16 +6,21 @@ import (
 	"errors"
 	"time"
 
+	"github.com/zalgonoise/x/cookies"
 	"github.com/zalgonoise/x/cookies/internal/repository"
 )
 
 const (
 	minAlloc = 64
 
-	getCookiesQuery    = `SELECT userID, cookies, lastGift FROM cookies WHERE userID = ?`
-	listCookiesQuery   = `SELECT userID, cookies FROM cookies`
-	addCookiesQuery    = `UPDATE cookies SET cookies = ?, lastGift = ? WHERE userID = ?`
-	insertCookiesQuery = `INSERT INTO cookies (userID, cookies, lastGift) VALUES (?, ?, ?)`
+	getCookiesQuery = `
+SELECT COUNT(*), COALESCE(SUM(CASE WHEN season = (SELECT MAX(id) FROM seasons) THEN amount ELSE 0 END), 0)
+	FROM ledger WHERE userID = ?`
+	getLastGiftQuery       = `SELECT COALESCE(MAX(timestamp), 0) FROM ledger WHERE actor = ? AND reason IN (?, ?)`
+	listCookiesQuery       = `SELECT userID, SUM(amount) FROM ledger WHERE season = (SELECT MAX(id) FROM seasons) GROUP BY userID`
+	insertTransactionQuery = `
+INSERT INTO ledger (season, userID, actor, reason, amount, timestamp)
+	VALUES ((SELECT MAX(id) FROM seasons), ?, ?, ?, ?, ?)`
 )
 
 var ErrUnexpectedRowsAffected = errors.New("unexpected number of rows affected")
@@ -24,6 +29,13 @@ type Clock interface {
 	Now() time.Time
 }
 
+// querier is implemented by both sql.DB and sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
 type SQLite struct {
 	db *sql.DB
 
@@ -31,123 +43,54 @@ type SQLite struct {
 }
 
 func (r *SQLite) GetCookies(ctx context.Context, user string) (int, time.Time, error) {
-	var (
-		userID   string
-		current  int
-		lastGift int
-	)
-
-	if err := r.db.QueryRowContext(ctx, getCookiesQuery, user).Scan(&userID, &current, &lastGift); err != nil {
-		switch {
-		case errors.Is(err, sql.ErrNoRows):
-			return 0, time.Time{}, repository.ErrNotFound
-		default:
-			return 0, time.Time{}, err
-		}
-	}
-
-	return current, time.UnixMilli(int64(lastGift)), nil
-}
-
-func (r *SQLite) ListCookies(ctx context.Context) (map[string]int, error) {
-	rows, err := r.db.QueryContext(ctx, listCookiesQuery)
+	current, err := getBalance(ctx, r.db, user)
 	if err != nil {
-		return nil, err
+		return 0, time.Time{}, err
 	}
 
-	defer rows.Close()
-
-	res := make(map[string]int, minAlloc)
-
-	for rows.Next() {
-		var (
-			userID  string
-			current int
-		)
-
-		if err := rows.Scan(&userID, &current); err != nil {
-			switch {
-			case errors.Is(err, sql.ErrNoRows):
-				return nil, repository.ErrNotFound
-			default:
-				return nil, err
-			}
-		}
+	var lastGift int
 
-		res[userID] = current
+	if err := r.db.QueryRowContext(ctx, getLastGiftQuery, user, cookies.ReasonDeal, cookies.ReasonShare).
+		Scan(&lastGift); err != nil {
+		return 0, time.Time{}, err
 	}
 
-	if err := rows.Close(); err != nil {
-		return nil, err
+	if lastGift == 0 {
+		return current, time.Time{}, nil
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
+	return current, time.UnixMilli(int64(lastGift)), nil
+}
 
-	return res, nil
+func (r *SQLite) ListCookies(ctx context.Context) (map[string]int, error) {
+	return listBalances(ctx, r.db)
 }
 
-func (r *SQLite) AddCookie(ctx context.Context, user string, n int) (int, error) {
-	var (
-		userID   string
-		previous int
-		lastGift int
-	)
-
-	if err := r.db.QueryRowContext(ctx, getCookiesQuery, user).Scan(&userID, &previous, &lastGift); err != nil {
-		switch {
-		case errors.Is(err, sql.ErrNoRows):
-			// create user if not they don't exist
-			res, err := r.db.ExecContext(ctx, insertCookiesQuery, user, n, int(r.clock.Now().UnixMilli()))
-			if err != nil {
-				return 0, err
-			}
-
-			rowsAffected, err := res.RowsAffected()
-			if err != nil {
-				return 0, err
-			}
-
-			if rowsAffected != 1 {
-				return 0, ErrUnexpectedRowsAffected
-			}
-
-			return n, nil
-		default:
-			return 0, err
-		}
+func (r *SQLite) AddCookie(ctx context.Context, actor, user string, n int) (int, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
 	}
 
-	current := previous + n
+	defer tx.Rollback()
 
-	res, err := r.db.ExecContext(ctx, addCookiesQuery, current, int(r.clock.Now().UnixMilli()), user)
-	if err != nil {
+	if err := insertTransaction(ctx, tx, user, actor, cookies.ReasonDeal, n, r.clock.Now()); err != nil {
 		return 0, err
 	}
 
-	rowsAffected, err := res.RowsAffected()
+	current, err := getBalance(ctx, tx, user)
 	if err != nil {
 		return 0, err
 	}
 
-	if rowsAffected != 1 {
-		return 0, ErrUnexpectedRowsAffected
+	if err := tx.Commit(); err != nil {
+		return 0, err
 	}
 
 	return current, nil
 }
 
 func (r *SQLite) SwapCookies(ctx context.Context, from, to string, n int) (int, int, error) {
-	var (
-		requesterID       string
-		requesterPrevious int
-		requesterLastGift int
-		targetID          string
-		targetPrevious    int
-		targetLastGift    int
-	)
-
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		return 0, 0, err
@@ -155,112 +98,135 @@ func (r *SQLite) SwapCookies(ctx context.Context, from, to string, n int) (int,
 
 	defer tx.Rollback()
 
-	if err := tx.QueryRowContext(ctx, getCookiesQuery, from).Scan(&requesterID, &requesterPrevious, &requesterLastGift); err != nil {
-		switch {
-		case errors.Is(err, sql.ErrNoRows):
-			return 0, 0, repository.ErrNotFound
-		default:
-			return 0, 0, err
-		}
+	// the requester needs to exist, it's OK if the target doesn't
+	if _, err := getBalance(ctx, tx, from); err != nil {
+		return 0, 0, err
 	}
 
-	var cookiesAdded bool
-
-	if err := tx.QueryRowContext(ctx, getCookiesQuery, to).Scan(&targetID, &targetPrevious, &targetLastGift); err != nil {
-		switch {
-		case errors.Is(err, sql.ErrNoRows):
-			// create user if not they don't exist
-			res, err := tx.ExecContext(ctx, insertCookiesQuery, targetID, n, int(r.clock.Now().UnixMilli()))
-			if err != nil {
-				return 0, 0, err
-			}
-
-			rowsAffected, err := res.RowsAffected()
-			if err != nil {
-				return 0, 0, err
-			}
-
-			if rowsAffected != 1 {
-				return 0, 0, ErrUnexpectedRowsAffected
-			}
-
-			cookiesAdded = true
-		default:
-			return 0, 0, err
-		}
+	now := r.clock.Now()
+
+	if err := insertTransaction(ctx, tx, from, from, cookies.ReasonShare, -n, now); err != nil {
+		return 0, 0, err
 	}
 
-	requesterCurrent := requesterPrevious - n
-	res, err := tx.ExecContext(ctx, addCookiesQuery, requesterCurrent, int(r.clock.Now().UnixMilli()), requesterID)
+	if err := insertTransaction(ctx, tx, to, from, cookies.ReasonShare, n, now); err != nil {
+		return 0, 0, err
+	}
+
+	requesterCurrent, err := getBalance(ctx, tx, from)
 	if err != nil {
 		return 0, 0, err
 	}
 
-	rowsAffected, err := res.RowsAffected()
+	targetCurrent, err := getBalance(ctx, tx, to)
 	if err != nil {
 		return 0, 0, err
 	}
 
-	if rowsAffected != 1 {
-		return 0, 0, ErrUnexpectedRowsAffected
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
 	}
 
-	targetCurrent := n
-	if !cookiesAdded {
-		targetCurrent = targetPrevious + n
-		res, err = tx.ExecContext(ctx, addCookiesQuery, targetCurrent, int(r.clock.Now().UnixMilli()), targetID)
-		if err != nil {
-			return 0, 0, err
-		}
+	return requesterCurrent, targetCurrent, nil
+}
 
-		rowsAffected, err = res.RowsAffected()
-		if err != nil {
-			return 0, 0, err
-		}
+func (r *SQLite) EatCookie(ctx context.Context, user string) (int, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
 
-		if rowsAffected != 1 {
-			return 0, 0, ErrUnexpectedRowsAffected
-		}
+	defer tx.Rollback()
+
+	if _, err := getBalance(ctx, tx, user); err != nil {
+		return 0, err
+	}
+
+	if err := insertTransaction(ctx, tx, user, user, cookies.ReasonEat, -1, r.clock.Now()); err != nil {
+		return 0, err
+	}
+
+	current, err := getBalance(ctx, tx, user)
+	if err != nil {
+		return 0, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		return 0, 0, err
+		return 0, err
 	}
 
-	return requesterCurrent, targetCurrent, nil
+	return current, nil
 }
 
-func (r *SQLite) EatCookie(ctx context.Context, user string) (int, error) {
-	var userID string
-	var previous int
-	var lastGift int
+// getBalance returns the user's cookies in the current season, or a repository.ErrNotFound error if they have no
+// transactions in the ledger, in any season.
+func getBalance(ctx context.Context, q querier, user string) (int, error) {
+	var count, current int
+
+	if err := q.QueryRowContext(ctx, getCookiesQuery, user).Scan(&count, &current); err != nil {
+		return 0, err
+	}
+
+	if count == 0 {
+		return 0, repository.ErrNotFound
+	}
+
+	return current, nil
+}
 
-	if err := r.db.QueryRowContext(ctx, getCookiesQuery, user).Scan(&userID, &previous, &lastGift); err != nil {
-		switch {
-		case errors.Is(err, sql.ErrNoRows):
-			return 0, repository.ErrNotFound
-		default:
-			return 0, err
+func listBalances(ctx context.Context, q querier) (map[string]int, error) {
+	rows, err := q.QueryContext(ctx, listCookiesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := make(map[string]int, minAlloc)
+
+	for rows.Next() {
+		var (
+			userID  string
+			current int
+		)
+
+		if err := rows.Scan(&userID, &current); err != nil {
+			return nil, err
 		}
+
+		res[userID] = current
 	}
 
-	current := previous - 1
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
 
-	res, err := r.db.ExecContext(ctx, addCookiesQuery, current, lastGift, user)
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func insertTransaction(
+	ctx context.Context, q querier,
+	user, actor string, reason cookies.Reason, n int, now time.Time,
+) error {
+	res, err := q.ExecContext(ctx, insertTransactionQuery, user, actor, string(reason), n, int(now.UnixMilli()))
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	if rowsAffected != 1 {
-		return 0, ErrUnexpectedRowsAffected
+		return ErrUnexpectedRowsAffected
 	}
 
-	return current, nil
+	return nil
 }
 
 func NewSQLite(db *sql.DB, clock Clock) *SQLite {
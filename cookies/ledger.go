@@ -0,0 +1,92 @@
+package cookies
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultLeaderboardSize = 10
+	DefaultHistorySize     = 10
+)
+
+// Reason describes why cookies were moved in a Transaction.
+type Reason string
+
+const (
+	// ReasonDeal is set when cookies are dealt to a user, by an admin or a user with the giver role.
+	ReasonDeal Reason = "deal"
+	// ReasonShare is set on both sides of a user sharing their own cookies with another user.
+	ReasonShare Reason = "share"
+	// ReasonEat is set when a user eats one of their cookies.
+	ReasonEat Reason = "eat"
+	// ReasonBake is set when a character bakes cookies from the crumbs gathered on each Tick.
+	ReasonBake Reason = "bake"
+	// ReasonImport is set on the balances carried over from before the ledger existed.
+	ReasonImport Reason = "import"
+)
+
+// Transaction is an entry in the cookies ledger, which is append-only. A user's balance is the sum of the amounts of
+// their transactions in the current Season.
+type Transaction struct {
+	ID     int64
+	Season int
+	// User is the user whose balance changes by Amount.
+	User string
+	// Actor is the user responsible for the transaction, like the admin dealing cookies.
+	Actor     string
+	Reason    Reason
+	Amount    int
+	Timestamp time.Time
+}
+
+// Season is a period in which users collect cookies. Starting a new season archives the standings of the current one,
+// and resets all balances.
+type Season struct {
+	ID        int
+	StartedAt time.Time
+	// EndedAt is zero while the season is still running.
+	EndedAt time.Time
+}
+
+func (s Season) Current() bool {
+	return s.EndedAt.IsZero()
+}
+
+// Standing is a user's position in a season's leaderboard.
+type Standing struct {
+	Position int
+	User     string
+	Cookies  int
+}
+
+// NewStandings ranks the input balances, with the most cookies first. Users tied on the same number of cookies share
+// the same position, skipping the following ones (1, 2, 2, 4).
+func NewStandings(balances map[string]int) []Standing {
+	standings := make([]Standing, 0, len(balances))
+
+	for user, n := range balances {
+		standings = append(standings, Standing{User: user, Cookies: n})
+	}
+
+	slices.SortFunc(standings, func(a, b Standing) int {
+		if c := cmp.Compare(b.Cookies, a.Cookies); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.User, b.User)
+	})
+
+	for i := range standings {
+		switch {
+		case i > 0 && standings[i].Cookies == standings[i-1].Cookies:
+			standings[i].Position = standings[i-1].Position
+		default:
+			standings[i].Position = i + 1
+		}
+	}
+
+	return standings
+}
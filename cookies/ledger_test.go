@@ -0,0 +1,60 @@
+package cookies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStandings(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		balances map[string]int
+		wants    []Standing
+	}{
+		{
+			name:  "Empty",
+			wants: []Standing{},
+		},
+		{
+			name:     "NoTies",
+			balances: map[string]int{"a": 1, "b": 3, "c": 2},
+			wants: []Standing{
+				{Position: 1, User: "b", Cookies: 3},
+				{Position: 2, User: "c", Cookies: 2},
+				{Position: 3, User: "a", Cookies: 1},
+			},
+		},
+		{
+			name:     "Ties",
+			balances: map[string]int{"a": 7, "b": 7, "c": 9, "d": 1},
+			wants: []Standing{
+				{Position: 1, User: "c", Cookies: 9},
+				{Position: 2, User: "a", Cookies: 7},
+				{Position: 2, User: "b", Cookies: 7},
+				{Position: 4, User: "d", Cookies: 1},
+			},
+		},
+		{
+			name:     "AllTied",
+			balances: map[string]int{"c": 2, "b": 2, "a": 2},
+			wants: []Standing{
+				{Position: 1, User: "a", Cookies: 2},
+				{Position: 1, User: "b", Cookies: 2},
+				{Position: 1, User: "c", Cookies: 2},
+			},
+		},
+		{
+			name:     "NegativeBalances",
+			balances: map[string]int{"a": -1, "b": 0},
+			wants: []Standing{
+				{Position: 1, User: "b", Cookies: 0},
+				{Position: 2, User: "a", Cookies: -1},
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			require.Equal(t, testcase.wants, NewStandings(testcase.balances))
+		})
+	}
+}
@@ -20,6 +20,13 @@ const (
 	commandSwap = "givecookies"
 	commandEat  = "eatcookie"
 
+	commandClass     = "chooseclass"
+	commandCharacter = "character"
+
+	commandLeaderboard = "leaderboard"
+	commandHistory     = "history"
+	commandSeason      = "newseason"
+
 	userLabel  = "User"
 	adminLabel = "Cookie Factory"
 
@@ -39,11 +46,36 @@ type CommandCallback func(ctx context.Context, s *discordgo.Session, i *discordg
 type Repository interface {
 	GetCookies(ctx context.Context, user string) (int, time.Time, error)
 	ListCookies(ctx context.Context) (map[string]int, error)
-	AddCookie(ctx context.Context, user string, n int) (int, error)
+	// AddCookie deals n cookies to the user on behalf of the actor.
+	AddCookie(ctx context.Context, actor, user string, n int) (int, error)
 	SwapCookies(ctx context.Context, from, to string, n int) (int, int, error)
 	EatCookie(ctx context.Context, user string) (int, error)
 }
 
+type CharacterRepository interface {
+	// GetCharacter returns the user's character, or a repository.ErrCharacterNotFound error if they don't have one.
+	GetCharacter(ctx context.Context, user string) (Character, error)
+	// CreateCharacter stores a new character, or returns a repository.ErrCharacterExists error if the user already
+	// has one.
+	CreateCharacter(ctx context.Context, character Character) error
+	ListCharacters(ctx context.Context) ([]Character, error)
+	// TickCharacter stores the character after a Tick, adding the cookies baked to the user's total.
+	TickCharacter(ctx context.Context, character Character, cookies int) error
+}
+
+type LedgerRepository interface {
+	// ListTransactions returns the user's latest transactions, most recent first.
+	ListTransactions(ctx context.Context, user string, limit int) ([]Transaction, error)
+	// GetSeason returns the season with the input ID, or the current season if the ID is zero. It returns a
+	// repository.ErrSeasonNotFound error if there is no such season.
+	GetSeason(ctx context.Context, id int) (Season, error)
+	// Leaderboard returns the top standings for the season with the input ID.
+	Leaderboard(ctx context.Context, season, limit int) ([]Standing, error)
+	// NewSeason archives the current season's standings and starts a new season, resetting all balances. It returns
+	// the new season and the archived standings.
+	NewSeason(ctx context.Context) (Season, []Standing, error)
+}
+
 type Clock interface {
 	Now() time.Time
 }
@@ -156,7 +188,7 @@ func (c *AddCommand) Callback(ctx context.Context, s *discordgo.Session, i *disc
 	c.logger.DebugContext(ctx, "adding cookies")
 
 	// add cookies to target user
-	n, err := c.repo.AddCookie(ctx, user.ID, cookies)
+	n, err := c.repo.AddCookie(ctx, requester.ID, user.ID, cookies)
 	if errors.Is(err, repository.ErrNotFound) {
 		c.logger.ErrorContext(ctx, "requester does not exist", slog.String("error", err.Error()))
 
@@ -318,8 +350,8 @@ func (c *ListCommand) Callback(ctx context.Context, s *discordgo.Session, i *dis
 
 	cookieMap, err := c.repo.ListCookies(ctx)
 
-	if errors.Is(err, repository.ErrNotFound) || len(cookieMap) == 0 {
-		c.logger.ErrorContext(ctx, "no users or cookies added yet", slog.String("error", err.Error()))
+	if errors.Is(err, repository.ErrNotFound) || (err == nil && len(cookieMap) == 0) {
+		c.logger.WarnContext(ctx, "no users or cookies added yet")
 
 		// no users found yet
 		label := userLabel
@@ -677,6 +709,353 @@ func (c *EatCommand) Elements() []ApplicationCommandOpts {
 	return nil
 }
 
+type ClassCommand struct {
+	logChannelID string
+
+	repo   CharacterRepository
+	clock  Clock
+	logger *slog.Logger
+}
+
+func (c *ClassCommand) Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	requester, _, err := getUser(i.Interaction, "")
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting requester context", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	class, err := getClass(i.ApplicationCommandData().Options)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting selected class", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	character, err := NewCharacter(requester.ID, class, c.clock.Now())
+	if err != nil {
+		c.logger.ErrorContext(ctx, "creating character", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	c.logger.DebugContext(ctx, "creating character", slog.String("class", class.String()))
+
+	err = c.repo.CreateCharacter(ctx, character)
+	if errors.Is(err, repository.ErrCharacterExists) {
+		c.logger.WarnContext(ctx, "requester already has a character")
+
+		existing, err := c.repo.GetCharacter(ctx, requester.ID)
+		if err != nil {
+			c.logger.ErrorContext(ctx, "failed to fetch requester's character", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		if _, err := s.ChannelMessageSendEmbed(c.logChannelID, EmbedClassAlreadySelected(existing)); err != nil {
+			c.logger.ErrorContext(ctx, "sending message",
+				slog.String("action", c.Name()),
+				slog.String("log_channel_id", c.logChannelID),
+				slog.String("error", err.Error()))
+		}
+
+		return &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: "you already have a class", Flags: discordgo.MessageFlagsEphemeral},
+		}, nil
+	}
+
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to create character", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	c.logger.DebugContext(ctx, "created character")
+
+	if _, err := s.ChannelMessageSendEmbed(c.logChannelID, EmbedCharacter("🧁 Chose a class", character)); err != nil {
+		c.logger.ErrorContext(ctx, "sending message",
+			slog.String("action", c.Name()),
+			slog.String("log_channel_id", c.logChannelID),
+			slog.String("error", err.Error()))
+	}
+
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: "chose a class!", Flags: discordgo.MessageFlagsEphemeral},
+	}, nil
+}
+
+func (c *ClassCommand) Name() string {
+	return commandClass
+}
+
+func (c *ClassCommand) Elements() []ApplicationCommandOpts {
+	classes := Classes()
+	choices := make([]string, 0, len(classes))
+
+	for _, class := range classes {
+		choices = append(choices, class.String())
+	}
+
+	return []ApplicationCommandOpts{
+		CommandWithChoices("class", "class to play as", true, choices...)}
+}
+
+type CharacterCommand struct {
+	logChannelID string
+
+	repo   CharacterRepository
+	logger *slog.Logger
+}
+
+func (c *CharacterCommand) Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	requester, _, err := getUser(i.Interaction, "")
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting requester context", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	// the user option is optional, defaulting to the requester's own character
+	user, err := getUserID(s, i.ApplicationCommandData().Options)
+	if err != nil {
+		user = requester
+	}
+
+	c.logger.DebugContext(ctx, "getting character for user")
+
+	character, err := c.repo.GetCharacter(ctx, user.ID)
+	if errors.Is(err, repository.ErrCharacterNotFound) {
+		c.logger.WarnContext(ctx, "user does not have a character", slog.String("error", err.Error()))
+
+		if _, err := s.ChannelMessageSendEmbed(c.logChannelID, EmbedCharacterNotFound(user.ID)); err != nil {
+			c.logger.ErrorContext(ctx, "sending message",
+				slog.String("action", c.Name()),
+				slog.String("log_channel_id", c.logChannelID),
+				slog.String("error", err.Error()))
+		}
+
+		return &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: "no character found", Flags: discordgo.MessageFlagsEphemeral},
+		}, nil
+	}
+
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to fetch character", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	c.logger.DebugContext(ctx, "fetched character for user")
+
+	if _, err := s.ChannelMessageSendEmbed(c.logChannelID, EmbedCharacter("🧁 Fetching character", character)); err != nil {
+		c.logger.ErrorContext(ctx, "sending message",
+			slog.String("action", c.Name()),
+			slog.String("log_channel_id", c.logChannelID),
+			slog.String("error", err.Error()))
+	}
+
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: "fetched character", Flags: discordgo.MessageFlagsEphemeral},
+	}, nil
+}
+
+func (c *CharacterCommand) Name() string {
+	return commandCharacter
+}
+
+func (c *CharacterCommand) Elements() []ApplicationCommandOpts {
+	return []ApplicationCommandOpts{
+		CommandWithElement("user", "user to fetch the character for", discordgo.ApplicationCommandOptionUser, false)}
+}
+
+type LeaderboardCommand struct {
+	logChannelID string
+
+	repo   LedgerRepository
+	logger *slog.Logger
+}
+
+func (c *LeaderboardCommand) Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	// the season option is optional, defaulting to the current season
+	season, err := c.repo.GetSeason(ctx, getSeasonID(i.ApplicationCommandData().Options))
+	if errors.Is(err, repository.ErrSeasonNotFound) {
+		c.logger.WarnContext(ctx, "season does not exist", slog.String("error", err.Error()))
+
+		return &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: "no such season", Flags: discordgo.MessageFlagsEphemeral},
+		}, nil
+	}
+
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to fetch season", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	c.logger.DebugContext(ctx, "getting leaderboard", slog.Int("season", season.ID))
+
+	standings, err := c.repo.Leaderboard(ctx, season.ID, DefaultLeaderboardSize)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to fetch leaderboard", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	c.logger.DebugContext(ctx, "fetched leaderboard")
+
+	if _, err := s.ChannelMessageSendEmbed(c.logChannelID, EmbedLeaderboard(season, standings)); err != nil {
+		c.logger.ErrorContext(ctx, "sending message",
+			slog.String("action", c.Name()),
+			slog.String("log_channel_id", c.logChannelID),
+			slog.String("error", err.Error()))
+	}
+
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: "fetched leaderboard", Flags: discordgo.MessageFlagsEphemeral},
+	}, nil
+}
+
+func (c *LeaderboardCommand) Name() string {
+	return commandLeaderboard
+}
+
+func (c *LeaderboardCommand) Elements() []ApplicationCommandOpts {
+	return []ApplicationCommandOpts{
+		CommandWithElement("season", "season to fetch the leaderboard for", discordgo.ApplicationCommandOptionInteger, false)}
+}
+
+type HistoryCommand struct {
+	logChannelID string
+
+	repo   LedgerRepository
+	logger *slog.Logger
+}
+
+func (c *HistoryCommand) Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	requester, _, err := getUser(i.Interaction, "")
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting requester context", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	// the user option is optional, defaulting to the requester's own history
+	user, err := getUserID(s, i.ApplicationCommandData().Options)
+	if err != nil {
+		user = requester
+	}
+
+	c.logger.DebugContext(ctx, "getting transactions for user")
+
+	transactions, err := c.repo.ListTransactions(ctx, user.ID, DefaultHistorySize)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to fetch transactions", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	c.logger.DebugContext(ctx, "fetched transactions for user", slog.Int("transactions", len(transactions)))
+
+	if _, err := s.ChannelMessageSendEmbed(c.logChannelID, EmbedHistory(user.ID, transactions)); err != nil {
+		c.logger.ErrorContext(ctx, "sending message",
+			slog.String("action", c.Name()),
+			slog.String("log_channel_id", c.logChannelID),
+			slog.String("error", err.Error()))
+	}
+
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: "fetched history", Flags: discordgo.MessageFlagsEphemeral},
+	}, nil
+}
+
+func (c *HistoryCommand) Name() string {
+	return commandHistory
+}
+
+func (c *HistoryCommand) Elements() []ApplicationCommandOpts {
+	return []ApplicationCommandOpts{
+		CommandWithElement("user", "user to fetch the history for", discordgo.ApplicationCommandOptionUser, false)}
+}
+
+type SeasonCommand struct {
+	adminList    []string
+	logChannelID string
+
+	repo   LedgerRepository
+	logger *slog.Logger
+}
+
+func (c *SeasonCommand) Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	requester, _, err := getUser(i.Interaction, "")
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting requester context", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	// only cookies admins are able to reset everyone's balances
+	isAdmin := slices.Contains(c.adminList, requester.ID)
+	c.logger.DebugContext(ctx, "admin check", slog.Bool("is_admin", isAdmin))
+
+	if !isAdmin {
+		c.logger.WarnContext(ctx, "user cannot start a new season")
+
+		if _, err := s.ChannelMessageSendEmbed(c.logChannelID, EmbedNoPermissionsToStartSeason(requester.ID)); err != nil {
+			c.logger.ErrorContext(ctx, "sending message",
+				slog.String("action", c.Name()),
+				slog.String("log_channel_id", c.logChannelID),
+				slog.String("error", err.Error()))
+		}
+
+		return &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{Content: "not allowed to start a new season", Flags: discordgo.MessageFlagsEphemeral},
+		}, nil
+	}
+
+	c.logger.DebugContext(ctx, "starting a new season")
+
+	season, standings, err := c.repo.NewSeason(ctx)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to start a new season", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	c.logger.InfoContext(ctx, "started a new season",
+		slog.Int("season", season.ID),
+		slog.Int("archived_standings", len(standings)))
+
+	if _, err := s.ChannelMessageSendEmbed(c.logChannelID,
+		EmbedNewSeason(requester.ID, season, standings[:min(len(standings), DefaultLeaderboardSize)]),
+	); err != nil {
+		c.logger.ErrorContext(ctx, "sending message",
+			slog.String("action", c.Name()),
+			slog.String("log_channel_id", c.logChannelID),
+			slog.String("error", err.Error()))
+	}
+
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: "started a new season!", Flags: discordgo.MessageFlagsEphemeral},
+	}, nil
+}
+
+func (c *SeasonCommand) Name() string {
+	return commandSeason
+}
+
+func (c *SeasonCommand) Elements() []ApplicationCommandOpts {
+	return nil
+}
+
 func NewAddCommand(
 	adminList []string, logChannelID string, giverRole string,
 	nonAdminMaxCookies int, thresh time.Duration,
@@ -731,6 +1110,26 @@ func NewEatCommand(adminList []string, logChannelID string, repo Repository, log
 	return &EatCommand{adminList: adminList, logChannelID: logChannelID, repo: repo, logger: logger}
 }
 
+func NewClassCommand(logChannelID string, repo CharacterRepository, clock Clock, logger *slog.Logger) *ClassCommand {
+	return &ClassCommand{logChannelID: logChannelID, repo: repo, clock: clock, logger: logger}
+}
+
+func NewCharacterCommand(logChannelID string, repo CharacterRepository, logger *slog.Logger) *CharacterCommand {
+	return &CharacterCommand{logChannelID: logChannelID, repo: repo, logger: logger}
+}
+
+func NewLeaderboardCommand(logChannelID string, repo LedgerRepository, logger *slog.Logger) *LeaderboardCommand {
+	return &LeaderboardCommand{logChannelID: logChannelID, repo: repo, logger: logger}
+}
+
+func NewHistoryCommand(logChannelID string, repo LedgerRepository, logger *slog.Logger) *HistoryCommand {
+	return &HistoryCommand{logChannelID: logChannelID, repo: repo, logger: logger}
+}
+
+func NewSeasonCommand(adminList []string, logChannelID string, repo LedgerRepository, logger *slog.Logger) *SeasonCommand {
+	return &SeasonCommand{adminList: adminList, logChannelID: logChannelID, repo: repo, logger: logger}
+}
+
 func CommandWithElement(name, desc string, typ discordgo.ApplicationCommandOptionType, req bool) ApplicationCommandOpts {
 	return func(command *discordgo.ApplicationCommand) {
 		command.Options = append(command.Options, &discordgo.ApplicationCommandOption{
@@ -742,6 +1141,27 @@ func CommandWithElement(name, desc string, typ discordgo.ApplicationCommandOptio
 	}
 }
 
+func CommandWithChoices(name, desc string, req bool, choices ...string) ApplicationCommandOpts {
+	return func(command *discordgo.ApplicationCommand) {
+		option := &discordgo.ApplicationCommandOption{
+			Name:        name,
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    req,
+			Description: desc,
+			Choices:     make([]*discordgo.ApplicationCommandOptionChoice, 0, len(choices)),
+		}
+
+		for _, choice := range choices {
+			option.Choices = append(option.Choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  choice,
+				Value: choice,
+			})
+		}
+
+		command.Options = append(command.Options, option)
+	}
+}
+
 func RegisterSlashCommand(logger *slog.Logger, command string, callback CommandCallback, opts ...ApplicationCommandOpts) (func(s *discordgo.Session, i *discordgo.InteractionCreate), *discordgo.ApplicationCommand) {
 	cmd := &discordgo.ApplicationCommand{
 		Name:        command,
@@ -830,3 +1250,24 @@ func getUserID(s *discordgo.Session, values []*discordgo.ApplicationCommandInter
 
 	return user.UserValue(s), nil
 }
+
+func getClass(values []*discordgo.ApplicationCommandInteractionDataOption) (Class, error) {
+	for _, opt := range values {
+		if opt.Name == "class" {
+			return ParseClass(opt.StringValue())
+		}
+	}
+
+	return ClassNone, ErrMalformedInteraction
+}
+
+// getSeasonID returns the season ID set in the interaction's options, or zero if unset.
+func getSeasonID(values []*discordgo.ApplicationCommandInteractionDataOption) int {
+	for _, opt := range values {
+		if opt.Name == "season" {
+			return int(opt.IntValue())
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,92 @@
+package cookies
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+const (
+	DefaultTickInterval = time.Hour
+	minTickInterval     = time.Second
+)
+
+// TickEngine advances the game's time, applying the ticks elapsed since each character's last one: recovering their
+// vitals and baking cookies from the crumbs they gather.
+//
+// Since the elapsed ticks are applied as a whole, characters catch up on the ticks they missed while the bot was down.
+type TickEngine struct {
+	interval time.Duration
+
+	repo   CharacterRepository
+	clock  Clock
+	logger *slog.Logger
+}
+
+func NewTickEngine(interval time.Duration, repo CharacterRepository, clock Clock, logger *slog.Logger) *TickEngine {
+	if interval < minTickInterval {
+		interval = minTickInterval
+	}
+
+	return &TickEngine{
+		interval: interval,
+		repo:     repo,
+		clock:    clock,
+		logger:   logger,
+	}
+}
+
+// Run applies ticks on each interval, until the input context is done.
+func (e *TickEngine) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.Tick(ctx); err != nil {
+				e.logger.ErrorContext(ctx, "applying tick", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// Tick applies the elapsed ticks to all characters, returning the errors raised by any of them.
+func (e *TickEngine) Tick(ctx context.Context) error {
+	characters, err := e.repo.ListCharacters(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := e.clock.Now()
+	errs := make([]error, 0, len(characters))
+
+	for i := range characters {
+		n := int(now.Sub(characters[i].LastTick) / e.interval)
+		if n <= 0 {
+			continue
+		}
+
+		cookies := characters[i].Tick(n)
+		characters[i].LastTick = characters[i].LastTick.Add(time.Duration(n) * e.interval)
+
+		if err := e.repo.TickCharacter(ctx, characters[i], cookies); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if cookies > 0 {
+			e.logger.DebugContext(ctx, "character baked cookies",
+				slog.String("user", characters[i].User),
+				slog.String("class", characters[i].Class.String()),
+				slog.Int("ticks", n),
+				slog.Int("cookies", cookies))
+		}
+	}
+
+	return errors.Join(errs...)
+}
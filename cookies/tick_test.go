@@ -0,0 +1,115 @@
+package cookies_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/cookies"
+	"github.com/zalgonoise/x/cookies/internal/repository/memory"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestTickEngine_Tick(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name    string
+		class   cookies.Class
+		elapsed time.Duration
+
+		wantCookies  int
+		wantCrumbs   int
+		wantLastTick time.Time
+	}{
+		{
+			name:         "WithinTheInterval",
+			class:        cookies.ClassGrandBaker,
+			elapsed:      59 * time.Minute,
+			wantLastTick: start,
+		},
+		{
+			name:         "SingleTick",
+			class:        cookies.ClassGrandBaker,
+			elapsed:      time.Hour,
+			wantCrumbs:   9,
+			wantLastTick: start.Add(time.Hour),
+		},
+		{
+			name:         "MissedTicks",
+			class:        cookies.ClassGrandBaker,
+			elapsed:      12 * time.Hour,
+			wantCookies:  1,
+			wantCrumbs:   8,
+			wantLastTick: start.Add(12 * time.Hour),
+		},
+		{
+			name:         "KeepsTheRemainder",
+			class:        cookies.ClassSugarAlchemist,
+			elapsed:      15*time.Hour + 30*time.Minute,
+			wantCookies:  1,
+			wantCrumbs:   5,
+			wantLastTick: start.Add(15 * time.Hour),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			clock := &fakeClock{now: start}
+			repo := memory.NewInMemory(clock)
+
+			character, err := cookies.NewCharacter("user", testcase.class, start)
+			require.NoError(t, err)
+			require.NoError(t, repo.CreateCharacter(ctx, character))
+
+			engine := cookies.NewTickEngine(time.Hour, repo, clock, slog.New(slog.DiscardHandler))
+
+			clock.now = start.Add(testcase.elapsed)
+			require.NoError(t, engine.Tick(ctx))
+
+			// ticking again at the same time is a no-op
+			require.NoError(t, engine.Tick(ctx))
+
+			character, err = repo.GetCharacter(ctx, "user")
+			require.NoError(t, err)
+			require.Equal(t, testcase.wantCrumbs, character.Crumbs)
+			require.Equal(t, testcase.wantLastTick, character.LastTick)
+
+			balance, _, err := repo.GetCookies(ctx, "user")
+			require.NoError(t, err)
+			require.Equal(t, testcase.wantCookies, balance)
+		})
+	}
+}
+
+func TestTickEngine_Tick_Remainder(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: start}
+	repo := memory.NewInMemory(clock)
+
+	character, err := cookies.NewCharacter("user", cookies.ClassGrandBaker, start)
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateCharacter(ctx, character))
+
+	engine := cookies.NewTickEngine(time.Hour, repo, clock, slog.New(slog.DiscardHandler))
+
+	clock.now = start.Add(90 * time.Minute)
+	require.NoError(t, engine.Tick(ctx))
+
+	// the 30 minutes left over from the previous run count towards the next tick
+	clock.now = start.Add(2 * time.Hour)
+	require.NoError(t, engine.Tick(ctx))
+
+	character, err = repo.GetCharacter(ctx, "user")
+	require.NoError(t, err)
+	require.Equal(t, 18, character.Crumbs)
+	require.Equal(t, start.Add(2*time.Hour), character.LastTick)
+}
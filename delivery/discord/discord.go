@@ -0,0 +1,52 @@
+// Package discord executes Discord webhooks through a delivery.Queue, so that their requests are retried on rate
+// limits and failures instead of being dropped.
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/switchupcb/dasgo/v10/dasgo"
+	"github.com/zalgonoise/x/discord/webhook"
+
+	"github.com/zalgonoise/x/delivery"
+)
+
+const webhookExecuteURLFormat = "https://discord.com/api/webhooks/%s/%s"
+
+// Queue executes a Discord webhook through a delivery.Queue. Its methods return the queued message's ID, as the
+// request is sent asynchronously.
+type Queue struct {
+	url   string
+	queue *delivery.Queue
+}
+
+func (q Queue) Execute(ctx context.Context, text string) (id int64, err error) {
+	return q.ExecuteContent(ctx, &dasgo.ExecuteWebhook{
+		Content: &text,
+	})
+}
+
+func (q Queue) ExecuteContent(ctx context.Context, content *dasgo.ExecuteWebhook) (id int64, err error) {
+	buf, err := json.Marshal(content)
+	if err != nil {
+		return 0, err
+	}
+
+	return q.queue.Enqueue(ctx, q.url, buf)
+}
+
+// NewQueue creates a Queue for the webhook URL, delivering its messages through the input delivery.Queue. A single
+// delivery.Queue can be shared across webhooks, so they share Discord's rate limit buckets.
+func NewQueue(url string, queue *delivery.Queue) (Queue, error) {
+	id, token, err := webhook.Extract(url)
+	if err != nil {
+		return Queue{}, err
+	}
+
+	return Queue{
+		url:   fmt.Sprintf(webhookExecuteURLFormat, id, token),
+		queue: queue,
+	}, nil
+}
@@ -0,0 +1,199 @@
+package discord_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/x/discord/webhook"
+
+	"github.com/zalgonoise/x/delivery"
+	"github.com/zalgonoise/x/delivery/discord"
+)
+
+// redirect sends requests to the test server instead of Discord.
+type redirect struct {
+	target *url.URL
+}
+
+func (r redirect) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// bucketServer replies like Discord, reporting every webhook in the same rate limit bucket, which allows two
+// requests every two seconds.
+type bucketServer struct {
+	mu        sync.Mutex
+	clock     *testClock
+	remaining int
+	resetAt   time.Time
+	requests  int
+	paths     []string
+	bodies    []string
+}
+
+func (s *bucketServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	now := s.clock.Now()
+	s.requests++
+
+	if !now.Before(s.resetAt) {
+		s.remaining = 2
+		s.resetAt = now.Add(2 * time.Second)
+	}
+
+	w.Header().Set("X-RateLimit-Bucket", "shared")
+	w.Header().Set("X-RateLimit-Reset-After", "2")
+
+	if s.remaining == 0 {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_ = json.NewEncoder(w).Encode(map[string]any{"retry_after": s.resetAt.Sub(now).Seconds(), "global": false})
+
+		return
+	}
+
+	s.remaining--
+	s.paths = append(s.paths, r.URL.Path)
+	s.bodies = append(s.bodies, string(body))
+
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(s.remaining))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *bucketServer) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+func (s *bucketServer) delivered() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.paths...)
+}
+
+func newTestQueue(t *testing.T) (*delivery.Queue, *bucketServer, *testClock) {
+	t.Helper()
+
+	clock := &testClock{now: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)}
+	srv := &bucketServer{clock: clock}
+
+	httpServer := httptest.NewServer(srv)
+	t.Cleanup(httpServer.Close)
+
+	target, err := url.Parse(httpServer.URL)
+	require.NoError(t, err)
+
+	return delivery.NewQueue(nil,
+		delivery.WithClient(&http.Client{Transport: redirect{target: target}}),
+		delivery.WithClock(clock),
+	), srv, clock
+}
+
+func TestQueue(t *testing.T) {
+	ctx := context.Background()
+	queue, srv, _ := newTestQueue(t)
+
+	h, err := discord.NewQueue("https://discord.com/api/webhooks/123/token", queue)
+	require.NoError(t, err)
+
+	_, err = h.Execute(ctx, "beep boop this is a test message")
+	require.NoError(t, err)
+
+	delivered, err := queue.Process(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	require.Equal(t, []string{"/api/webhooks/123/token"}, srv.delivered())
+	require.JSONEq(t, `{"content": "beep boop this is a test message"}`, srv.bodies[0])
+
+	_, err = discord.NewQueue("", queue)
+	require.ErrorIs(t, err, webhook.ErrEmptyURL)
+
+	_, err = discord.NewQueue("https://discord.com/api/webhooks/123", queue)
+	require.Error(t, err)
+}
+
+func TestQueue_SharedBucket(t *testing.T) {
+	ctx := context.Background()
+	queue, srv, clock := newTestQueue(t)
+
+	first, err := discord.NewQueue("https://discord.com/api/webhooks/1/first", queue)
+	require.NoError(t, err)
+
+	second, err := discord.NewQueue("https://discord.com/api/webhooks/2/second", queue)
+	require.NoError(t, err)
+
+	_, err = first.Execute(ctx, "one")
+	require.NoError(t, err)
+
+	_, err = second.Execute(ctx, "two")
+	require.NoError(t, err)
+
+	// both webhooks report the same bucket, and the second request exhausts it
+	delivered, err := queue.Process(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, delivered)
+
+	_, err = first.Execute(ctx, "three")
+	require.NoError(t, err)
+
+	// the first webhook waits for the bucket exhausted by the second one, without hitting a 429
+	clock.Add(time.Second)
+
+	delivered, err = queue.Process(ctx)
+	require.NoError(t, err)
+	require.Zero(t, delivered)
+	require.Equal(t, 2, srv.count())
+
+	clock.Add(time.Second)
+
+	delivered, err = queue.Process(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	require.Equal(t, []string{
+		"/api/webhooks/1/first",
+		"/api/webhooks/2/second",
+		"/api/webhooks/1/first",
+	}, srv.delivered())
+	require.Equal(t, 3, srv.count())
+
+	dead, err := queue.DeadLetters(ctx)
+	require.NoError(t, err)
+	require.Empty(t, dead)
+}
@@ -0,0 +1,39 @@
+module github.com/zalgonoise/x/delivery
+
+go 1.21.0
+
+toolchain go1.21.3
+
+require (
+	github.com/stretchr/testify v1.8.4
+	github.com/switchupcb/dasgo/v10 v10.0.0-20221206085309-6335a3c15f52
+	github.com/zalgonoise/cfg v1.0.0
+	github.com/zalgonoise/x/discord v0.0.0-20240624121040-fa2b30b0a485
+	github.com/zalgonoise/x/errs v0.0.0-20231028161929-130f85682aea
+	github.com/zalgonoise/x/slack v0.0.0-20231028163844-9788f3b3b512
+	modernc.org/sqlite v1.26.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/dustin/go-humanize v1.0.1 // indirect
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/kballard/go-shellquote v0.0.0-20180428030007-95032a82bc51 // indirect
+	github.com/mattn/go-isatty v0.0.16 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/remyoudompheng/bigfft v0.0.0-20230129092748-24d4a6f8daec // indirect
+	golang.org/x/mod v0.3.0 // indirect
+	golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab // indirect
+	golang.org/x/tools v0.0.0-20201124115921-2c860bdd6e78 // indirect
+	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+	lukechampine.com/uint128 v1.2.0 // indirect
+	modernc.org/cc/v3 v3.40.0 // indirect
+	modernc.org/ccgo/v3 v3.16.13 // indirect
+	modernc.org/libc v1.24.1 // indirect
+	modernc.org/mathutil v1.5.0 // indirect
+	modernc.org/memory v1.6.0 // indirect
+	modernc.org/opt v0.1.3 // indirect
+	modernc.org/strutil v1.1.3 // indirect
+	modernc.org/token v1.0.1 // indirect
+)
@@ -0,0 +1,153 @@
+package delivery
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// MemoryStore is a Store that keeps messages in memory; queued messages are lost when the process exits.
+type MemoryStore struct {
+	mu     sync.Mutex
+	nextID int64
+	queued []Message
+	dead   []Message
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Push(_ context.Context, msg Message) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	msg.ID = s.nextID
+	s.queued = append(s.queued, msg)
+
+	return msg.ID, nil
+}
+
+func (s *MemoryStore) Due(_ context.Context, now time.Time, limit int, exclude ...string) ([]Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	due := make([]Message, 0, len(s.queued))
+
+	for i := range s.queued {
+		if !s.queued[i].NextAttempt.After(now) && !slices.Contains(exclude, s.queued[i].URL) {
+			due = append(due, s.queued[i])
+		}
+	}
+
+	slices.SortStableFunc(due, func(a, b Message) int {
+		return a.NextAttempt.Compare(b.NextAttempt)
+	})
+
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+
+	return due, nil
+}
+
+func (s *MemoryStore) Next(_ context.Context, after time.Time) (time.Time, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		next time.Time
+		ok   bool
+	)
+
+	for i := range s.queued {
+		if s.queued[i].NextAttempt.After(after) && (!ok || s.queued[i].NextAttempt.Before(next)) {
+			next, ok = s.queued[i].NextAttempt, true
+		}
+	}
+
+	return next, ok, nil
+}
+
+func (s *MemoryStore) Retry(_ context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.index(msg.ID)
+	if idx < 0 {
+		return ErrMessageNotFound
+	}
+
+	s.queued[idx].Attempts = msg.Attempts
+	s.queued[idx].RateLimited = msg.RateLimited
+	s.queued[idx].NextAttempt = msg.NextAttempt
+	s.queued[idx].LastError = msg.LastError
+
+	return nil
+}
+
+func (s *MemoryStore) Ack(_ context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.index(id)
+	if idx < 0 {
+		return ErrMessageNotFound
+	}
+
+	s.queued = slices.Delete(s.queued, idx, idx+1)
+
+	return nil
+}
+
+func (s *MemoryStore) DeadLetter(_ context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.index(msg.ID)
+	if idx < 0 {
+		return ErrMessageNotFound
+	}
+
+	s.queued = slices.Delete(s.queued, idx, idx+1)
+	s.dead = append(s.dead, msg)
+
+	return nil
+}
+
+func (s *MemoryStore) DeadLetters(context.Context) ([]Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.dead), nil
+}
+
+func (s *MemoryStore) Redrive(_ context.Context, id int64, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := slices.IndexFunc(s.dead, func(msg Message) bool {
+		return msg.ID == id
+	})
+	if idx < 0 {
+		return ErrMessageNotFound
+	}
+
+	msg := s.dead[idx]
+	msg.Attempts = 0
+	msg.RateLimited = 0
+	msg.NextAttempt = now
+
+	s.dead = slices.Delete(s.dead, idx, idx+1)
+	s.queued = append(s.queued, msg)
+
+	return nil
+}
+
+func (s *MemoryStore) index(id int64) int {
+	return slices.IndexFunc(s.queued, func(msg Message) bool {
+		return msg.ID == id
+	})
+}
@@ -0,0 +1,65 @@
+package delivery
+
+import (
+	"context"
+	"time"
+
+	"github.com/zalgonoise/x/errs"
+)
+
+const (
+	errDomain = errs.Domain("x/delivery")
+
+	ErrEmpty    = errs.Kind("empty")
+	ErrNotFound = errs.Kind("not found")
+
+	ErrURL     = errs.Entity("URL")
+	ErrMessage = errs.Entity("message")
+)
+
+var (
+	ErrEmptyURL        = errs.WithDomain(errDomain, ErrEmpty, ErrURL)
+	ErrMessageNotFound = errs.WithDomain(errDomain, ErrNotFound, ErrMessage)
+)
+
+// Message is a webhook request waiting to be delivered, or dead-lettered after failing to.
+type Message struct {
+	ID   int64
+	URL  string
+	Body []byte
+
+	// Attempts is the number of failed delivery attempts; rate-limited attempts are only counted once they repeat
+	// too many times in a row.
+	Attempts int
+	// RateLimited is the number of consecutive rate-limited attempts not yet counted in Attempts.
+	RateLimited int
+	NextAttempt time.Time
+	// LastError describes the last failed delivery attempt.
+	LastError string
+
+	CreatedAt time.Time
+}
+
+// Store keeps the queued and dead-lettered messages.
+type Store interface {
+	// Push adds a message to the queue, returning its ID.
+	Push(ctx context.Context, msg Message) (int64, error)
+	// Due returns up to limit queued messages whose next attempt is due at the input time, in the order they should
+	// be delivered. Messages to the URLs in exclude, which are held back by rate limits, are skipped.
+	Due(ctx context.Context, now time.Time, limit int, exclude ...string) ([]Message, error)
+	// Next returns the earliest next attempt after the input time out of all queued messages, or false if there is
+	// none.
+	Next(ctx context.Context, after time.Time) (time.Time, bool, error)
+	// Retry updates a queued message's attempts, next attempt and last error.
+	Retry(ctx context.Context, msg Message) error
+	// Ack removes a delivered message from the queue.
+	Ack(ctx context.Context, id int64) error
+
+	// DeadLetter moves a message out of the queue, after it failed to be delivered.
+	DeadLetter(ctx context.Context, msg Message) error
+	// DeadLetters returns the dead-lettered messages, oldest first.
+	DeadLetters(ctx context.Context) ([]Message, error)
+	// Redrive moves a dead-lettered message back into the queue, resetting its attempts. It returns an
+	// ErrMessageNotFound error if there is no such dead-lettered message.
+	Redrive(ctx context.Context, id int64, now time.Time) error
+}
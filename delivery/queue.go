@@ -0,0 +1,369 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+)
+
+// maxResponseBody caps how much of a response body is read, to parse rate limits and describe errors.
+const maxResponseBody = 4096
+
+// Queue delivers webhook messages from a Store, retrying them with backoff when they fail, and holding them back
+// while their rate limit bucket is exhausted. Messages that fail with a client error, or that run out of attempts,
+// are dead-lettered and can be redriven.
+//
+// Messages are delivered at least once; a failing message does not hold back the messages queued after it.
+type Queue struct {
+	store Store
+
+	client       *http.Client
+	maxAttempts  int
+	maxRateLimit int
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	batchSize    int
+	pollInterval time.Duration
+
+	limits *rateLimits
+	wake   chan struct{}
+
+	clock  Clock
+	logger *slog.Logger
+}
+
+// NewQueue creates a Queue delivering messages from the input Store, or from an in-memory store if nil.
+func NewQueue(store Store, options ...cfg.Option[Config]) *Queue {
+	config := cfg.New(options...)
+
+	if store == nil {
+		store = NewMemoryStore()
+	}
+
+	if config.client == nil {
+		config.client = &http.Client{
+			Transport: http.DefaultTransport,
+			Timeout:   defaultTimeout,
+		}
+	}
+
+	if config.maxAttempts <= 0 {
+		config.maxAttempts = defaultMaxAttempts
+	}
+
+	if config.maxRateLimit <= 0 {
+		config.maxRateLimit = defaultMaxRateLimit
+	}
+
+	if config.minBackoff <= 0 {
+		config.minBackoff = defaultMinBackoff
+	}
+
+	if config.maxBackoff < config.minBackoff {
+		config.maxBackoff = max(defaultMaxBackoff, config.minBackoff)
+	}
+
+	if config.batchSize <= 0 {
+		config.batchSize = defaultBatchSize
+	}
+
+	if config.pollInterval <= 0 {
+		config.pollInterval = defaultPollInterval
+	}
+
+	if config.clock == nil {
+		config.clock = realClock{}
+	}
+
+	if config.handler == nil {
+		config.handler = slog.NewTextHandler(io.Discard, nil)
+	}
+
+	return &Queue{
+		store:        store,
+		client:       config.client,
+		maxAttempts:  config.maxAttempts,
+		maxRateLimit: config.maxRateLimit,
+		minBackoff:   config.minBackoff,
+		maxBackoff:   config.maxBackoff,
+		batchSize:    config.batchSize,
+		pollInterval: config.pollInterval,
+		limits:       newRateLimits(),
+		wake:         make(chan struct{}, 1),
+		clock:        config.clock,
+		logger:       slog.New(config.handler),
+	}
+}
+
+// Enqueue queues a JSON body to be posted to the URL, returning the message ID.
+func (q *Queue) Enqueue(ctx context.Context, url string, body []byte) (int64, error) {
+	if url == "" {
+		return 0, ErrEmptyURL
+	}
+
+	now := q.clock.Now()
+
+	id, err := q.store.Push(ctx, Message{
+		URL:         url,
+		Body:        body,
+		NextAttempt: now,
+		CreatedAt:   now,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	q.notify()
+
+	return id, nil
+}
+
+// DeadLetters returns the messages that failed to be delivered.
+func (q *Queue) DeadLetters(ctx context.Context) ([]Message, error) {
+	return q.store.DeadLetters(ctx)
+}
+
+// Redrive queues a dead-lettered message again, with a fresh set of attempts.
+func (q *Queue) Redrive(ctx context.Context, id int64) error {
+	if err := q.store.Redrive(ctx, id, q.clock.Now()); err != nil {
+		return err
+	}
+
+	q.notify()
+
+	return nil
+}
+
+// Run delivers messages as they become due, until the context is canceled.
+func (q *Queue) Run(ctx context.Context) error {
+	for {
+		_, next, err := q.process(ctx)
+		if err != nil {
+			q.logger.ErrorContext(ctx, "processing delivery queue", slog.String("error", err.Error()))
+		}
+
+		wait := q.pollInterval
+
+		if !next.IsZero() {
+			wait = min(wait, next.Sub(q.clock.Now()))
+		}
+
+		timer := time.NewTimer(max(wait, 0))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		case <-q.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// Process attempts to deliver the messages that are due, once, returning the number of messages delivered.
+func (q *Queue) Process(ctx context.Context) (int, error) {
+	delivered, _, err := q.process(ctx)
+
+	return delivered, err
+}
+
+// process attempts to deliver a batch of due messages, returning the number of messages delivered and the time the
+// next message is due, if any.
+func (q *Queue) process(ctx context.Context) (delivered int, next time.Time, err error) {
+	now := q.clock.Now()
+
+	// rate-limited URLs are left out of the batch, so that they don't take up the slots of other webhooks
+	blocked, next, global := q.limits.blocked(now)
+	if global {
+		return 0, next, nil
+	}
+
+	messages, err := q.store.Due(ctx, now, q.batchSize, blocked...)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	var attempted int
+
+	for i := range messages {
+		if ctx.Err() != nil {
+			return delivered, time.Time{}, ctx.Err()
+		}
+
+		// the URL's bucket may be exhausted by an earlier message in the batch
+		if until := q.limits.blockedUntil(messages[i].URL, q.clock.Now()); !until.IsZero() {
+			next = earliest(next, until)
+
+			continue
+		}
+
+		attempted++
+
+		ok, err := q.deliver(ctx, messages[i])
+		if err != nil {
+			return delivered, time.Time{}, err
+		}
+
+		if ok {
+			delivered++
+		}
+	}
+
+	// a full batch may leave more messages due, unless it was held back by rate limits
+	if len(messages) == q.batchSize && attempted > 0 {
+		return delivered, q.clock.Now(), nil
+	}
+
+	queued, ok, err := q.store.Next(ctx, now)
+	if err != nil {
+		return delivered, time.Time{}, err
+	}
+
+	if ok {
+		next = earliest(next, queued)
+	}
+
+	return delivered, next, nil
+}
+
+// deliver posts the message, acknowledging, rescheduling or dead-lettering it depending on the response. Errors are
+// only returned when updating the store fails.
+func (q *Queue) deliver(ctx context.Context, msg Message) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, msg.URL, bytes.NewReader(msg.Body))
+	if err != nil {
+		return false, q.deadLetter(ctx, msg, err.Error())
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	res, err := q.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+
+		return false, q.retry(ctx, msg, err.Error())
+	}
+
+	defer res.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseBody))
+	now := q.clock.Now()
+
+	q.limits.observe(msg.URL, res.Header, now)
+
+	switch {
+	case res.StatusCode < 300:
+		q.logger.DebugContext(ctx, "delivered message",
+			slog.Int64("id", msg.ID),
+			slog.Int("attempts", msg.Attempts),
+			slog.String("status", res.Status))
+
+		return true, q.store.Ack(ctx, msg.ID)
+	case res.StatusCode == http.StatusTooManyRequests:
+		msg.NextAttempt = q.limits.limit(msg.URL, res.Header, body, now, now.Add(q.minBackoff))
+		msg.LastError = describe(res, body)
+		msg.RateLimited++
+
+		// a message rate limited over and over counts as a failed attempt, so it is not retried forever
+		if msg.RateLimited >= q.maxRateLimit {
+			msg.RateLimited = 0
+			msg.Attempts++
+
+			if msg.Attempts >= q.maxAttempts {
+				return false, q.deadLetter(ctx, msg, msg.LastError)
+			}
+		}
+
+		q.logger.WarnContext(ctx, "rate limited",
+			slog.Int64("id", msg.ID),
+			slog.Int("attempts", msg.Attempts),
+			slog.Int("rate_limited", msg.RateLimited),
+			slog.Time("retry_at", msg.NextAttempt))
+
+		return false, q.store.Retry(ctx, msg)
+	case res.StatusCode >= 500 || res.StatusCode == http.StatusRequestTimeout:
+		return false, q.retry(ctx, msg, describe(res, body))
+	default:
+		// other client errors, like a deleted webhook or a malformed message, fail again if retried
+		return false, q.deadLetter(ctx, msg, describe(res, body))
+	}
+}
+
+func (q *Queue) retry(ctx context.Context, msg Message, reason string) error {
+	msg.Attempts++
+	msg.RateLimited = 0
+	msg.LastError = reason
+
+	if msg.Attempts >= q.maxAttempts {
+		return q.deadLetter(ctx, msg, reason)
+	}
+
+	msg.NextAttempt = q.clock.Now().Add(q.backoff(msg.Attempts))
+
+	q.logger.WarnContext(ctx, "delivery failed, retrying",
+		slog.Int64("id", msg.ID),
+		slog.Int("attempts", msg.Attempts),
+		slog.Time("retry_at", msg.NextAttempt),
+		slog.String("error", reason))
+
+	return q.store.Retry(ctx, msg)
+}
+
+func (q *Queue) deadLetter(ctx context.Context, msg Message, reason string) error {
+	msg.LastError = reason
+
+	q.logger.ErrorContext(ctx, "delivery failed, dead-lettering message",
+		slog.Int64("id", msg.ID),
+		slog.Int("attempts", msg.Attempts),
+		slog.String("error", reason))
+
+	return q.store.DeadLetter(ctx, msg)
+}
+
+// backoff doubles the delay on each attempt, starting from minBackoff and capped at maxBackoff.
+func (q *Queue) backoff(attempts int) time.Duration {
+	d := q.minBackoff
+
+	for i := 1; i < attempts && d < q.maxBackoff; i++ {
+		d *= 2
+	}
+
+	return min(d, q.maxBackoff)
+}
+
+func (q *Queue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func describe(res *http.Response, body []byte) string {
+	if len(body) == 0 {
+		return fmt.Sprintf("HTTP request failed: status %s", res.Status)
+	}
+
+	return fmt.Sprintf("HTTP request failed: status %s: %s", res.Status, body)
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || b.Before(a) {
+		return b
+	}
+
+	return a
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
@@ -0,0 +1,118 @@
+package delivery
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/zalgonoise/cfg"
+)
+
+const (
+	defaultMaxAttempts  = 5
+	defaultMaxRateLimit = 10
+	defaultMinBackoff   = time.Second
+	defaultMaxBackoff   = 5 * time.Minute
+	defaultBatchSize    = 50
+	defaultPollInterval = time.Minute
+	defaultTimeout      = 15 * time.Second
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+type Config struct {
+	client       *http.Client
+	maxAttempts  int
+	maxRateLimit int
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	batchSize    int
+	pollInterval time.Duration
+
+	clock   Clock
+	handler slog.Handler
+}
+
+// WithClient sets the HTTP client used to deliver messages. Defaults to a client with a 15 seconds timeout.
+func WithClient(client *http.Client) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.client = client
+
+		return config
+	})
+}
+
+// WithMaxAttempts sets the number of failed attempts after which a message is dead-lettered. Defaults to 5.
+func WithMaxAttempts(attempts int) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.maxAttempts = attempts
+
+		return config
+	})
+}
+
+// WithMaxRateLimited sets the number of consecutive rate-limited attempts which count as one failed attempt, so
+// that a message that is always rate limited is eventually dead-lettered. Defaults to 10.
+func WithMaxRateLimited(attempts int) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.maxRateLimit = attempts
+
+		return config
+	})
+}
+
+// WithBackoff sets the delay before retrying a failed message, doubling from min on each attempt up to max.
+// Defaults to 1 second and 5 minutes.
+func WithBackoff(min, max time.Duration) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.minBackoff = min
+		config.maxBackoff = max
+
+		return config
+	})
+}
+
+// WithBatchSize sets the number of due messages read from the store at once. Defaults to 50.
+func WithBatchSize(size int) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.batchSize = size
+
+		return config
+	})
+}
+
+// WithPollInterval sets how often an idle queue checks the store for messages pushed by other processes. Defaults
+// to 1 minute.
+func WithPollInterval(interval time.Duration) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.pollInterval = interval
+
+		return config
+	})
+}
+
+func WithClock(clock Clock) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.clock = clock
+
+		return config
+	})
+}
+
+func WithLogger(logger *slog.Logger) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.handler = logger.Handler()
+
+		return config
+	})
+}
+
+func WithLogHandler(handler slog.Handler) cfg.Option[Config] {
+	return cfg.Register(func(config Config) Config {
+		config.handler = handler
+
+		return config
+	})
+}
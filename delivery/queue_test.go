@@ -0,0 +1,398 @@
+package delivery_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/delivery"
+)
+
+type response struct {
+	status int
+	header map[string]string
+	body   string
+}
+
+// server replies with the scripted responses in order, and with the last one once they run out.
+type server struct {
+	mu        sync.Mutex
+	responses []response
+	requests  []string
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.URL.Path)
+
+	res := s.responses[0]
+	if len(s.responses) > 1 {
+		s.responses = s.responses[1:]
+	}
+
+	for k, v := range res.header {
+		w.Header().Set(k, v)
+	}
+
+	w.WriteHeader(res.status)
+	_, _ = w.Write([]byte(res.body))
+}
+
+func (s *server) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.requests)
+}
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newTestQueue(t *testing.T, responses ...response) (*delivery.Queue, *server, *httptest.Server, *testClock) {
+	t.Helper()
+
+	srv := &server{responses: responses}
+	httpServer := httptest.NewServer(srv)
+	t.Cleanup(httpServer.Close)
+
+	clock := &testClock{now: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)}
+
+	queue := delivery.NewQueue(nil,
+		delivery.WithClient(httpServer.Client()),
+		delivery.WithMaxAttempts(3),
+		delivery.WithMaxRateLimited(2),
+		delivery.WithBackoff(time.Second, 10*time.Second),
+		delivery.WithClock(clock),
+	)
+
+	return queue, srv, httpServer, clock
+}
+
+func TestQueue_Process(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		responses []response
+		// steps are the clock advances between each Process call, after the first one
+		steps     []time.Duration
+		delivered int
+		requests  int
+		dead      string
+	}{
+		{
+			name:      "Delivered",
+			responses: []response{{status: http.StatusNoContent}},
+			delivered: 1,
+			requests:  1,
+		},
+		{
+			name: "ServerError/RetriedWithBackoff",
+			responses: []response{
+				{status: http.StatusInternalServerError},
+				{status: http.StatusBadGateway},
+				{status: http.StatusOK},
+			},
+			// not due before the 1s and 2s backoffs
+			steps:     []time.Duration{500 * time.Millisecond, 500 * time.Millisecond, time.Second, time.Second},
+			delivered: 1,
+			requests:  3,
+		},
+		{
+			name:      "ServerError/DeadLettered",
+			responses: []response{{status: http.StatusServiceUnavailable, body: "down"}},
+			steps:     []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+			requests:  3,
+			dead:      "503 Service Unavailable: down",
+		},
+		{
+			name:      "ClientError/DeadLettered",
+			responses: []response{{status: http.StatusNotFound, body: `{"message": "Unknown Webhook"}`}},
+			steps:     []time.Duration{time.Second},
+			requests:  1,
+			dead:      "Unknown Webhook",
+		},
+		{
+			name: "RateLimited/DiscordBody",
+			responses: []response{
+				{status: http.StatusTooManyRequests, header: map[string]string{"Retry-After": "3"},
+					body: `{"message": "You are being rate limited.", "retry_after": 2.5, "global": false}`},
+				{status: http.StatusNoContent},
+			},
+			steps:     []time.Duration{2 * time.Second, 500 * time.Millisecond},
+			delivered: 1,
+			requests:  2,
+		},
+		{
+			name: "RateLimited/RetryAfterHeader",
+			responses: []response{
+				{status: http.StatusTooManyRequests, header: map[string]string{"Retry-After": "3"}},
+				{status: http.StatusOK},
+			},
+			steps:     []time.Duration{2 * time.Second, time.Second},
+			delivered: 1,
+			requests:  2,
+		},
+		{
+			name: "RateLimited/CountedAfterConsecutive",
+			responses: []response{
+				{status: http.StatusTooManyRequests, header: map[string]string{"Retry-After": "1"}},
+				{status: http.StatusTooManyRequests, header: map[string]string{"Retry-After": "1"}},
+				{status: http.StatusTooManyRequests, header: map[string]string{"Retry-After": "1"}},
+				{status: http.StatusOK},
+			},
+			steps:     []time.Duration{time.Second, time.Second, time.Second},
+			delivered: 1,
+			requests:  4,
+		},
+		{
+			name: "RateLimited/DeadLettered",
+			responses: []response{
+				{status: http.StatusTooManyRequests, header: map[string]string{"Retry-After": "1"}, body: "slow down"},
+			},
+			// every second consecutive 429 counts as an attempt, out of 3
+			steps:    []time.Duration{time.Second, time.Second, time.Second, time.Second, time.Second, time.Second},
+			requests: 6,
+			dead:     "429 Too Many Requests: slow down",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			queue, srv, httpServer, clock := newTestQueue(t, testcase.responses...)
+
+			_, err := queue.Enqueue(context.Background(), httpServer.URL+"/webhook", []byte(`{"content":"hi"}`))
+			require.NoError(t, err)
+
+			delivered, err := queue.Process(context.Background())
+			require.NoError(t, err)
+
+			for _, step := range testcase.steps {
+				clock.Add(step)
+
+				n, err := queue.Process(context.Background())
+				require.NoError(t, err)
+
+				delivered += n
+			}
+
+			require.Equal(t, testcase.delivered, delivered)
+			require.Equal(t, testcase.requests, srv.count())
+
+			dead, err := queue.DeadLetters(context.Background())
+			require.NoError(t, err)
+
+			if testcase.dead == "" {
+				require.Empty(t, dead)
+
+				return
+			}
+
+			require.Len(t, dead, 1)
+			require.Contains(t, dead[0].LastError, testcase.dead)
+		})
+	}
+}
+
+func TestQueue_RateLimitBuckets(t *testing.T) {
+	t.Run("BucketExhausted", func(t *testing.T) {
+		queue, srv, httpServer, clock := newTestQueue(t,
+			response{status: http.StatusOK, header: map[string]string{
+				"X-RateLimit-Bucket":      "abcd",
+				"X-RateLimit-Remaining":   "0",
+				"X-RateLimit-Reset-After": "1.5",
+			}},
+			response{status: http.StatusOK},
+		)
+
+		for i := 0; i < 2; i++ {
+			_, err := queue.Enqueue(context.Background(), httpServer.URL+"/webhook", []byte(`{}`))
+			require.NoError(t, err)
+		}
+
+		// the second message waits for the bucket to reset
+		delivered, err := queue.Process(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, delivered)
+
+		clock.Add(time.Second)
+
+		delivered, err = queue.Process(context.Background())
+		require.NoError(t, err)
+		require.Zero(t, delivered)
+
+		clock.Add(500 * time.Millisecond)
+
+		delivered, err = queue.Process(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, delivered)
+		require.Equal(t, 2, srv.count())
+	})
+
+	t.Run("BlockedBucketDoesNotStarveOthers", func(t *testing.T) {
+		srv := &server{responses: []response{
+			{status: http.StatusOK, header: map[string]string{
+				"X-RateLimit-Bucket":      "abcd",
+				"X-RateLimit-Remaining":   "0",
+				"X-RateLimit-Reset-After": "10",
+			}},
+			{status: http.StatusOK},
+		}}
+		httpServer := httptest.NewServer(srv)
+		defer httpServer.Close()
+
+		queue := delivery.NewQueue(nil,
+			delivery.WithClient(httpServer.Client()),
+			delivery.WithBatchSize(2),
+			delivery.WithClock(&testClock{now: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)}),
+		)
+
+		for i := 0; i < 4; i++ {
+			_, err := queue.Enqueue(context.Background(), httpServer.URL+"/a", []byte(`{}`))
+			require.NoError(t, err)
+		}
+
+		_, err := queue.Enqueue(context.Background(), httpServer.URL+"/b", []byte(`{}`))
+		require.NoError(t, err)
+
+		// the first message exhausts /a's bucket, holding back the rest of the batch
+		delivered, err := queue.Process(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, delivered)
+
+		// the messages to /a still waiting are left out of the next batch, instead of filling it
+		delivered, err = queue.Process(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, delivered)
+		require.Equal(t, []string{"/a", "/b"}, srv.requests)
+	})
+
+	t.Run("GlobalLimit", func(t *testing.T) {
+		queue, srv, httpServer, clock := newTestQueue(t,
+			response{status: http.StatusTooManyRequests, header: map[string]string{"X-RateLimit-Global": "true"},
+				body: `{"retry_after": 2, "global": true}`},
+			response{status: http.StatusOK},
+		)
+
+		_, err := queue.Enqueue(context.Background(), httpServer.URL+"/a", []byte(`{}`))
+		require.NoError(t, err)
+
+		_, err = queue.Enqueue(context.Background(), httpServer.URL+"/b", []byte(`{}`))
+		require.NoError(t, err)
+
+		// a global rate limit holds back other webhooks too
+		delivered, err := queue.Process(context.Background())
+		require.NoError(t, err)
+		require.Zero(t, delivered)
+		require.Equal(t, 1, srv.count())
+
+		clock.Add(2 * time.Second)
+
+		delivered, err = queue.Process(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 2, delivered)
+	})
+}
+
+func TestQueue_Redrive(t *testing.T) {
+	queue, srv, httpServer, _ := newTestQueue(t,
+		response{status: http.StatusBadRequest, body: `{"content": ["Must be 2000 or fewer in length."]}`},
+		response{status: http.StatusOK},
+	)
+
+	id, err := queue.Enqueue(context.Background(), httpServer.URL+"/webhook", []byte(`{}`))
+	require.NoError(t, err)
+
+	_, err = queue.Process(context.Background())
+	require.NoError(t, err)
+
+	dead, err := queue.DeadLetters(context.Background())
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	require.Equal(t, id, dead[0].ID)
+
+	require.NoError(t, queue.Redrive(context.Background(), id))
+	require.ErrorIs(t, queue.Redrive(context.Background(), id), delivery.ErrMessageNotFound)
+
+	delivered, err := queue.Process(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	require.Equal(t, 2, srv.count())
+
+	dead, err = queue.DeadLetters(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, dead)
+}
+
+func TestQueue_NetworkError(t *testing.T) {
+	queue, _, httpServer, _ := newTestQueue(t, response{status: http.StatusOK})
+	httpServer.Close()
+
+	_, err := queue.Enqueue(context.Background(), httpServer.URL+"/webhook", []byte(`{}`))
+	require.NoError(t, err)
+
+	delivered, err := queue.Process(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, delivered)
+
+	// the message is kept for a retry
+	dead, err := queue.DeadLetters(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, dead)
+}
+
+func TestQueue_Run(t *testing.T) {
+	srv := &server{responses: []response{
+		{status: http.StatusTooManyRequests, body: `{"retry_after": 0.05, "global": false}`},
+		{status: http.StatusOK},
+	}}
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	queue := delivery.NewQueue(nil, delivery.WithClient(httpServer.Client()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- queue.Run(ctx)
+	}()
+
+	for i := 0; i < 3; i++ {
+		_, err := queue.Enqueue(ctx, httpServer.URL+"/webhook", []byte(`{}`))
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return srv.count() == 4
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	for _, path := range srv.requests {
+		require.True(t, strings.HasSuffix(path, "/webhook"))
+	}
+}
@@ -0,0 +1,188 @@
+package delivery
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	headerRetryAfter = "Retry-After"
+	// Discord rate limit headers: https://discord.com/developers/docs/topics/rate-limits
+	headerBucket     = "X-RateLimit-Bucket"
+	headerRemaining  = "X-RateLimit-Remaining"
+	headerResetAfter = "X-RateLimit-Reset-After"
+	headerGlobal     = "X-RateLimit-Global"
+
+	bucketPrefix = "bucket:"
+)
+
+// rateLimitBody is Discord's 429 response body, with a more precise retry delay than the Retry-After header.
+type rateLimitBody struct {
+	RetryAfter float64 `json:"retry_after"`
+	Global     bool    `json:"global"`
+}
+
+// rateLimits tracks when each rate limit bucket is available again. Discord shares a bucket across the webhook
+// URLs it reports with the same bucket header; other URLs are limited on their own.
+type rateLimits struct {
+	mu          sync.Mutex
+	buckets     map[string]string
+	until       map[string]time.Time
+	globalUntil time.Time
+}
+
+func newRateLimits() *rateLimits {
+	return &rateLimits{
+		buckets: make(map[string]string),
+		until:   make(map[string]time.Time),
+	}
+}
+
+// blockedUntil returns the time the URL's bucket is available again, or the zero time if it is available.
+func (r *rateLimits) blockedUntil(url string, now time.Time) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	until := r.until[r.key(url)]
+	if r.globalUntil.After(until) {
+		until = r.globalUntil
+	}
+
+	if !until.After(now) {
+		return time.Time{}
+	}
+
+	return until
+}
+
+// blocked returns the URLs whose bucket is not yet available, and the earliest time one of them is available again.
+// It returns true instead when all URLs are held back by a global rate limit.
+func (r *rateLimits) blocked(now time.Time) (urls []string, until time.Time, global bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.globalUntil.After(now) {
+		return nil, r.globalUntil, true
+	}
+
+	// the URLs with a known bucket, and the ones limited on their own
+	known := make(map[string]struct{}, len(r.buckets)+len(r.until))
+
+	for url := range r.buckets {
+		known[url] = struct{}{}
+	}
+
+	for key := range r.until {
+		if !strings.HasPrefix(key, bucketPrefix) {
+			known[key] = struct{}{}
+		}
+	}
+
+	for url := range known {
+		if t := r.until[r.key(url)]; t.After(now) {
+			urls = append(urls, url)
+
+			if until.IsZero() || t.Before(until) {
+				until = t
+			}
+		}
+	}
+
+	return urls, until, false
+}
+
+// observe learns the URL's bucket from the response headers, blocking it until it resets when it has no requests
+// remaining.
+func (r *rateLimits) observe(url string, header http.Header, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if bucket := header.Get(headerBucket); bucket != "" {
+		r.buckets[url] = bucket
+	}
+
+	if header.Get(headerRemaining) != "0" {
+		return
+	}
+
+	if resetAfter, ok := parseSeconds(header.Get(headerResetAfter)); ok {
+		r.until[r.key(url)] = now.Add(resetAfter)
+	}
+}
+
+// limit blocks the URL's bucket, or all buckets on a global rate limit, after a 429 response. It returns the time
+// the request can be retried.
+func (r *rateLimits) limit(url string, header http.Header, body []byte, now, fallback time.Time) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	until := fallback
+	global := strings.EqualFold(header.Get(headerGlobal), "true")
+
+	var limitBody rateLimitBody
+
+	switch {
+	case json.Unmarshal(body, &limitBody) == nil && limitBody.RetryAfter > 0:
+		until = now.Add(time.Duration(limitBody.RetryAfter * float64(time.Second)))
+		global = global || limitBody.Global
+	default:
+		if retryAfter, ok := parseRetryAfter(header.Get(headerRetryAfter), now); ok {
+			until = now.Add(retryAfter)
+		} else if resetAfter, ok := parseSeconds(header.Get(headerResetAfter)); ok {
+			until = now.Add(resetAfter)
+		}
+	}
+
+	if global {
+		r.globalUntil = until
+
+		return until
+	}
+
+	r.until[r.key(url)] = until
+
+	return until
+}
+
+func (r *rateLimits) key(url string) string {
+	if bucket, ok := r.buckets[url]; ok {
+		return bucketPrefix + bucket
+	}
+
+	return url
+}
+
+// parseRetryAfter reads a Retry-After header, either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if d, ok := parseSeconds(value); ok {
+		return d, true
+	}
+
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(t.Sub(now), 0), true
+}
+
+func parseSeconds(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+
+	return time.Duration(seconds * float64(time.Second)), true
+}
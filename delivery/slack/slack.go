@@ -0,0 +1,51 @@
+// Package slack executes Slack webhooks through a delivery.Queue, so that their requests are retried on rate limits
+// and failures instead of being dropped.
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/zalgonoise/x/slack/webhook"
+
+	"github.com/zalgonoise/x/delivery"
+)
+
+const webhookExecuteURLFormat = "https://hooks.slack.com/services/%s/%s/%s"
+
+// Queue executes a Slack webhook through a delivery.Queue. Its methods return the queued message's ID, as the
+// request is sent asynchronously.
+type Queue struct {
+	url   string
+	queue *delivery.Queue
+}
+
+func (q Queue) Execute(ctx context.Context, text string) (id int64, err error) {
+	return q.ExecuteContent(ctx, webhook.SlackWebhook{
+		Text: &text,
+	})
+}
+
+func (q Queue) ExecuteContent(ctx context.Context, content webhook.SlackWebhook) (id int64, err error) {
+	buf, err := json.Marshal(content)
+	if err != nil {
+		return 0, err
+	}
+
+	return q.queue.Enqueue(ctx, q.url, buf)
+}
+
+// NewQueue creates a Queue for the webhook URL, delivering its messages through the input delivery.Queue. Slack
+// limits each webhook on its own, replying with a Retry-After header once it is exceeded.
+func NewQueue(url string, queue *delivery.Queue) (Queue, error) {
+	team, channel, token, err := webhook.Extract(url)
+	if err != nil {
+		return Queue{}, err
+	}
+
+	return Queue{
+		url:   fmt.Sprintf(webhookExecuteURLFormat, team, channel, token),
+		queue: queue,
+	}, nil
+}
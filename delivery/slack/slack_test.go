@@ -0,0 +1,194 @@
+package slack_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/x/slack/webhook"
+
+	"github.com/zalgonoise/x/delivery"
+	"github.com/zalgonoise/x/delivery/slack"
+)
+
+// redirect sends requests to the test server instead of Slack.
+type redirect struct {
+	target *url.URL
+}
+
+func (r redirect) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// server replies like Slack, limiting the webhooks in `limited` with a plain-text 429 and a Retry-After header.
+type server struct {
+	mu       sync.Mutex
+	limited  map[string]string
+	requests []string
+	bodies   []string
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+
+	s.requests = append(s.requests, r.URL.Path)
+	s.bodies = append(s.bodies, string(body))
+
+	if retryAfter, ok := s.limited[r.URL.Path]; ok {
+		delete(s.limited, r.URL.Path)
+
+		w.Header().Set("Retry-After", retryAfter)
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte("rate_limited"))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
+
+func (s *server) paths() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+func newTestQueue(t *testing.T, limited map[string]string) (*delivery.Queue, *server, *testClock) {
+	t.Helper()
+
+	srv := &server{limited: limited}
+	clock := &testClock{now: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)}
+
+	httpServer := httptest.NewServer(srv)
+	t.Cleanup(httpServer.Close)
+
+	target, err := url.Parse(httpServer.URL)
+	require.NoError(t, err)
+
+	return delivery.NewQueue(nil,
+		delivery.WithClient(&http.Client{Transport: redirect{target: target}}),
+		delivery.WithClock(clock),
+	), srv, clock
+}
+
+func TestQueue(t *testing.T) {
+	ctx := context.Background()
+	queue, srv, _ := newTestQueue(t, nil)
+
+	h, err := slack.NewQueue("https://hooks.slack.com/services/T000/B000/token", queue)
+	require.NoError(t, err)
+
+	_, err = h.Execute(ctx, "beep boop this is a test message")
+	require.NoError(t, err)
+
+	delivered, err := queue.Process(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	require.Equal(t, []string{"/services/T000/B000/token"}, srv.paths())
+	require.JSONEq(t, `{"text": "beep boop this is a test message"}`, srv.bodies[0])
+
+	_, err = slack.NewQueue("", queue)
+	require.ErrorIs(t, err, webhook.ErrEmptyURL)
+}
+
+func TestQueue_RetryAfter(t *testing.T) {
+	for _, testcase := range []struct {
+		name       string
+		retryAfter func(now time.Time) string
+		wait       time.Duration
+	}{
+		{
+			name:       "Seconds",
+			retryAfter: func(time.Time) string { return "30" },
+			wait:       30 * time.Second,
+		},
+		{
+			name: "HTTPDate",
+			retryAfter: func(now time.Time) string {
+				return now.Add(time.Minute).Format(http.TimeFormat)
+			},
+			wait: time.Minute,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			start := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+
+			queue, srv, clock := newTestQueue(t, map[string]string{
+				"/services/T000/B001/limited": testcase.retryAfter(start),
+			})
+
+			limited, err := slack.NewQueue("https://hooks.slack.com/services/T000/B001/limited", queue)
+			require.NoError(t, err)
+
+			other, err := slack.NewQueue("https://hooks.slack.com/services/T000/B002/other", queue)
+			require.NoError(t, err)
+
+			_, err = limited.Execute(ctx, "one")
+			require.NoError(t, err)
+
+			_, err = other.Execute(ctx, "two")
+			require.NoError(t, err)
+
+			// Slack limits each webhook on its own, so the other webhook is not held back
+			delivered, err := queue.Process(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 1, delivered)
+
+			clock.Add(testcase.wait - time.Second)
+
+			delivered, err = queue.Process(ctx)
+			require.NoError(t, err)
+			require.Zero(t, delivered)
+			require.Len(t, srv.paths(), 2)
+
+			clock.Add(time.Second)
+
+			delivered, err = queue.Process(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 1, delivered)
+			require.Equal(t, []string{
+				"/services/T000/B001/limited",
+				"/services/T000/B002/other",
+				"/services/T000/B001/limited",
+			}, srv.paths())
+
+			dead, err := queue.DeadLetters(ctx)
+			require.NoError(t, err)
+			require.Empty(t, dead)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/zalgonoise/x/delivery"
+)
+
+const (
+	uriFormat = "file:%s?_pragma=busy_timeout(5000)"
+	inMemory  = ":memory:"
+
+	checkTableExists = `
+SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='messages');
+`
+
+	createTableQuery = `
+CREATE TABLE messages (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	url          TEXT    NOT NULL,
+	body         BLOB    NOT NULL,
+	attempts     INTEGER NOT NULL,
+	rate_limited INTEGER NOT NULL,
+	next_attempt INTEGER NOT NULL,
+	last_error   TEXT    NOT NULL,
+	created_at   INTEGER NOT NULL,
+	dead         INTEGER NOT NULL
+);
+
+CREATE INDEX idx_messages_due ON messages (dead, next_attempt, id);
+`
+
+	messageColumns = `id, url, body, attempts, rate_limited, next_attempt, last_error, created_at`
+
+	pushQuery = `
+INSERT INTO messages (url, body, attempts, rate_limited, next_attempt, last_error, created_at, dead)
+	VALUES (?, ?, ?, ?, ?, ?, ?, 0)`
+	dueQueryFormat = `SELECT ` + messageColumns + ` FROM messages
+	WHERE dead = 0 AND next_attempt <= ?%s ORDER BY next_attempt, id LIMIT ?`
+	excludeURLsFormat = ` AND url NOT IN (%s)`
+	nextQuery         = `SELECT MIN(next_attempt) FROM messages WHERE dead = 0 AND next_attempt > ?`
+	retryQuery        = `
+UPDATE messages SET attempts = ?, rate_limited = ?, next_attempt = ?, last_error = ?
+	WHERE id = ? AND dead = 0`
+	ackQuery        = `DELETE FROM messages WHERE id = ? AND dead = 0`
+	deadLetterQuery = `
+UPDATE messages SET attempts = ?, last_error = ?, dead = 1
+	WHERE id = ? AND dead = 0`
+	deadLettersQuery = `SELECT ` + messageColumns + ` FROM messages WHERE dead = 1 ORDER BY id`
+	redriveQuery     = `
+UPDATE messages SET attempts = 0, rate_limited = 0, next_attempt = ?, dead = 0
+	WHERE id = ? AND dead = 1`
+
+	// noLimit is SQLite's value for an unbounded LIMIT clause
+	noLimit = -1
+)
+
+// Store is a delivery.Store persisting messages in a SQLite database, so that queued and dead-lettered messages
+// survive restarts.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens the SQLite database in the input path, creating it if it doesn't exist. An empty path or ":memory:"
+// opens an in-memory database.
+func Open(uri string) (*sql.DB, error) {
+	switch uri {
+	case inMemory:
+	case "":
+		uri = inMemory
+	default:
+		if err := validateURI(uri); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := sql.Open("sqlite", fmt.Sprintf(uriFormat, uri))
+	if err != nil {
+		return nil, err
+	}
+
+	// a single connection keeps in-memory databases alive and serializes writes
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
+
+// New creates a Store in the database, creating its table if it doesn't exist.
+func New(ctx context.Context, db *sql.DB) (*Store, error) {
+	if err := initDatabase(ctx, db); err != nil {
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Push(ctx context.Context, msg delivery.Message) (int64, error) {
+	res, err := s.db.ExecContext(ctx, pushQuery,
+		msg.URL, msg.Body, msg.Attempts, msg.RateLimited, msg.NextAttempt.UnixMilli(), msg.LastError, msg.CreatedAt.UnixMilli())
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+func (s *Store) Due(ctx context.Context, now time.Time, limit int, exclude ...string) ([]delivery.Message, error) {
+	if limit <= 0 {
+		limit = noLimit
+	}
+
+	if len(exclude) == 0 {
+		return s.query(ctx, fmt.Sprintf(dueQueryFormat, ""), now.UnixMilli(), limit)
+	}
+
+	args := make([]any, 0, len(exclude)+2)
+	args = append(args, now.UnixMilli())
+
+	for i := range exclude {
+		args = append(args, exclude[i])
+	}
+
+	args = append(args, limit)
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(exclude)), ", ")
+
+	return s.query(ctx, fmt.Sprintf(dueQueryFormat, fmt.Sprintf(excludeURLsFormat, placeholders)), args...)
+}
+
+func (s *Store) Next(ctx context.Context, after time.Time) (time.Time, bool, error) {
+	var next sql.NullInt64
+
+	if err := s.db.QueryRowContext(ctx, nextQuery, after.UnixMilli()).Scan(&next); err != nil {
+		return time.Time{}, false, err
+	}
+
+	if !next.Valid {
+		return time.Time{}, false, nil
+	}
+
+	return time.UnixMilli(next.Int64), true, nil
+}
+
+func (s *Store) Retry(ctx context.Context, msg delivery.Message) error {
+	return s.exec(ctx, retryQuery, msg.Attempts, msg.RateLimited, msg.NextAttempt.UnixMilli(), msg.LastError, msg.ID)
+}
+
+func (s *Store) Ack(ctx context.Context, id int64) error {
+	return s.exec(ctx, ackQuery, id)
+}
+
+func (s *Store) DeadLetter(ctx context.Context, msg delivery.Message) error {
+	return s.exec(ctx, deadLetterQuery, msg.Attempts, msg.LastError, msg.ID)
+}
+
+func (s *Store) DeadLetters(ctx context.Context) ([]delivery.Message, error) {
+	return s.query(ctx, deadLettersQuery)
+}
+
+func (s *Store) Redrive(ctx context.Context, id int64, now time.Time) error {
+	return s.exec(ctx, redriveQuery, now.UnixMilli(), id)
+}
+
+// exec runs a statement updating a single message, returning a delivery.ErrMessageNotFound error if there is none.
+func (s *Store) exec(ctx context.Context, query string, args ...any) error {
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return delivery.ErrMessageNotFound
+	}
+
+	return nil
+}
+
+func (s *Store) query(ctx context.Context, query string, args ...any) ([]delivery.Message, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var messages []delivery.Message
+
+	for rows.Next() {
+		var (
+			msg         delivery.Message
+			nextAttempt int64
+			createdAt   int64
+		)
+
+		if err := rows.Scan(
+			&msg.ID, &msg.URL, &msg.Body, &msg.Attempts, &msg.RateLimited, &nextAttempt, &msg.LastError, &createdAt,
+		); err != nil {
+			return nil, err
+		}
+
+		msg.NextAttempt = time.UnixMilli(nextAttempt)
+		msg.CreatedAt = time.UnixMilli(createdAt)
+
+		messages = append(messages, msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func validateURI(uri string) error {
+	stat, err := os.Stat(uri)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			f, err := os.Create(uri)
+			if err != nil {
+				return err
+			}
+
+			return f.Close()
+		}
+
+		return err
+	}
+
+	if stat.IsDir() {
+		return fmt.Errorf("%s is a directory", uri)
+	}
+
+	return nil
+}
+
+func initDatabase(ctx context.Context, db *sql.DB) error {
+	var exists bool
+
+	if err := db.QueryRowContext(ctx, checkTableExists).Scan(&exists); err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	_, err := db.ExecContext(ctx, createTableQuery)
+
+	return err
+}
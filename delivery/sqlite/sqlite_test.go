@@ -0,0 +1,124 @@
+package sqlite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/delivery"
+	"github.com/zalgonoise/x/delivery/sqlite"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.UnixMilli(time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC).UnixMilli())
+
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "delivery.db"))
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	store, err := sqlite.New(ctx, db)
+	require.NoError(t, err)
+
+	first, err := store.Push(ctx, delivery.Message{URL: "a", Body: []byte(`{}`), NextAttempt: now, CreatedAt: now})
+	require.NoError(t, err)
+
+	second, err := store.Push(ctx, delivery.Message{
+		URL: "b", Body: []byte(`{}`), NextAttempt: now.Add(time.Minute), CreatedAt: now,
+	})
+	require.NoError(t, err)
+
+	due, err := store.Due(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, delivery.Message{ID: first, URL: "a", Body: []byte(`{}`), NextAttempt: now, CreatedAt: now}, due[0])
+
+	// messages to the excluded URLs are skipped
+	excluded, err := store.Due(ctx, now.Add(time.Minute), 10, "a", "c")
+	require.NoError(t, err)
+	require.Len(t, excluded, 1)
+	require.Equal(t, second, excluded[0].ID)
+
+	next, ok, err := store.Next(ctx, now)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, now.Add(time.Minute), next)
+
+	// retried messages are due after the ones already waiting
+	due[0].Attempts = 1
+	due[0].RateLimited = 2
+	due[0].NextAttempt = now.Add(2 * time.Minute)
+	due[0].LastError = "HTTP request failed: status 500 Internal Server Error"
+	require.NoError(t, store.Retry(ctx, due[0]))
+
+	due, err = store.Due(ctx, now.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	require.Equal(t, second, due[0].ID)
+	require.Equal(t, first, due[1].ID)
+	require.Equal(t, 1, due[1].Attempts)
+	require.Equal(t, 2, due[1].RateLimited)
+
+	require.NoError(t, store.Ack(ctx, second))
+	require.ErrorIs(t, store.Ack(ctx, second), delivery.ErrMessageNotFound)
+
+	require.NoError(t, store.DeadLetter(ctx, due[1]))
+
+	_, ok, err = store.Next(ctx, now)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	dead, err := store.DeadLetters(ctx)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	require.Equal(t, due[1].LastError, dead[0].LastError)
+
+	require.NoError(t, store.Redrive(ctx, first, now.Add(time.Hour)))
+	require.ErrorIs(t, store.Redrive(ctx, first, now), delivery.ErrMessageNotFound)
+
+	due, err = store.Due(ctx, now.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Zero(t, due[0].Attempts)
+	require.Zero(t, due[0].RateLimited)
+}
+
+func TestStore_Queue(t *testing.T) {
+	ctx := context.Background()
+
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer httpServer.Close()
+
+	path := filepath.Join(t.TempDir(), "delivery.db")
+
+	db, err := sqlite.Open(path)
+	require.NoError(t, err)
+
+	store, err := sqlite.New(ctx, db)
+	require.NoError(t, err)
+
+	_, err = delivery.NewQueue(store).Enqueue(ctx, httpServer.URL, []byte(`{"text":"hi"}`))
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// queued messages survive a restart
+	db, err = sqlite.Open(path)
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	store, err = sqlite.New(ctx, db)
+	require.NoError(t, err)
+
+	delivered, err := delivery.NewQueue(store, delivery.WithClient(httpServer.Client())).Process(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+}
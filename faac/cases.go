@@ -0,0 +1,206 @@
+package faac
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+var ErrCaseReversed = errors.New("case was already reversed")
+
+// CaseStatus describes whether a case's action still stands.
+type CaseStatus string
+
+const (
+	CaseOpen CaseStatus = "open"
+	// CaseReversed is set when a moderator reverses the case's action.
+	CaseReversed CaseStatus = "reversed"
+	// CaseAppealAccepted is set when a moderator reverses the case's action on appeal, adding the user to the allow
+	// list, so they bypass the screening rules when joining again.
+	CaseAppealAccepted CaseStatus = "appeal_accepted"
+)
+
+// Case is the record of a screening action taken on a member.
+type Case struct {
+	ID       int64
+	GuildID  string
+	UserID   string
+	Username string
+	Rule     string
+	Action   Action
+	Evidence []string
+	Raid     bool
+	Status   CaseStatus
+
+	CreatedAt time.Time
+
+	// Reversal is set once a moderator reverses the case.
+	Reversal *Reversal
+	Notes    []Note
+}
+
+// Reversal records a moderator undoing a case's action.
+type Reversal struct {
+	Moderator    string
+	Reason       string
+	AcceptAppeal bool
+	At           time.Time
+}
+
+// Note is a moderator's annotation on a case.
+type Note struct {
+	Author    string
+	Text      string
+	CreatedAt time.Time
+}
+
+type CaseRepository interface {
+	// CreateCase stores a new case, returning its ID.
+	CreateCase(ctx context.Context, c Case) (int64, error)
+	// GetCase returns the case with the input ID, with its notes, or a repository.ErrCaseNotFound error.
+	GetCase(ctx context.Context, id int64) (Case, error)
+	// ListCases returns the latest cases for the user, or for all users if empty, most recent first.
+	ListCases(ctx context.Context, userID string, limit int) ([]Case, error)
+	// CountCases returns the number of cases for the user, reversed or not.
+	CountCases(ctx context.Context, userID string) (int, error)
+	AnnotateCase(ctx context.Context, id int64, note Note) error
+	// ReverseCase marks the case as reversed, adding the user to the allow list if the appeal is accepted. It returns
+	// an ErrCaseReversed error if the case was already reversed.
+	ReverseCase(ctx context.Context, id int64, reversal Reversal) error
+	// IsAllowed reports whether the user was added to the allow list on an accepted appeal.
+	IsAllowed(ctx context.Context, userID string) (bool, error)
+}
+
+// CaseLog records the screening actions as cases, allowing moderators to annotate and reverse them.
+type CaseLog struct {
+	quarantineRoleID string
+
+	repo   CaseRepository
+	clock  Clock
+	logger *slog.Logger
+}
+
+func NewCaseLog(quarantineRoleID string, repo CaseRepository, clock Clock, logger *slog.Logger) *CaseLog {
+	return &CaseLog{
+		quarantineRoleID: quarantineRoleID,
+		repo:             repo,
+		clock:            clock,
+		logger:           logger,
+	}
+}
+
+// Record stores a case for the verdict's action, returning the case ID and the number of previous cases for the
+// member.
+func (l *CaseLog) Record(ctx context.Context, member *discordgo.Member, verdict Verdict) (int64, int, error) {
+	previous, err := l.repo.CountCases(ctx, member.User.ID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	id, err := l.repo.CreateCase(ctx, Case{
+		GuildID:   member.GuildID,
+		UserID:    member.User.ID,
+		Username:  member.User.Username,
+		Rule:      verdict.Rule,
+		Action:    verdict.Action,
+		Evidence:  verdict.Evidence,
+		Raid:      verdict.Raid,
+		Status:    CaseOpen,
+		CreatedAt: l.clock.Now(),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return id, previous, nil
+}
+
+func (l *CaseLog) Get(ctx context.Context, id int64) (Case, error) {
+	return l.repo.GetCase(ctx, id)
+}
+
+func (l *CaseLog) List(ctx context.Context, userID string, limit int) ([]Case, error) {
+	return l.repo.ListCases(ctx, userID, limit)
+}
+
+func (l *CaseLog) Allowed(ctx context.Context, userID string) (bool, error) {
+	return l.repo.IsAllowed(ctx, userID)
+}
+
+// Annotate adds a moderator's note to the case, returning the updated case.
+func (l *CaseLog) Annotate(ctx context.Context, id int64, author, text string) (Case, error) {
+	if err := l.repo.AnnotateCase(ctx, id, Note{
+		Author:    author,
+		Text:      text,
+		CreatedAt: l.clock.Now(),
+	}); err != nil {
+		return Case{}, err
+	}
+
+	return l.repo.GetCase(ctx, id)
+}
+
+// Reverse undoes the case's action where possible (lifting a ban or timeout, or removing the quarantine role) and
+// marks the case as reversed, returning the updated case. Kicks cannot be undone, but the user is able to join
+// again; accepting their appeal adds them to the allow list, so they bypass the screening rules when they do.
+func (l *CaseLog) Reverse(
+	ctx context.Context, session Session, id int64, moderator, reason string, acceptAppeal bool,
+) (Case, error) {
+	c, err := l.repo.GetCase(ctx, id)
+	if err != nil {
+		return Case{}, err
+	}
+
+	if c.Status != CaseOpen {
+		return Case{}, ErrCaseReversed
+	}
+
+	if err := l.undo(session, c); err != nil {
+		return Case{}, err
+	}
+
+	if err := l.repo.ReverseCase(ctx, id, Reversal{
+		Moderator:    moderator,
+		Reason:       reason,
+		AcceptAppeal: acceptAppeal,
+		At:           l.clock.Now(),
+	}); err != nil {
+		return Case{}, err
+	}
+
+	l.logger.InfoContext(ctx, "reversed case",
+		slog.Int64("case_id", id),
+		slog.String("action", string(c.Action)),
+		slog.String("user_id", c.UserID),
+		slog.String("moderator", moderator),
+		slog.Bool("appeal_accepted", acceptAppeal))
+
+	return l.repo.GetCase(ctx, id)
+}
+
+func (l *CaseLog) undo(session Session, c Case) error {
+	var err error
+
+	switch c.Action {
+	case ActionBan:
+		err = session.GuildBanDelete(c.GuildID, c.UserID)
+	case ActionTimeout:
+		err = session.GuildMemberTimeout(c.GuildID, c.UserID, nil)
+	case ActionQuarantine:
+		err = session.GuildMemberRoleRemove(c.GuildID, c.UserID, l.quarantineRoleID)
+	default:
+		return nil
+	}
+
+	// the user may have left the server, or been unbanned by hand
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
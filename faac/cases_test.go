@@ -0,0 +1,201 @@
+package faac
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/faac/internal/repository"
+)
+
+type fakeCaseRepository struct {
+	cases   []Case
+	allowed map[string]bool
+}
+
+func (r *fakeCaseRepository) CreateCase(_ context.Context, c Case) (int64, error) {
+	c.ID = int64(len(r.cases) + 1)
+	r.cases = append(r.cases, c)
+
+	return c.ID, nil
+}
+
+func (r *fakeCaseRepository) GetCase(_ context.Context, id int64) (Case, error) {
+	if id <= 0 || int(id) > len(r.cases) {
+		return Case{}, repository.ErrCaseNotFound
+	}
+
+	return r.cases[id-1], nil
+}
+
+func (r *fakeCaseRepository) ListCases(_ context.Context, userID string, limit int) ([]Case, error) {
+	res := make([]Case, 0, len(r.cases))
+
+	for _, c := range slices.Backward(r.cases) {
+		if userID == "" || c.UserID == userID {
+			res = append(res, c)
+		}
+	}
+
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+
+	return res, nil
+}
+
+func (r *fakeCaseRepository) CountCases(_ context.Context, userID string) (int, error) {
+	var count int
+
+	for i := range r.cases {
+		if r.cases[i].UserID == userID {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *fakeCaseRepository) AnnotateCase(_ context.Context, id int64, note Note) error {
+	if id <= 0 || int(id) > len(r.cases) {
+		return repository.ErrCaseNotFound
+	}
+
+	r.cases[id-1].Notes = append(r.cases[id-1].Notes, note)
+
+	return nil
+}
+
+func (r *fakeCaseRepository) ReverseCase(_ context.Context, id int64, reversal Reversal) error {
+	if id <= 0 || int(id) > len(r.cases) {
+		return repository.ErrCaseNotFound
+	}
+
+	if r.cases[id-1].Status != CaseOpen {
+		return ErrCaseReversed
+	}
+
+	r.cases[id-1].Status = CaseReversed
+	r.cases[id-1].Reversal = &reversal
+
+	if reversal.AcceptAppeal {
+		r.cases[id-1].Status = CaseAppealAccepted
+		r.allowed[r.cases[id-1].UserID] = true
+	}
+
+	return nil
+}
+
+func (r *fakeCaseRepository) IsAllowed(_ context.Context, userID string) (bool, error) {
+	return r.allowed[userID], nil
+}
+
+func TestCaseLog_Reverse(t *testing.T) {
+	now := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	for _, testcase := range []struct {
+		name         string
+		daysOld      int
+		username     string
+		avatar       string
+		acceptAppeal bool
+		wantAction   Action
+		wantUndo     []call
+		wantStatus   CaseStatus
+	}{
+		{
+			name:       "Ban/Unban",
+			daysOld:    1,
+			username:   "Free-Nitro",
+			wantAction: ActionBan,
+			wantUndo:   []call{{method: "unban"}},
+			wantStatus: CaseReversed,
+		},
+		{
+			name:       "Kick/NothingToUndo",
+			daysOld:    1,
+			username:   "gopher",
+			avatar:     "avatar",
+			wantAction: ActionKick,
+			wantStatus: CaseReversed,
+		},
+		{
+			name:         "Ban/AppealAccepted",
+			daysOld:      1,
+			username:     "Free-Nitro",
+			acceptAppeal: true,
+			wantAction:   ActionBan,
+			wantUndo:     []call{{method: "unban"}},
+			wantStatus:   CaseAppealAccepted,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			repo := &fakeCaseRepository{allowed: make(map[string]bool)}
+			clock := &testClock{now: now}
+			cases := NewCaseLog(roleID, repo, clock, logger)
+
+			screener, err := NewScreener(logChannelID, testConfig(), clock, logger, WithCaseLog(cases))
+			require.NoError(t, err)
+
+			session := &fakeSession{}
+			member := newMember(now, testcase.daysOld, testcase.username, testcase.avatar)
+
+			verdict, err := screener.Screen(t.Context(), session, member)
+			require.NoError(t, err)
+			require.Equal(t, testcase.wantAction, verdict.Action)
+			require.Equal(t, int64(1), verdict.CaseID)
+			require.Zero(t, verdict.PreviousCases)
+
+			session.calls = nil
+
+			c, err := cases.Reverse(t.Context(), session, verdict.CaseID, "moderator", "false positive",
+				testcase.acceptAppeal)
+			require.NoError(t, err)
+			require.Equal(t, testcase.wantStatus, c.Status)
+			require.Equal(t, "moderator", c.Reversal.Moderator)
+
+			for i := range testcase.wantUndo {
+				testcase.wantUndo[i].userID = member.User.ID
+			}
+
+			require.Equal(t, testcase.wantUndo, session.calls)
+
+			// reversing the case again fails
+			_, err = cases.Reverse(t.Context(), session, verdict.CaseID, "moderator", "", false)
+			require.ErrorIs(t, err, ErrCaseReversed)
+
+			// joining again bypasses the rules only if the appeal was accepted, otherwise a new case is recorded
+			verdict, err = screener.Screen(t.Context(), session, member)
+			require.NoError(t, err)
+			require.Equal(t, testcase.acceptAppeal, verdict.Bypassed)
+
+			if !testcase.acceptAppeal {
+				require.Equal(t, int64(2), verdict.CaseID)
+				require.Equal(t, 1, verdict.PreviousCases)
+			}
+		})
+	}
+}
+
+func TestCaseLog_Annotate(t *testing.T) {
+	now := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	cases := NewCaseLog(roleID, &fakeCaseRepository{allowed: make(map[string]bool)}, &testClock{now: now}, logger)
+
+	_, err := cases.Annotate(t.Context(), 1, "moderator", "note")
+	require.ErrorIs(t, err, repository.ErrCaseNotFound)
+
+	id, _, err := cases.Record(t.Context(), newMember(now, 1, "gopher", ""), Verdict{Rule: "no-avatar", Action: ActionLog})
+	require.NoError(t, err)
+
+	c, err := cases.Annotate(t.Context(), id, "moderator", "known alt account")
+	require.NoError(t, err)
+	require.Equal(t, []Note{{Author: "moderator", Text: "known alt account", CreatedAt: now}}, c.Notes)
+}
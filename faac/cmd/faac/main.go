@@ -9,12 +9,14 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/zalgonoise/x/cli/v2"
 
 	"github.com/zalgonoise/x/faac"
 	"github.com/zalgonoise/x/faac/internal/log"
+	"github.com/zalgonoise/x/faac/internal/repository/sqlite"
 )
 
 const (
@@ -26,8 +28,15 @@ var (
 	ErrNoToken        = errors.New("no token provided")
 	ErrNoLogChannelID = errors.New("no log channel ID provided")
 	ErrDaysAgeTooLow  = errors.New("configured account age threshold is too low")
+	ErrNoAppID        = errors.New("no app ID provided, required to register the case log's commands")
 )
 
+type Command interface {
+	Name() string
+	Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error)
+	Elements() []faac.ApplicationCommandOpts
+}
+
 func main() {
 	logger := log.New("debug", true, true)
 
@@ -52,6 +61,10 @@ func ExecBot(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 	logChannelID := fs.String("chan", "", "log channel id")
 	daysAge := fs.Int("days", defaultDaysAge, "min days age")
 	allowedUsers := fs.String("allowlist", "", "comma-separated list of user IDs that should bypass this rule")
+	configPath := fs.String("config", "", "path to a JSON file with the join screening rules, replacing the -days rule")
+	dbPath := fs.String("db-path", "", "SQLite database path, to record actions as cases moderators can review")
+	serverID := fs.String("server", "", "discord server ID to register the case log's commands in (empty: register them globally)")
+	appID := fs.String("app", "", "discord app ID (required with -db-path)")
 
 	if err := fs.Parse(args); err != nil {
 		return 1, err
@@ -75,7 +88,16 @@ func ExecBot(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 		return 1, ErrDaysAgeTooLow
 	}
 
-	var allowList []string
+	if *dbPath != "" && *appID == "" {
+		logger.ErrorContext(ctx, ErrNoAppID.Error(), slog.String("db_path", *dbPath))
+
+		return 1, ErrNoAppID
+	}
+
+	var (
+		allowList []string
+		err       error
+	)
 
 	if *allowedUsers != "" {
 		allowList = strings.Split(*allowedUsers, ",")
@@ -84,6 +106,24 @@ func ExecBot(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 			slog.Any("allowed_users", allowList))
 	}
 
+	cfg := faac.AccountAgeConfig(*daysAge, allowList...)
+
+	if *configPath != "" {
+		cfg, err = faac.LoadConfig(*configPath)
+		if err != nil {
+			logger.ErrorContext(ctx, "loading screening rules", slog.String("error", err.Error()))
+
+			return 1, err
+		}
+
+		cfg.AllowList = append(cfg.AllowList, allowList...)
+
+		logger.InfoContext(ctx, "loaded screening rules",
+			slog.String("path", *configPath),
+			slog.Int("rules", len(cfg.Rules)),
+			slog.Int("raid_joins", cfg.Raid.Joins))
+	}
+
 	dg, err := discordgo.New("Bot " + *token)
 	if err != nil {
 		logger.ErrorContext(ctx, "error creating Discord session", slog.String("error", err.Error()))
@@ -91,8 +131,65 @@ func ExecBot(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 		return 1, err
 	}
 
+	var opts []faac.ScreenerOption
+
+	if *dbPath != "" {
+		db, err := sqlite.OpenSQLite(*dbPath, sqlite.ReadWritePragmas(), logger)
+		if err != nil {
+			logger.ErrorContext(ctx, "opening SQLite database", slog.String("error", err.Error()))
+
+			return 1, err
+		}
+
+		defer func() {
+			if err := db.Close(); err != nil {
+				logger.ErrorContext(ctx, "closing SQLite database", slog.String("error", err.Error()))
+			}
+		}()
+
+		if err := sqlite.MigrateSQLite(ctx, db, logger); err != nil {
+			logger.ErrorContext(ctx, "migrating SQLite database", slog.String("error", err.Error()))
+
+			return 1, err
+		}
+
+		cases := faac.NewCaseLog(cfg.QuarantineRoleID, sqlite.NewSQLite(db), realClock{}, logger)
+		opts = append(opts, faac.WithCaseLog(cases))
+
+		commands := []Command{
+			faac.NewCasesCommand(cases, logger),
+			faac.NewCaseCommand(cases, logger),
+			faac.NewCaseNoteCommand(*logChannelID, cases, logger),
+			faac.NewCaseReverseCommand(*logChannelID, cases, logger),
+		}
+
+		cmds := make([]*discordgo.ApplicationCommand, 0, len(commands))
+		for _, command := range commands {
+			handler, cmd := faac.RegisterSlashCommand(logger, command.Name(), command.Callback, command.Elements()...)
+			dg.AddHandler(handler)
+
+			cmds = append(cmds, cmd)
+		}
+
+		if _, err := dg.ApplicationCommandBulkOverwrite(*appID, *serverID, cmds); err != nil {
+			logger.ErrorContext(ctx, "error creating commands",
+				slog.String("error", err.Error()))
+
+			return 1, err
+		}
+
+		logger.InfoContext(ctx, "case log is enabled", slog.String("db_path", *dbPath))
+	}
+
+	screener, err := faac.NewScreener(*logChannelID, cfg, realClock{}, logger, opts...)
+	if err != nil {
+		logger.ErrorContext(ctx, "creating screener", slog.String("error", err.Error()))
+
+		return 1, err
+	}
+
 	// register the handler for when a new user joins
-	dg.AddHandler(faac.MemberAccountAgeFilter(logger, *daysAge, *logChannelID, allowList...))
+	dg.AddHandler(screener.Handler())
 
 	// intents: we need GuildMembers to detect joins
 	dg.Identify.Intents = discordgo.IntentsGuilds | discordgo.IntentsGuildMembers
@@ -124,3 +221,7 @@ func ExecBot(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 
 	return 0, nil
 }
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
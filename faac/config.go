@@ -0,0 +1,237 @@
+package faac
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+)
+
+const (
+	defaultTimeout        = 24 * time.Hour
+	maxTimeout            = 28 * 24 * time.Hour
+	defaultAccountAgeRule = "account-age"
+)
+
+var (
+	ErrNoRules           = errors.New("no screening rules configured")
+	ErrInvalidRuleType   = errors.New("invalid rule type")
+	ErrInvalidAction     = errors.New("invalid action")
+	ErrInvalidMinDays    = errors.New("account age rules require a positive min_days")
+	ErrNoPatterns        = errors.New("username rules require at least one pattern")
+	ErrNoQuarantineRole  = errors.New("quarantine actions require a quarantine_role_id")
+	ErrInvalidRaidConfig = errors.New("raid detection requires a positive window and duration")
+	ErrInvalidTimeout    = errors.New("timeout must be positive and up to 28 days")
+)
+
+// RuleType defines which property of a joining member a Rule checks.
+type RuleType string
+
+const (
+	// RuleAccountAge matches accounts younger than the rule's MinDays.
+	RuleAccountAge RuleType = "account_age"
+	// RuleAvatar matches accounts without an avatar.
+	RuleAvatar RuleType = "avatar"
+	// RuleUsername matches accounts whose username or display name matches any of the rule's Patterns.
+	RuleUsername RuleType = "username"
+)
+
+// Action is what faac does to a member matching a Rule. When a member matches more than one rule, the most severe
+// action is applied.
+type Action string
+
+const (
+	ActionLog        Action = "log"
+	ActionQuarantine Action = "quarantine"
+	ActionTimeout    Action = "timeout"
+	ActionKick       Action = "kick"
+	ActionBan        Action = "ban"
+)
+
+func (a Action) severity() int {
+	switch a {
+	case ActionLog:
+		return 1
+	case ActionQuarantine:
+		return 2
+	case ActionTimeout:
+		return 3
+	case ActionKick:
+		return 4
+	case ActionBan:
+		return 5
+	default:
+		return 0
+	}
+}
+
+// Duration is a time.Duration that is (un)marshalled from JSON as a string, like "30m".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	dur, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(dur)
+
+	return nil
+}
+
+// Config describes the join screening rules, as loaded from a JSON file.
+type Config struct {
+	// AllowList holds the IDs of the users that bypass all rules.
+	AllowList []string `json:"allow_list,omitempty"`
+	Rules     []Rule   `json:"rules"`
+	Raid      Raid     `json:"raid,omitempty"`
+
+	// Timeout is how long members are timed out for, with the timeout action. Defaults to 24h.
+	Timeout Duration `json:"timeout,omitempty"`
+	// QuarantineRoleID is the role assigned to members with the quarantine action.
+	QuarantineRoleID string `json:"quarantine_role_id,omitempty"`
+}
+
+// Rule matches joining members by one of their properties, applying its Action to them.
+type Rule struct {
+	Name   string   `json:"name"`
+	Type   RuleType `json:"type"`
+	Action Action   `json:"action"`
+
+	// MinDays is the minimum account age, in days, for RuleAccountAge.
+	MinDays int `json:"min_days,omitempty"`
+	// Patterns are the regular expressions to match usernames against, for RuleUsername.
+	Patterns []string `json:"patterns,omitempty"`
+
+	// Raid tightens the rule while raid mode is active.
+	Raid *RaidRule `json:"raid,omitempty"`
+}
+
+// RaidRule overrides a Rule's settings while raid mode is active. Unset fields keep the rule's values.
+type RaidRule struct {
+	Action  Action `json:"action,omitempty"`
+	MinDays int    `json:"min_days,omitempty"`
+}
+
+// Raid configures the detection of join-rate spikes: when Joins members join a server within Window, raid mode is
+// active in that server for Duration, tightening the rules with a RaidRule. Raid detection is disabled when Joins is
+// zero.
+type Raid struct {
+	Joins    int      `json:"joins,omitempty"`
+	Window   Duration `json:"window,omitempty"`
+	Duration Duration `json:"duration,omitempty"`
+}
+
+// LoadConfig reads and validates the JSON configuration file in path.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var cfg Config
+
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// AccountAgeConfig returns a Config with a single rule, kicking members whose account is younger than minDaysAge.
+func AccountAgeConfig(minDaysAge int, allowList ...string) Config {
+	return Config{
+		AllowList: allowList,
+		Rules: []Rule{{
+			Name:    defaultAccountAgeRule,
+			Type:    RuleAccountAge,
+			Action:  ActionKick,
+			MinDays: minDaysAge,
+		}},
+	}
+}
+
+// Validate checks the configuration for unknown rule types or actions, and for any missing settings.
+func (c Config) Validate() error {
+	if len(c.Rules) == 0 {
+		return ErrNoRules
+	}
+
+	if c.Timeout != 0 && (c.Timeout < 0 || time.Duration(c.Timeout) > maxTimeout) {
+		return fmt.Errorf("%w: %s", ErrInvalidTimeout, time.Duration(c.Timeout))
+	}
+
+	if c.Raid.Joins > 0 && (c.Raid.Window <= 0 || c.Raid.Duration <= 0) {
+		return ErrInvalidRaidConfig
+	}
+
+	for i := range c.Rules {
+		if err := c.validateRule(c.Rules[i]); err != nil {
+			return fmt.Errorf("rule #%d (%s): %w", i, c.Rules[i].Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (c Config) validateRule(rule Rule) error {
+	if err := c.validateAction(rule.Action); err != nil {
+		return err
+	}
+
+	if rule.Raid != nil && rule.Raid.Action != "" {
+		if err := c.validateAction(rule.Raid.Action); err != nil {
+			return err
+		}
+	}
+
+	switch rule.Type {
+	case RuleAccountAge:
+		if rule.MinDays <= 0 {
+			return ErrInvalidMinDays
+		}
+	case RuleAvatar:
+	case RuleUsername:
+		if len(rule.Patterns) == 0 {
+			return ErrNoPatterns
+		}
+
+		for _, pattern := range rule.Patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidRuleType, rule.Type)
+	}
+
+	return nil
+}
+
+func (c Config) validateAction(action Action) error {
+	if action.severity() == 0 {
+		return fmt.Errorf("%w: %q", ErrInvalidAction, action)
+	}
+
+	if action == ActionQuarantine && c.QuarantineRoleID == "" {
+		return ErrNoQuarantineRole
+	}
+
+	return nil
+}
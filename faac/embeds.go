@@ -0,0 +1,245 @@
+package faac
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func EmbedScreeningBypassed(user *discordgo.User, verdict Verdict) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title: "🛡️ Auto-Screening Bypassed",
+		Color: 0xffff00, // Yellow
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Automated user screening bypassed (allow-list)",
+				Value:  fmt.Sprintf("%s (`%s`)", user.Mention(), user.ID),
+				Inline: true,
+			},
+			{
+				Name:   "Account Age",
+				Value:  fmt.Sprintf("**%d days**", verdict.AccountAge),
+				Inline: true,
+			},
+			{
+				Name:   "Creation Date",
+				Value:  verdict.CreatedAt.Format("2006-01-02 15:04:05"),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Allow-list user has bypassed the server screening rules.",
+		},
+	}
+}
+
+func EmbedScreeningAction(user *discordgo.User, verdict Verdict, timeoutUntil time.Time) *discordgo.MessageEmbed {
+	var (
+		title  string
+		color  int
+		footer string
+	)
+
+	switch verdict.Action {
+	case ActionBan:
+		title, color, footer = "🛡️ Auto-Ban Triggered", 0xff0000, "User has been banned from the server."
+	case ActionKick:
+		title, color, footer = "🛡️ Auto-Kick Triggered", 0xff0000, "User has been removed from the server."
+	case ActionTimeout:
+		title, color = "🛡️ Auto-Timeout Triggered", 0xff8800
+		footer = fmt.Sprintf("User has been timed out until %s.", timeoutUntil.Format("2006-01-02 15:04:05"))
+	case ActionQuarantine:
+		title, color, footer = "🛡️ Auto-Quarantine Triggered", 0xff8800, "User has been assigned the quarantine role."
+	default:
+		title, color, footer = "🛡️ Suspicious Account Joined", 0xffff00, "No action was taken."
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:   "User",
+			Value:  fmt.Sprintf("%s (`%s`)", user.Mention(), user.ID),
+			Inline: true,
+		},
+		{
+			Name:   "Rule",
+			Value:  fmt.Sprintf("`%s`", verdict.Rule),
+			Inline: true,
+		},
+		{
+			Name:   "Account Age",
+			Value:  fmt.Sprintf("**%d days**", verdict.AccountAge),
+			Inline: true,
+		},
+		{
+			Name:   "Evidence",
+			Value:  strings.Join(verdict.Evidence, "\n"),
+			Inline: false,
+		},
+		{
+			Name:   "Creation Date",
+			Value:  verdict.CreatedAt.Format("2006-01-02 15:04:05"),
+			Inline: false,
+		},
+	}
+
+	if verdict.CaseID > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Case",
+			Value:  fmt.Sprintf("#%d", verdict.CaseID),
+			Inline: true,
+		}, &discordgo.MessageEmbedField{
+			Name:   "Previous Cases",
+			Value:  strconv.Itoa(verdict.PreviousCases),
+			Inline: true,
+		})
+	}
+
+	if verdict.Raid {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Raid Mode",
+			Value:  "active, rules were tightened",
+			Inline: false,
+		})
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:  title,
+		Color:  color,
+		Fields: fields,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: footer,
+		},
+	}
+}
+
+func EmbedRaidModeActivated(raid Raid, until time.Time) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title: "🚨 Raid Mode Activated",
+		Color: 0xff0000, // Red
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Join Rate",
+				Value:  fmt.Sprintf("**%d joins** within %s", raid.Joins, time.Duration(raid.Window)),
+				Inline: true,
+			},
+			{
+				Name:   "Active Until",
+				Value:  until.Format("2006-01-02 15:04:05"),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Screening rules are tightened while raid mode is active.",
+		},
+	}
+}
+
+func EmbedCase(title string, c Case) *discordgo.MessageEmbed {
+	color, footer := 0xff8800, "Case is open." // Orange
+	if c.Reversal != nil {
+		color, footer = 0x00ff00, "Case was reversed." // Green
+		if c.Reversal.AcceptAppeal {
+			footer = "Case was reversed on appeal, and the user was added to the allow list."
+		}
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:   "Case",
+			Value:  fmt.Sprintf("#%d", c.ID),
+			Inline: true,
+		},
+		{
+			Name:   "User",
+			Value:  fmt.Sprintf("<@%s> (`%s`)", c.UserID, c.UserID),
+			Inline: true,
+		},
+		{
+			Name:   "Action",
+			Value:  fmt.Sprintf("`%s` (rule: `%s`)", c.Action, c.Rule),
+			Inline: true,
+		},
+		{
+			Name:   "Evidence",
+			Value:  strings.Join(c.Evidence, "\n"),
+			Inline: false,
+		},
+		{
+			Name:   "Created At",
+			Value:  c.CreatedAt.Format("2006-01-02 15:04:05"),
+			Inline: true,
+		},
+	}
+
+	if c.Raid {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Raid Mode",
+			Value:  "active",
+			Inline: true,
+		})
+	}
+
+	if c.Reversal != nil {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name: "Reversed",
+			Value: fmt.Sprintf("by <@%s> at %s: %s",
+				c.Reversal.Moderator, c.Reversal.At.Format("2006-01-02 15:04:05"), c.Reversal.Reason),
+			Inline: false,
+		})
+	}
+
+	if len(c.Notes) > 0 {
+		notes := make([]string, 0, len(c.Notes))
+		for i := range c.Notes {
+			notes = append(notes, fmt.Sprintf("<@%s> (%s): %s",
+				c.Notes[i].Author, c.Notes[i].CreatedAt.Format("2006-01-02 15:04:05"), c.Notes[i].Text))
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Notes",
+			Value:  strings.Join(notes, "\n"),
+			Inline: false,
+		})
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:  title,
+		Color:  color,
+		Fields: fields,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: footer,
+		},
+	}
+}
+
+func EmbedCaseList(userID string, cases []Case) *discordgo.MessageEmbed {
+	description := "Latest cases"
+	if userID != "" {
+		description = fmt.Sprintf("Latest cases for <@%s>", userID)
+	}
+
+	if len(cases) == 0 {
+		description = "No cases found."
+	}
+
+	fields := make([]*discordgo.MessageEmbedField, 0, len(cases))
+
+	for i := range cases {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name: fmt.Sprintf("#%d · %s · %s", cases[i].ID, cases[i].Action, cases[i].Status),
+			Value: fmt.Sprintf("<@%s> (rule: `%s`) at %s",
+				cases[i].UserID, cases[i].Rule, cases[i].CreatedAt.Format("2006-01-02 15:04:05")),
+			Inline: false,
+		})
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       "📁 Cases",
+		Description: description,
+		Color:       0x0000ff, // Blue
+		Fields:      fields,
+	}
+}
@@ -0,0 +1,5 @@
+package repository
+
+import "errors"
+
+var ErrCaseNotFound = errors.New("case not found")
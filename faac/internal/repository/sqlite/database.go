@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	//_ "github.com/mattn/go-sqlite3"
+	_ "modernc.org/sqlite"
+)
+
+const (
+	sqlDriver = "sqlite"
+	maxAlloc  = 5_000_000
+
+	uriFormat = "file:%s?_readonly=true&_txlock=immediate&cache=shared"
+	inMemory  = ":memory:"
+
+	applyPragma   = `PRAGMA %s;`
+	applyPragmaKV = `PRAGMA %s = %s;`
+
+	createCasesTableQuery = `
+	CREATE TABLE cases (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    guildID TEXT NOT NULL,
+    userID TEXT NOT NULL,
+    username TEXT NOT NULL,
+    rule TEXT NOT NULL,
+    action TEXT NOT NULL,
+    evidence TEXT NOT NULL,
+    raid INTEGER NOT NULL,
+    status TEXT NOT NULL,
+    createdAt INTEGER NOT NULL,
+    reversedBy TEXT NOT NULL DEFAULT '',
+    reversalReason TEXT NOT NULL DEFAULT '',
+	reversedAt INTEGER NOT NULL DEFAULT 0
+	) STRICT;
+
+	CREATE INDEX idx_cases_userID ON cases (userID);
+`
+
+	createCaseNotesTableQuery = `
+	CREATE TABLE case_notes (
+    caseID INTEGER NOT NULL,
+    author TEXT NOT NULL,
+    note TEXT NOT NULL,
+	createdAt INTEGER NOT NULL
+	) STRICT;
+
+	CREATE INDEX idx_case_notes_caseID ON case_notes (caseID);
+`
+
+	createAllowListTableQuery = `
+	CREATE TABLE allow_list (
+    userID TEXT PRIMARY KEY NOT NULL,
+    caseID INTEGER NOT NULL,
+    addedBy TEXT NOT NULL,
+	addedAt INTEGER NOT NULL
+	) STRICT;
+`
+
+	checkTableExists = `
+SELECT EXISTS(SELECT 1 FROM sqlite_master 
+	WHERE type='table' 
+	AND name='%s');
+`
+)
+
+func OpenSQLite(uri string, pragmas map[string]string, logger *slog.Logger) (*sql.DB, error) {
+	switch uri {
+	case inMemory:
+	case "":
+		uri = inMemory
+	default:
+		if err := validateURI(uri); err != nil {
+			return nil, err
+		}
+	}
+
+	if pragmas == nil {
+		pragmas = ReadWritePragmas()
+	}
+
+	db, err := sql.Open(sqlDriver, fmt.Sprintf(uriFormat, uri))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("opened target DB", slog.String("uri", uri))
+
+	if err := applyPragmas(context.Background(), db, pragmas); err != nil {
+		return nil, err
+	}
+
+	logger.Info("prepared pragmas")
+
+	return db, nil
+}
+
+func validateURI(uri string) error {
+	stat, err := os.Stat(uri)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			f, err := os.Create(uri)
+			if err != nil {
+				return err
+			}
+
+			return f.Close()
+		}
+
+		return err
+	}
+
+	if stat.IsDir() {
+		return fmt.Errorf("%s is a directory", uri)
+	}
+
+	return nil
+}
+
+func applyPragmas(ctx context.Context, db *sql.DB, pragmas map[string]string) (err error) {
+	for k, v := range pragmas {
+		switch v {
+		case "":
+			_, err = db.ExecContext(ctx, fmt.Sprintf(applyPragma, k))
+		default:
+			_, err = db.ExecContext(ctx, fmt.Sprintf(applyPragmaKV, k, v))
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/zalgonoise/x/faac"
+	"github.com/zalgonoise/x/faac/internal/repository"
+)
+
+const (
+	minAlloc = 64
+
+	// noLimit is SQLite's value for an unbounded LIMIT clause
+	noLimit = -1
+
+	// evidenceSeparator joins a case's evidence into a single column; evidence lines never contain line breaks
+	evidenceSeparator = "\n"
+
+	caseColumns = `id, guildID, userID, username, rule, action, evidence, raid, status, createdAt,
+	reversedBy, reversalReason, reversedAt`
+
+	getCaseQuery   = `SELECT ` + caseColumns + ` FROM cases WHERE id = ?`
+	listCasesQuery = `SELECT ` + caseColumns + ` FROM cases
+	WHERE (? = '' OR userID = ?) ORDER BY id DESC LIMIT ?`
+	countCasesQuery = `SELECT COUNT(*) FROM cases WHERE userID = ?`
+	insertCaseQuery = `
+INSERT INTO cases (guildID, userID, username, rule, action, evidence, raid, status, createdAt)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	reverseCaseQuery = `
+UPDATE cases SET status = ?, reversedBy = ?, reversalReason = ?, reversedAt = ?
+	WHERE id = ? AND status = ?`
+
+	listNotesQuery  = `SELECT author, note, createdAt FROM case_notes WHERE caseID = ? ORDER BY createdAt, rowid`
+	insertNoteQuery = `INSERT INTO case_notes (caseID, author, note, createdAt) VALUES (?, ?, ?, ?)`
+
+	isAllowedQuery     = `SELECT EXISTS(SELECT 1 FROM allow_list WHERE userID = ?)`
+	insertAllowedQuery = `
+INSERT INTO allow_list (userID, caseID, addedBy, addedAt) VALUES (?, ?, ?, ?)
+	ON CONFLICT (userID) DO UPDATE SET caseID = excluded.caseID, addedBy = excluded.addedBy, addedAt = excluded.addedAt`
+)
+
+var ErrUnexpectedRowsAffected = errors.New("unexpected number of rows affected")
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+type SQLite struct {
+	db *sql.DB
+}
+
+func (r *SQLite) CreateCase(ctx context.Context, c faac.Case) (int64, error) {
+	res, err := r.db.ExecContext(ctx, insertCaseQuery,
+		c.GuildID, c.UserID, c.Username, c.Rule, string(c.Action),
+		strings.Join(c.Evidence, evidenceSeparator), boolToInt(c.Raid), string(c.Status),
+		int(c.CreatedAt.UnixMilli()),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+func (r *SQLite) GetCase(ctx context.Context, id int64) (faac.Case, error) {
+	c, err := scanCase(r.db.QueryRowContext(ctx, getCaseQuery, id))
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return faac.Case{}, repository.ErrCaseNotFound
+		default:
+			return faac.Case{}, err
+		}
+	}
+
+	rows, err := r.db.QueryContext(ctx, listNotesQuery, id)
+	if err != nil {
+		return faac.Case{}, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			note      faac.Note
+			createdAt int
+		)
+
+		if err := rows.Scan(&note.Author, &note.Text, &createdAt); err != nil {
+			return faac.Case{}, err
+		}
+
+		note.CreatedAt = time.UnixMilli(int64(createdAt))
+
+		c.Notes = append(c.Notes, note)
+	}
+
+	if err := rows.Close(); err != nil {
+		return faac.Case{}, err
+	}
+
+	if err := rows.Err(); err != nil {
+		return faac.Case{}, err
+	}
+
+	return c, nil
+}
+
+func (r *SQLite) ListCases(ctx context.Context, userID string, limit int) ([]faac.Case, error) {
+	if limit <= 0 {
+		limit = noLimit
+	}
+
+	rows, err := r.db.QueryContext(ctx, listCasesQuery, userID, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := make([]faac.Case, 0, minAlloc)
+
+	for rows.Next() {
+		c, err := scanCase(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, c)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *SQLite) CountCases(ctx context.Context, userID string) (int, error) {
+	var count int
+
+	if err := r.db.QueryRowContext(ctx, countCasesQuery, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *SQLite) AnnotateCase(ctx context.Context, id int64, note faac.Note) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	if _, err := scanCase(tx.QueryRowContext(ctx, getCaseQuery, id)); err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return repository.ErrCaseNotFound
+		default:
+			return err
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, insertNoteQuery, id, note.Author, note.Text, int(note.CreatedAt.UnixMilli()))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		return ErrUnexpectedRowsAffected
+	}
+
+	return tx.Commit()
+}
+
+func (r *SQLite) ReverseCase(ctx context.Context, id int64, reversal faac.Reversal) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	c, err := scanCase(tx.QueryRowContext(ctx, getCaseQuery, id))
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return repository.ErrCaseNotFound
+		default:
+			return err
+		}
+	}
+
+	status := faac.CaseReversed
+	if reversal.AcceptAppeal {
+		status = faac.CaseAppealAccepted
+	}
+
+	res, err := tx.ExecContext(ctx, reverseCaseQuery,
+		string(status), reversal.Moderator, reversal.Reason, int(reversal.At.UnixMilli()),
+		id, string(faac.CaseOpen),
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		return faac.ErrCaseReversed
+	}
+
+	if reversal.AcceptAppeal {
+		if _, err := tx.ExecContext(ctx, insertAllowedQuery,
+			c.UserID, id, reversal.Moderator, int(reversal.At.UnixMilli()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *SQLite) IsAllowed(ctx context.Context, userID string) (bool, error) {
+	var allowed bool
+
+	if err := r.db.QueryRowContext(ctx, isAllowedQuery, userID).Scan(&allowed); err != nil {
+		return false, err
+	}
+
+	return allowed, nil
+}
+
+func scanCase(row rowScanner) (faac.Case, error) {
+	var (
+		c              faac.Case
+		action         string
+		evidence       string
+		raid           int
+		status         string
+		createdAt      int
+		reversedBy     string
+		reversalReason string
+		reversedAt     int
+	)
+
+	if err := row.Scan(
+		&c.ID, &c.GuildID, &c.UserID, &c.Username, &c.Rule, &action, &evidence, &raid, &status, &createdAt,
+		&reversedBy, &reversalReason, &reversedAt,
+	); err != nil {
+		return faac.Case{}, err
+	}
+
+	c.Action = faac.Action(action)
+	c.Raid = raid != 0
+	c.Status = faac.CaseStatus(status)
+	c.CreatedAt = time.UnixMilli(int64(createdAt))
+
+	if evidence != "" {
+		c.Evidence = strings.Split(evidence, evidenceSeparator)
+	}
+
+	if c.Status != faac.CaseOpen {
+		c.Reversal = &faac.Reversal{
+			Moderator:    reversedBy,
+			Reason:       reversalReason,
+			AcceptAppeal: c.Status == faac.CaseAppealAccepted,
+			At:           time.UnixMilli(int64(reversedAt)),
+		}
+	}
+
+	return c, nil
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func NewSQLite(db *sql.DB) *SQLite {
+	return &SQLite{db: db}
+}
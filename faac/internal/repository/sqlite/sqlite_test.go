@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"io"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/faac"
+	"github.com/zalgonoise/x/faac/internal/repository"
+)
+
+func newTestSQLite(t *testing.T) *SQLite {
+	t.Helper()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	db, err := OpenSQLite(filepath.Join(t.TempDir(), "faac.db"), ReadWritePragmas(), logger)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	require.NoError(t, MigrateSQLite(t.Context(), db, logger))
+
+	return NewSQLite(db)
+}
+
+func TestSQLite_Cases(t *testing.T) {
+	now := time.UnixMilli(time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC).UnixMilli())
+	repo := newTestSQLite(t)
+
+	ban := faac.Case{
+		GuildID:   "guild",
+		UserID:    "user-a",
+		Username:  "Free-Nitro",
+		Rule:      "scam-names",
+		Action:    faac.ActionBan,
+		Evidence:  []string{"scam-names: name matches", "no-avatar: account has no avatar"},
+		Raid:      true,
+		Status:    faac.CaseOpen,
+		CreatedAt: now,
+	}
+
+	id, err := repo.CreateCase(t.Context(), ban)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), id)
+
+	_, err = repo.CreateCase(t.Context(), faac.Case{
+		GuildID: "guild", UserID: "user-b", Rule: "new-account", Action: faac.ActionKick,
+		Status: faac.CaseOpen, CreatedAt: now.Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	t.Run("GetCase", func(t *testing.T) {
+		c, err := repo.GetCase(t.Context(), id)
+		require.NoError(t, err)
+
+		ban.ID = id
+		require.Equal(t, ban, c)
+
+		_, err = repo.GetCase(t.Context(), 100)
+		require.ErrorIs(t, err, repository.ErrCaseNotFound)
+	})
+
+	t.Run("ListCases", func(t *testing.T) {
+		cases, err := repo.ListCases(t.Context(), "", 0)
+		require.NoError(t, err)
+		require.Len(t, cases, 2)
+		require.Equal(t, int64(2), cases[0].ID)
+
+		cases, err = repo.ListCases(t.Context(), "user-a", 10)
+		require.NoError(t, err)
+		require.Len(t, cases, 1)
+
+		count, err := repo.CountCases(t.Context(), "user-b")
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("AnnotateCase", func(t *testing.T) {
+		note := faac.Note{Author: "moderator", Text: "known scammer", CreatedAt: now}
+		require.NoError(t, repo.AnnotateCase(t.Context(), id, note))
+		require.ErrorIs(t, repo.AnnotateCase(t.Context(), 100, note), repository.ErrCaseNotFound)
+
+		c, err := repo.GetCase(t.Context(), id)
+		require.NoError(t, err)
+		require.Equal(t, []faac.Note{note}, c.Notes)
+	})
+
+	t.Run("ReverseCase", func(t *testing.T) {
+		reversal := faac.Reversal{Moderator: "moderator", Reason: "appeal", AcceptAppeal: true, At: now.Add(time.Hour)}
+
+		allowed, err := repo.IsAllowed(t.Context(), "user-a")
+		require.NoError(t, err)
+		require.False(t, allowed)
+
+		require.NoError(t, repo.ReverseCase(t.Context(), id, reversal))
+		require.ErrorIs(t, repo.ReverseCase(t.Context(), id, reversal), faac.ErrCaseReversed)
+		require.ErrorIs(t, repo.ReverseCase(t.Context(), 100, reversal), repository.ErrCaseNotFound)
+
+		c, err := repo.GetCase(t.Context(), id)
+		require.NoError(t, err)
+		require.Equal(t, faac.CaseAppealAccepted, c.Status)
+		require.Equal(t, &reversal, c.Reversal)
+
+		allowed, err = repo.IsAllowed(t.Context(), "user-a")
+		require.NoError(t, err)
+		require.True(t, allowed)
+	})
+}
@@ -0,0 +1,389 @@
+package faac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+var ErrNilMember = errors.New("member is nil")
+
+// Session describes the Discord API calls used when screening members, as implemented by discordgo.Session. It allows
+// testing the screening rules without a Discord connection.
+type Session interface {
+	GuildMemberDeleteWithReason(guildID, userID, reason string, options ...discordgo.RequestOption) error
+	GuildBanCreateWithReason(guildID, userID, reason string, days int, options ...discordgo.RequestOption) error
+	GuildMemberTimeout(guildID string, userID string, until *time.Time, options ...discordgo.RequestOption) error
+	GuildMemberRoleAdd(guildID, userID, roleID string, options ...discordgo.RequestOption) error
+	GuildBanDelete(guildID, userID string, options ...discordgo.RequestOption) error
+	GuildMemberRoleRemove(guildID, userID, roleID string, options ...discordgo.RequestOption) error
+	ChannelMessageSend(channelID string, content string, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	ChannelMessageSendEmbed(channelID string, embed *discordgo.MessageEmbed, options ...discordgo.RequestOption) (*discordgo.Message, error)
+}
+
+type Clock interface {
+	Now() time.Time
+}
+
+// Verdict is the outcome of screening a joining member.
+type Verdict struct {
+	// Rule is the name of the rule whose action was applied; empty if no rule matched.
+	Rule   string
+	Action Action
+	// Evidence describes why each matching rule matched.
+	Evidence []string
+	// Raid is set when the member joined while raid mode was active.
+	Raid bool
+	// Bypassed is set when the member is in the allow list.
+	Bypassed bool
+
+	CreatedAt  time.Time
+	AccountAge int
+
+	// CaseID is the case recorded for the action, if a CaseLog is set.
+	CaseID int64
+	// PreviousCases is the number of cases recorded for the member before this one, if a CaseLog is set.
+	PreviousCases int
+}
+
+type ScreenerOption func(s *Screener)
+
+// WithCaseLog records each action taken as a case, and lets users allowed on an accepted appeal bypass the rules.
+func WithCaseLog(cases *CaseLog) ScreenerOption {
+	return func(s *Screener) {
+		s.cases = cases
+	}
+}
+
+type rule struct {
+	Rule
+
+	patterns []*regexp.Regexp
+}
+
+// Screener checks members joining a server against a set of rules, applying the most severe action out of the
+// rules they match. It also tracks the join rate in each server, switching to raid mode when it spikes.
+type Screener struct {
+	logChannelID     string
+	allowList        []string
+	rules            []rule
+	raid             Raid
+	timeout          time.Duration
+	quarantineRoleID string
+
+	mu        *sync.Mutex
+	joins     map[string][]time.Time
+	raidUntil map[string]time.Time
+
+	cases  *CaseLog
+	clock  Clock
+	logger *slog.Logger
+}
+
+func NewScreener(
+	logChannelID string, cfg Config, clock Clock, logger *slog.Logger, opts ...ScreenerOption,
+) (*Screener, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	rules := make([]rule, 0, len(cfg.Rules))
+
+	for i := range cfg.Rules {
+		r := rule{Rule: cfg.Rules[i]}
+
+		for _, pattern := range cfg.Rules[i].Patterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, err
+			}
+
+			r.patterns = append(r.patterns, re)
+		}
+
+		rules = append(rules, r)
+	}
+
+	timeout := time.Duration(cfg.Timeout)
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	screener := &Screener{
+		logChannelID:     logChannelID,
+		allowList:        cfg.AllowList,
+		rules:            rules,
+		raid:             cfg.Raid,
+		timeout:          timeout,
+		quarantineRoleID: cfg.QuarantineRoleID,
+		mu:               new(sync.Mutex),
+		joins:            make(map[string][]time.Time),
+		raidUntil:        make(map[string]time.Time),
+		clock:            clock,
+		logger:           logger,
+	}
+
+	for _, opt := range opts {
+		opt(screener)
+	}
+
+	return screener, nil
+}
+
+// Handler returns a discordgo event handler screening members as they join.
+func (s *Screener) Handler() func(session *discordgo.Session, m *discordgo.GuildMemberAdd) {
+	return func(session *discordgo.Session, m *discordgo.GuildMemberAdd) {
+		// errors are logged and reported to the log channel when screening
+		_, _ = s.Screen(context.Background(), session, m.Member)
+	}
+}
+
+// Screen checks the joining member against the configured rules, applying the most severe action out of the rules
+// they match, and reporting it to the log channel.
+func (s *Screener) Screen(ctx context.Context, session Session, member *discordgo.Member) (Verdict, error) {
+	if member == nil || member.User == nil {
+		return Verdict{}, ErrNilMember
+	}
+
+	creationTime, err := discordgo.SnowflakeTimestamp(member.User.ID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "getting snowflake timestamp",
+			slog.String("error", err.Error()),
+			slog.String("user", member.User.Username),
+			slog.String("user_id", member.User.ID),
+		)
+
+		return Verdict{}, err
+	}
+
+	now := s.clock.Now()
+
+	verdict := Verdict{
+		CreatedAt:  creationTime,
+		AccountAge: int(now.Sub(creationTime).Hours() / 24),
+	}
+
+	var raidStarted bool
+
+	verdict.Raid, raidStarted = s.registerJoin(member.GuildID, now)
+
+	if raidStarted {
+		s.logger.WarnContext(ctx, "raid mode activated",
+			slog.String("guild_id", member.GuildID),
+			slog.Int("joins", s.raid.Joins),
+			slog.Duration("window", time.Duration(s.raid.Window)))
+
+		s.notify(ctx, session, member, EmbedRaidModeActivated(s.raid, now.Add(time.Duration(s.raid.Duration))))
+	}
+
+	if s.allowed(ctx, member.User.ID) {
+		verdict.Bypassed = true
+
+		s.logger.WarnContext(ctx, "allow-list user has bypassed the screening rules",
+			slog.String("user", member.User.Username),
+			slog.String("user_id", member.User.ID))
+
+		s.notify(ctx, session, member, EmbedScreeningBypassed(member.User, verdict))
+
+		return verdict, nil
+	}
+
+	s.evaluate(member.User, &verdict)
+
+	if verdict.Action == "" {
+		return verdict, nil
+	}
+
+	s.logger.InfoContext(ctx, "suspicious account detected",
+		slog.String("rule", verdict.Rule),
+		slog.String("action", string(verdict.Action)),
+		slog.Bool("raid", verdict.Raid),
+		slog.Int("account_age", verdict.AccountAge),
+		slog.String("user", member.User.Username),
+		slog.String("user_id", member.User.ID))
+
+	if err := s.apply(session, member, verdict); err != nil {
+		s.logger.ErrorContext(ctx, "applying screening action",
+			slog.String("error", err.Error()),
+			slog.String("action", string(verdict.Action)),
+			slog.String("user", member.User.Username),
+			slog.String("user_id", member.User.ID),
+		)
+
+		// usually due to permissions
+		if _, err := session.ChannelMessageSend(s.logChannelID,
+			fmt.Sprintf("⚠️ **Error:** Tried to %s <@%s> (rule: `%s`), but failed. Check my permissions.",
+				verdict.Action, member.User.ID, verdict.Rule)); err != nil {
+			s.logger.ErrorContext(ctx, "notifying screening action failure",
+				slog.String("error", err.Error()),
+				slog.String("user", member.User.Username),
+				slog.String("user_id", member.User.ID),
+			)
+		}
+
+		return verdict, err
+	}
+
+	if s.cases != nil {
+		verdict.CaseID, verdict.PreviousCases, err = s.cases.Record(ctx, member, verdict)
+		if err != nil {
+			// the action was applied regardless, so it is still reported
+			s.logger.ErrorContext(ctx, "recording case",
+				slog.String("error", err.Error()),
+				slog.String("user", member.User.Username),
+				slog.String("user_id", member.User.ID),
+			)
+		}
+	}
+
+	s.notify(ctx, session, member, EmbedScreeningAction(member.User, verdict, s.clock.Now().Add(s.timeout)))
+
+	return verdict, nil
+}
+
+// allowed checks the user against the configured allow list, as well as the users allowed on appeal.
+func (s *Screener) allowed(ctx context.Context, userID string) bool {
+	if slices.Contains(s.allowList, userID) {
+		return true
+	}
+
+	if s.cases == nil {
+		return false
+	}
+
+	ok, err := s.cases.Allowed(ctx, userID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "checking allow list", slog.String("error", err.Error()), slog.String("user_id", userID))
+
+		return false
+	}
+
+	return ok
+}
+
+// RaidMode reports whether raid mode is active in the input server.
+func (s *Screener) RaidMode(guildID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.clock.Now().Before(s.raidUntil[guildID])
+}
+
+// registerJoin records a join in the server, returning whether raid mode is active, and whether this join activated
+// it.
+func (s *Screener) registerJoin(guildID string, now time.Time) (active, started bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wasActive := now.Before(s.raidUntil[guildID])
+
+	if s.raid.Joins <= 0 {
+		return wasActive, false
+	}
+
+	since := now.Add(-time.Duration(s.raid.Window))
+	joins := slices.DeleteFunc(s.joins[guildID], func(t time.Time) bool {
+		return !t.After(since)
+	})
+	joins = append(joins, now)
+	s.joins[guildID] = joins
+
+	if len(joins) >= s.raid.Joins {
+		// keep extending raid mode while the spike goes on
+		s.raidUntil[guildID] = now.Add(time.Duration(s.raid.Duration))
+
+		return true, !wasActive
+	}
+
+	return wasActive, false
+}
+
+// evaluate checks the user against all rules, setting the most severe action in the verdict. On a tie, the first
+// rule in the configuration wins.
+func (s *Screener) evaluate(user *discordgo.User, verdict *Verdict) {
+	for i := range s.rules {
+		action := s.rules[i].Action
+		minDays := s.rules[i].MinDays
+
+		if verdict.Raid && s.rules[i].Raid != nil {
+			if s.rules[i].Raid.Action != "" {
+				action = s.rules[i].Raid.Action
+			}
+
+			if s.rules[i].Raid.MinDays > 0 {
+				minDays = s.rules[i].Raid.MinDays
+			}
+		}
+
+		evidence, ok := s.rules[i].match(user, verdict.AccountAge, minDays)
+		if !ok {
+			continue
+		}
+
+		verdict.Evidence = append(verdict.Evidence, fmt.Sprintf("%s: %s", s.rules[i].Name, evidence))
+
+		if action.severity() > verdict.Action.severity() {
+			verdict.Rule = s.rules[i].Name
+			verdict.Action = action
+		}
+	}
+}
+
+func (r rule) match(user *discordgo.User, accountAge, minDays int) (string, bool) {
+	switch r.Type {
+	case RuleAccountAge:
+		if accountAge < minDays {
+			return fmt.Sprintf("account is %d days old (threshold: %d)", accountAge, minDays), true
+		}
+	case RuleAvatar:
+		if user.Avatar == "" {
+			return "account has no avatar", true
+		}
+	case RuleUsername:
+		for _, re := range r.patterns {
+			for _, name := range []string{user.Username, user.GlobalName} {
+				if name != "" && re.MatchString(name) {
+					return fmt.Sprintf("name %q matches %q", name, re.String()), true
+				}
+			}
+		}
+	}
+
+	return "", false
+}
+
+func (s *Screener) apply(session Session, member *discordgo.Member, verdict Verdict) error {
+	reason := fmt.Sprintf("faac: %s", verdict.Rule)
+
+	switch verdict.Action {
+	case ActionBan:
+		return session.GuildBanCreateWithReason(member.GuildID, member.User.ID, reason, 0)
+	case ActionKick:
+		return session.GuildMemberDeleteWithReason(member.GuildID, member.User.ID, reason)
+	case ActionTimeout:
+		until := s.clock.Now().Add(s.timeout)
+
+		return session.GuildMemberTimeout(member.GuildID, member.User.ID, &until)
+	case ActionQuarantine:
+		return session.GuildMemberRoleAdd(member.GuildID, member.User.ID, s.quarantineRoleID)
+	default:
+		return nil
+	}
+}
+
+func (s *Screener) notify(ctx context.Context, session Session, member *discordgo.Member, embed *discordgo.MessageEmbed) {
+	if _, err := session.ChannelMessageSendEmbed(s.logChannelID, embed); err != nil {
+		s.logger.ErrorContext(ctx, "notifying screening result",
+			slog.String("error", err.Error()),
+			slog.String("user", member.User.Username),
+			slog.String("user_id", member.User.ID),
+		)
+	}
+}
@@ -0,0 +1,296 @@
+package faac
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	guildID      = "guild"
+	logChannelID = "log"
+	roleID       = "quarantine"
+
+	discordEpoch = 1420070400000
+)
+
+var errPermissions = errors.New("missing permissions")
+
+type call struct {
+	method string
+	userID string
+	arg    string
+}
+
+type fakeSession struct {
+	calls  []call
+	embeds []*discordgo.MessageEmbed
+	err    error
+}
+
+func (f *fakeSession) GuildMemberDeleteWithReason(_, userID, reason string, _ ...discordgo.RequestOption) error {
+	f.calls = append(f.calls, call{method: "kick", userID: userID, arg: reason})
+
+	return f.err
+}
+
+func (f *fakeSession) GuildBanCreateWithReason(_, userID, reason string, _ int, _ ...discordgo.RequestOption) error {
+	f.calls = append(f.calls, call{method: "ban", userID: userID, arg: reason})
+
+	return f.err
+}
+
+func (f *fakeSession) GuildMemberTimeout(_ string, userID string, until *time.Time, _ ...discordgo.RequestOption) error {
+	if until == nil {
+		f.calls = append(f.calls, call{method: "untimeout", userID: userID})
+
+		return f.err
+	}
+
+	f.calls = append(f.calls, call{method: "timeout", userID: userID, arg: until.Format(time.RFC3339)})
+
+	return f.err
+}
+
+func (f *fakeSession) GuildMemberRoleAdd(_, userID, roleID string, _ ...discordgo.RequestOption) error {
+	f.calls = append(f.calls, call{method: "quarantine", userID: userID, arg: roleID})
+
+	return f.err
+}
+
+func (f *fakeSession) GuildBanDelete(_, userID string, _ ...discordgo.RequestOption) error {
+	f.calls = append(f.calls, call{method: "unban", userID: userID})
+
+	return f.err
+}
+
+func (f *fakeSession) GuildMemberRoleRemove(_, userID, roleID string, _ ...discordgo.RequestOption) error {
+	f.calls = append(f.calls, call{method: "unquarantine", userID: userID, arg: roleID})
+
+	return f.err
+}
+
+func (f *fakeSession) ChannelMessageSend(string, string, ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return &discordgo.Message{}, nil
+}
+
+func (f *fakeSession) ChannelMessageSendEmbed(
+	_ string, embed *discordgo.MessageEmbed, _ ...discordgo.RequestOption,
+) (*discordgo.Message, error) {
+	f.embeds = append(f.embeds, embed)
+
+	return &discordgo.Message{}, nil
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+// newMember creates a member whose account was created daysOld days before now.
+func newMember(now time.Time, daysOld int, username, avatar string) *discordgo.Member {
+	created := now.Add(-time.Duration(daysOld) * 24 * time.Hour).UnixMilli()
+
+	return &discordgo.Member{
+		GuildID: guildID,
+		User: &discordgo.User{
+			ID:       strconv.FormatInt((created-discordEpoch)<<22, 10),
+			Username: username,
+			Avatar:   avatar,
+		},
+	}
+}
+
+func testConfig() Config {
+	return Config{
+		Rules: []Rule{
+			{Name: "new-account", Type: RuleAccountAge, Action: ActionKick, MinDays: 7,
+				Raid: &RaidRule{MinDays: 90}},
+			{Name: "no-avatar", Type: RuleAvatar, Action: ActionLog,
+				Raid: &RaidRule{Action: ActionQuarantine}},
+			{Name: "scam-names", Type: RuleUsername, Action: ActionBan, Patterns: []string{`(?i)free.?nitro`}},
+		},
+		Raid:             Raid{Joins: 3, Window: Duration(time.Minute), Duration: Duration(30 * time.Minute)},
+		QuarantineRoleID: roleID,
+	}
+}
+
+func TestScreener_Screen(t *testing.T) {
+	now := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	for _, testcase := range []struct {
+		name      string
+		member    *discordgo.Member
+		allowList []string
+		err       error
+		wants     Verdict
+		wantCalls []string
+	}{
+		{
+			name:   "NoMatch",
+			member: newMember(now, 365, "gopher", "avatar"),
+			wants:  Verdict{AccountAge: 365},
+		},
+		{
+			name:      "AccountAge/Kick",
+			member:    newMember(now, 1, "gopher", "avatar"),
+			wants:     Verdict{Rule: "new-account", Action: ActionKick, AccountAge: 1},
+			wantCalls: []string{"kick"},
+		},
+		{
+			name:   "Avatar/LogOnly",
+			member: newMember(now, 365, "gopher", ""),
+			wants:  Verdict{Rule: "no-avatar", Action: ActionLog, AccountAge: 365},
+		},
+		{
+			name:      "Username/BanIsMostSevere",
+			member:    newMember(now, 1, "Free-Nitro", ""),
+			wants:     Verdict{Rule: "scam-names", Action: ActionBan, AccountAge: 1},
+			wantCalls: []string{"ban"},
+		},
+		{
+			name:      "AllowList/Bypassed",
+			member:    newMember(now, 1, "Free-Nitro", ""),
+			allowList: []string{newMember(now, 1, "", "").User.ID},
+			wants:     Verdict{Bypassed: true, AccountAge: 1},
+		},
+		{
+			name:      "ActionFails",
+			member:    newMember(now, 1, "gopher", "avatar"),
+			err:       errPermissions,
+			wants:     Verdict{Rule: "new-account", Action: ActionKick, AccountAge: 1},
+			wantCalls: []string{"kick"},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			cfg := testConfig()
+			cfg.AllowList = testcase.allowList
+
+			screener, err := NewScreener(logChannelID, cfg, &testClock{now: now}, logger)
+			require.NoError(t, err)
+
+			session := &fakeSession{err: testcase.err}
+
+			verdict, err := screener.Screen(t.Context(), session, testcase.member)
+			require.ErrorIs(t, err, testcase.err)
+
+			require.Equal(t, testcase.wants.Rule, verdict.Rule)
+			require.Equal(t, testcase.wants.Action, verdict.Action)
+			require.Equal(t, testcase.wants.Bypassed, verdict.Bypassed)
+			require.Equal(t, testcase.wants.AccountAge, verdict.AccountAge)
+
+			methods := make([]string, 0, len(session.calls))
+			for i := range session.calls {
+				require.Equal(t, testcase.member.User.ID, session.calls[i].userID)
+
+				methods = append(methods, session.calls[i].method)
+			}
+
+			require.ElementsMatch(t, testcase.wantCalls, methods)
+		})
+	}
+}
+
+func TestScreener_RaidMode(t *testing.T) {
+	clock := &testClock{now: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	screener, err := NewScreener(logChannelID, testConfig(), clock, logger)
+	require.NoError(t, err)
+
+	session := &fakeSession{}
+
+	// a 30-day-old account passes the regular rules
+	for range 2 {
+		verdict, err := screener.Screen(t.Context(), session, newMember(clock.now, 30, "gopher", "avatar"))
+		require.NoError(t, err)
+		require.False(t, verdict.Raid)
+		require.Empty(t, verdict.Action)
+
+		clock.now = clock.now.Add(10 * time.Second)
+	}
+
+	// the third join within a minute activates raid mode, tightening the account age threshold
+	verdict, err := screener.Screen(t.Context(), session, newMember(clock.now, 30, "gopher", "avatar"))
+	require.NoError(t, err)
+	require.True(t, verdict.Raid)
+	require.Equal(t, ActionKick, verdict.Action)
+	require.True(t, screener.RaidMode(guildID))
+	require.Equal(t, "🚨 Raid Mode Activated", session.embeds[0].Title)
+
+	// members without an avatar are quarantined instead of just logged
+	verdict, err = screener.Screen(t.Context(), session, newMember(clock.now, 365, "gopher", ""))
+	require.NoError(t, err)
+	require.Equal(t, ActionQuarantine, verdict.Action)
+	require.Equal(t, call{method: "quarantine", userID: newMember(clock.now, 365, "", "").User.ID, arg: roleID},
+		session.calls[len(session.calls)-1])
+
+	// raid mode expires after its duration
+	clock.now = clock.now.Add(time.Hour)
+	require.False(t, screener.RaidMode(guildID))
+
+	verdict, err = screener.Screen(t.Context(), session, newMember(clock.now, 30, "gopher", "avatar"))
+	require.NoError(t, err)
+	require.False(t, verdict.Raid)
+	require.Empty(t, verdict.Action)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	for _, testcase := range []struct {
+		name string
+		cfg  Config
+		err  error
+	}{
+		{
+			name: "Valid",
+			cfg:  testConfig(),
+		},
+		{
+			name: "Valid/AccountAgeConfig",
+			cfg:  AccountAgeConfig(30),
+		},
+		{
+			name: "Invalid/NoRules",
+			cfg:  Config{},
+			err:  ErrNoRules,
+		},
+		{
+			name: "Invalid/RuleType",
+			cfg:  Config{Rules: []Rule{{Name: "x", Type: "karma", Action: ActionLog}}},
+			err:  ErrInvalidRuleType,
+		},
+		{
+			name: "Invalid/Action",
+			cfg:  Config{Rules: []Rule{{Name: "x", Type: RuleAvatar, Action: "shame"}}},
+			err:  ErrInvalidAction,
+		},
+		{
+			name: "Invalid/NoQuarantineRole",
+			cfg: Config{Rules: []Rule{{Name: "x", Type: RuleAvatar, Action: ActionLog,
+				Raid: &RaidRule{Action: ActionQuarantine}}}},
+			err: ErrNoQuarantineRole,
+		},
+		{
+			name: "Invalid/NoPatterns",
+			cfg:  Config{Rules: []Rule{{Name: "x", Type: RuleUsername, Action: ActionLog}}},
+			err:  ErrNoPatterns,
+		},
+		{
+			name: "Invalid/Raid",
+			cfg:  Config{Rules: AccountAgeConfig(30).Rules, Raid: Raid{Joins: 5}},
+			err:  ErrInvalidRaidConfig,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			require.ErrorIs(t, testcase.cfg.Validate(), testcase.err)
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package faac
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/zalgonoise/x/faac/internal/repository"
+)
+
+const (
+	commandCases       = "cases"
+	commandCase        = "case"
+	commandCaseNote    = "casenote"
+	commandCaseReverse = "casereverse"
+
+	defaultCasesLimit = 10
+
+	// moderatorPermissions are required to see and use the moderation commands, unless overridden in the server's
+	// integration settings.
+	moderatorPermissions int64 = discordgo.PermissionModerateMembers
+)
+
+var (
+	ErrCreateInteractionIsNil = errors.New("create interaction is nil")
+	ErrInteractionUserIsNil   = errors.New("interaction user is nil")
+	ErrMalformedInteraction   = errors.New("malformed interaction")
+)
+
+type ApplicationCommandOpts func(command *discordgo.ApplicationCommand)
+
+type CommandCallback func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error)
+
+type CasesCommand struct {
+	cases  *CaseLog
+	logger *slog.Logger
+}
+
+func (c *CasesCommand) Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	options := getOptions(i.ApplicationCommandData().Options)
+
+	// the user option is optional, listing cases for all users if unset
+	var userID string
+	if opt, ok := options["user"]; ok {
+		userID = opt.UserValue(nil).ID
+	}
+
+	c.logger.DebugContext(ctx, "listing cases", slog.String("user_id", userID))
+
+	cases, err := c.cases.List(ctx, userID, defaultCasesLimit)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to list cases", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return ephemeralEmbed(EmbedCaseList(userID, cases)), nil
+}
+
+func (c *CasesCommand) Name() string {
+	return commandCases
+}
+
+func (c *CasesCommand) Elements() []ApplicationCommandOpts {
+	return []ApplicationCommandOpts{
+		CommandWithElement("user", "user to list cases for", discordgo.ApplicationCommandOptionUser, false),
+		CommandWithPermissions(moderatorPermissions)}
+}
+
+type CaseCommand struct {
+	cases  *CaseLog
+	logger *slog.Logger
+}
+
+func (c *CaseCommand) Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	id, err := getCaseID(i.ApplicationCommandData().Options)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting case ID", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	caseEntry, err := c.cases.Get(ctx, id)
+	if errors.Is(err, repository.ErrCaseNotFound) {
+		c.logger.WarnContext(ctx, "case does not exist", slog.Int64("case_id", id))
+
+		return ephemeralMessage("case not found"), nil
+	}
+
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to fetch case", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return ephemeralEmbed(EmbedCase("📁 Case", caseEntry)), nil
+}
+
+func (c *CaseCommand) Name() string {
+	return commandCase
+}
+
+func (c *CaseCommand) Elements() []ApplicationCommandOpts {
+	return []ApplicationCommandOpts{
+		CommandWithElement("id", "case ID", discordgo.ApplicationCommandOptionInteger, true),
+		CommandWithPermissions(moderatorPermissions)}
+}
+
+type CaseNoteCommand struct {
+	logChannelID string
+
+	cases  *CaseLog
+	logger *slog.Logger
+}
+
+func (c *CaseNoteCommand) Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	moderator, err := getUser(i.Interaction)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting requester context", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	options := i.ApplicationCommandData().Options
+
+	id, err := getCaseID(options)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting case ID", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	note, ok := getOptions(options)["note"]
+	if !ok {
+		return nil, ErrMalformedInteraction
+	}
+
+	caseEntry, err := c.cases.Annotate(ctx, id, moderator.ID, note.StringValue())
+	if errors.Is(err, repository.ErrCaseNotFound) {
+		c.logger.WarnContext(ctx, "case does not exist", slog.Int64("case_id", id))
+
+		return ephemeralMessage("case not found"), nil
+	}
+
+	if err != nil {
+		c.logger.ErrorContext(ctx, "failed to annotate case", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	c.logger.InfoContext(ctx, "annotated case", slog.Int64("case_id", id), slog.String("moderator", moderator.ID))
+
+	if _, err := s.ChannelMessageSendEmbed(c.logChannelID, EmbedCase("📝 Case Annotated", caseEntry)); err != nil {
+		c.logger.ErrorContext(ctx, "sending message",
+			slog.String("action", c.Name()),
+			slog.String("log_channel_id", c.logChannelID),
+			slog.String("error", err.Error()))
+	}
+
+	return ephemeralMessage("annotated case"), nil
+}
+
+func (c *CaseNoteCommand) Name() string {
+	return commandCaseNote
+}
+
+func (c *CaseNoteCommand) Elements() []ApplicationCommandOpts {
+	return []ApplicationCommandOpts{
+		CommandWithElement("id", "case ID", discordgo.ApplicationCommandOptionInteger, true),
+		CommandWithElement("note", "note to add to the case", discordgo.ApplicationCommandOptionString, true),
+		CommandWithPermissions(moderatorPermissions)}
+}
+
+type CaseReverseCommand struct {
+	logChannelID string
+
+	cases  *CaseLog
+	logger *slog.Logger
+}
+
+func (c *CaseReverseCommand) Callback(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	moderator, err := getUser(i.Interaction)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting requester context", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	options := i.ApplicationCommandData().Options
+
+	id, err := getCaseID(options)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "getting case ID", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	optionMap := getOptions(options)
+
+	reason, ok := optionMap["reason"]
+	if !ok {
+		return nil, ErrMalformedInteraction
+	}
+
+	var acceptAppeal bool
+	if opt, ok := optionMap["accept_appeal"]; ok {
+		acceptAppeal = opt.BoolValue()
+	}
+
+	caseEntry, err := c.cases.Reverse(ctx, s, id, moderator.ID, reason.StringValue(), acceptAppeal)
+
+	switch {
+	case errors.Is(err, repository.ErrCaseNotFound):
+		c.logger.WarnContext(ctx, "case does not exist", slog.Int64("case_id", id))
+
+		return ephemeralMessage("case not found"), nil
+	case errors.Is(err, ErrCaseReversed):
+		c.logger.WarnContext(ctx, "case was already reversed", slog.Int64("case_id", id))
+
+		return ephemeralMessage("case was already reversed"), nil
+	case err != nil:
+		c.logger.ErrorContext(ctx, "failed to reverse case", slog.String("error", err.Error()))
+
+		return ephemeralMessage("failed to reverse case, check my permissions"), nil
+	}
+
+	if _, err := s.ChannelMessageSendEmbed(c.logChannelID, EmbedCase("↩️ Case Reversed", caseEntry)); err != nil {
+		c.logger.ErrorContext(ctx, "sending message",
+			slog.String("action", c.Name()),
+			slog.String("log_channel_id", c.logChannelID),
+			slog.String("error", err.Error()))
+	}
+
+	return ephemeralMessage("reversed case"), nil
+}
+
+func (c *CaseReverseCommand) Name() string {
+	return commandCaseReverse
+}
+
+func (c *CaseReverseCommand) Elements() []ApplicationCommandOpts {
+	return []ApplicationCommandOpts{
+		CommandWithElement("id", "case ID", discordgo.ApplicationCommandOptionInteger, true),
+		CommandWithElement("reason", "why the case is reversed", discordgo.ApplicationCommandOptionString, true),
+		CommandWithElement("accept_appeal", "add the user to the allow list", discordgo.ApplicationCommandOptionBoolean, false),
+		CommandWithPermissions(moderatorPermissions)}
+}
+
+func NewCasesCommand(cases *CaseLog, logger *slog.Logger) *CasesCommand {
+	return &CasesCommand{cases: cases, logger: logger}
+}
+
+func NewCaseCommand(cases *CaseLog, logger *slog.Logger) *CaseCommand {
+	return &CaseCommand{cases: cases, logger: logger}
+}
+
+func NewCaseNoteCommand(logChannelID string, cases *CaseLog, logger *slog.Logger) *CaseNoteCommand {
+	return &CaseNoteCommand{logChannelID: logChannelID, cases: cases, logger: logger}
+}
+
+func NewCaseReverseCommand(logChannelID string, cases *CaseLog, logger *slog.Logger) *CaseReverseCommand {
+	return &CaseReverseCommand{logChannelID: logChannelID, cases: cases, logger: logger}
+}
+
+func CommandWithElement(name, desc string, typ discordgo.ApplicationCommandOptionType, req bool) ApplicationCommandOpts {
+	return func(command *discordgo.ApplicationCommand) {
+		command.Options = append(command.Options, &discordgo.ApplicationCommandOption{
+			Name:        name,
+			Type:        typ,
+			Required:    req,
+			Description: desc,
+		})
+	}
+}
+
+// CommandWithPermissions restricts the command to members with the input permissions, by default.
+func CommandWithPermissions(permissions int64) ApplicationCommandOpts {
+	return func(command *discordgo.ApplicationCommand) {
+		command.DefaultMemberPermissions = &permissions
+	}
+}
+
+func RegisterSlashCommand(logger *slog.Logger, command string, callback CommandCallback, opts ...ApplicationCommandOpts) (func(s *discordgo.Session, i *discordgo.InteractionCreate), *discordgo.ApplicationCommand) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        command,
+		Description: command,
+		Options:     []*discordgo.ApplicationCommandOption{},
+	}
+
+	for _, opt := range opts {
+		opt(cmd)
+	}
+
+	return func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+		if i.Type == discordgo.InteractionApplicationCommand && i.ApplicationCommandData().Name == command {
+			ctx := context.Background()
+
+			res, err := callback(ctx, s, i)
+			if err != nil {
+				logger.ErrorContext(ctx, "failed to execute command",
+					slog.String("command", command),
+					slog.String("error", err.Error()),
+				)
+
+				return
+			}
+
+			if err := s.InteractionRespond(i.Interaction, res); err != nil {
+				logger.ErrorContext(ctx, "failed to respond to command",
+					slog.String("command", command),
+					slog.String("error", err.Error()),
+					slog.Any("response", res),
+				)
+			}
+		}
+	}, cmd
+}
+
+func getUser(i *discordgo.Interaction) (*discordgo.User, error) {
+	if i == nil {
+		return nil, ErrCreateInteractionIsNil
+	}
+
+	switch {
+	case i.User != nil:
+		return i.User, nil
+	case i.Member != nil && i.Member.User != nil:
+		return i.Member.User, nil
+	default:
+		return nil, ErrInteractionUserIsNil
+	}
+}
+
+func getOptions(values []*discordgo.ApplicationCommandInteractionDataOption) map[string]*discordgo.ApplicationCommandInteractionDataOption {
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(values))
+	for _, opt := range values {
+		optionMap[opt.Name] = opt
+	}
+
+	return optionMap
+}
+
+func getCaseID(values []*discordgo.ApplicationCommandInteractionDataOption) (int64, error) {
+	id, ok := getOptions(values)["id"]
+	if !ok {
+		return 0, ErrMalformedInteraction
+	}
+
+	return id.IntValue(), nil
+}
+
+func ephemeralMessage(content string) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+	}
+}
+
+func ephemeralEmbed(embed *discordgo.MessageEmbed) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{embed},
+			Flags:  discordgo.MessageFlagsEphemeral,
+		},
+	}
+}
@@ -7,9 +7,12 @@ import (
 	"log/slog"
 	"os/exec"
 	"strings"
+	"sync"
 
 	"github.com/zalgonoise/x/modupdate/config"
 	"github.com/zalgonoise/x/modupdate/events"
+	"github.com/zalgonoise/x/modupdate/forge"
+	"github.com/zalgonoise/x/modupdate/repository"
 )
 
 const (
@@ -18,9 +21,13 @@ const (
 	actionCheckoutBranch  = "checkout.branch"
 	actionUpdateRepo      = "update.repository"
 	actionUpdateMod       = "update.modules"
+	actionUpdateReport    = "update.report"
 	actionCheckBuild      = "check.build"
 	actionPushCommit      = "push.commit"
 	actionPushPush        = "push.push"
+	actionPushBranch      = "push.branch"
+	actionPullRequest     = "pull_request.create"
+	actionPullRequestOpen = "pull_request.open"
 )
 
 var ErrBinNotFound = errors.New("binary not found")
@@ -30,6 +37,23 @@ type Reporter interface {
 	Flush()
 }
 
+// Store records the task's runs and the dependency updates applied to a repository.
+type Store interface {
+	AddModuleUpdates(ctx context.Context, repo *config.Repository, updates []repository.ModuleUpdate) error
+	CreateRun(ctx context.Context, run *repository.Run) (int64, error)
+	AddRunStep(ctx context.Context, id int64, step repository.Step) error
+	FinishRun(ctx context.Context, run *repository.Run) error
+}
+
+type Option func(*ModUpdate)
+
+// WithStore records each run, as well as its dependency updates, in the input Store.
+func WithStore(store Store) Option {
+	return func(a *ModUpdate) {
+		a.store = store
+	}
+}
+
 type ModUpdate struct {
 	repo     *config.Repository
 	checkout *config.Checkout
@@ -37,16 +61,27 @@ type ModUpdate struct {
 	check    *config.Check
 	push     *config.Push
 
+	forge    Forge
+	store    Store
 	reporter Reporter
 	logger   *slog.Logger
+
+	// mu prevents scheduled and manually triggered runs from overlapping in the same checkout
+	mu sync.Mutex
+
+	// updates and groups are set by Update, to be reported, stored and committed
+	updates []repository.ModuleUpdate
+	groups  []updateGroup
+	// commit is set by Push to the resulting commit's hash
+	commit string
 }
 
-func NewModUpdate(reporter Reporter, cfg *config.Task, logger *slog.Logger) *ModUpdate {
+func NewModUpdate(reporter Reporter, cfg *config.Task, logger *slog.Logger, opts ...Option) *ModUpdate {
 	if cfg == nil {
 		return nil
 	}
 
-	return &ModUpdate{
+	a := &ModUpdate{
 		repo:     &cfg.Repository,
 		checkout: &cfg.Checkout,
 		update:   &cfg.Update,
@@ -55,24 +90,23 @@ func NewModUpdate(reporter Reporter, cfg *config.Task, logger *slog.Logger) *Mod
 		reporter: reporter,
 		logger:   logger,
 	}
-}
 
-func (a *ModUpdate) Run(ctx context.Context) error {
-	defer a.reporter.Flush()
-
-	if err := a.Checkout(ctx); err != nil {
-		return err
+	if cfg.Push.PullRequest != nil {
+		a.forge = forge.NewClient(forgeURL(a.repo, cfg.Push.PullRequest), cfg.Repository.Token, nil)
 	}
 
-	if err := a.Update(ctx); err != nil {
-		return err
+	for i := range opts {
+		opts[i](a)
 	}
 
-	if err := a.Check(ctx); err != nil {
-		return err
-	}
+	return a
+}
+
+func (a *ModUpdate) Run(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 
-	return a.Push(ctx)
+	return a.run(ctx, a.startRun(ctx, repository.TriggerSchedule))
 }
 
 func cmd(ctx context.Context, dir, bin string, args ...string) ([]string, error) {
@@ -93,12 +127,17 @@ func cmd(ctx context.Context, dir, bin string, args ...string) ([]string, error)
 	}
 
 	buf, err := c.CombinedOutput()
-	if err != nil {
-		return nil, err
-	}
+	output := splitLines(buf)
+
+	recordCommand(ctx, bin, args, output, err)
+
+	// the output is returned on failure too, as it usually describes the error
+	return output, err
+}
 
+func splitLines(buf []byte) []string {
 	if len(buf) == 0 {
-		return []string{}, nil
+		return []string{}
 	}
 
 	split := strings.Split(string(buf), "\n")
@@ -111,5 +150,5 @@ func cmd(ctx context.Context, dir, bin string, args ...string) ([]string, error)
 		}
 	}
 
-	return output, nil
+	return output
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/zalgonoise/x/modupdate/config"
@@ -59,7 +60,11 @@ func (a *ModUpdate) setPath(ctx context.Context) error {
 }
 
 func (a *ModUpdate) checkRepoExists(ctx context.Context) (bool, error) {
-	if out, err := cmd(ctx, a.checkout.Path, "file", ".git"); err == nil {
+	gitDir := filepath.Join(a.checkout.Path, ".git")
+
+	if stat, err := os.Stat(gitDir); err == nil && stat.IsDir() {
+		out := []string{gitDir}
+
 		a.reporter.ReportEvent(events.Event{
 			Action: actionCheckoutPresent,
 			URI:    a.repo.Path,
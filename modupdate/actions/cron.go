@@ -1,6 +1,9 @@
 package actions
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -12,13 +15,25 @@ import (
 
 const serviceID = "modupdate"
 
-func NewActions(reporter Reporter, logger *slog.Logger, tasks ...*config.Task) (micron.Runtime, error) {
+var ErrTaskNotFound = errors.New("task not found")
+
+// Actions runs the configured tasks on their cron schedules, while also allowing them to be triggered on demand.
+type Actions struct {
+	micron.Runtime
+
+	tasks []*ModUpdate
+}
+
+func NewActions(reporter Reporter, store Store, logger *slog.Logger, tasks ...*config.Task) (*Actions, error) {
 	execs := make([]executor.Executor, 0, len(tasks))
+	runners := make([]*ModUpdate, 0, len(tasks))
 
 	for i := range tasks {
+		runner := NewModUpdate(reporter, tasks[i], logger, WithStore(store))
+
 		e, err := executor.New(serviceID,
 			executor.WithSchedule(tasks[i].CronSchedule),
-			executor.WithRunners(NewModUpdate(reporter, tasks[i], logger)),
+			executor.WithRunners(runner),
 			executor.WithLocation(time.Local),
 			executor.WithLogger(logger),
 		)
@@ -28,6 +43,7 @@ func NewActions(reporter Reporter, logger *slog.Logger, tasks ...*config.Task) (
 		}
 
 		execs = append(execs, e)
+		runners = append(runners, runner)
 	}
 
 	sel, err := selector.New(selector.WithBlock(), selector.WithExecutors(execs...))
@@ -35,8 +51,28 @@ func NewActions(reporter Reporter, logger *slog.Logger, tasks ...*config.Task) (
 		return nil, err
 	}
 
-	return micron.New(
+	runtime, err := micron.New(
 		micron.WithLogger(logger),
 		micron.WithSelector(sel),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Actions{
+		Runtime: runtime,
+		tasks:   runners,
+	}, nil
+}
+
+// Trigger runs the task matching the input repository URI, module and branch on demand, returning the ID of the
+// recorded run.
+func (a *Actions) Trigger(ctx context.Context, uri, module, branch string) (int64, error) {
+	for i := range a.tasks {
+		if a.tasks[i].repo.Path == uri && a.tasks[i].repo.ModulePath == module && a.tasks[i].repo.Branch == branch {
+			return a.tasks[i].Trigger(ctx)
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s/%s@%s", ErrTaskNotFound, uri, module, branch)
 }
@@ -0,0 +1,257 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/zalgonoise/x/modupdate/config"
+	"github.com/zalgonoise/x/modupdate/events"
+	"github.com/zalgonoise/x/modupdate/forge"
+	"github.com/zalgonoise/x/modupdate/repository"
+)
+
+const (
+	defaultBranchPrefix    = "modupdate/"
+	defaultPullRequestBody = "Automated Go module update by modupdate."
+	githubHost             = "github.com"
+	githubAPI              = "https://api.github.com"
+	giteaAPIPath           = "/api/v1"
+)
+
+var ErrInvalidRepositoryPath = errors.New("repository URI must be in the form {host}/{owner}/{name}")
+
+type Forge interface {
+	ListOpenPullRequests(ctx context.Context, owner, name string) ([]forge.PullRequest, error)
+	CreatePullRequest(ctx context.Context, owner, name string, pr forge.PullRequest) (forge.PullRequest, error)
+}
+
+// pullRequest commits the changes to a branch generated for the task, which is force-pushed and proposed as a pull
+// request against the task's branch. The branch name is stable, so that when the previous update's pull request is
+// still open, pushing the branch refreshes it instead of opening a new one.
+func (a *ModUpdate) pullRequest(ctx context.Context, dir string) error {
+	owner, name, err := repositoryName(a.repo, a.push.PullRequest)
+	if err != nil {
+		return err
+	}
+
+	base := a.repo.Branch
+	if base == "" {
+		base = defaultBranch
+	}
+
+	head := headBranch(a.repo, a.push.PullRequest)
+
+	// git checkout -B {head}
+	out, err := cmd(ctx, a.checkout.Path, a.checkout.GitPath, "checkout", "-B", head)
+	if err != nil {
+		return err
+	}
+
+	// leave the checkout in the task's branch, to be updated in the next run
+	defer func() {
+		if _, err := cmd(ctx, a.checkout.Path, a.checkout.GitPath, "checkout", base); err != nil {
+			a.logger.WarnContext(ctx, "failed to check out the base branch",
+				slog.String("branch", base), slog.String("error", err.Error()))
+		}
+	}()
+
+	output, err := a.gitAddGoMod(ctx, dir)
+	if err != nil {
+		return err
+	}
+
+	out = append(out, output...)
+
+	if a.push.DryRun {
+		return a.doDryRun(ctx, dir, out)
+	}
+
+	remote := a.push.PullRequest.Remote
+	if remote == "" {
+		remote = buildPath(a.repo)
+	}
+
+	// git push --force {remote} {head}
+	output, err = cmd(ctx, dir, a.checkout.GitPath, "push", "--force", remote, head)
+	if err != nil {
+		if len(output) > 0 {
+			err = fmt.Errorf("%w: %s", err, strings.Join(output, "; "))
+		}
+
+		return err
+	}
+
+	out = append(out, output...)
+
+	a.reporter.ReportEvent(events.Event{
+		Action: actionPushBranch,
+		URI:    a.repo.Path,
+		Module: a.repo.ModulePath,
+		Branch: head,
+		Output: out,
+	})
+
+	a.logger.InfoContext(ctx, "files pushed to update branch",
+		slog.String("branch", head), slog.Any("output", out))
+
+	open, err := a.forge.ListOpenPullRequests(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	for i := range open {
+		if open[i].Head == head && open[i].Base == base {
+			a.reporter.ReportEvent(events.Event{
+				Action: actionPullRequestOpen,
+				URI:    a.repo.Path,
+				Module: a.repo.ModulePath,
+				Branch: base,
+				Output: []string{open[i].URL},
+			})
+
+			a.logger.InfoContext(ctx, "update pull request is already open",
+				slog.Int("number", open[i].Number), slog.String("url", open[i].URL))
+
+			return nil
+		}
+	}
+
+	pr, err := a.forge.CreatePullRequest(ctx, owner, name, forge.PullRequest{
+		Title: pullRequestTitle(a.push),
+		Body:  pullRequestBody(a.push.PullRequest, a.updates, out),
+		Head:  head,
+		Base:  base,
+	})
+	if err != nil {
+		return err
+	}
+
+	a.reporter.ReportEvent(events.Event{
+		Action: actionPullRequest,
+		URI:    a.repo.Path,
+		Module: a.repo.ModulePath,
+		Branch: base,
+		Output: []string{pr.URL},
+	})
+
+	a.logger.InfoContext(ctx, "opened update pull request",
+		slog.Int("number", pr.Number), slog.String("url", pr.URL))
+
+	return nil
+}
+
+// headBranch returns the name of the branch to push updates to, from the task's branch and module. Module paths are
+// flattened so that updates to different modules in the same branch never collide as git refs.
+func headBranch(repo *config.Repository, cfg *config.PullRequest) string {
+	prefix := cfg.BranchPrefix
+	if prefix == "" {
+		prefix = defaultBranchPrefix
+	}
+
+	branch := repo.Branch
+	if branch == "" {
+		branch = defaultBranch
+	}
+
+	if module := strings.Trim(repo.ModulePath, "/"); module != "" {
+		branch += "-" + strings.ReplaceAll(module, "/", "-")
+	}
+
+	return prefix + branch
+}
+
+func pullRequestTitle(push *config.Push) string {
+	switch {
+	case push.PullRequest.Title != "":
+		return push.PullRequest.Title
+	case push.CommitMessage != "":
+		return push.CommitMessage
+	default:
+		return defaultCommitMessage
+	}
+}
+
+func pullRequestBody(cfg *config.PullRequest, updates []repository.ModuleUpdate, out []string) string {
+	body := cfg.Body
+	if body == "" {
+		body = defaultPullRequestBody
+	}
+
+	sb := &strings.Builder{}
+	sb.WriteString(body)
+
+	if len(updates) > 0 {
+		sb.WriteString("\n\n")
+
+		for i := range updates {
+			sb.WriteString("- ")
+			sb.WriteString(formatUpdate(updates[i]))
+			sb.WriteByte('\n')
+		}
+	}
+
+	if len(out) > 0 {
+		sb.WriteString("\n\n```\n")
+
+		for i := range out {
+			sb.WriteString(out[i])
+			sb.WriteByte('\n')
+		}
+
+		sb.WriteString("```\n")
+	}
+
+	return sb.String()
+}
+
+func splitRepositoryPath(path string) (host, owner, name string, err error) {
+	split := strings.Split(strings.TrimSuffix(strings.Trim(path, "/"), ".git"), "/")
+
+	if len(split) < 3 {
+		return "", "", "", fmt.Errorf("%w: %q", ErrInvalidRepositoryPath, path)
+	}
+
+	return split[0], split[len(split)-2], split[len(split)-1], nil
+}
+
+func repositoryName(repo *config.Repository, cfg *config.PullRequest) (owner, name string, err error) {
+	if cfg.Owner != "" && cfg.Name != "" {
+		return cfg.Owner, cfg.Name, nil
+	}
+
+	_, owner, name, err = splitRepositoryPath(repo.Path)
+	if err != nil {
+		return "", "", err
+	}
+
+	if cfg.Owner != "" {
+		owner = cfg.Owner
+	}
+
+	if cfg.Name != "" {
+		name = cfg.Name
+	}
+
+	return owner, name, nil
+}
+
+func forgeURL(repo *config.Repository, cfg *config.PullRequest) string {
+	if cfg.ForgeURL != "" {
+		return cfg.ForgeURL
+	}
+
+	host, _, _, err := splitRepositoryPath(repo.Path)
+	if err != nil {
+		// reported when resolving the repository's owner and name
+		return ""
+	}
+
+	if host == githubHost {
+		return githubAPI
+	}
+
+	return "https://" + host + giteaAPIPath
+}
@@ -0,0 +1,184 @@
+package actions
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/modupdate/config"
+	"github.com/zalgonoise/x/modupdate/events"
+	"github.com/zalgonoise/x/modupdate/forge/forgetest"
+)
+
+const (
+	testToken = "secret"
+	testOwner = "zalgonoise"
+	testName  = "x"
+)
+
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	c := exec.Command("git", args...)
+	c.Dir = dir
+
+	out, err := c.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	return string(out)
+}
+
+// newRemote creates a bare repository with a Go module in its main branch, returning its path and a clone of it.
+func newRemote(t *testing.T) (remote, checkout string) {
+	t.Helper()
+
+	for key, value := range map[string]string{
+		"GIT_AUTHOR_NAME":     "modupdate",
+		"GIT_AUTHOR_EMAIL":    "modupdate@example.com",
+		"GIT_COMMITTER_NAME":  "modupdate",
+		"GIT_COMMITTER_EMAIL": "modupdate@example.com",
+	} {
+		t.Setenv(key, value)
+	}
+
+	dir := t.TempDir()
+	remote = filepath.Join(dir, "remote.git")
+	checkout = filepath.Join(dir, "checkout")
+	seed := filepath.Join(dir, "seed")
+
+	git(t, dir, "init", "--bare", "-b", "main", remote)
+	git(t, dir, "init", "-b", "main", seed)
+
+	require.NoError(t, os.WriteFile(filepath.Join(seed, "go.mod"), []byte("module example.com/x\n\ngo 1.22\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(seed, "go.sum"), []byte{}, 0o600))
+
+	git(t, seed, "add", ".")
+	git(t, seed, "commit", "-m", "init")
+	git(t, seed, "push", remote, "main")
+	git(t, dir, "clone", remote, checkout)
+
+	return remote, checkout
+}
+
+func touchGoMod(t *testing.T, checkout, line string) {
+	t.Helper()
+
+	f, err := os.OpenFile(filepath.Join(checkout, "go.mod"), os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+
+	_, err = f.WriteString("\n// " + line + "\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestPush_PullRequest(t *testing.T) {
+	server := forgetest.NewServer(testToken)
+	defer server.Close()
+
+	remote, checkout := newRemote(t)
+	ctx := context.Background()
+
+	a := NewModUpdate(events.NoOp{}, &config.Task{
+		Repository: config.Repository{
+			Path:   "example.com/" + testOwner + "/" + testName,
+			Branch: "main",
+			Token:  testToken,
+		},
+		Checkout: config.Checkout{
+			Path: checkout,
+		},
+		Push: config.Push{
+			CommitMessage: "chore: updated modules",
+			PullRequest: &config.PullRequest{
+				ForgeURL: server.URL,
+				Remote:   remote,
+			},
+		},
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	t.Run("OpensPullRequest", func(t *testing.T) {
+		touchGoMod(t, checkout, "first")
+		require.NoError(t, a.Push(ctx))
+
+		pulls := server.PullRequests(testOwner, testName)
+		require.Len(t, pulls, 1)
+		require.Equal(t, "modupdate/main", pulls[0].Head)
+		require.Equal(t, "main", pulls[0].Base)
+		require.Equal(t, "chore: updated modules", pulls[0].Title)
+
+		// the base branch is never pushed to, and the checkout is left on it
+		require.Contains(t, git(t, remote, "log", "--format=%s", "modupdate/main"), "chore: updated modules")
+		require.NotContains(t, git(t, remote, "log", "--format=%s", "main"), "chore: updated modules")
+		require.Equal(t, "main\n", git(t, checkout, "branch", "--show-current"))
+	})
+
+	t.Run("ReusesOpenPullRequest", func(t *testing.T) {
+		touchGoMod(t, checkout, "second")
+		require.NoError(t, a.Push(ctx))
+
+		require.Len(t, server.PullRequests(testOwner, testName), 1)
+		require.Contains(t, git(t, remote, "show", "modupdate/main:go.mod"), "// second")
+	})
+
+	t.Run("OpensNewPullRequestOnceClosed", func(t *testing.T) {
+		server.ClosePullRequest(testOwner, testName, 1)
+
+		touchGoMod(t, checkout, "third")
+		require.NoError(t, a.Push(ctx))
+
+		pulls := server.PullRequests(testOwner, testName)
+		require.Len(t, pulls, 2)
+		require.Equal(t, 2, pulls[1].Number)
+	})
+}
+
+func TestHeadBranch(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		repo  config.Repository
+		cfg   config.PullRequest
+		wants string
+	}{
+		{
+			name:  "Default",
+			repo:  config.Repository{},
+			wants: "modupdate/main",
+		},
+		{
+			name:  "WithModule",
+			repo:  config.Repository{Branch: "master", ModulePath: "cmd/modupdate/"},
+			wants: "modupdate/master-cmd-modupdate",
+		},
+		{
+			name:  "WithPrefix",
+			repo:  config.Repository{Branch: "master"},
+			cfg:   config.PullRequest{BranchPrefix: "deps/"},
+			wants: "deps/master",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			require.Equal(t, testcase.wants, headBranch(&testcase.repo, &testcase.cfg))
+		})
+	}
+}
+
+func TestForgeURL(t *testing.T) {
+	require.Equal(t, "https://api.github.com",
+		forgeURL(&config.Repository{Path: "github.com/zalgonoise/x"}, &config.PullRequest{}))
+	require.Equal(t, "https://gitea.example.com/api/v1",
+		forgeURL(&config.Repository{Path: "gitea.example.com/zalgonoise/x.git"}, &config.PullRequest{}))
+
+	owner, name, err := repositoryName(&config.Repository{Path: "gitea.example.com/zalgonoise/x.git"}, &config.PullRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "zalgonoise", owner)
+	require.Equal(t, "x", name)
+
+	_, _, err = repositoryName(&config.Repository{Path: "x"}, &config.PullRequest{})
+	require.ErrorIs(t, err, ErrInvalidRepositoryPath)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"os"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/zalgonoise/x/modupdate/events"
@@ -26,6 +28,10 @@ func (a *ModUpdate) Push(ctx context.Context) error {
 		return err
 	}
 
+	if a.push.PullRequest != nil {
+		return a.pullRequest(ctx, dir)
+	}
+
 	// git add go.mod go.sum
 	// git commit -m 'chore: updated modules'
 	out, err := a.gitAddGoMod(ctx, dir)
@@ -57,6 +63,14 @@ func (a *ModUpdate) gitAddGoMod(ctx context.Context, dir string) (out []string,
 			out = append(out, output...)
 		}
 
+	case len(a.groups) > 0:
+		output, err := a.gitCommitGroups(ctx, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, output...)
+
 	default:
 		output, err := cmd(ctx, dir, a.checkout.GitPath, "add", "go.mod", "go.sum")
 		if err != nil {
@@ -81,9 +95,62 @@ func (a *ModUpdate) gitAddGoMod(ctx context.Context, dir string) (out []string,
 		out = append(out, output...)
 	}
 
+	// the resulting commit is recorded in the task's run
+	if head, err := cmd(ctx, dir, a.checkout.GitPath, "rev-parse", "HEAD"); err == nil && len(head) > 0 {
+		a.commit = head[0]
+	}
+
 	return out, nil
 }
 
+// gitCommitGroups commits each dependency update separately, by restoring the go.mod and go.sum files as they were
+// after each update.
+func (a *ModUpdate) gitCommitGroups(ctx context.Context, dir string) (out []string, err error) {
+	if a.push.CommitMessage == "" {
+		a.push.CommitMessage = defaultCommitMessage
+	}
+
+	for i := range a.groups {
+		if err = os.WriteFile(filepath.Join(dir, goModFile), a.groups[i].goMod, 0o644); err != nil {
+			return out, err
+		}
+
+		if err = os.WriteFile(filepath.Join(dir, goSumFile), a.groups[i].goSum, 0o644); err != nil {
+			return out, err
+		}
+
+		output, err := cmd(ctx, dir, a.checkout.GitPath, "add", goModFile, goSumFile)
+		if err != nil {
+			if len(output) > 0 {
+				err = fmt.Errorf("%w: %s", err, strings.Join(output, "; "))
+			}
+
+			return out, err
+		}
+
+		out = append(out, output...)
+
+		output, err = cmd(ctx, dir, a.checkout.GitPath, "commit", "-m", groupCommitMessage(a.push.CommitMessage, a.groups[i]))
+		if err != nil {
+			return out, err
+		}
+
+		out = append(out, output...)
+	}
+
+	return out, nil
+}
+
+func groupCommitMessage(message string, group updateGroup) string {
+	for i := range group.updates {
+		if group.updates[i].Path == group.module {
+			return fmt.Sprintf("%s: %s %s => %s", message, group.module, group.updates[i].From, group.updates[i].To)
+		}
+	}
+
+	return fmt.Sprintf("%s: %s", message, group.module)
+}
+
 func (a *ModUpdate) doDryRun(ctx context.Context, dir string, out []string) error {
 	output, err := cmd(ctx, dir, a.checkout.GitPath, "status")
 	if err != nil {
@@ -0,0 +1,200 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zalgonoise/x/modupdate/repository"
+)
+
+const (
+	stepCheckout = "checkout"
+	stepUpdate   = "update"
+	stepCheck    = "check"
+	stepPush     = "push"
+
+	redacted = "********"
+)
+
+var ErrRunInProgress = errors.New("task is already running")
+
+// Trigger runs the task on demand, in the background. It returns the ID of the recorded run, or ErrRunInProgress if
+// the task is already running.
+func (a *ModUpdate) Trigger(ctx context.Context) (int64, error) {
+	if !a.mu.TryLock() {
+		return 0, ErrRunInProgress
+	}
+
+	run := a.startRun(ctx, repository.TriggerManual)
+
+	// the run outlives the request that triggered it
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		defer a.mu.Unlock()
+
+		if err := a.run(ctx, run); err != nil {
+			a.logger.ErrorContext(ctx, "manually triggered run failed",
+				slog.Int64("run_id", run.ID), slog.String("error", err.Error()))
+		}
+	}()
+
+	return run.ID, nil
+}
+
+// run executes each of the task's steps in order, recording them and the run's outcome in the Store.
+func (a *ModUpdate) run(ctx context.Context, run *repository.Run) (err error) {
+	defer a.reporter.Flush()
+
+	a.commit = ""
+
+	defer func() {
+		a.finishRun(ctx, run, err)
+	}()
+
+	for _, step := range []struct {
+		name string
+		fn   func(context.Context) error
+	}{
+		{name: stepCheckout, fn: a.Checkout},
+		{name: stepUpdate, fn: a.Update},
+		{name: stepCheck, fn: a.Check},
+		{name: stepPush, fn: a.Push},
+	} {
+		if err = a.runStep(ctx, run, step.name, step.fn); err != nil {
+			return fmt.Errorf("%s: %w", step.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *ModUpdate) startRun(ctx context.Context, trigger string) *repository.Run {
+	run := &repository.Run{
+		URI:       a.repo.Path,
+		Module:    a.repo.ModulePath,
+		Branch:    a.repo.Branch,
+		Trigger:   trigger,
+		Status:    repository.StatusRunning,
+		StartedAt: time.Now(),
+	}
+
+	if a.store == nil {
+		return run
+	}
+
+	id, err := a.store.CreateRun(ctx, run)
+	if err != nil {
+		a.logger.WarnContext(ctx, "failed to record run", slog.String("error", err.Error()))
+
+		return run
+	}
+
+	run.ID = id
+
+	return run
+}
+
+func (a *ModUpdate) runStep(
+	ctx context.Context, run *repository.Run, name string, fn func(context.Context) error,
+) error {
+	rec := &recorder{secrets: []string{a.repo.Token}}
+	step := repository.Step{
+		Name:      name,
+		StartedAt: time.Now(),
+	}
+
+	err := fn(withRecorder(ctx, rec))
+
+	step.Duration = time.Since(step.StartedAt)
+	step.Output = rec.lines()
+
+	if err != nil {
+		step.Error = rec.redact(err.Error())
+	}
+
+	if a.store != nil && run.ID != 0 {
+		if storeErr := a.store.AddRunStep(ctx, run.ID, step); storeErr != nil {
+			a.logger.WarnContext(ctx, "failed to record run step",
+				slog.Int64("run_id", run.ID), slog.String("step", name), slog.String("error", storeErr.Error()))
+		}
+	}
+
+	return err
+}
+
+func (a *ModUpdate) finishRun(ctx context.Context, run *repository.Run, err error) {
+	run.Duration = time.Since(run.StartedAt)
+	run.Commit = a.commit
+	run.Status = repository.StatusSuccess
+
+	if err != nil {
+		run.Status = repository.StatusFailure
+		run.Error = (&recorder{secrets: []string{a.repo.Token}}).redact(err.Error())
+	}
+
+	if a.store == nil || run.ID == 0 {
+		return
+	}
+
+	if storeErr := a.store.FinishRun(ctx, run); storeErr != nil {
+		a.logger.WarnContext(ctx, "failed to record run outcome",
+			slog.Int64("run_id", run.ID), slog.String("error", storeErr.Error()))
+	}
+}
+
+type recorderKey struct{}
+
+// recorder collects the commands executed in a step, along with their output.
+type recorder struct {
+	mu      sync.Mutex
+	secrets []string
+	output  []string
+}
+
+func withRecorder(ctx context.Context, rec *recorder) context.Context {
+	return context.WithValue(ctx, recorderKey{}, rec)
+}
+
+func recordCommand(ctx context.Context, bin string, args, output []string, err error) {
+	rec, ok := ctx.Value(recorderKey{}).(*recorder)
+	if !ok {
+		return
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	rec.output = append(rec.output, rec.redact("$ "+strings.Join(append([]string{bin}, args...), " ")))
+
+	for i := range output {
+		rec.output = append(rec.output, rec.redact(output[i]))
+	}
+
+	if err != nil {
+		rec.output = append(rec.output, rec.redact("error: "+err.Error()))
+	}
+}
+
+func (r *recorder) lines() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.output...)
+}
+
+// redact hides the repository's credentials, as clone and push URLs include them.
+func (r *recorder) redact(s string) string {
+	for i := range r.secrets {
+		if r.secrets[i] != "" {
+			s = strings.ReplaceAll(s, r.secrets[i], redacted)
+		}
+	}
+
+	return s
+}
@@ -97,6 +97,14 @@ func (a *ModUpdate) gitFetchPull(ctx context.Context, dir string) (err error) {
 func (a *ModUpdate) goGet(ctx context.Context, dir, goBin string) error {
 	out := make([]string, 0, 8)
 
+	file, err := readGoMod(dir)
+	if err != nil {
+		return err
+	}
+
+	a.updates = nil
+	a.groups = nil
+
 	switch {
 	case len(a.update.GoCommandOverrides) > 0:
 		for i := range a.update.GoCommandOverrides {
@@ -107,6 +115,13 @@ func (a *ModUpdate) goGet(ctx context.Context, dir, goBin string) error {
 
 			out = append(out, output...)
 		}
+	case a.update.Selective():
+		output, err := a.goGetSelective(ctx, dir, goBin)
+		if err != nil {
+			return err
+		}
+
+		out = append(out, output...)
 	default:
 		output, err := cmd(ctx, dir, goBin, "get", "-u", "./...")
 		if err != nil {
@@ -116,13 +131,16 @@ func (a *ModUpdate) goGet(ctx context.Context, dir, goBin string) error {
 		out = append(out, output...)
 	}
 
-	// go mod tidy
-	tidyOut, err := cmd(ctx, dir, goBin, "mod", "tidy")
-	if err != nil {
-		return err
-	}
+	// grouped updates are already tidied, and the last group must match the files in the checkout
+	if len(a.groups) == 0 {
+		// go mod tidy
+		tidyOut, err := cmd(ctx, dir, goBin, "mod", "tidy")
+		if err != nil {
+			return err
+		}
 
-	out = append(out, tidyOut...)
+		out = append(out, tidyOut...)
+	}
 
 	a.reporter.ReportEvent(events.Event{
 		Action: actionUpdateMod,
@@ -134,7 +152,42 @@ func (a *ModUpdate) goGet(ctx context.Context, dir, goBin string) error {
 
 	a.logger.InfoContext(ctx, "modules updated successfully", slog.Any("output", out))
 
-	return nil
+	after, err := readGoMod(dir)
+	if err != nil {
+		return err
+	}
+
+	a.updates = diffRequirements(requirements(file), requirements(after))
+
+	return a.reportUpdates(ctx)
+}
+
+func (a *ModUpdate) reportUpdates(ctx context.Context) error {
+	if len(a.updates) == 0 {
+		return nil
+	}
+
+	report := make([]string, 0, len(a.updates))
+
+	for i := range a.updates {
+		report = append(report, formatUpdate(a.updates[i]))
+	}
+
+	a.reporter.ReportEvent(events.Event{
+		Action: actionUpdateReport,
+		URI:    a.repo.Path,
+		Module: a.repo.ModulePath,
+		Branch: a.repo.Branch,
+		Output: report,
+	})
+
+	a.logger.InfoContext(ctx, "dependency versions changed", slog.Any("updates", report))
+
+	if a.store == nil {
+		return nil
+	}
+
+	return a.store.AddModuleUpdates(ctx, a.repo, a.updates)
 }
 
 func Update(
@@ -0,0 +1,320 @@
+package actions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+
+	"github.com/zalgonoise/x/modupdate/repository"
+)
+
+const (
+	policyLatest = "latest"
+	policyMinor  = "minor"
+	policyPatch  = "patch"
+
+	goModFile = "go.mod"
+	goSumFile = "go.sum"
+)
+
+var ErrInvalidPolicy = errors.New("invalid update policy")
+
+// updateGroup is the state of the go.mod and go.sum files after updating a single dependency, to be committed on
+// its own.
+type updateGroup struct {
+	module  string
+	updates []repository.ModuleUpdate
+	goMod   []byte
+	goSum   []byte
+}
+
+type moduleVersions struct {
+	Path     string   `json:"Path"`
+	Version  string   `json:"Version"`
+	Versions []string `json:"Versions"`
+}
+
+// goGetSelective updates the direct dependencies selected by the allow and deny lists, each to the highest stable
+// version permitted by the policy. When commits are grouped, each dependency is updated (and tidied) on its own, and
+// the resulting files are kept in a.groups.
+func (a *ModUpdate) goGetSelective(ctx context.Context, dir, goBin string) ([]string, error) {
+	switch a.update.Policy {
+	case "", policyLatest, policyMinor, policyPatch:
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidPolicy, a.update.Policy)
+	}
+
+	file, err := readGoMod(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(file.Require))
+	current := make(map[string]string, len(file.Require))
+
+	for _, req := range file.Require {
+		if req.Indirect || !selectModule(req.Mod.Path, a.update.Allow, a.update.Deny) {
+			continue
+		}
+
+		paths = append(paths, req.Mod.Path)
+		current[req.Mod.Path] = req.Mod.Version
+	}
+
+	if len(paths) == 0 {
+		return []string{}, nil
+	}
+
+	versions, err := listVersions(ctx, dir, goBin, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]string, 0, len(paths))
+
+	for _, p := range paths {
+		if target := targetVersion(a.update.Policy, current[p], versions[p]); target != "" {
+			targets = append(targets, p+"@"+target)
+		}
+	}
+
+	if len(targets) == 0 {
+		return []string{}, nil
+	}
+
+	if !a.update.GroupCommits {
+		return cmd(ctx, dir, goBin, append([]string{"get"}, targets...)...)
+	}
+
+	out := make([]string, 0, len(targets))
+	before := requirements(file)
+
+	for _, target := range targets {
+		output, err := cmd(ctx, dir, goBin, "get", target)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, output...)
+
+		if output, err = cmd(ctx, dir, goBin, "mod", "tidy"); err != nil {
+			return nil, err
+		}
+
+		out = append(out, output...)
+
+		group, err := snapshot(dir, target, before)
+		if err != nil {
+			return nil, err
+		}
+
+		// a previous update may have already updated this dependency
+		if len(group.updates) == 0 {
+			continue
+		}
+
+		a.groups = append(a.groups, group)
+		before = applyUpdates(before, group.updates)
+	}
+
+	return out, nil
+}
+
+func snapshot(dir, target string, before map[string]string) (updateGroup, error) {
+	goMod, err := os.ReadFile(filepath.Join(dir, goModFile))
+	if err != nil {
+		return updateGroup{}, err
+	}
+
+	goSum, err := os.ReadFile(filepath.Join(dir, goSumFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return updateGroup{}, err
+	}
+
+	file, err := modfile.Parse(goModFile, goMod, nil)
+	if err != nil {
+		return updateGroup{}, err
+	}
+
+	module, _, _ := strings.Cut(target, "@")
+
+	return updateGroup{
+		module:  module,
+		updates: diffRequirements(before, requirements(file)),
+		goMod:   goMod,
+		goSum:   goSum,
+	}, nil
+}
+
+func listVersions(ctx context.Context, dir, goBin string, paths []string) (map[string][]string, error) {
+	c := exec.CommandContext(ctx, goBin, append([]string{"list", "-m", "-versions", "-json"}, paths...)...)
+	c.Dir = dir
+
+	stderr := &bytes.Buffer{}
+	c.Stderr = stderr
+
+	buf, err := c.Output()
+	if err != nil {
+		if stderr.Len() > 0 {
+			err = fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+
+		return nil, err
+	}
+
+	versions := make(map[string][]string, len(paths))
+	dec := json.NewDecoder(bytes.NewReader(buf))
+
+	for dec.More() {
+		mod := moduleVersions{}
+
+		if err = dec.Decode(&mod); err != nil {
+			return nil, err
+		}
+
+		versions[mod.Path] = mod.Versions
+	}
+
+	return versions, nil
+}
+
+// targetVersion returns the highest stable version that is newer than current and within the policy, or an empty
+// string if there is none.
+func targetVersion(policy, current string, versions []string) string {
+	best := current
+
+	for _, v := range versions {
+		if !semver.IsValid(v) || semver.Prerelease(v) != "" {
+			continue
+		}
+
+		switch policy {
+		case policyMinor:
+			if semver.Major(v) != semver.Major(current) {
+				continue
+			}
+		case policyPatch:
+			if semver.MajorMinor(v) != semver.MajorMinor(current) {
+				continue
+			}
+		}
+
+		if semver.Compare(v, best) > 0 {
+			best = v
+		}
+	}
+
+	if best == current {
+		return ""
+	}
+
+	return best
+}
+
+func selectModule(module string, allow, deny []string) bool {
+	for i := range deny {
+		if matchModule(deny[i], module) {
+			return false
+		}
+	}
+
+	if len(allow) == 0 {
+		return true
+	}
+
+	for i := range allow {
+		if matchModule(allow[i], module) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchModule(pattern, module string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return module == prefix || strings.HasPrefix(module, prefix+"/")
+	}
+
+	ok, err := path.Match(pattern, module)
+
+	return err == nil && ok
+}
+
+func readGoMod(dir string) (*modfile.File, error) {
+	buf, err := os.ReadFile(filepath.Join(dir, goModFile))
+	if err != nil {
+		return nil, err
+	}
+
+	return modfile.Parse(goModFile, buf, nil)
+}
+
+func requirements(file *modfile.File) map[string]string {
+	reqs := make(map[string]string, len(file.Require))
+
+	for _, req := range file.Require {
+		reqs[req.Mod.Path] = req.Mod.Version
+	}
+
+	return reqs
+}
+
+// diffRequirements lists the requirements that changed between before and after, sorted by module path.
+func diffRequirements(before, after map[string]string) []repository.ModuleUpdate {
+	updates := make([]repository.ModuleUpdate, 0, len(after))
+
+	for p, to := range after {
+		if from := before[p]; from != to {
+			updates = append(updates, repository.ModuleUpdate{Path: p, From: from, To: to})
+		}
+	}
+
+	for p, from := range before {
+		if _, ok := after[p]; !ok {
+			updates = append(updates, repository.ModuleUpdate{Path: p, From: from})
+		}
+	}
+
+	slices.SortFunc(updates, func(a, b repository.ModuleUpdate) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return updates
+}
+
+func applyUpdates(reqs map[string]string, updates []repository.ModuleUpdate) map[string]string {
+	for i := range updates {
+		if updates[i].To == "" {
+			delete(reqs, updates[i].Path)
+
+			continue
+		}
+
+		reqs[updates[i].Path] = updates[i].To
+	}
+
+	return reqs
+}
+
+func formatUpdate(update repository.ModuleUpdate) string {
+	switch {
+	case update.From == "":
+		return fmt.Sprintf("%s: added %s", update.Path, update.To)
+	case update.To == "":
+		return fmt.Sprintf("%s: removed %s", update.Path, update.From)
+	default:
+		return fmt.Sprintf("%s: %s => %s", update.Path, update.From, update.To)
+	}
+}
@@ -0,0 +1,232 @@
+package actions
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/modupdate/config"
+	"github.com/zalgonoise/x/modupdate/events"
+	"github.com/zalgonoise/x/modupdate/repository"
+)
+
+var testModules = map[string][]string{
+	"example.com/dep":   {"v1.0.0", "v1.0.1", "v1.1.0", "v1.2.0-rc.1"},
+	"example.com/other": {"v0.1.0", "v0.2.0"},
+}
+
+// newProxy writes a file-based GOPROXY serving testModules, and points the go command at it.
+func newProxy(t *testing.T) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for mod, versions := range testModules {
+		base := filepath.Join(dir, mod, "@v")
+		require.NoError(t, os.MkdirAll(base, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(base, "list"), []byte(strings.Join(versions, "\n")+"\n"), 0o644))
+
+		for _, version := range versions {
+			goMod := fmt.Sprintf("module %s\n\ngo 1.22\n", mod)
+			pkg := fmt.Sprintf("package %s\n\nconst Version = %q\n", filepath.Base(mod), version)
+
+			require.NoError(t, os.WriteFile(filepath.Join(base, version+".mod"), []byte(goMod), 0o644))
+			require.NoError(t, os.WriteFile(filepath.Join(base, version+".info"),
+				[]byte(fmt.Sprintf(`{"Version":%q,"Time":"2024-01-01T00:00:00Z"}`, version)), 0o644))
+
+			f, err := os.Create(filepath.Join(base, version+".zip"))
+			require.NoError(t, err)
+
+			w := zip.NewWriter(f)
+
+			for name, content := range map[string]string{"go.mod": goMod, "pkg.go": pkg} {
+				fw, err := w.Create(mod + "@" + version + "/" + name)
+				require.NoError(t, err)
+
+				_, err = io.WriteString(fw, content)
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, w.Close())
+			require.NoError(t, f.Close())
+		}
+	}
+
+	t.Setenv("GOPROXY", "file://"+filepath.ToSlash(dir))
+	t.Setenv("GOSUMDB", "off")
+	t.Setenv("GOFLAGS", "-modcacherw")
+	t.Setenv("GOMODCACHE", t.TempDir())
+	t.Setenv("GOTOOLCHAIN", "local")
+	t.Setenv("GOWORK", "off")
+}
+
+// newModule creates a git repository with a Go module depending on the first version of each of the testModules.
+func newModule(t *testing.T) string {
+	t.Helper()
+
+	_, checkout := newRemote(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(checkout, "go.mod"), []byte(`module example.com/x
+
+go 1.22
+
+require (
+	example.com/dep v1.0.0
+	example.com/other v0.1.0
+)
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(checkout, "main.go"), []byte(`package main
+
+import (
+	"example.com/dep"
+	"example.com/other"
+)
+
+func main() {
+	println(dep.Version, other.Version)
+}
+`), 0o644))
+
+	_, err := cmd(context.Background(), checkout, "go", "mod", "tidy")
+	require.NoError(t, err)
+
+	git(t, checkout, "add", ".")
+	git(t, checkout, "commit", "-m", "add dependencies")
+
+	return checkout
+}
+
+func TestUpdate_Selective(t *testing.T) {
+	newProxy(t)
+
+	for _, testcase := range []struct {
+		name    string
+		update  config.Update
+		wants   []repository.ModuleUpdate
+		commits []string
+		err     error
+	}{
+		{
+			name:   "Latest",
+			update: config.Update{Policy: "latest"},
+			wants: []repository.ModuleUpdate{
+				{Path: "example.com/dep", From: "v1.0.0", To: "v1.1.0"},
+				{Path: "example.com/other", From: "v0.1.0", To: "v0.2.0"},
+			},
+			commits: []string{"chore: updated modules"},
+		},
+		{
+			name:   "Patch",
+			update: config.Update{Policy: "patch"},
+			wants: []repository.ModuleUpdate{
+				{Path: "example.com/dep", From: "v1.0.0", To: "v1.0.1"},
+			},
+			commits: []string{"chore: updated modules"},
+		},
+		{
+			name:   "Deny",
+			update: config.Update{Deny: []string{"example.com/other"}},
+			wants: []repository.ModuleUpdate{
+				{Path: "example.com/dep", From: "v1.0.0", To: "v1.1.0"},
+			},
+			commits: []string{"chore: updated modules"},
+		},
+		{
+			name:   "Allow",
+			update: config.Update{Allow: []string{"example.com/..."}, Deny: []string{"example.com/dep"}},
+			wants: []repository.ModuleUpdate{
+				{Path: "example.com/other", From: "v0.1.0", To: "v0.2.0"},
+			},
+			commits: []string{"chore: updated modules"},
+		},
+		{
+			name:   "GroupCommits",
+			update: config.Update{Policy: "minor", GroupCommits: true},
+			wants: []repository.ModuleUpdate{
+				{Path: "example.com/dep", From: "v1.0.0", To: "v1.1.0"},
+				{Path: "example.com/other", From: "v0.1.0", To: "v0.2.0"},
+			},
+			commits: []string{
+				"chore: updated modules: example.com/other v0.1.0 => v0.2.0",
+				"chore: updated modules: example.com/dep v1.0.0 => v1.1.0",
+			},
+		},
+		{
+			name:   "Fail/InvalidPolicy",
+			update: config.Update{Policy: "major"},
+			err:    ErrInvalidPolicy,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			checkout := newModule(t)
+			store := &testStore{}
+
+			a := NewModUpdate(events.NoOp{}, &config.Task{
+				Repository: config.Repository{Branch: "main"},
+				Checkout:   config.Checkout{Path: checkout},
+				Update:     testcase.update,
+				Push:       config.Push{DryRun: true},
+			}, slog.New(slog.NewTextHandler(io.Discard, nil)), WithStore(store))
+
+			ctx := context.Background()
+
+			require.NoError(t, a.setGit(ctx))
+
+			err := a.goGet(ctx, checkout, "go")
+			require.ErrorIs(t, err, testcase.err)
+
+			if testcase.err != nil {
+				return
+			}
+
+			require.Equal(t, testcase.wants, a.updates)
+			require.Equal(t, testcase.wants, store.updates)
+
+			// the checkout builds with the updated dependencies
+			_, err = cmd(ctx, checkout, "go", "build", "-o", os.DevNull, "./...")
+			require.NoError(t, err)
+
+			require.NoError(t, a.Push(ctx))
+
+			log := git(t, checkout, "log", "--format=%s", fmt.Sprintf("-%d", len(testcase.commits)))
+			require.Equal(t, strings.Join(testcase.commits, "\n")+"\n", log)
+			require.Empty(t, git(t, checkout, "status", "--porcelain"))
+		})
+	}
+}
+
+func TestTargetVersion(t *testing.T) {
+	versions := []string{"v1.0.0", "v1.0.1", "v1.1.0", "v1.2.0-rc.1", "v2.0.0+incompatible"}
+
+	for _, testcase := range []struct {
+		policy  string
+		current string
+		wants   string
+	}{
+		{policy: "", current: "v1.0.0", wants: "v2.0.0+incompatible"},
+		{policy: "minor", current: "v1.0.0", wants: "v1.1.0"},
+		{policy: "patch", current: "v1.0.0", wants: "v1.0.1"},
+		{policy: "patch", current: "v1.1.0", wants: ""},
+		{policy: "minor", current: "v1.0.2-0.20240101000000-abcdefabcdef", wants: "v1.1.0"},
+	} {
+		t.Run(testcase.policy+"/"+testcase.current, func(t *testing.T) {
+			require.Equal(t, testcase.wants, targetVersion(testcase.policy, testcase.current, versions))
+		})
+	}
+}
+
+func TestMatchModule(t *testing.T) {
+	require.True(t, matchModule("golang.org/x/...", "golang.org/x/mod"))
+	require.True(t, matchModule("golang.org/x/...", "golang.org/x"))
+	require.False(t, matchModule("golang.org/x/...", "golang.org/xyz"))
+	require.True(t, matchModule("github.com/zalgonoise/*", "github.com/zalgonoise/micron"))
+	require.False(t, matchModule("github.com/zalgonoise/*", "github.com/zalgonoise/x/cli"))
+}
@@ -6,17 +6,22 @@ import (
 	"errors"
 	"flag"
 	"log/slog"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/zalgonoise/x/cli"
 	"github.com/zalgonoise/x/modupdate/actions"
 	"github.com/zalgonoise/x/modupdate/config"
 	"github.com/zalgonoise/x/modupdate/database"
 	"github.com/zalgonoise/x/modupdate/events"
+	"github.com/zalgonoise/x/modupdate/httpserver"
 	"github.com/zalgonoise/x/modupdate/repository"
 	"github.com/zalgonoise/x/modupdate/service"
 )
 
+const shutdownTimeout = 30 * time.Second
+
 var modes = []string{"run", "exec"}
 
 func main() {
@@ -65,6 +70,11 @@ func ExecRun(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 
 	repo := repository.NewRepository(db)
 
+	// runs left unfinished by a previous process will not complete
+	if err := repo.InterruptRuns(ctx); err != nil {
+		return 1, err
+	}
+
 	// add tasks from config if present
 	for i := range cfg.Tasks {
 		if err := repo.AddTask(ctx, cfg.Tasks[i]); err != nil {
@@ -78,7 +88,7 @@ func ExecRun(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 		return 1, err
 	}
 
-	cron, err := actions.NewActions(reporter, logger, tasks...)
+	cron, err := actions.NewActions(reporter, repo, logger, tasks...)
 	if err != nil {
 		return 1, err
 	}
@@ -97,6 +107,30 @@ func ExecRun(ctx context.Context, logger *slog.Logger, args []string) (int, erro
 		}
 	}()
 
+	if cfg.HTTPAddress != "" {
+		server, err := httpserver.NewServer(cfg.HTTPAddress, svc, logger, httpserver.WithToken(cfg.HTTPToken))
+		if err != nil {
+			return 1, err
+		}
+
+		go func() {
+			logger.InfoContext(ctx, "starting HTTP server", slog.String("address", cfg.HTTPAddress))
+
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.ErrorContext(ctx, "running HTTP server", slog.String("error", err.Error()))
+			}
+		}()
+
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				logger.ErrorContext(ctx, "shutting HTTP server down", slog.String("error", err.Error()))
+			}
+		}()
+	}
+
 	svc.Start()
 
 	return 0, nil
@@ -1,9 +1,13 @@
 package config
 
 type Config struct {
-	DatabaseURI string  `json:"database_uri,omitempty"`
-	Events      *Events `json:"events,omitempty"`
-	Tasks       []*Task `json:"tasks,omitempty"`
+	DatabaseURI string `json:"database_uri,omitempty"`
+	// HTTPAddress is the address to serve the runs' status page and API on, like ":8080". It is disabled if empty.
+	HTTPAddress string `json:"http_address,omitempty"`
+	// HTTPToken authorizes triggering runs through the HTTP server. Triggers are disabled if empty.
+	HTTPToken string  `json:"http_token,omitempty"`
+	Events    *Events `json:"events,omitempty"`
+	Tasks     []*Task `json:"tasks,omitempty"`
 }
 
 type Task struct {
@@ -40,6 +44,23 @@ type Update struct {
 	GoBin               string   `json:"go_bin,omitempty"`
 	GitCommandOverrides []string `json:"git_command_overrides,omitempty"`
 	GoCommandOverrides  []string `json:"go_command_overrides,omitempty"`
+
+	// Allow and Deny select the direct dependencies to update, by module path. Patterns are matched with path.Match,
+	// or as a prefix when ending in "/..." (like "golang.org/x/..."). Deny takes precedence over Allow, and an empty
+	// Allow list allows all modules.
+	Allow []string `json:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty"`
+	// Policy limits how far dependencies are updated: "latest" (the default), "minor" (no major version changes) or
+	// "patch" (no minor version changes).
+	Policy string `json:"policy,omitempty"`
+	// GroupCommits commits each dependency's update separately.
+	GroupCommits bool `json:"group_commits,omitempty"`
+}
+
+// Selective returns true if the update is limited to a selection of dependencies or versions, or is committed
+// per dependency, instead of updating all dependencies at once.
+func (u *Update) Selective() bool {
+	return len(u.Allow) > 0 || len(u.Deny) > 0 || u.Policy != "" || u.GroupCommits
 }
 
 type Check struct {
@@ -52,4 +73,23 @@ type Push struct {
 	CommitMessage    string   `json:"commit_message,omitempty"`
 	CommandOverrides []string `json:"command_overrides,omitempty"`
 	FilesOverride    []string `json:"files_override,omitempty"`
+
+	// PullRequest, when set, pushes the changes to a generated branch and opens a pull request against Branch,
+	// instead of pushing to Branch directly.
+	PullRequest *PullRequest `json:"pull_request,omitempty"`
+}
+
+type PullRequest struct {
+	// ForgeURL is the base URL of the forge's REST API, defaulting to https://api.github.com for github.com
+	// repositories, or to https://{host}/api/v1 (Gitea) otherwise.
+	ForgeURL string `json:"forge_url,omitempty"`
+	// Owner and Name identify the repository in the forge, defaulting to the ones in the repository's URI.
+	Owner string `json:"owner,omitempty"`
+	Name  string `json:"name,omitempty"`
+	// Remote is the git remote (name or URL) where the generated branch is pushed to, defaulting to the
+	// repository's URI with its credentials.
+	Remote       string `json:"remote,omitempty"`
+	BranchPrefix string `json:"branch_prefix,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Body         string `json:"body,omitempty"`
 }
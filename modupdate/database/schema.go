@@ -15,11 +15,68 @@ const (
 ) STRICT;`
 
 	createOverrides = `CREATE TABLE overrides (
-    id          TEXT PRIMARY KEY    NOT NULL,
+    id          TEXT                NOT NULL,
     type        TEXT                NOT NULL,
     command     TEXT                NOT NULL
+) STRICT;
+
+CREATE INDEX idx_overrides_id ON overrides (id, type);`
+
+	createPullRequests = `CREATE TABLE pull_requests (
+    id              TEXT PRIMARY KEY    NOT NULL,
+    forge_url       TEXT                NOT NULL,
+    owner           TEXT                NOT NULL,
+    name            TEXT                NOT NULL,
+    remote          TEXT                NOT NULL,
+    branch_prefix   TEXT                NOT NULL,
+    title           TEXT                NOT NULL,
+    body            TEXT                NOT NULL
 ) STRICT;`
 
+	createUpdatePolicies = `CREATE TABLE update_policies (
+    id              TEXT PRIMARY KEY    NOT NULL,
+    policy          TEXT                NOT NULL,
+    group_commits   INTEGER             NOT NULL
+) STRICT;`
+
+	createModuleUpdates = `CREATE TABLE module_updates (
+    uri             TEXT                NOT NULL,
+    module          TEXT                NOT NULL,
+    branch          TEXT                NOT NULL,
+    dependency      TEXT                NOT NULL,
+    from_version    TEXT                NOT NULL,
+    to_version      TEXT                NOT NULL,
+    updated_at      INTEGER             NOT NULL
+) STRICT;
+
+CREATE INDEX idx_module_updates_repository ON module_updates (uri, module, branch, updated_at);`
+
+	createRuns = `CREATE TABLE runs (
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    uri             TEXT                NOT NULL,
+    module          TEXT                NOT NULL,
+    branch          TEXT                NOT NULL,
+    trigger         TEXT                NOT NULL,
+    status          TEXT                NOT NULL,
+    error           TEXT                NOT NULL,
+    commit_hash     TEXT                NOT NULL,
+    started_at      INTEGER             NOT NULL,
+    duration        INTEGER             NOT NULL
+) STRICT;
+
+CREATE INDEX idx_runs_repository ON runs (uri, module, branch, started_at);`
+
+	createRunSteps = `CREATE TABLE run_steps (
+    run_id          INTEGER             NOT NULL,
+    name            TEXT                NOT NULL,
+    started_at      INTEGER             NOT NULL,
+    duration        INTEGER             NOT NULL,
+    output          TEXT                NOT NULL,
+    error           TEXT                NOT NULL
+) STRICT;
+
+CREATE INDEX idx_run_steps_run_id ON run_steps (run_id);`
+
 	createBin = `CREATE TABLE bin (
     git TEXT    NOT NULL,
     go  TEXT    NOT NULL
@@ -30,6 +87,11 @@ func newSchema() []migration {
 	return []migration{
 		{table: "repositories", create: createRepositories},
 		{table: "overrides", create: createOverrides},
+		{table: "pull_requests", create: createPullRequests},
+		{table: "update_policies", create: createUpdatePolicies},
+		{table: "module_updates", create: createModuleUpdates},
+		{table: "runs", create: createRuns},
+		{table: "run_steps", create: createRunSteps},
 		{table: "bin", create: createBin},
 	}
 }
@@ -0,0 +1,201 @@
+package forge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+	pageSize       = 50
+	maxErrorBody   = 512
+
+	stateOpen = "open"
+)
+
+var (
+	ErrUnexpectedStatus = errors.New("unexpected response status")
+	ErrEmptyOwner       = errors.New("repository owner cannot be empty")
+	ErrEmptyName        = errors.New("repository name cannot be empty")
+)
+
+// PullRequest describes a pull request in a forge, where Head and Base are branch names.
+type PullRequest struct {
+	Number int
+	Title  string
+	Body   string
+	Head   string
+	Base   string
+	URL    string
+}
+
+// Client calls the pull requests REST API shared by GitHub and Gitea (and its forks), from a base URL like
+// https://api.github.com or https://gitea.example.com/api/v1.
+type Client struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// NewClient creates a Client for the forge API in baseURL, authenticating with token if it is not empty. A nil
+// client defaults to an http.Client with a 30-second timeout.
+func NewClient(baseURL, token string, client *http.Client) *Client {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		client:  client,
+	}
+}
+
+type branchRef struct {
+	Ref string `json:"ref"`
+}
+
+type pullResponse struct {
+	Number  int       `json:"number"`
+	Title   string    `json:"title"`
+	Body    string    `json:"body"`
+	HTMLURL string    `json:"html_url"`
+	Head    branchRef `json:"head"`
+	Base    branchRef `json:"base"`
+}
+
+func (p pullResponse) toPullRequest() PullRequest {
+	return PullRequest{
+		Number: p.Number,
+		Title:  p.Title,
+		Body:   p.Body,
+		Head:   p.Head.Ref,
+		Base:   p.Base.Ref,
+		URL:    p.HTMLURL,
+	}
+}
+
+type createRequest struct {
+	Title string `json:"title"`
+	Body  string `json:"body,omitempty"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// ListOpenPullRequests returns all open pull requests in the owner/name repository.
+func (c *Client) ListOpenPullRequests(ctx context.Context, owner, name string) ([]PullRequest, error) {
+	path, err := pullsPath(owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	pulls := make([]PullRequest, 0, pageSize)
+
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("state", stateOpen)
+		query.Set("page", strconv.Itoa(page))
+		// GitHub pages with per_page, while Gitea pages with limit
+		query.Set("per_page", strconv.Itoa(pageSize))
+		query.Set("limit", strconv.Itoa(pageSize))
+
+		res := make([]pullResponse, 0, pageSize)
+
+		if err = c.do(ctx, http.MethodGet, path+"?"+query.Encode(), nil, &res); err != nil {
+			return nil, err
+		}
+
+		for i := range res {
+			pulls = append(pulls, res[i].toPullRequest())
+		}
+
+		if len(res) < pageSize {
+			return pulls, nil
+		}
+	}
+}
+
+// CreatePullRequest opens a pull request in the owner/name repository, to merge pr.Head into pr.Base.
+func (c *Client) CreatePullRequest(ctx context.Context, owner, name string, pr PullRequest) (PullRequest, error) {
+	path, err := pullsPath(owner, name)
+	if err != nil {
+		return PullRequest{}, err
+	}
+
+	res := pullResponse{}
+
+	if err = c.do(ctx, http.MethodPost, path, createRequest{
+		Title: pr.Title,
+		Body:  pr.Body,
+		Head:  pr.Head,
+		Base:  pr.Base,
+	}, &res); err != nil {
+		return PullRequest{}, err
+	}
+
+	return res.toPullRequest(), nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, dst any) error {
+	var r io.Reader = http.NoBody
+
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		r = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, r)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBody))
+
+		return fmt.Errorf("%w: %s %s: %d: %s", ErrUnexpectedStatus, method, path, res.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	return json.NewDecoder(res.Body).Decode(dst)
+}
+
+func pullsPath(owner, name string) (string, error) {
+	if owner == "" {
+		return "", ErrEmptyOwner
+	}
+
+	if name == "" {
+		return "", ErrEmptyName
+	}
+
+	return fmt.Sprintf("/repos/%s/%s/pulls", url.PathEscape(owner), url.PathEscape(name)), nil
+}
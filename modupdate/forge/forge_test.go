@@ -0,0 +1,83 @@
+package forge_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/modupdate/forge"
+	"github.com/zalgonoise/x/modupdate/forge/forgetest"
+)
+
+const (
+	testToken = "secret"
+	testOwner = "zalgonoise"
+	testName  = "x"
+)
+
+func TestClient(t *testing.T) {
+	server := forgetest.NewServer(testToken)
+	defer server.Close()
+
+	ctx := context.Background()
+	client := forge.NewClient(server.URL, testToken, nil)
+
+	t.Run("CreatePullRequest", func(t *testing.T) {
+		pr, err := client.CreatePullRequest(ctx, testOwner, testName, forge.PullRequest{
+			Title: "chore: updated modules",
+			Body:  "body",
+			Head:  "modupdate/master",
+			Base:  "master",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, pr.Number)
+		require.Equal(t, "modupdate/master", pr.Head)
+		require.Equal(t, "master", pr.Base)
+		require.NotEmpty(t, pr.URL)
+	})
+
+	t.Run("CreatePullRequest/AlreadyOpen", func(t *testing.T) {
+		_, err := client.CreatePullRequest(ctx, testOwner, testName, forge.PullRequest{
+			Title: "chore: updated modules",
+			Head:  "modupdate/master",
+			Base:  "master",
+		})
+		require.ErrorIs(t, err, forge.ErrUnexpectedStatus)
+	})
+
+	t.Run("ListOpenPullRequests/Paginated", func(t *testing.T) {
+		for i := range 60 {
+			_, err := client.CreatePullRequest(ctx, testOwner, testName, forge.PullRequest{
+				Title: "chore: updated modules",
+				Head:  fmt.Sprintf("branch-%d", i),
+				Base:  "master",
+			})
+			require.NoError(t, err)
+		}
+
+		server.ClosePullRequest(testOwner, testName, 1)
+
+		pulls, err := client.ListOpenPullRequests(ctx, testOwner, testName)
+		require.NoError(t, err)
+		require.Len(t, pulls, 60)
+		require.Equal(t, 2, pulls[0].Number)
+	})
+
+	t.Run("ListOpenPullRequests/OtherRepository", func(t *testing.T) {
+		pulls, err := client.ListOpenPullRequests(ctx, testOwner, "other")
+		require.NoError(t, err)
+		require.Empty(t, pulls)
+	})
+
+	t.Run("Fail/InvalidToken", func(t *testing.T) {
+		_, err := forge.NewClient(server.URL, "invalid", nil).ListOpenPullRequests(ctx, testOwner, testName)
+		require.ErrorIs(t, err, forge.ErrUnexpectedStatus)
+	})
+
+	t.Run("Fail/EmptyOwner", func(t *testing.T) {
+		_, err := client.ListOpenPullRequests(ctx, "", testName)
+		require.ErrorIs(t, err, forge.ErrEmptyOwner)
+	})
+}
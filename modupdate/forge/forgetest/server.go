@@ -0,0 +1,212 @@
+// Package forgetest provides an in-process forge server, implementing the subset of the GitHub / Gitea pull requests
+// API that is used by the forge.Client, for tests.
+package forgetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+
+	"github.com/zalgonoise/x/modupdate/forge"
+)
+
+const (
+	defaultPageSize = 30
+
+	stateOpen   = "open"
+	stateClosed = "closed"
+)
+
+type branchRef struct {
+	Ref string `json:"ref"`
+}
+
+type pull struct {
+	Number  int       `json:"number"`
+	State   string    `json:"state"`
+	Title   string    `json:"title"`
+	Body    string    `json:"body"`
+	HTMLURL string    `json:"html_url"`
+	Head    branchRef `json:"head"`
+	Base    branchRef `json:"base"`
+}
+
+type createRequest struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// Server is a fake forge, serving the pull requests API for any owner/name repository, over an httptest.Server.
+//
+// Like GitHub, it refuses to open a second pull request for the same head and base branches while one is open.
+type Server struct {
+	*httptest.Server
+
+	token string
+
+	mu    sync.Mutex
+	next  int
+	pulls map[string][]*pull
+}
+
+// NewServer starts a fake forge that requires the input token, unless it is empty.
+func NewServer(token string) *Server {
+	s := &Server{
+		token: token,
+		next:  1,
+		pulls: make(map[string][]*pull),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/{owner}/{name}/pulls", s.list)
+	mux.HandleFunc("POST /repos/{owner}/{name}/pulls", s.create)
+
+	s.Server = httptest.NewServer(s.auth(mux))
+
+	return s
+}
+
+// PullRequests returns all the pull requests in the owner/name repository, either open or closed.
+func (s *Server) PullRequests(owner, name string) []forge.PullRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pulls := make([]forge.PullRequest, 0, len(s.pulls[owner+"/"+name]))
+
+	for _, p := range s.pulls[owner+"/"+name] {
+		pulls = append(pulls, forge.PullRequest{
+			Number: p.Number,
+			Title:  p.Title,
+			Body:   p.Body,
+			Head:   p.Head.Ref,
+			Base:   p.Base.Ref,
+			URL:    p.HTMLURL,
+		})
+	}
+
+	return pulls
+}
+
+// ClosePullRequest closes a pull request, as if it was merged or dismissed.
+func (s *Server) ClosePullRequest(owner, name string, number int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.pulls[owner+"/"+name] {
+		if p.Number == number {
+			p.State = stateClosed
+		}
+	}
+}
+
+func (s *Server) auth(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.token != "" && r.Header.Get("Authorization") != "token "+s.token {
+			writeError(w, http.StatusUnauthorized, "Bad credentials")
+
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	state := query.Get("state")
+
+	if state == "" {
+		state = stateOpen
+	}
+
+	page := queryInt(query.Get("page"), 1)
+	size := queryInt(query.Get("per_page"), defaultPageSize)
+
+	s.mu.Lock()
+
+	pulls := make([]*pull, 0, len(s.pulls[r.PathValue("owner")+"/"+r.PathValue("name")]))
+
+	for _, p := range s.pulls[r.PathValue("owner")+"/"+r.PathValue("name")] {
+		if state == "all" || p.State == state {
+			cp := *p
+			pulls = append(pulls, &cp)
+		}
+	}
+
+	s.mu.Unlock()
+
+	start := min((page-1)*size, len(pulls))
+	end := min(start+size, len(pulls))
+
+	writeJSON(w, http.StatusOK, pulls[start:end])
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request) {
+	req := createRequest{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	if req.Title == "" || req.Head == "" || req.Base == "" {
+		writeError(w, http.StatusUnprocessableEntity, "title, head and base are required")
+
+		return
+	}
+
+	key := r.PathValue("owner") + "/" + r.PathValue("name")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.pulls[key] {
+		if p.State == stateOpen && p.Head.Ref == req.Head && p.Base.Ref == req.Base {
+			writeError(w, http.StatusUnprocessableEntity,
+				fmt.Sprintf("A pull request already exists for %s:%s.", r.PathValue("owner"), req.Head))
+
+			return
+		}
+	}
+
+	p := &pull{
+		Number:  s.next,
+		State:   stateOpen,
+		Title:   req.Title,
+		Body:    req.Body,
+		HTMLURL: fmt.Sprintf("%s/%s/pull/%d", s.URL, key, s.next),
+		Head:    branchRef{Ref: req.Head},
+		Base:    branchRef{Ref: req.Base},
+	}
+
+	s.next++
+	s.pulls[key] = append(s.pulls[key], p)
+
+	writeJSON(w, http.StatusCreated, p)
+}
+
+func queryInt(value string, fallback int) int {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return fallback
+	}
+
+	return n
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"message": msg})
+}
@@ -13,6 +13,7 @@ require (
 	github.com/zalgonoise/x/discord v0.0.0-20240624121040-fa2b30b0a485
 	github.com/zalgonoise/x/iter v0.0.0-20240624121040-fa2b30b0a485
 	go.opentelemetry.io/otel/trace v1.27.0
+	golang.org/x/mod v0.18.0
 	modernc.org/sqlite v1.30.1
 )
 
@@ -196,7 +197,6 @@ require (
 	go.uber.org/zap v1.27.0 // indirect
 	golang.org/x/exp v0.0.0-20240613232115-7f521ea00fb8 // indirect
 	golang.org/x/exp/typeparams v0.0.0-20240613232115-7f521ea00fb8 // indirect
-	golang.org/x/mod v0.18.0 // indirect
 	golang.org/x/net v0.26.0 // indirect
 	golang.org/x/sync v0.7.0 // indirect
 	golang.org/x/sys v0.21.0 // indirect
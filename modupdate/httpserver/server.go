@@ -0,0 +1,463 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zalgonoise/x/modupdate/actions"
+	"github.com/zalgonoise/x/modupdate/config"
+	"github.com/zalgonoise/x/modupdate/repository"
+)
+
+const (
+	defaultReadTimeout  = 5 * time.Second
+	defaultWriteTimeout = 10 * time.Second
+	defaultRunsLimit    = 50
+	maxRunsLimit        = 500
+
+	bearerPrefix = "Bearer "
+	tokenField   = "token"
+)
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+var (
+	ErrInvalidLimit    = errors.New("limit must be a positive integer")
+	ErrTriggerDisabled = errors.New("triggering runs is disabled")
+	ErrMissingToken    = errors.New("missing token")
+	ErrInvalidToken    = errors.New("invalid token")
+	ErrCrossOrigin     = errors.New("cross-origin request")
+)
+
+type Service interface {
+	ListTasks(ctx context.Context) ([]*config.Task, error)
+	ListRuns(ctx context.Context, limit int) ([]repository.Run, error)
+	GetRun(ctx context.Context, id int64) (*repository.Run, error)
+	Trigger(ctx context.Context, uri, module, branch string) (int64, error)
+}
+
+// Server serves a status page listing the tasks and their runs, as well as a JSON API to query runs and to trigger
+// tasks on demand:
+//
+//   - GET /api/tasks lists the configured tasks.
+//   - GET /api/runs lists the latest runs, limited by the optional limit query parameter.
+//   - GET /api/runs/{id} returns a run with its steps' outputs.
+//   - POST /api/runs triggers a run for the task in the JSON body ({"uri", "module", "branch"}).
+//
+// Triggering runs, either through the API or the status page's form, requires the token configured WithToken.
+type Server struct {
+	server http.Server
+
+	svc       Service
+	token     string
+	templates *template.Template
+	logger    *slog.Logger
+}
+
+// Option configures optional features in a Server.
+type Option func(*Server)
+
+// WithToken enables triggering runs for requests carrying the input token, as a bearer token in their Authorization
+// header for the API, or in the token field of the status page's form. Triggers are rejected if the token is empty.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+func NewServer(addr string, svc Service, logger *slog.Logger, opts ...Option) (*Server, error) {
+	templates, err := template.New("").Funcs(template.FuncMap{
+		"duration": formatDuration,
+		"time":     formatTime,
+	}).ParseFS(templatesFS, "templates/*.html")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		svc:       svc,
+		templates: templates,
+		logger:    logger,
+	}
+
+	for i := range opts {
+		opts[i](s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ready", ready)
+	mux.HandleFunc("GET /api/tasks", s.listTasks)
+	mux.HandleFunc("GET /api/runs", s.listRuns)
+	mux.HandleFunc("GET /api/runs/{id}", s.getRun)
+	mux.HandleFunc("POST /api/runs", s.trigger)
+	mux.HandleFunc("GET /{$}", s.indexPage)
+	mux.HandleFunc("GET /runs/{id}", s.runPage)
+	mux.HandleFunc("POST /runs", s.triggerForm)
+
+	s.server = http.Server{
+		Handler:      mux,
+		Addr:         addr,
+		ReadTimeout:  defaultReadTimeout,
+		WriteTimeout: defaultWriteTimeout,
+	}
+
+	return s, nil
+}
+
+func (s *Server) ListenAndServe() error {
+	return s.server.ListenAndServe()
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+// Handler returns the server's HTTP handler.
+func (s *Server) Handler() http.Handler {
+	return s.server.Handler
+}
+
+type taskRequest struct {
+	URI    string `json:"uri"`
+	Module string `json:"module"`
+	Branch string `json:"branch"`
+}
+
+type triggerResponse struct {
+	ID int64 `json:"id"`
+}
+
+// taskResponse describes a task without its credentials.
+type taskResponse struct {
+	URI          string `json:"uri"`
+	Module       string `json:"module,omitempty"`
+	Branch       string `json:"branch,omitempty"`
+	CronSchedule string `json:"cron_schedule,omitempty"`
+	DryRun       bool   `json:"dry_run,omitempty"`
+	PullRequest  bool   `json:"pull_request,omitempty"`
+}
+
+type runResponse struct {
+	ID        int64          `json:"id"`
+	URI       string         `json:"uri"`
+	Module    string         `json:"module,omitempty"`
+	Branch    string         `json:"branch,omitempty"`
+	Trigger   string         `json:"trigger"`
+	Status    string         `json:"status"`
+	Error     string         `json:"error,omitempty"`
+	Commit    string         `json:"commit,omitempty"`
+	StartedAt time.Time      `json:"started_at"`
+	Duration  string         `json:"duration"`
+	Steps     []stepResponse `json:"steps,omitempty"`
+}
+
+type stepResponse struct {
+	Name      string    `json:"name"`
+	StartedAt time.Time `json:"started_at"`
+	Duration  string    `json:"duration"`
+	Output    []string  `json:"output,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (s *Server) listTasks(w http.ResponseWriter, r *http.Request) {
+	tasks, err := s.svc.ListTasks(r.Context())
+	if err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	s.writeJSON(w, r, http.StatusOK, toTasks(tasks))
+}
+
+func (s *Server) listRuns(w http.ResponseWriter, r *http.Request) {
+	limit, err := parseLimit(r.URL.Query().Get("limit"))
+	if err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	runs, err := s.svc.ListRuns(r.Context(), limit)
+	if err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	res := make([]runResponse, 0, len(runs))
+
+	for i := range runs {
+		res = append(res, toRun(&runs[i]))
+	}
+
+	s.writeJSON(w, r, http.StatusOK, res)
+}
+
+func (s *Server) getRun(w http.ResponseWriter, r *http.Request) {
+	run, err := s.run(r)
+	if err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	s.writeJSON(w, r, http.StatusOK, toRun(run))
+}
+
+func (s *Server) trigger(w http.ResponseWriter, r *http.Request) {
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), bearerPrefix)
+
+	if err := s.authorize(token); err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	req := taskRequest{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeJSON(w, r, http.StatusBadRequest, errorResponse{Error: err.Error()})
+
+		return
+	}
+
+	id, err := s.svc.Trigger(r.Context(), req.URI, req.Module, req.Branch)
+	if err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	s.writeJSON(w, r, http.StatusAccepted, triggerResponse{ID: id})
+}
+
+func (s *Server) indexPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tasks, err := s.svc.ListTasks(ctx)
+	if err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	runs, err := s.svc.ListRuns(ctx, defaultRunsLimit)
+	if err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	s.render(w, r, "index.html", struct {
+		Tasks    []taskResponse
+		Runs     []repository.Run
+		Triggers bool
+	}{
+		Tasks:    toTasks(tasks),
+		Runs:     runs,
+		Triggers: s.token != "",
+	})
+}
+
+func (s *Server) runPage(w http.ResponseWriter, r *http.Request) {
+	run, err := s.run(r)
+	if err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	s.render(w, r, "run.html", run)
+}
+
+func (s *Server) triggerForm(w http.ResponseWriter, r *http.Request) {
+	if !sameOrigin(r) {
+		s.writeError(w, r, ErrCrossOrigin)
+
+		return
+	}
+
+	if err := s.authorize(r.PostFormValue(tokenField)); err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	id, err := s.svc.Trigger(r.Context(), r.FormValue("uri"), r.FormValue("module"), r.FormValue("branch"))
+	if err != nil {
+		s.writeError(w, r, err)
+
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/runs/%d", id), http.StatusSeeOther)
+}
+
+// authorize verifies that the input token matches the configured one.
+func (s *Server) authorize(token string) error {
+	switch {
+	case s.token == "":
+		return ErrTriggerDisabled
+	case token == "":
+		return ErrMissingToken
+	case subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1:
+		return ErrInvalidToken
+	default:
+		return nil
+	}
+}
+
+// sameOrigin returns true if the request's Origin (or, lacking one, its Referer) header points to the server's own
+// host, so that forms posted from other sites are rejected.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = r.Header.Get("Referer")
+	}
+
+	if origin == "" {
+		return false
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return u.Host == r.Host
+}
+
+func (s *Server) run(r *http.Request) (*repository.Run, error) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", repository.ErrRunNotFound, r.PathValue("id"))
+	}
+
+	return s.svc.GetRun(r.Context(), id)
+}
+
+func (s *Server) render(w http.ResponseWriter, r *http.Request, name string, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := s.templates.ExecuteTemplate(w, name, data); err != nil {
+		s.logger.ErrorContext(r.Context(), "rendering template",
+			slog.String("template", name), slog.String("error", err.Error()))
+	}
+}
+
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+
+	switch {
+	case errors.Is(err, actions.ErrTaskNotFound), errors.Is(err, repository.ErrRunNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, actions.ErrRunInProgress):
+		status = http.StatusConflict
+	case errors.Is(err, ErrInvalidLimit):
+		status = http.StatusBadRequest
+	case errors.Is(err, ErrMissingToken):
+		status = http.StatusUnauthorized
+	case errors.Is(err, ErrTriggerDisabled), errors.Is(err, ErrInvalidToken), errors.Is(err, ErrCrossOrigin):
+		status = http.StatusForbidden
+	default:
+		s.logger.ErrorContext(r.Context(), "handling request",
+			slog.String("path", r.URL.Path), slog.String("error", err.Error()))
+	}
+
+	s.writeJSON(w, r, status, errorResponse{Error: err.Error()})
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, r *http.Request, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		s.logger.WarnContext(r.Context(), "writing response", slog.String("error", err.Error()))
+	}
+}
+
+func ready(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func parseLimit(value string) (int, error) {
+	if value == "" {
+		return defaultRunsLimit, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidLimit, value)
+	}
+
+	return min(limit, maxRunsLimit), nil
+}
+
+func toTasks(tasks []*config.Task) []taskResponse {
+	res := make([]taskResponse, 0, len(tasks))
+
+	for i := range tasks {
+		res = append(res, taskResponse{
+			URI:          tasks[i].Repository.Path,
+			Module:       tasks[i].Repository.ModulePath,
+			Branch:       tasks[i].Repository.Branch,
+			CronSchedule: tasks[i].CronSchedule,
+			DryRun:       tasks[i].Push.DryRun,
+			PullRequest:  tasks[i].Push.PullRequest != nil,
+		})
+	}
+
+	return res
+}
+
+func toRun(run *repository.Run) runResponse {
+	res := runResponse{
+		ID:        run.ID,
+		URI:       run.URI,
+		Module:    run.Module,
+		Branch:    run.Branch,
+		Trigger:   run.Trigger,
+		Status:    run.Status,
+		Error:     run.Error,
+		Commit:    run.Commit,
+		StartedAt: run.StartedAt,
+		Duration:  formatDuration(run.Duration),
+	}
+
+	for i := range run.Steps {
+		res.Steps = append(res.Steps, stepResponse{
+			Name:      run.Steps[i].Name,
+			StartedAt: run.Steps[i].StartedAt,
+			Duration:  formatDuration(run.Steps[i].Duration),
+			Output:    run.Steps[i].Output,
+			Error:     run.Steps[i].Error,
+		})
+	}
+
+	return res
+}
+
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.DateTime)
+}
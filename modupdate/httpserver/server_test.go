@@ -0,0 +1,376 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/modupdate/actions"
+	"github.com/zalgonoise/x/modupdate/config"
+	"github.com/zalgonoise/x/modupdate/repository"
+)
+
+type testService struct {
+	tasks []*config.Task
+	runs  []repository.Run
+}
+
+func (s *testService) ListTasks(context.Context) ([]*config.Task, error) {
+	return s.tasks, nil
+}
+
+func (s *testService) ListRuns(_ context.Context, limit int) ([]repository.Run, error) {
+	return s.runs[:min(limit, len(s.runs))], nil
+}
+
+func (s *testService) GetRun(_ context.Context, id int64) (*repository.Run, error) {
+	for i := range s.runs {
+		if s.runs[i].ID == id {
+			return &s.runs[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %d", repository.ErrRunNotFound, id)
+}
+
+func (s *testService) Trigger(_ context.Context, uri, module, branch string) (int64, error) {
+	for i := range s.tasks {
+		if s.tasks[i].Repository.Path == uri && s.tasks[i].Repository.ModulePath == module &&
+			s.tasks[i].Repository.Branch == branch {
+			return int64(len(s.runs) + 1), nil
+		}
+	}
+
+	return 0, actions.ErrTaskNotFound
+}
+
+const testToken = "t0k3n"
+
+func newTestServer(t *testing.T, opts ...Option) *httptest.Server {
+	t.Helper()
+
+	svc := &testService{
+		tasks: []*config.Task{{
+			CronSchedule: "0 6 * * *",
+			Repository: config.Repository{
+				Path:       "github.com/zalgonoise/x",
+				ModulePath: "modupdate",
+				Branch:     "master",
+				Token:      "s3cr3t",
+			},
+		}},
+		runs: []repository.Run{{
+			ID:        1,
+			URI:       "github.com/zalgonoise/x",
+			Module:    "modupdate",
+			Branch:    "master",
+			Trigger:   repository.TriggerSchedule,
+			Status:    repository.StatusFailure,
+			Error:     "push: exit status 1",
+			StartedAt: time.Unix(1_700_000_000, 0),
+			Duration:  1500 * time.Millisecond,
+			Steps: []repository.Step{{
+				Name:     "push",
+				Duration: 20 * time.Millisecond,
+				Output:   []string{"$ git commit -m <update>", "nothing to commit"},
+				Error:    "exit status 1",
+			}},
+		}},
+	}
+
+	s, err := NewServer(":0", svc, slog.New(slog.NewTextHandler(io.Discard, nil)), opts...)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(s.Handler())
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestServer_API(t *testing.T) {
+	server := newTestServer(t, WithToken(testToken))
+
+	t.Run("ListTasks", func(t *testing.T) {
+		res, err := http.Get(server.URL + "/api/tasks")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		buf, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.NotContains(t, string(buf), "s3cr3t")
+
+		tasks := []taskResponse{}
+		require.NoError(t, json.Unmarshal(buf, &tasks))
+		require.Equal(t, []taskResponse{{
+			URI: "github.com/zalgonoise/x", Module: "modupdate", Branch: "master", CronSchedule: "0 6 * * *",
+		}}, tasks)
+	})
+
+	t.Run("ListRuns", func(t *testing.T) {
+		res, err := http.Get(server.URL + "/api/runs?limit=10")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		runs := []runResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&runs))
+		require.Len(t, runs, 1)
+		require.Equal(t, "1.5s", runs[0].Duration)
+	})
+
+	t.Run("ListRuns/InvalidLimit", func(t *testing.T) {
+		res, err := http.Get(server.URL + "/api/runs?limit=-1")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("GetRun", func(t *testing.T) {
+		res, err := http.Get(server.URL + "/api/runs/1")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		run := runResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&run))
+		require.Equal(t, repository.StatusFailure, run.Status)
+		require.Equal(t, []stepResponse{{
+			Name:     "push",
+			Duration: "20ms",
+			Output:   []string{"$ git commit -m <update>", "nothing to commit"},
+			Error:    "exit status 1",
+		}}, run.Steps)
+	})
+
+	t.Run("GetRun/NotFound", func(t *testing.T) {
+		for _, id := range []string{"2", "abc"} {
+			res, err := http.Get(server.URL + "/api/runs/" + id)
+			require.NoError(t, err)
+			require.NoError(t, res.Body.Close())
+
+			require.Equal(t, http.StatusNotFound, res.StatusCode)
+		}
+	})
+
+	t.Run("Trigger", func(t *testing.T) {
+		res, err := postTrigger(t, server.URL, testToken,
+			`{"uri":"github.com/zalgonoise/x","module":"modupdate","branch":"master"}`)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		require.Equal(t, http.StatusAccepted, res.StatusCode)
+
+		trigger := triggerResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&trigger))
+		require.Equal(t, int64(2), trigger.ID)
+	})
+
+	t.Run("Trigger/NotFound", func(t *testing.T) {
+		res, err := postTrigger(t, server.URL, testToken,
+			`{"uri":"github.com/zalgonoise/x","module":"audio","branch":"master"}`)
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
+
+func TestServer_TriggerAuthorization(t *testing.T) {
+	const body = `{"uri":"github.com/zalgonoise/x","module":"modupdate","branch":"master"}`
+
+	for _, testcase := range []struct {
+		name   string
+		opts   []Option
+		token  string
+		status int
+	}{
+		{
+			name:   "Success",
+			opts:   []Option{WithToken(testToken)},
+			token:  testToken,
+			status: http.StatusAccepted,
+		},
+		{
+			name:   "MissingToken",
+			opts:   []Option{WithToken(testToken)},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "InvalidToken",
+			opts:   []Option{WithToken(testToken)},
+			token:  "guess",
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "Disabled",
+			token:  testToken,
+			status: http.StatusForbidden,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			server := newTestServer(t, testcase.opts...)
+
+			res, err := postTrigger(t, server.URL, testcase.token, body)
+			require.NoError(t, err)
+			require.NoError(t, res.Body.Close())
+
+			require.Equal(t, testcase.status, res.StatusCode)
+		})
+	}
+}
+
+func postTrigger(t *testing.T, serverURL, token, body string) (*http.Response, error) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, serverURL+"/api/runs", strings.NewReader(body))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return http.DefaultClient.Do(req)
+}
+
+func TestServer_Pages(t *testing.T) {
+	server := newTestServer(t, WithToken(testToken))
+
+	t.Run("Index", func(t *testing.T) {
+		res, err := http.Get(server.URL + "/")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		buf, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(buf), `<a href="/runs/1">#1</a>`)
+		require.Contains(t, string(buf), `<input type="hidden" name="module" value="modupdate">`)
+		require.Contains(t, string(buf), `<input type="password" name="token"`)
+	})
+
+	t.Run("Index/TriggersDisabled", func(t *testing.T) {
+		res, err := http.Get(newTestServer(t).URL + "/")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		buf, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.NotContains(t, string(buf), `<form`)
+	})
+
+	t.Run("Run", func(t *testing.T) {
+		res, err := http.Get(server.URL + "/runs/1")
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		buf, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(buf), "$ git commit -m &lt;update&gt;\nnothing to commit\n")
+	})
+
+	t.Run("TriggerForm", func(t *testing.T) {
+		client := &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		for _, testcase := range []struct {
+			name     string
+			origin   string
+			referer  string
+			token    string
+			status   int
+			location string
+		}{
+			{
+				name:     "Success",
+				origin:   server.URL,
+				token:    testToken,
+				status:   http.StatusSeeOther,
+				location: "/runs/2",
+			},
+			{
+				name:     "Success/Referer",
+				referer:  server.URL + "/",
+				token:    testToken,
+				status:   http.StatusSeeOther,
+				location: "/runs/2",
+			},
+			{
+				name:   "CrossOrigin",
+				origin: "https://example.com",
+				token:  testToken,
+				status: http.StatusForbidden,
+			},
+			{
+				name:   "NoOrigin",
+				token:  testToken,
+				status: http.StatusForbidden,
+			},
+			{
+				name:   "MissingToken",
+				origin: server.URL,
+				status: http.StatusUnauthorized,
+			},
+			{
+				name:   "InvalidToken",
+				origin: server.URL,
+				token:  "guess",
+				status: http.StatusForbidden,
+			},
+		} {
+			t.Run(testcase.name, func(t *testing.T) {
+				form := url.Values{
+					"uri": {"github.com/zalgonoise/x"}, "module": {"modupdate"}, "branch": {"master"},
+				}
+
+				if testcase.token != "" {
+					form.Set("token", testcase.token)
+				}
+
+				req, err := http.NewRequest(http.MethodPost, server.URL+"/runs", strings.NewReader(form.Encode()))
+				require.NoError(t, err)
+
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+				if testcase.origin != "" {
+					req.Header.Set("Origin", testcase.origin)
+				}
+
+				if testcase.referer != "" {
+					req.Header.Set("Referer", testcase.referer)
+				}
+
+				res, err := client.Do(req)
+				require.NoError(t, err)
+				require.NoError(t, res.Body.Close())
+
+				require.Equal(t, testcase.status, res.StatusCode)
+				require.Equal(t, testcase.location, res.Header.Get("Location"))
+			})
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zalgonoise/x/iter"
+	"github.com/zalgonoise/x/modupdate/config"
+)
+
+const (
+	queryModuleUpdates = `
+SELECT dependency, from_version, to_version, updated_at FROM module_updates
+	WHERE uri = ?
+	AND module = ?
+	AND branch = ?
+	ORDER BY updated_at DESC, rowid DESC`
+
+	insertModuleUpdate = `
+INSERT INTO module_updates (
+	uri, module, branch, dependency, from_version, to_version, updated_at
+) VALUES (
+	?, ?, ?, ?, ?, ?, ?
+)`
+)
+
+// ModuleUpdate describes a change in a dependency's version in a go.mod file. An empty From version means the
+// dependency was added, while an empty To version means it was removed.
+type ModuleUpdate struct {
+	Path      string
+	From      string
+	To        string
+	UpdatedAt time.Time
+}
+
+type moduleUpdate struct {
+	path      sql.Null[string]
+	from      sql.Null[string]
+	to        sql.Null[string]
+	updatedAt sql.Null[int64]
+}
+
+func (m *moduleUpdate) Scan(row *sql.Rows) error {
+	return row.Scan(
+		&m.path,
+		&m.from,
+		&m.to,
+		&m.updatedAt,
+	)
+}
+
+// AddModuleUpdates records the dependency updates applied to a repository's module in a single transaction.
+func (r *Repository) AddModuleUpdates(ctx context.Context, repo *config.Repository, updates []ModuleUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	for i := range updates {
+		updatedAt := updates[i].UpdatedAt
+		if updatedAt.IsZero() {
+			updatedAt = time.Now()
+		}
+
+		if _, err = tx.ExecContext(ctx, insertModuleUpdate,
+			repo.Path, repo.ModulePath, repo.Branch,
+			updates[i].Path, updates[i].From, updates[i].To, updatedAt.Unix(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListModuleUpdates returns the dependency updates recorded for a repository's module, from the most recent.
+func (r *Repository) ListModuleUpdates(ctx context.Context, uri, module, branch string) ([]ModuleUpdate, error) {
+	seq, err := iter.QueryContext[*moduleUpdate](ctx, r.db, queryModuleUpdates, uri, module, branch)
+	if errors.Is(err, sql.ErrNoRows) {
+		return []ModuleUpdate{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]ModuleUpdate, 0, minAlloc)
+
+	if !seq(func(m *moduleUpdate, e error) bool {
+		if e != nil {
+			err = e
+
+			return false
+		}
+
+		updates = append(updates, ModuleUpdate{
+			Path:      m.path.V,
+			From:      m.from.V,
+			To:        m.to.V,
+			UpdatedAt: time.Unix(m.updatedAt.V, 0),
+		})
+
+		return true
+	}) {
+		return nil, err
+	}
+
+	return updates, nil
+}
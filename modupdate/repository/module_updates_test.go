@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/x/modupdate/config"
+	"github.com/zalgonoise/x/modupdate/database"
+	"github.com/zalgonoise/x/modupdate/log"
+)
+
+func TestRepository_AddModuleUpdates_ListModuleUpdates(t *testing.T) {
+	logger := log.New("debug")
+
+	db, err := database.OpenSQLite("", database.ReadWritePragmas(), logger)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, database.MigrateSQLite(ctx, db, logger))
+
+	repo := NewRepository(db)
+	cfg := &config.Repository{
+		Path:       "github.com/zalgonoise/x",
+		ModulePath: "modupdate",
+		Branch:     "master",
+	}
+
+	first := time.Unix(1_700_000_000, 0)
+	second := first.Add(time.Hour)
+
+	require.NoError(t, repo.AddModuleUpdates(ctx, cfg, nil))
+	require.NoError(t, repo.AddModuleUpdates(ctx, cfg, []ModuleUpdate{
+		{Path: "golang.org/x/mod", From: "v0.17.0", To: "v0.18.0", UpdatedAt: first},
+	}))
+	require.NoError(t, repo.AddModuleUpdates(ctx, cfg, []ModuleUpdate{
+		{Path: "golang.org/x/mod", From: "v0.18.0", To: "v0.19.0", UpdatedAt: second},
+		{Path: "golang.org/x/sync", To: "v0.7.0", UpdatedAt: second},
+	}))
+
+	updates, err := repo.ListModuleUpdates(ctx, cfg.Path, cfg.ModulePath, cfg.Branch)
+	require.NoError(t, err)
+
+	for i := range updates {
+		updates[i].UpdatedAt = updates[i].UpdatedAt.UTC()
+	}
+
+	require.Equal(t, []ModuleUpdate{
+		{Path: "golang.org/x/sync", To: "v0.7.0", UpdatedAt: second.UTC()},
+		{Path: "golang.org/x/mod", From: "v0.18.0", To: "v0.19.0", UpdatedAt: second.UTC()},
+		{Path: "golang.org/x/mod", From: "v0.17.0", To: "v0.18.0", UpdatedAt: first.UTC()},
+	}, updates)
+
+	updates, err = repo.ListModuleUpdates(ctx, cfg.Path, "audio", cfg.Branch)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+}
@@ -16,6 +16,8 @@ const (
 	typeCheckout  = "checkout"
 	typeUpdateGit = "update/git"
 	typeUpdateGo  = "update/go"
+	typeAllow     = "update/allow"
+	typeDeny      = "update/deny"
 	typePush      = "push"
 	typePushFiles = "push/files"
 
@@ -36,12 +38,26 @@ SELECT command FROM overrides
 		AND module = ?
 		AND branch = ?;`
 
+	queryPullRequest = `
+SELECT forge_url, owner, name, remote, branch_prefix, title, body FROM pull_requests
+	WHERE id = ?`
+
+	queryUpdatePolicy = `
+SELECT policy, group_commits FROM update_policies
+	WHERE id = ?`
+
 	deleteRepo = `
 DELETE FROM repositories WHERE id = ?`
 
 	deleteOverrides = `
 DELETE FROM overrides WHERE id = ?`
 
+	deletePullRequest = `
+DELETE FROM pull_requests WHERE id = ?`
+
+	deleteUpdatePolicy = `
+DELETE FROM update_policies WHERE id = ?`
+
 	insertRepositoryAndTasks = `
 INSERT INTO repositories (
 	id, uri, module, branch, username, token, cron_schedule, dry_run, fs_path, commit_message
@@ -49,11 +65,25 @@ INSERT INTO repositories (
 	?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )`
 
+	insertPullRequest = `
+INSERT INTO pull_requests (
+	id, forge_url, owner, name, remote, branch_prefix, title, body
+) VALUES (
+	?, ?, ?, ?, ?, ?, ?, ?
+)`
+
+	insertUpdatePolicy = `
+INSERT INTO update_policies (
+	id, policy, group_commits
+) VALUES (
+	?, ?, ?
+)`
+
 	insertOverrides = `
 INSERT INTO overrides (
 	id, type, command
 ) VALUES (
-	?, ?, ?,
+	?, ?, ?
 )`
 )
 
@@ -108,7 +138,7 @@ func (t *task) Scan(row *sql.Rows) error {
 type override string
 
 func (o *override) Scan(row *sql.Rows) error {
-	return row.Scan(&o)
+	return row.Scan((*string)(o))
 }
 
 func (r *Repository) ListTasks(ctx context.Context) ([]*config.Task, error) {
@@ -196,6 +226,33 @@ func (r *Repository) collectSeq(ctx context.Context, configs *[]*config.Task, er
 
 		c.Update.GoCommandOverrides = overrides
 
+		overrides, err = addOverrides(ctx, r.db, t.id.V, typeAllow)
+		if err != nil {
+			*errs = append(*errs, err)
+
+			// collect all valid tasks
+			return true
+		}
+
+		c.Update.Allow = overrides
+
+		overrides, err = addOverrides(ctx, r.db, t.id.V, typeDeny)
+		if err != nil {
+			*errs = append(*errs, err)
+
+			// collect all valid tasks
+			return true
+		}
+
+		c.Update.Deny = overrides
+
+		if err = getUpdatePolicy(ctx, r.db, t.id.V, &c.Update); err != nil {
+			*errs = append(*errs, err)
+
+			// collect all valid tasks
+			return true
+		}
+
 		overrides, err = addOverrides(ctx, r.db, t.id.V, typePush)
 		if err != nil {
 			*errs = append(*errs, err)
@@ -216,6 +273,16 @@ func (r *Repository) collectSeq(ctx context.Context, configs *[]*config.Task, er
 
 		c.Push.FilesOverride = overrides
 
+		pullRequest, err := getPullRequest(ctx, r.db, t.id.V)
+		if err != nil {
+			*errs = append(*errs, err)
+
+			// collect all valid tasks
+			return true
+		}
+
+		c.Push.PullRequest = pullRequest
+
 		*configs = append(*configs, c)
 
 		return true
@@ -255,6 +322,38 @@ func addOverrides(ctx context.Context, tx transactioner, id, typ string) ([]stri
 	return overrides, nil
 }
 
+func getPullRequest(ctx context.Context, db *sql.DB, id string) (*config.PullRequest, error) {
+	pr := &config.PullRequest{}
+
+	if err := db.QueryRowContext(ctx, queryPullRequest, id).Scan(
+		&pr.ForgeURL, &pr.Owner, &pr.Name, &pr.Remote, &pr.BranchPrefix, &pr.Title, &pr.Body,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func getUpdatePolicy(ctx context.Context, db *sql.DB, id string, cfg *config.Update) error {
+	var groupCommits int
+
+	if err := db.QueryRowContext(ctx, queryUpdatePolicy, id).Scan(&cfg.Policy, &groupCommits); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	cfg.GroupCommits = groupCommits == 1
+
+	return nil
+}
+
 func (r *Repository) AddTask(ctx context.Context, cfg *config.Task) error {
 	if err := r.DeleteTask(ctx, cfg.Repository.Path, cfg.Repository.ModulePath, cfg.Repository.Branch); err != nil {
 		return err
@@ -295,6 +394,24 @@ func (r *Repository) AddTask(ctx context.Context, cfg *config.Task) error {
 		}
 	}
 
+	for i := range cfg.Update.Allow {
+		if err := createOverrides(ctx, tx, id, typeAllow, cfg.Update.Allow[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := range cfg.Update.Deny {
+		if err := createOverrides(ctx, tx, id, typeDeny, cfg.Update.Deny[i]); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Update.Policy != "" || cfg.Update.GroupCommits {
+		if err := createUpdatePolicy(ctx, tx, id, &cfg.Update); err != nil {
+			return err
+		}
+	}
+
 	if len(cfg.Push.CommandOverrides) > 0 {
 		for i := range cfg.Push.CommandOverrides {
 			if err := createOverrides(ctx, tx, id, typePush, cfg.Push.CommandOverrides[i]); err != nil {
@@ -311,6 +428,12 @@ func (r *Repository) AddTask(ctx context.Context, cfg *config.Task) error {
 		}
 	}
 
+	if cfg.Push.PullRequest != nil {
+		if err := createPullRequest(ctx, tx, id, cfg.Push.PullRequest); err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit()
 }
 
@@ -322,7 +445,8 @@ func (r *Repository) DeleteTask(ctx context.Context, uri, module, branch string)
 
 	defer tx.Rollback()
 
-	seq, err := iter.QueryContext[*taskID](ctx, r.db, queryRepositoryID+filterByURIModuleAndBranch,
+	// query within the transaction, as a read outside of it would wait on the transaction's lock
+	seq, err := iter.QueryContext[*taskID](ctx, tx, queryRepositoryID+filterByURIModuleAndBranch,
 		uri, module, branch)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil
@@ -349,12 +473,6 @@ func (r *Repository) DeleteTask(ctx context.Context, uri, module, branch string)
 
 		ids = append(ids, t.id.V)
 
-		if err := removeTask(ctx, tx, t.id.V); err != nil {
-			errs = append(errs, err)
-
-			return false
-		}
-
 		return true
 	}) {
 		errs = append(errs, ErrSeqFailed, tx.Rollback())
@@ -362,6 +480,13 @@ func (r *Repository) DeleteTask(ctx context.Context, uri, module, branch string)
 		return errors.Join(errs...)
 	}
 
+	// rows are only removed once the query's rows are closed
+	for i := range ids {
+		if err := removeTask(ctx, tx, ids[i]); err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit()
 }
 
@@ -407,6 +532,39 @@ func createOverrides(ctx context.Context, tx transactioner, id, typ, command str
 	return nil
 }
 
+func createPullRequest(ctx context.Context, tx transactioner, id string, pr *config.PullRequest) error {
+	res, err := tx.ExecContext(ctx, insertPullRequest,
+		id, pr.ForgeURL, pr.Owner, pr.Name, pr.Remote, pr.BranchPrefix, pr.Title, pr.Body,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err = res.RowsAffected(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createUpdatePolicy(ctx context.Context, tx transactioner, id string, cfg *config.Update) error {
+	groupCommits := 0
+	if cfg.GroupCommits {
+		groupCommits = 1
+	}
+
+	res, err := tx.ExecContext(ctx, insertUpdatePolicy, id, cfg.Policy, groupCommits)
+	if err != nil {
+		return err
+	}
+
+	if _, err = res.RowsAffected(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func removeTask(ctx context.Context, tx transactioner, id string) error {
 	res, err := tx.ExecContext(ctx, deleteOverrides, id)
 	if err != nil {
@@ -417,6 +575,24 @@ func removeTask(ctx context.Context, tx transactioner, id string) error {
 		return err
 	}
 
+	res, err = tx.ExecContext(ctx, deletePullRequest, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err = res.RowsAffected(); err != nil {
+		return err
+	}
+
+	res, err = tx.ExecContext(ctx, deleteUpdatePolicy, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err = res.RowsAffected(); err != nil {
+		return err
+	}
+
 	res, err = tx.ExecContext(ctx, deleteRepo, id)
 	if err != nil {
 		return err
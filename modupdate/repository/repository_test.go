@@ -37,6 +37,51 @@ func TestRepository_AddTask_ListTasks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success_WithPullRequest",
+			input: &config.Task{
+				Repository: config.Repository{
+					Path:       "github.com/zalgonoise/x",
+					ModulePath: "audio",
+					Branch:     "master",
+				},
+				Checkout: config.Checkout{
+					Persist: true,
+					Path:    "local/src/github.com/zalgonoise/x",
+				},
+				Push: config.Push{
+					CommitMessage: "audio: go.mod: updated dependencies",
+					PullRequest: &config.PullRequest{
+						ForgeURL:     "https://api.github.com",
+						BranchPrefix: "deps/",
+						Title:        "audio: updated dependencies",
+					},
+				},
+			},
+		},
+		{
+			name: "Success_WithUpdatePolicy",
+			input: &config.Task{
+				Repository: config.Repository{
+					Path:       "github.com/zalgonoise/x",
+					ModulePath: "audio",
+					Branch:     "master",
+				},
+				Checkout: config.Checkout{
+					Persist: true,
+					Path:    "local/src/github.com/zalgonoise/x",
+				},
+				Update: config.Update{
+					Allow:        []string{"github.com/zalgonoise/..."},
+					Deny:         []string{"github.com/zalgonoise/x/cli"},
+					Policy:       "minor",
+					GroupCommits: true,
+				},
+				Push: config.Push{
+					CommitMessage: "audio: go.mod: updated dependencies",
+				},
+			},
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			logger := log.New("debug")
@@ -67,6 +112,11 @@ func TestRepository_AddTask_ListTasks(t *testing.T) {
 			require.NoError(t, err)
 
 			require.Len(t, tasks, 1)
+			require.Equal(t, testcase.input.Push.PullRequest, tasks[0].Push.PullRequest)
+			require.ElementsMatch(t, testcase.input.Update.Allow, tasks[0].Update.Allow)
+			require.ElementsMatch(t, testcase.input.Update.Deny, tasks[0].Update.Deny)
+			require.Equal(t, testcase.input.Update.Policy, tasks[0].Update.Policy)
+			require.Equal(t, testcase.input.Update.GroupCommits, tasks[0].Update.GroupCommits)
 			t.Log(tasks[0])
 		})
 	}
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zalgonoise/x/iter"
+)
+
+const (
+	StatusRunning = "running"
+	StatusSuccess = "success"
+	StatusFailure = "failure"
+
+	TriggerSchedule = "schedule"
+	TriggerManual   = "manual"
+
+	defaultRunsLimit = 50
+
+	queryRuns = `
+SELECT id, uri, module, branch, trigger, status, error, commit_hash, started_at, duration FROM runs`
+
+	filterRunByID = `
+	WHERE id = ?`
+
+	orderRunsByStart = `
+	ORDER BY started_at DESC, id DESC
+	LIMIT ?`
+
+	queryRunSteps = `
+SELECT name, started_at, duration, output, error FROM run_steps
+	WHERE run_id = ?
+	ORDER BY rowid`
+
+	insertRun = `
+INSERT INTO runs (
+	uri, module, branch, trigger, status, error, commit_hash, started_at, duration
+) VALUES (
+	?, ?, ?, ?, ?, ?, ?, ?, ?
+)`
+
+	insertRunStep = `
+INSERT INTO run_steps (
+	run_id, name, started_at, duration, output, error
+) VALUES (
+	?, ?, ?, ?, ?, ?
+)`
+
+	updateRun = `
+UPDATE runs SET status = ?, error = ?, commit_hash = ?, duration = ?
+	WHERE id = ?`
+
+	updateRunsInterrupted = `
+UPDATE runs SET status = ?, error = ?
+	WHERE status = ?`
+)
+
+var ErrRunNotFound = errors.New("run not found")
+
+// Run describes an execution of a task, from checking out the repository to pushing the changes.
+type Run struct {
+	ID        int64
+	URI       string
+	Module    string
+	Branch    string
+	Trigger   string
+	Status    string
+	Error     string
+	Commit    string
+	StartedAt time.Time
+	Duration  time.Duration
+	Steps     []Step
+}
+
+// Step describes one of a Run's stages, with the commands it executed and their output.
+type Step struct {
+	Name      string
+	StartedAt time.Time
+	Duration  time.Duration
+	Output    []string
+	Error     string
+}
+
+type run struct {
+	id        sql.Null[int64]
+	uri       sql.Null[string]
+	module    sql.Null[string]
+	branch    sql.Null[string]
+	trigger   sql.Null[string]
+	status    sql.Null[string]
+	err       sql.Null[string]
+	commit    sql.Null[string]
+	startedAt sql.Null[int64]
+	duration  sql.Null[int64]
+}
+
+func (r *run) Scan(row *sql.Rows) error {
+	return row.Scan(
+		&r.id,
+		&r.uri,
+		&r.module,
+		&r.branch,
+		&r.trigger,
+		&r.status,
+		&r.err,
+		&r.commit,
+		&r.startedAt,
+		&r.duration,
+	)
+}
+
+func (r *run) toRun() Run {
+	return Run{
+		ID:        r.id.V,
+		URI:       r.uri.V,
+		Module:    r.module.V,
+		Branch:    r.branch.V,
+		Trigger:   r.trigger.V,
+		Status:    r.status.V,
+		Error:     r.err.V,
+		Commit:    r.commit.V,
+		StartedAt: time.UnixMilli(r.startedAt.V),
+		Duration:  time.Duration(r.duration.V),
+	}
+}
+
+type step struct {
+	name      sql.Null[string]
+	startedAt sql.Null[int64]
+	duration  sql.Null[int64]
+	output    sql.Null[string]
+	err       sql.Null[string]
+}
+
+func (s *step) Scan(row *sql.Rows) error {
+	return row.Scan(
+		&s.name,
+		&s.startedAt,
+		&s.duration,
+		&s.output,
+		&s.err,
+	)
+}
+
+// CreateRun records the start of a task's run, returning its ID.
+func (r *Repository) CreateRun(ctx context.Context, run *Run) (int64, error) {
+	res, err := r.db.ExecContext(ctx, insertRun,
+		run.URI, run.Module, run.Branch, run.Trigger, run.Status, run.Error, run.Commit,
+		run.StartedAt.UnixMilli(), int64(run.Duration),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+// AddRunStep records a completed step of a run.
+func (r *Repository) AddRunStep(ctx context.Context, id int64, step Step) error {
+	_, err := r.db.ExecContext(ctx, insertRunStep,
+		id, step.Name, step.StartedAt.UnixMilli(), int64(step.Duration), strings.Join(step.Output, "\n"), step.Error,
+	)
+
+	return err
+}
+
+// FinishRun records the outcome of a run: its status, error, resulting commit and duration.
+func (r *Repository) FinishRun(ctx context.Context, run *Run) error {
+	res, err := r.db.ExecContext(ctx, updateRun, run.Status, run.Error, run.Commit, int64(run.Duration), run.ID)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%w: %d", ErrRunNotFound, run.ID)
+	}
+
+	return nil
+}
+
+// InterruptRuns marks the runs that never finished (e.g. when the process exited mid-run) as failed.
+func (r *Repository) InterruptRuns(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, updateRunsInterrupted, StatusFailure, "run was interrupted", StatusRunning)
+
+	return err
+}
+
+// ListRuns returns the latest runs across all tasks, without their steps, from the most recent.
+func (r *Repository) ListRuns(ctx context.Context, limit int) ([]Run, error) {
+	if limit <= 0 {
+		limit = defaultRunsLimit
+	}
+
+	seq, err := iter.QueryContext[*run](ctx, r.db, queryRuns+orderRunsByStart, limit)
+	if errors.Is(err, sql.ErrNoRows) {
+		return []Run{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]Run, 0, minAlloc)
+
+	if !seq(func(r *run, e error) bool {
+		if e != nil {
+			err = e
+
+			return false
+		}
+
+		runs = append(runs, r.toRun())
+
+		return true
+	}) {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+// GetRun returns a run along with its steps.
+func (r *Repository) GetRun(ctx context.Context, id int64) (*Run, error) {
+	seq, err := iter.QueryContext[*run](ctx, r.db, queryRuns+filterRunByID, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %d", ErrRunNotFound, id)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var res *Run
+
+	if !seq(func(r *run, e error) bool {
+		if e != nil {
+			err = e
+
+			return false
+		}
+
+		item := r.toRun()
+		res = &item
+
+		return true
+	}) {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, fmt.Errorf("%w: %d", ErrRunNotFound, id)
+	}
+
+	steps, err := iter.QueryContext[*step](ctx, r.db, queryRunSteps, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return res, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.Steps = make([]Step, 0, 4)
+
+	if !steps(func(s *step, e error) bool {
+		if e != nil {
+			err = e
+
+			return false
+		}
+
+		var output []string
+		if s.output.V != "" {
+			output = strings.Split(s.output.V, "\n")
+		}
+
+		res.Steps = append(res.Steps, Step{
+			Name:      s.name.V,
+			StartedAt: time.UnixMilli(s.startedAt.V),
+			Duration:  time.Duration(s.duration.V),
+			Output:    output,
+			Error:     s.err.V,
+		})
+
+		return true
+	}) {
+		return nil, err
+	}
+
+	return res, nil
+}
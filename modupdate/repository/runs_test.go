@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/x/modupdate/database"
+	"github.com/zalgonoise/x/modupdate/log"
+)
+
+func TestRepository_Runs(t *testing.T) {
+	logger := log.New("debug")
+
+	db, err := database.OpenSQLite("", database.ReadWritePragmas(), logger)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, database.MigrateSQLite(ctx, db, logger))
+
+	repo := NewRepository(db)
+	start := time.UnixMilli(1_700_000_000_000)
+
+	run := &Run{
+		URI:       "github.com/zalgonoise/x",
+		Module:    "modupdate",
+		Branch:    "master",
+		Trigger:   TriggerSchedule,
+		Status:    StatusRunning,
+		StartedAt: start,
+	}
+
+	run.ID, err = repo.CreateRun(ctx, run)
+	require.NoError(t, err)
+	require.NotZero(t, run.ID)
+
+	steps := []Step{
+		{Name: "checkout", StartedAt: start, Duration: time.Second, Output: []string{"$ git checkout master"}},
+		{Name: "update", StartedAt: start.Add(time.Second), Duration: 2 * time.Second, Error: "exit status 1"},
+	}
+
+	for i := range steps {
+		require.NoError(t, repo.AddRunStep(ctx, run.ID, steps[i]))
+	}
+
+	run.Status = StatusFailure
+	run.Error = "update: exit status 1"
+	run.Duration = 3 * time.Second
+
+	require.NoError(t, repo.FinishRun(ctx, run))
+
+	interrupted := &Run{
+		URI:       "github.com/zalgonoise/x",
+		Module:    "audio",
+		Branch:    "master",
+		Trigger:   TriggerManual,
+		Status:    StatusRunning,
+		StartedAt: start.Add(time.Minute),
+	}
+
+	interrupted.ID, err = repo.CreateRun(ctx, interrupted)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.InterruptRuns(ctx))
+
+	t.Run("GetRun", func(t *testing.T) {
+		got, err := repo.GetRun(ctx, run.ID)
+		require.NoError(t, err)
+
+		run.Steps = steps
+		require.Equal(t, run, got)
+	})
+
+	t.Run("ListRuns", func(t *testing.T) {
+		runs, err := repo.ListRuns(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+
+		require.Equal(t, interrupted.ID, runs[0].ID)
+		require.Equal(t, StatusFailure, runs[0].Status)
+		require.Equal(t, run.ID, runs[1].ID)
+		require.Empty(t, runs[1].Steps)
+
+		runs, err = repo.ListRuns(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+	})
+
+	t.Run("Fail/NotFound", func(t *testing.T) {
+		_, err := repo.GetRun(ctx, 1_000)
+		require.ErrorIs(t, err, ErrRunNotFound)
+
+		require.ErrorIs(t, repo.FinishRun(ctx, &Run{ID: 1_000}), ErrRunNotFound)
+	})
+}
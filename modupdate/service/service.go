@@ -7,6 +7,7 @@ import (
 	"sync/atomic"
 
 	"github.com/zalgonoise/x/modupdate/config"
+	"github.com/zalgonoise/x/modupdate/repository"
 )
 
 var (
@@ -18,12 +19,15 @@ type Repository interface {
 	ListTasks(ctx context.Context) ([]*config.Task, error)
 	AddTask(ctx context.Context, cfg *config.Task) error
 	DeleteTask(ctx context.Context, uri, module, branch string) error
+	ListRuns(ctx context.Context, limit int) ([]repository.Run, error)
+	GetRun(ctx context.Context, id int64) (*repository.Run, error)
 	Close() error
 }
 
 type Runtime interface {
 	Run(ctx context.Context)
 	Err() <-chan error
+	Trigger(ctx context.Context, uri, module, branch string) (int64, error)
 }
 
 // Service handles requests to list, add and remove tasks as stored in the repository,
@@ -66,6 +70,7 @@ func (s *Service) Start() {
 		for {
 			select {
 			case <-ctx.Done():
+				return
 			case err := <-s.runtime.Err():
 				s.err.Store(&err)
 				s.logger.ErrorContext(ctx, "cron runtime error", slog.String("error", err.Error()))
@@ -76,6 +81,34 @@ func (s *Service) Start() {
 	s.runtime.Run(ctx)
 }
 
+// ListTasks returns the configured tasks.
+func (s *Service) ListTasks(ctx context.Context) ([]*config.Task, error) {
+	return s.repo.ListTasks(ctx)
+}
+
+// ListRuns returns the latest task runs, from the most recent.
+func (s *Service) ListRuns(ctx context.Context, limit int) ([]repository.Run, error) {
+	return s.repo.ListRuns(ctx, limit)
+}
+
+// GetRun returns a task run along with its steps' durations, outputs and errors.
+func (s *Service) GetRun(ctx context.Context, id int64) (*repository.Run, error) {
+	return s.repo.GetRun(ctx, id)
+}
+
+// Trigger runs a task on demand, outside of its cron schedule, returning the ID of the recorded run.
+func (s *Service) Trigger(ctx context.Context, uri, module, branch string) (int64, error) {
+	id, err := s.runtime.Trigger(ctx, uri, module, branch)
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.InfoContext(ctx, "triggered task run",
+		slog.Int64("run_id", id), slog.String("uri", uri), slog.String("module", module), slog.String("branch", branch))
+
+	return id, nil
+}
+
 func (s *Service) Err() error {
 	err := s.err.Load()
 	if err == nil {
@@ -4,22 +4,36 @@ import (
 	"context"
 	"slices"
 	"sync"
+
+	"github.com/zalgonoise/x/rbac"
 )
 
 type Memory[User comparable, Role comparable, Permission comparable] struct {
 	rolePermissions map[Role][]Permission
-	muRoles         *sync.RWMutex
+	roleIncludes    map[Role][]Role
+	roleGrants      map[Role][]rbac.Grant[Role, Permission]
+	// granted keeps the sequence in which permissions and scoped grants were (last) granted, to explain decisions in
+	// the same order as the SQLite backend
+	granted map[grantKey[Role, Permission]]uint64
+	seq     uint64
+	muRoles *sync.RWMutex
 
 	userRoles map[User][]Role
 	muUsers   *sync.RWMutex
+
+	conditions *rbac.Conditions
 }
 
 func NewMemory[User comparable, Role comparable, Permission comparable]() *Memory[User, Role, Permission] {
 	return &Memory[User, Role, Permission]{
 		rolePermissions: make(map[Role][]Permission),
+		roleIncludes:    make(map[Role][]Role),
+		roleGrants:      make(map[Role][]rbac.Grant[Role, Permission]),
+		granted:         make(map[grantKey[Role, Permission]]uint64),
 		muRoles:         &sync.RWMutex{},
 		userRoles:       make(map[User][]Role),
 		muUsers:         &sync.RWMutex{},
+		conditions:      &rbac.Conditions{},
 	}
 }
 
@@ -35,6 +49,7 @@ func (r *Memory[User, Role, Permission]) GrantPermission(_ context.Context, role
 	}
 
 	r.rolePermissions[role] = append(r.rolePermissions[role], permission)
+	r.markGranted(grantKey[Role, Permission]{role: role, permission: permission}, false)
 	r.muRoles.Unlock()
 
 	return nil
@@ -63,6 +78,10 @@ func (r *Memory[User, Role, Permission]) RevokePermission(_ context.Context, rol
 		r.rolePermissions[role] = append(permissions[:idx], permissions[idx+1:]...)
 	}
 
+	if !slices.Contains(r.rolePermissions[role], permission) {
+		delete(r.granted, grantKey[Role, Permission]{role: role, permission: permission})
+	}
+
 	return nil
 }
 
@@ -111,6 +130,50 @@ func (r *Memory[User, Role, Permission]) RevokeRole(_ context.Context, user User
 
 }
 
+func (r *Memory[User, Role, Permission]) IncludeRole(_ context.Context, role Role, included Role) error {
+	r.muRoles.Lock()
+	defer r.muRoles.Unlock()
+
+	if r.roleIncludes == nil {
+		r.roleIncludes = make(map[Role][]Role)
+	}
+
+	if slices.Contains(r.roleIncludes[role], included) {
+		return nil
+	}
+
+	// role would include itself if it is already reachable from the included role
+	if slices.Contains(r.expand([]Role{included}), role) {
+		return rbac.ErrRoleCycle
+	}
+
+	r.roleIncludes[role] = append(r.roleIncludes[role], included)
+
+	return nil
+}
+
+func (r *Memory[User, Role, Permission]) ExcludeRole(_ context.Context, role Role, included Role) error {
+	r.muRoles.Lock()
+	defer r.muRoles.Unlock()
+
+	if r.roleIncludes == nil {
+		r.roleIncludes = make(map[Role][]Role)
+
+		return nil
+	}
+
+	includes := r.roleIncludes[role]
+
+	switch idx := slices.Index(includes, included); idx {
+	case -1:
+		return nil
+	default:
+		r.roleIncludes[role] = append(includes[:idx], includes[idx+1:]...)
+	}
+
+	return nil
+}
+
 func (r *Memory[User, Role, Permission]) Can(_ context.Context, user User, permission Permission) bool {
 	r.muUsers.RLock()
 
@@ -121,18 +184,107 @@ func (r *Memory[User, Role, Permission]) Can(_ context.Context, user User, permi
 		return false
 	}
 
+	roles = slices.Clone(roles)
 	r.muUsers.RUnlock()
 
 	r.muRoles.RLock()
-	for _, role := range roles {
-		if slices.Contains(r.rolePermissions[role], permission) {
-			r.muRoles.RUnlock()
+	defer r.muRoles.RUnlock()
 
+	for _, role := range r.expand(roles) {
+		if slices.Contains(r.rolePermissions[role], permission) {
 			return true
 		}
 	}
 
-	r.muRoles.RUnlock()
-
 	return false
 }
+
+func (r *Memory[User, Role, Permission]) Roles(_ context.Context, user User) ([]Role, error) {
+	r.muUsers.RLock()
+	roles := slices.Clone(r.userRoles[user])
+	r.muUsers.RUnlock()
+
+	r.muRoles.RLock()
+	defer r.muRoles.RUnlock()
+
+	return r.expand(roles), nil
+}
+
+func (r *Memory[User, Role, Permission]) Users(_ context.Context, permission Permission) ([]User, error) {
+	r.muUsers.RLock()
+	defer r.muUsers.RUnlock()
+
+	r.muRoles.RLock()
+	defer r.muRoles.RUnlock()
+
+	users := make([]User, 0, len(r.userRoles))
+
+	for user, roles := range r.userRoles {
+		for _, role := range r.expand(roles) {
+			if slices.Contains(r.rolePermissions[role], permission) {
+				users = append(users, user)
+
+				break
+			}
+		}
+	}
+
+	return users, nil
+}
+
+// grantKey identifies a role's permission, or its scoped grant for a resource.
+type grantKey[Role comparable, Permission comparable] struct {
+	role       Role
+	permission Permission
+	resource   string
+	scoped     bool
+}
+
+// markGranted records the sequence of a new grant. Granting a permission again keeps its sequence, while replacing a
+// scoped grant moves it to the end, like the SQLite backend's inserts do. It must be called while holding muRoles.
+func (r *Memory[User, Role, Permission]) markGranted(key grantKey[Role, Permission], replace bool) {
+	if r.granted == nil {
+		r.granted = make(map[grantKey[Role, Permission]]uint64)
+	}
+
+	if _, ok := r.granted[key]; ok && !replace {
+		return
+	}
+
+	r.seq++
+	r.granted[key] = r.seq
+}
+
+// expand returns the input roles followed by all roles they (transitively) include, without duplicates, in
+// breadth-first order. It must be called while holding muRoles.
+func (r *Memory[User, Role, Permission]) expand(roles []Role) []Role {
+	expanded, _ := r.walk(roles)
+
+	return expanded
+}
+
+// walk returns the same roles as expand, along with each role's distance to the input roles in the role hierarchy. It
+// must be called while holding muRoles.
+func (r *Memory[User, Role, Permission]) walk(roles []Role) ([]Role, map[Role]int) {
+	expanded := make([]Role, 0, len(roles))
+	depths := make(map[Role]int, len(roles))
+
+	level := slices.Clone(roles)
+	for depth := 0; len(level) > 0; depth++ {
+		next := make([]Role, 0, len(level))
+
+		for _, role := range level {
+			if _, ok := depths[role]; ok {
+				continue
+			}
+
+			depths[role] = depth
+			expanded = append(expanded, role)
+			next = append(next, r.roleIncludes[role]...)
+		}
+
+		level = next
+	}
+
+	return expanded, depths
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/rbac"
 )
 
 func TestMemory_GrantPermission(t *testing.T) {
@@ -427,3 +429,129 @@ func TestMemory_Can(t *testing.T) {
 		})
 	}
 }
+
+func TestMemory_IncludeRole(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		includes [][2]string
+		excludes [][2]string
+		wants    map[string][]string
+		err      error
+	}{
+		{
+			name:     "Success/SingleInclude",
+			includes: [][2]string{{"users.admin", "users.audit"}},
+			wants:    map[string][]string{"users.admin": {"users.audit"}},
+		},
+		{
+			name: "Success/NestedIncludes",
+			includes: [][2]string{
+				{"root", "users.admin"},
+				{"users.admin", "users.audit"},
+			},
+			wants: map[string][]string{
+				"root":        {"users.admin"},
+				"users.admin": {"users.audit"},
+			},
+		},
+		{
+			name: "Success/DuplicateInclude",
+			includes: [][2]string{
+				{"users.admin", "users.audit"},
+				{"users.admin", "users.audit"},
+			},
+			wants: map[string][]string{"users.admin": {"users.audit"}},
+		},
+		{
+			name: "Success/WithExclusion",
+			includes: [][2]string{
+				{"root", "users.admin"},
+				{"root", "users.audit"},
+			},
+			excludes: [][2]string{{"root", "users.admin"}},
+			wants:    map[string][]string{"root": {"users.audit"}},
+		},
+		{
+			name:     "Fail/SelfInclude",
+			includes: [][2]string{{"users.admin", "users.admin"}},
+			err:      rbac.ErrRoleCycle,
+		},
+		{
+			name: "Fail/TransitiveCycle",
+			includes: [][2]string{
+				{"root", "users.admin"},
+				{"users.admin", "users.audit"},
+				{"users.audit", "root"},
+			},
+			err: rbac.ErrRoleCycle,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			m := NewMemory[string, string, string]()
+			ctx := context.Background()
+
+			for i := range testcase.includes {
+				if err := m.IncludeRole(ctx, testcase.includes[i][0], testcase.includes[i][1]); err != nil {
+					require.ErrorIs(t, err, testcase.err)
+
+					return
+				}
+			}
+
+			for i := range testcase.excludes {
+				require.NoError(t, m.ExcludeRole(ctx, testcase.excludes[i][0], testcase.excludes[i][1]))
+			}
+
+			require.NoError(t, testcase.err)
+
+			for role, includes := range testcase.wants {
+				require.Equal(t, includes, m.roleIncludes[role])
+			}
+		})
+	}
+}
+
+func TestMemory_Hierarchy(t *testing.T) {
+	m := NewMemory[string, string, string]()
+	ctx := context.Background()
+
+	require.NoError(t, m.GrantPermission(ctx, "users.audit", "read"))
+	require.NoError(t, m.GrantPermission(ctx, "users.admin", "delete"))
+	require.NoError(t, m.IncludeRole(ctx, "root", "users.admin"))
+	require.NoError(t, m.IncludeRole(ctx, "users.admin", "users.audit"))
+	require.NoError(t, m.GrantRole(ctx, "gopher", "root"))
+	require.NoError(t, m.GrantRole(ctx, "auditor", "users.audit"))
+
+	t.Run("Can", func(t *testing.T) {
+		require.True(t, m.Can(ctx, "gopher", "read"))
+		require.True(t, m.Can(ctx, "gopher", "delete"))
+		require.True(t, m.Can(ctx, "auditor", "read"))
+		require.False(t, m.Can(ctx, "auditor", "delete"))
+	})
+
+	t.Run("Roles", func(t *testing.T) {
+		roles, err := m.Roles(ctx, "gopher")
+		require.NoError(t, err)
+		require.Equal(t, []string{"root", "users.admin", "users.audit"}, roles)
+
+		roles, err = m.Roles(ctx, "unknown")
+		require.NoError(t, err)
+		require.Empty(t, roles)
+	})
+
+	t.Run("Users", func(t *testing.T) {
+		users, err := m.Users(ctx, "read")
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"gopher", "auditor"}, users)
+
+		users, err = m.Users(ctx, "delete")
+		require.NoError(t, err)
+		require.Equal(t, []string{"gopher"}, users)
+	})
+
+	t.Run("ExcludeRole", func(t *testing.T) {
+		require.NoError(t, m.ExcludeRole(ctx, "users.admin", "users.audit"))
+		require.False(t, m.Can(ctx, "gopher", "read"))
+		require.True(t, m.Can(ctx, "gopher", "delete"))
+	})
+}
@@ -0,0 +1,163 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/zalgonoise/x/rbac"
+)
+
+func (r *Memory[User, Role, Permission]) RegisterCondition(name string, condition rbac.Condition) {
+	r.conditions.Register(name, condition)
+}
+
+func (r *Memory[User, Role, Permission]) GrantScoped(_ context.Context, grant rbac.Grant[Role, Permission]) error {
+	r.muRoles.Lock()
+	defer r.muRoles.Unlock()
+
+	if r.roleGrants == nil {
+		r.roleGrants = make(map[Role][]rbac.Grant[Role, Permission])
+	}
+
+	grant.Conditions = slices.Clone(grant.Conditions)
+	grants := r.roleGrants[grant.Role]
+
+	switch idx := slices.IndexFunc(grants, sameGrant(grant)); idx {
+	case -1:
+		r.roleGrants[grant.Role] = append(grants, grant)
+	default:
+		grants[idx] = grant
+	}
+
+	r.markGranted(scopedKey(grant), true)
+
+	return nil
+}
+
+func (r *Memory[User, Role, Permission]) RevokeScoped(_ context.Context, grant rbac.Grant[Role, Permission]) error {
+	r.muRoles.Lock()
+	defer r.muRoles.Unlock()
+
+	if r.roleGrants == nil {
+		r.roleGrants = make(map[Role][]rbac.Grant[Role, Permission])
+
+		return nil
+	}
+
+	grants := r.roleGrants[grant.Role]
+
+	switch idx := slices.IndexFunc(grants, sameGrant(grant)); idx {
+	case -1:
+		return nil
+	default:
+		r.roleGrants[grant.Role] = append(grants[:idx], grants[idx+1:]...)
+	}
+
+	delete(r.granted, scopedKey(grant))
+
+	return nil
+}
+
+func (r *Memory[User, Role, Permission]) CanAccess(ctx context.Context, req rbac.Request[User, Permission]) bool {
+	decision, _ := r.Explain(ctx, req)
+
+	return decision.Allowed
+}
+
+// Explain evaluates the grants of the user's roles, granted and inherited, ordered by their distance in the role
+// hierarchy; where (unscoped) permissions precede scoped grants, each in the order they were granted.
+func (r *Memory[User, Role, Permission]) Explain(
+	ctx context.Context, req rbac.Request[User, Permission],
+) (rbac.Decision[Role, Permission], error) {
+	r.muUsers.RLock()
+	roles := slices.Clone(r.userRoles[req.User])
+	r.muUsers.RUnlock()
+
+	r.muRoles.RLock()
+
+	expanded, depths := r.walk(roles)
+	ranked := make([]rankedGrant[Role, Permission], 0, len(expanded))
+
+	for _, role := range expanded {
+		if slices.Contains(r.rolePermissions[role], req.Permission) {
+			key := grantKey[Role, Permission]{role: role, permission: req.Permission}
+
+			ranked = append(ranked, rankedGrant[Role, Permission]{
+				grant: rbac.Grant[Role, Permission]{
+					Role:       role,
+					Permission: req.Permission,
+					Resource:   rbac.Wildcard,
+				},
+				depth: depths[role],
+				seq:   r.granted[key],
+			})
+		}
+
+		for _, grant := range r.roleGrants[role] {
+			if grant.Permission == req.Permission {
+				grant.Conditions = slices.Clone(grant.Conditions)
+
+				ranked = append(ranked, rankedGrant[Role, Permission]{
+					grant:  grant,
+					depth:  depths[role],
+					scoped: true,
+					seq:    r.granted[scopedKey(grant)],
+				})
+			}
+		}
+	}
+
+	r.muRoles.RUnlock()
+
+	slices.SortStableFunc(ranked, compareRanked[Role, Permission])
+
+	grants := make([]rbac.Grant[Role, Permission], 0, len(ranked))
+	for i := range ranked {
+		grants = append(grants, ranked[i].grant)
+	}
+
+	// conditions are evaluated once the roles' lock is released, as they may call back into the access system
+	return rbac.Decide(ctx, r.conditions, grants, req), nil
+}
+
+// rankedGrant is a grant along with its position in the order grants are evaluated in.
+type rankedGrant[Role comparable, Permission comparable] struct {
+	grant  rbac.Grant[Role, Permission]
+	depth  int
+	scoped bool
+	seq    uint64
+}
+
+func compareRanked[Role comparable, Permission comparable](a, b rankedGrant[Role, Permission]) int {
+	if c := cmp.Compare(a.depth, b.depth); c != 0 {
+		return c
+	}
+
+	if a.scoped != b.scoped {
+		if a.scoped {
+			return 1
+		}
+
+		return -1
+	}
+
+	return cmp.Compare(a.seq, b.seq)
+}
+
+func scopedKey[Role comparable, Permission comparable](grant rbac.Grant[Role, Permission]) grantKey[Role, Permission] {
+	return grantKey[Role, Permission]{
+		role:       grant.Role,
+		permission: grant.Permission,
+		resource:   grant.Resource,
+		scoped:     true,
+	}
+}
+
+func sameGrant[Role comparable, Permission comparable](
+	grant rbac.Grant[Role, Permission],
+) func(rbac.Grant[Role, Permission]) bool {
+	return func(g rbac.Grant[Role, Permission]) bool {
+		return g.Permission == grant.Permission && g.Resource == grant.Resource
+	}
+}
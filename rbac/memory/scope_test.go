@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/rbac"
+)
+
+func TestMemory_Explain(t *testing.T) {
+	type grant = rbac.Grant[string, string]
+
+	ctx := context.Background()
+	m := NewMemory[string, string, string]()
+
+	m.RegisterCondition("owner", func(_ context.Context, attributes map[string]any) bool {
+		return attributes["owner"] == true
+	})
+
+	require.NoError(t, m.GrantPermission(ctx, "admin", "delete"))
+	require.NoError(t, m.GrantScoped(ctx, grant{Role: "dev", Permission: "write", Resource: "project/42/*"}))
+	require.NoError(t, m.GrantScoped(ctx, grant{
+		Role: "dev", Permission: "delete", Resource: "project/42/*", Conditions: []string{"owner"},
+	}))
+	require.NoError(t, m.GrantScoped(ctx, grant{
+		Role: "dev", Permission: "deploy", Resource: "project/42/*", Conditions: []string{"unregistered"},
+	}))
+	require.NoError(t, m.IncludeRole(ctx, "lead", "dev"))
+	require.NoError(t, m.GrantRole(ctx, "gopher", "lead"))
+	require.NoError(t, m.GrantRole(ctx, "root", "admin"))
+
+	for _, testcase := range []struct {
+		name  string
+		req   rbac.Request[string, string]
+		wants rbac.Decision[string, string]
+	}{
+		{
+			name: "Allowed/PrefixGrant",
+			req:  rbac.Request[string, string]{User: "gopher", Permission: "write", Resource: "project/42/readme"},
+			wants: rbac.Decision[string, string]{
+				Allowed: true,
+				Grant:   &grant{Role: "dev", Permission: "write", Resource: "project/42/*"},
+			},
+		},
+		{
+			name: "Allowed/UnscopedPermission",
+			req:  rbac.Request[string, string]{User: "root", Permission: "delete", Resource: "project/7"},
+			wants: rbac.Decision[string, string]{
+				Allowed: true,
+				Grant:   &grant{Role: "admin", Permission: "delete", Resource: rbac.Wildcard},
+			},
+		},
+		{
+			name: "Allowed/WithCondition",
+			req: rbac.Request[string, string]{
+				User: "gopher", Permission: "delete", Resource: "project/42/readme",
+				Attributes: map[string]any{"owner": true},
+			},
+			wants: rbac.Decision[string, string]{
+				Allowed: true,
+				Grant: &grant{
+					Role: "dev", Permission: "delete", Resource: "project/42/*", Conditions: []string{"owner"},
+				},
+			},
+		},
+		{
+			name: "Denied/FailedCondition",
+			req:  rbac.Request[string, string]{User: "gopher", Permission: "delete", Resource: "project/42/readme"},
+			wants: rbac.Decision[string, string]{
+				Grant: &grant{
+					Role: "dev", Permission: "delete", Resource: "project/42/*", Conditions: []string{"owner"},
+				},
+				Condition: "owner",
+			},
+		},
+		{
+			name: "Denied/UnregisteredCondition",
+			req:  rbac.Request[string, string]{User: "gopher", Permission: "deploy", Resource: "project/42/api"},
+			wants: rbac.Decision[string, string]{
+				Grant: &grant{
+					Role: "dev", Permission: "deploy", Resource: "project/42/*", Conditions: []string{"unregistered"},
+				},
+				Condition: "unregistered",
+			},
+		},
+		{
+			name: "Denied/OtherResource",
+			req:  rbac.Request[string, string]{User: "gopher", Permission: "write", Resource: "project/43/readme"},
+		},
+		{
+			name: "Denied/UnknownUser",
+			req:  rbac.Request[string, string]{User: "unknown", Permission: "write", Resource: "project/42/readme"},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			decision, err := m.Explain(ctx, testcase.req)
+			require.NoError(t, err)
+			require.Equal(t, testcase.wants, decision)
+			require.Equal(t, testcase.wants.Allowed, m.CanAccess(ctx, testcase.req))
+		})
+	}
+
+	t.Run("RevokeScoped", func(t *testing.T) {
+		req := rbac.Request[string, string]{User: "gopher", Permission: "write", Resource: "project/42/readme"}
+
+		require.NoError(t, m.RevokeScoped(ctx, grant{Role: "dev", Permission: "write", Resource: "project/42/*"}))
+		require.False(t, m.CanAccess(ctx, req))
+	})
+}
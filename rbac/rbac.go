@@ -1,6 +1,12 @@
 package rbac
 
-import "context"
+import (
+	"context"
+	"errors"
+)
+
+// ErrRoleCycle is returned when including a role would make it (transitively) include itself.
+var ErrRoleCycle = errors.New("role inclusion cycle")
 
 type AccessSystem[User comparable, Role comparable, Permission comparable] interface {
 	GrantPermission(ctx context.Context, role Role, permission Permission) error
@@ -8,5 +14,14 @@ type AccessSystem[User comparable, Role comparable, Permission comparable] inter
 	GrantRole(ctx context.Context, user User, role Role) error
 	RevokeRole(ctx context.Context, user User, role Role) error
 
+	// IncludeRole makes role inherit all permissions of the included role (and of the roles it includes).
+	IncludeRole(ctx context.Context, role Role, included Role) error
+	ExcludeRole(ctx context.Context, role Role, included Role) error
+
 	Can(ctx context.Context, user User, permission Permission) bool
+
+	// Roles lists the roles of a user, both granted and inherited.
+	Roles(ctx context.Context, user User) ([]Role, error)
+	// Users lists the users holding a permission, through any of their roles.
+	Users(ctx context.Context, permission Permission) ([]User, error)
 }
@@ -0,0 +1,137 @@
+package rbac
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// Wildcard is the resource pattern matching any resource, and the suffix marking a resource pattern as a prefix.
+const Wildcard = "*"
+
+// Condition is an attribute check evaluated when a scoped grant is matched, allowing access if it returns true.
+type Condition func(ctx context.Context, attributes map[string]any) bool
+
+// Grant binds a role to a permission over a resource, or over all resources under a prefix when the resource ends in
+// Wildcard (e.g. `project/42/*`). Conditions lists the names of registered conditions that must all pass for the
+// grant to apply.
+//
+// Permissions granted with GrantPermission apply to all resources, as a grant over Wildcard without conditions.
+type Grant[Role comparable, Permission comparable] struct {
+	Role       Role
+	Permission Permission
+	Resource   string
+	Conditions []string
+}
+
+// Request describes an access check for a user's permission over a resource, with the attributes passed to the
+// conditions of any matching grant.
+type Request[User comparable, Permission comparable] struct {
+	User       User
+	Permission Permission
+	Resource   string
+	Attributes map[string]any
+}
+
+// Decision is the outcome of an access check.
+//
+// When allowed, Grant is the grant that allowed it. When denied by a condition, Grant is the first matching grant
+// and Condition is the name of the condition that failed. When no grant matches the request, Grant is nil.
+type Decision[Role comparable, Permission comparable] struct {
+	Allowed   bool
+	Grant     *Grant[Role, Permission]
+	Condition string
+}
+
+type ScopedAccessSystem[User comparable, Role comparable, Permission comparable] interface {
+	AccessSystem[User, Role, Permission]
+
+	// RegisterCondition makes a condition available to grants under the input name, replacing any existing one.
+	RegisterCondition(name string, condition Condition)
+
+	// GrantScoped adds a scoped grant, replacing the conditions of an existing grant for the same role, permission and
+	// resource.
+	GrantScoped(ctx context.Context, grant Grant[Role, Permission]) error
+	RevokeScoped(ctx context.Context, grant Grant[Role, Permission]) error
+
+	CanAccess(ctx context.Context, req Request[User, Permission]) bool
+	Explain(ctx context.Context, req Request[User, Permission]) (Decision[Role, Permission], error)
+}
+
+// MatchResource returns true if the resource matches the pattern, either exactly or, when the pattern ends in
+// Wildcard, by its prefix.
+func MatchResource(pattern, resource string) bool {
+	prefix, ok := strings.CutSuffix(pattern, Wildcard)
+	if !ok {
+		return pattern == resource
+	}
+
+	return strings.HasPrefix(resource, prefix)
+}
+
+// Conditions is a concurrency-safe registry of named conditions.
+type Conditions struct {
+	mu         sync.RWMutex
+	conditions map[string]Condition
+}
+
+func (c *Conditions) Register(name string, condition Condition) {
+	c.mu.Lock()
+
+	if c.conditions == nil {
+		c.conditions = make(map[string]Condition)
+	}
+
+	c.conditions[name] = condition
+	c.mu.Unlock()
+}
+
+// Evaluate returns the name of the first condition that does not pass for the input attributes, and true if all of
+// them pass. Conditions that are not registered never pass.
+//
+// Conditions are called without holding the registry's lock, so that they are free to register other conditions.
+func (c *Conditions) Evaluate(ctx context.Context, names []string, attributes map[string]any) (string, bool) {
+	conditions := make([]Condition, len(names))
+
+	c.mu.RLock()
+
+	for i := range names {
+		conditions[i] = c.conditions[names[i]]
+	}
+
+	c.mu.RUnlock()
+
+	for i := range conditions {
+		if conditions[i] == nil || !conditions[i](ctx, attributes) {
+			return names[i], false
+		}
+	}
+
+	return "", true
+}
+
+// Decide evaluates the grants held by a user (in precedence order) against the request, returning a Decision
+// allowing access through the first grant that matches the resource and passes its conditions.
+func Decide[User comparable, Role comparable, Permission comparable](
+	ctx context.Context, conditions *Conditions, grants []Grant[Role, Permission], req Request[User, Permission],
+) Decision[Role, Permission] {
+	var decision Decision[Role, Permission]
+
+	for i := range grants {
+		if grants[i].Permission != req.Permission || !MatchResource(grants[i].Resource, req.Resource) {
+			continue
+		}
+
+		failed, ok := conditions.Evaluate(ctx, grants[i].Conditions, req.Attributes)
+		if ok {
+			return Decision[Role, Permission]{Allowed: true, Grant: &grants[i]}
+		}
+
+		if decision.Grant == nil {
+			decision.Grant = &grants[i]
+			decision.Condition = failed
+		}
+	}
+
+	return decision
+}
@@ -0,0 +1,87 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchResource(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		pattern  string
+		resource string
+		wants    bool
+	}{
+		{name: "Exact", pattern: "project/42", resource: "project/42", wants: true},
+		{name: "ExactMismatch", pattern: "project/42", resource: "project/42/readme"},
+		{name: "Prefix", pattern: "project/42/*", resource: "project/42/readme", wants: true},
+		{name: "PrefixMismatch", pattern: "project/42/*", resource: "project/420"},
+		{name: "Wildcard", pattern: Wildcard, resource: "project/42", wants: true},
+		{name: "Empty", pattern: "", resource: "project/42"},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			require.Equal(t, testcase.wants, MatchResource(testcase.pattern, testcase.resource))
+		})
+	}
+}
+
+func TestConditions_Evaluate(t *testing.T) {
+	conditions := &Conditions{}
+
+	conditions.Register("owner", func(_ context.Context, attributes map[string]any) bool {
+		return attributes["owner"] == true
+	})
+	conditions.Register("weekday", func(context.Context, map[string]any) bool {
+		return true
+	})
+
+	for _, testcase := range []struct {
+		name       string
+		names      []string
+		attributes map[string]any
+		failed     string
+		wants      bool
+	}{
+		{name: "NoConditions", wants: true},
+		{name: "Pass", names: []string{"weekday", "owner"}, attributes: map[string]any{"owner": true}, wants: true},
+		{name: "Fail", names: []string{"weekday", "owner"}, failed: "owner"},
+		{name: "Unregistered", names: []string{"weekday", "admin", "owner"}, failed: "admin"},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			failed, ok := conditions.Evaluate(context.Background(), testcase.names, testcase.attributes)
+			require.Equal(t, testcase.wants, ok)
+			require.Equal(t, testcase.failed, failed)
+		})
+	}
+
+	t.Run("Reentrant", func(t *testing.T) {
+		// a condition registering another one would deadlock if called while holding the registry's lock
+		conditions.Register("lazy", func(context.Context, map[string]any) bool {
+			conditions.Register("registered", func(context.Context, map[string]any) bool {
+				return true
+			})
+
+			return true
+		})
+
+		done := make(chan bool)
+
+		go func() {
+			_, ok := conditions.Evaluate(context.Background(), []string{"lazy"}, nil)
+			done <- ok
+		}()
+
+		select {
+		case ok := <-done:
+			require.True(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("conditions evaluated while holding the registry's lock")
+		}
+
+		_, ok := conditions.Evaluate(context.Background(), []string{"registered"}, nil)
+		require.True(t, ok)
+	})
+}
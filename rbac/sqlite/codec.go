@@ -0,0 +1,33 @@
+package sqlite
+
+import "encoding/json"
+
+// Codec converts users, roles or permissions to and from the text values stored in the database.
+//
+// Encoding must be deterministic, as the encoded values are compared when querying the database.
+type Codec[T any] interface {
+	Encode(value T) (string, error)
+	Decode(data string) (T, error)
+}
+
+// JSON is the default Codec, storing values as their JSON representation.
+type JSON[T any] struct{}
+
+func (JSON[T]) Encode(value T) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func (JSON[T]) Decode(data string) (T, error) {
+	var value T
+
+	if err := json.Unmarshal([]byte(data), &value); err != nil {
+		return value, err
+	}
+
+	return value, nil
+}
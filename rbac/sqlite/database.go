@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+
+	_ "modernc.org/sqlite"
+)
+
+const (
+	uriFormat = "file:%s?_pragma=busy_timeout(5000)"
+	inMemory  = ":memory:"
+
+	createTables = `
+CREATE TABLE IF NOT EXISTS role_permissions (
+	role       TEXT NOT NULL,
+	permission TEXT NOT NULL,
+	PRIMARY KEY (role, permission)
+);
+
+CREATE TABLE IF NOT EXISTS user_roles (
+	user TEXT NOT NULL,
+	role TEXT NOT NULL,
+	PRIMARY KEY (user, role)
+);
+
+CREATE TABLE IF NOT EXISTS role_includes (
+	role     TEXT NOT NULL,
+	included TEXT NOT NULL,
+	PRIMARY KEY (role, included)
+);
+
+CREATE TABLE IF NOT EXISTS role_grants (
+	role       TEXT NOT NULL,
+	permission TEXT NOT NULL,
+	resource   TEXT NOT NULL,
+	conditions TEXT NOT NULL,
+	PRIMARY KEY (role, permission, resource)
+);
+
+CREATE INDEX IF NOT EXISTS idx_role_permissions_permission ON role_permissions (permission);
+CREATE INDEX IF NOT EXISTS idx_user_roles_role ON user_roles (role);
+CREATE INDEX IF NOT EXISTS idx_role_includes_included ON role_includes (included);
+`
+)
+
+func open(uri string) (*sql.DB, error) {
+	switch uri {
+	case inMemory:
+	case "":
+		uri = inMemory
+	default:
+		if err := validateURI(uri); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := sql.Open("sqlite", fmt.Sprintf(uriFormat, uri))
+	if err != nil {
+		return nil, err
+	}
+
+	// a single connection keeps each in-memory database alive and private to its store, and serializes writes
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
+
+func validateURI(uri string) error {
+	stat, err := os.Stat(uri)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			f, err := os.Create(uri)
+			if err != nil {
+				return err
+			}
+
+			return f.Close()
+		}
+
+		return err
+	}
+
+	if stat.IsDir() {
+		return fmt.Errorf("%s is a directory", uri)
+	}
+
+	return nil
+}
+
+func initDatabase(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, createTables)
+
+	return err
+}
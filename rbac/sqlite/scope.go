@@ -0,0 +1,159 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zalgonoise/x/rbac"
+)
+
+const (
+	grantScoped  = `INSERT OR REPLACE INTO role_grants (role, permission, resource, conditions) VALUES (?, ?, ?, ?);`
+	revokeScoped = `DELETE FROM role_grants WHERE role = ? AND permission = ? AND resource = ?;`
+
+	// listGrants returns the unscoped permissions and scoped grants of the user's roles, granted and inherited, ordered
+	// by their distance in the role hierarchy; where permissions precede scoped grants, each in the order they were
+	// granted. The memory backend explains decisions in the same order.
+	listGrants = `
+WITH RECURSIVE roles(role, depth) AS (
+	SELECT role, 0 FROM user_roles WHERE user = ?1
+	UNION
+	SELECT ri.included, r.depth + 1 FROM role_includes ri JOIN roles r ON ri.role = r.role WHERE r.depth < ?2
+),
+nearest(role, depth) AS (
+	SELECT role, MIN(depth) FROM roles GROUP BY role
+),
+grants(role, resource, conditions, kind, seq) AS (
+	SELECT role, '*', '[]', 0, rowid FROM role_permissions WHERE permission = ?3
+	UNION ALL
+	SELECT role, resource, conditions, 1, rowid FROM role_grants WHERE permission = ?3
+)
+SELECT g.role, g.resource, g.conditions
+FROM grants g JOIN nearest n ON g.role = n.role
+ORDER BY n.depth, g.kind, g.seq;`
+)
+
+func (s *SQLite[User, Role, Permission]) RegisterCondition(name string, condition rbac.Condition) {
+	s.conditions.Register(name, condition)
+}
+
+func (s *SQLite[User, Role, Permission]) GrantScoped(ctx context.Context, grant rbac.Grant[Role, Permission]) error {
+	r, err := s.roles.Encode(grant.Role)
+	if err != nil {
+		return err
+	}
+
+	p, err := s.permissions.Encode(grant.Permission)
+	if err != nil {
+		return err
+	}
+
+	conditions := grant.Conditions
+	if conditions == nil {
+		conditions = []string{}
+	}
+
+	c, err := json.Marshal(conditions)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, grantScoped, r, p, grant.Resource, string(c))
+
+	return err
+}
+
+func (s *SQLite[User, Role, Permission]) RevokeScoped(ctx context.Context, grant rbac.Grant[Role, Permission]) error {
+	r, err := s.roles.Encode(grant.Role)
+	if err != nil {
+		return err
+	}
+
+	p, err := s.permissions.Encode(grant.Permission)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, revokeScoped, r, p, grant.Resource)
+
+	return err
+}
+
+// CanAccess returns true if any of the user's grants allows the request. Errors when querying the database deny
+// access.
+func (s *SQLite[User, Role, Permission]) CanAccess(ctx context.Context, req rbac.Request[User, Permission]) bool {
+	decision, err := s.Explain(ctx, req)
+	if err != nil {
+		return false
+	}
+
+	return decision.Allowed
+}
+
+func (s *SQLite[User, Role, Permission]) Explain(
+	ctx context.Context, req rbac.Request[User, Permission],
+) (rbac.Decision[Role, Permission], error) {
+	grants, err := s.grants(ctx, req.User, req.Permission)
+	if err != nil {
+		return rbac.Decision[Role, Permission]{}, err
+	}
+
+	return rbac.Decide(ctx, s.conditions, grants, req), nil
+}
+
+func (s *SQLite[User, Role, Permission]) grants(
+	ctx context.Context, user User, permission Permission,
+) ([]rbac.Grant[Role, Permission], error) {
+	u, err := s.users.Encode(user)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.permissions.Encode(permission)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, listGrants, u, maxDepth, p)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	grants := make([]rbac.Grant[Role, Permission], 0, 16)
+
+	for rows.Next() {
+		var role, resource, conditions string
+
+		if err = rows.Scan(&role, &resource, &conditions); err != nil {
+			return nil, err
+		}
+
+		grant := rbac.Grant[Role, Permission]{
+			Permission: permission,
+			Resource:   resource,
+		}
+
+		if grant.Role, err = s.roles.Decode(role); err != nil {
+			return nil, err
+		}
+
+		var names []string
+		if err = json.Unmarshal([]byte(conditions), &names); err != nil {
+			return nil, err
+		}
+
+		if len(names) > 0 {
+			grant.Conditions = names
+		}
+
+		grants = append(grants, grant)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
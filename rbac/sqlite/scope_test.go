@@ -0,0 +1,207 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/rbac"
+	"github.com/zalgonoise/x/rbac/memory"
+)
+
+func TestSQLite_Explain(t *testing.T) {
+	type grant = rbac.Grant[string, string]
+
+	ctx := context.Background()
+	m, err := New[string, string, string]("")
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, m.Close()) }()
+
+	m.RegisterCondition("owner", func(_ context.Context, attributes map[string]any) bool {
+		return attributes["owner"] == true
+	})
+
+	require.NoError(t, m.GrantPermission(ctx, "admin", "delete"))
+	require.NoError(t, m.GrantScoped(ctx, grant{Role: "dev", Permission: "write", Resource: "project/42/*"}))
+	require.NoError(t, m.GrantScoped(ctx, grant{
+		Role: "dev", Permission: "delete", Resource: "project/42/*", Conditions: []string{"owner"},
+	}))
+	require.NoError(t, m.GrantScoped(ctx, grant{
+		Role: "dev", Permission: "deploy", Resource: "project/42/*", Conditions: []string{"unregistered"},
+	}))
+	require.NoError(t, m.IncludeRole(ctx, "lead", "dev"))
+	require.NoError(t, m.GrantRole(ctx, "gopher", "lead"))
+	require.NoError(t, m.GrantRole(ctx, "root", "admin"))
+
+	for _, testcase := range []struct {
+		name  string
+		req   rbac.Request[string, string]
+		wants rbac.Decision[string, string]
+	}{
+		{
+			name: "Allowed/PrefixGrant",
+			req:  rbac.Request[string, string]{User: "gopher", Permission: "write", Resource: "project/42/readme"},
+			wants: rbac.Decision[string, string]{
+				Allowed: true,
+				Grant:   &grant{Role: "dev", Permission: "write", Resource: "project/42/*"},
+			},
+		},
+		{
+			name: "Allowed/UnscopedPermission",
+			req:  rbac.Request[string, string]{User: "root", Permission: "delete", Resource: "project/7"},
+			wants: rbac.Decision[string, string]{
+				Allowed: true,
+				Grant:   &grant{Role: "admin", Permission: "delete", Resource: rbac.Wildcard},
+			},
+		},
+		{
+			name: "Allowed/WithCondition",
+			req: rbac.Request[string, string]{
+				User: "gopher", Permission: "delete", Resource: "project/42/readme",
+				Attributes: map[string]any{"owner": true},
+			},
+			wants: rbac.Decision[string, string]{
+				Allowed: true,
+				Grant: &grant{
+					Role: "dev", Permission: "delete", Resource: "project/42/*", Conditions: []string{"owner"},
+				},
+			},
+		},
+		{
+			name: "Denied/FailedCondition",
+			req:  rbac.Request[string, string]{User: "gopher", Permission: "delete", Resource: "project/42/readme"},
+			wants: rbac.Decision[string, string]{
+				Grant: &grant{
+					Role: "dev", Permission: "delete", Resource: "project/42/*", Conditions: []string{"owner"},
+				},
+				Condition: "owner",
+			},
+		},
+		{
+			name: "Denied/UnregisteredCondition",
+			req:  rbac.Request[string, string]{User: "gopher", Permission: "deploy", Resource: "project/42/api"},
+			wants: rbac.Decision[string, string]{
+				Grant: &grant{
+					Role: "dev", Permission: "deploy", Resource: "project/42/*", Conditions: []string{"unregistered"},
+				},
+				Condition: "unregistered",
+			},
+		},
+		{
+			name: "Denied/OtherResource",
+			req:  rbac.Request[string, string]{User: "gopher", Permission: "write", Resource: "project/43/readme"},
+		},
+		{
+			name: "Denied/UnknownUser",
+			req:  rbac.Request[string, string]{User: "unknown", Permission: "write", Resource: "project/42/readme"},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			decision, err := m.Explain(ctx, testcase.req)
+			require.NoError(t, err)
+			require.Equal(t, testcase.wants, decision)
+			require.Equal(t, testcase.wants.Allowed, m.CanAccess(ctx, testcase.req))
+		})
+	}
+
+	t.Run("RevokeScoped", func(t *testing.T) {
+		req := rbac.Request[string, string]{User: "gopher", Permission: "write", Resource: "project/42/readme"}
+
+		require.NoError(t, m.RevokeScoped(ctx, grant{Role: "dev", Permission: "write", Resource: "project/42/*"}))
+		require.False(t, m.CanAccess(ctx, req))
+	})
+}
+
+func TestSQLite_Explain_MatchesMemory(t *testing.T) {
+	type grant = rbac.Grant[string, string]
+
+	ctx := context.Background()
+
+	db, err := New[string, string, string]("")
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, db.Close()) }()
+
+	backends := map[string]rbac.ScopedAccessSystem[string, string, string]{
+		"Memory": memory.NewMemory[string, string, string](),
+		"SQLite": db,
+	}
+
+	for _, backend := range backends {
+		backend.RegisterCondition("owner", func(_ context.Context, attributes map[string]any) bool {
+			return attributes["owner"] == true
+		})
+		backend.RegisterCondition("member", func(_ context.Context, attributes map[string]any) bool {
+			return attributes["member"] == true
+		})
+
+		// roles at the same depth are granted in the reverse order of their names
+		require.NoError(t, backend.GrantScoped(ctx, grant{
+			Role: "zeta", Permission: "write", Resource: "project/*", Conditions: []string{"member"},
+		}))
+		require.NoError(t, backend.GrantScoped(ctx, grant{
+			Role: "alpha", Permission: "write", Resource: "project/42/*", Conditions: []string{"owner"},
+		}))
+		require.NoError(t, backend.GrantPermission(ctx, "base", "deploy"))
+		require.NoError(t, backend.GrantScoped(ctx, grant{
+			Role: "alpha", Permission: "deploy", Resource: "project/*", Conditions: []string{"owner"},
+		}))
+		require.NoError(t, backend.GrantPermission(ctx, "zeta", "read"))
+		require.NoError(t, backend.GrantScoped(ctx, grant{Role: "alpha", Permission: "read", Resource: "project/*"}))
+		require.NoError(t, backend.IncludeRole(ctx, "alpha", "base"))
+		require.NoError(t, backend.GrantRole(ctx, "gopher", "alpha"))
+		require.NoError(t, backend.GrantRole(ctx, "gopher", "zeta"))
+	}
+
+	requests := []rbac.Request[string, string]{
+		{User: "gopher", Permission: "write", Resource: "project/42/readme"},
+		{User: "gopher", Permission: "write", Resource: "project/42/readme", Attributes: map[string]any{"owner": true}},
+		{User: "gopher", Permission: "write", Resource: "project/42/readme", Attributes: map[string]any{"member": true}},
+		{User: "gopher", Permission: "read", Resource: "project/42/readme"},
+		{User: "gopher", Permission: "deploy", Resource: "project/42/readme"},
+	}
+
+	explain := func(t *testing.T) {
+		for _, req := range requests {
+			want, err := backends["Memory"].Explain(ctx, req)
+			require.NoError(t, err)
+
+			decision, err := backends["SQLite"].Explain(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, want, decision)
+		}
+	}
+
+	t.Run("GrantOrder", func(t *testing.T) {
+		explain(t)
+
+		// the first grant is zeta's, as it was granted first
+		decision, err := db.Explain(ctx, requests[0])
+		require.NoError(t, err)
+		require.Equal(t, "zeta", decision.Grant.Role)
+		require.Equal(t, "member", decision.Condition)
+
+		// unscoped permissions precede scoped grants
+		decision, err = db.Explain(ctx, requests[3])
+		require.NoError(t, err)
+		require.Equal(t, &grant{Role: "zeta", Permission: "read", Resource: rbac.Wildcard}, decision.Grant)
+	})
+
+	t.Run("ReplacedGrant", func(t *testing.T) {
+		for _, backend := range backends {
+			require.NoError(t, backend.GrantScoped(ctx, grant{
+				Role: "zeta", Permission: "write", Resource: "project/*", Conditions: []string{"member"},
+			}))
+		}
+
+		explain(t)
+
+		// replacing zeta's grant moves it after alpha's
+		decision, err := db.Explain(ctx, requests[0])
+		require.NoError(t, err)
+		require.Equal(t, "alpha", decision.Grant.Role)
+		require.Equal(t, "owner", decision.Condition)
+	})
+}
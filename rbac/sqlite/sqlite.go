@@ -0,0 +1,274 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zalgonoise/x/rbac"
+)
+
+// maxDepth caps the role hierarchy walked when listing roles, as a safeguard against cycles written to the database
+// outside of IncludeRole.
+const maxDepth = 64
+
+const (
+	grantPermission  = `INSERT OR IGNORE INTO role_permissions (role, permission) VALUES (?, ?);`
+	revokePermission = `DELETE FROM role_permissions WHERE role = ? AND permission = ?;`
+	grantRole        = `INSERT OR IGNORE INTO user_roles (user, role) VALUES (?, ?);`
+	revokeRole       = `DELETE FROM user_roles WHERE user = ? AND role = ?;`
+	includeRole      = `INSERT OR IGNORE INTO role_includes (role, included) VALUES (?, ?);`
+	excludeRole      = `DELETE FROM role_includes WHERE role = ? AND included = ?;`
+
+	isReachable = `
+WITH RECURSIVE reachable(role) AS (
+	SELECT ?
+	UNION
+	SELECT ri.included FROM role_includes ri JOIN reachable r ON ri.role = r.role
+)
+SELECT EXISTS (SELECT 1 FROM reachable WHERE role = ?);`
+
+	can = `
+WITH RECURSIVE roles(role) AS (
+	SELECT role FROM user_roles WHERE user = ?
+	UNION
+	SELECT ri.included FROM role_includes ri JOIN roles r ON ri.role = r.role
+)
+SELECT EXISTS (
+	SELECT 1 FROM role_permissions rp JOIN roles r ON rp.role = r.role WHERE rp.permission = ?
+);`
+
+	listRoles = `
+WITH RECURSIVE roles(role, depth) AS (
+	SELECT role, 0 FROM user_roles WHERE user = ?
+	UNION
+	SELECT ri.included, r.depth + 1 FROM role_includes ri JOIN roles r ON ri.role = r.role WHERE r.depth < ?
+)
+SELECT role FROM roles GROUP BY role ORDER BY MIN(depth), role;`
+
+	listUsers = `
+WITH RECURSIVE granting(role) AS (
+	SELECT role FROM role_permissions WHERE permission = ?
+	UNION
+	SELECT ri.role FROM role_includes ri JOIN granting g ON ri.included = g.role
+)
+SELECT DISTINCT ur.user FROM user_roles ur JOIN granting g ON ur.role = g.role ORDER BY ur.user;`
+)
+
+// SQLite is an rbac.AccessSystem backed by a SQLite database, where users, roles and permissions are stored encoded
+// with their respective Codec.
+type SQLite[User comparable, Role comparable, Permission comparable] struct {
+	db *sql.DB
+
+	users       Codec[User]
+	roles       Codec[Role]
+	permissions Codec[Permission]
+
+	conditions *rbac.Conditions
+}
+
+// New opens (or creates) the SQLite database in the input URI, storing users, roles and permissions as JSON. An
+// empty URI uses an in-memory database.
+func New[User comparable, Role comparable, Permission comparable](uri string) (*SQLite[User, Role, Permission], error) {
+	return NewWithCodecs(uri, JSON[User]{}, JSON[Role]{}, JSON[Permission]{})
+}
+
+// NewWithCodecs opens (or creates) the SQLite database in the input URI, storing users, roles and permissions with
+// the input Codec implementations. An empty URI uses an in-memory database.
+func NewWithCodecs[User comparable, Role comparable, Permission comparable](
+	uri string, users Codec[User], roles Codec[Role], permissions Codec[Permission],
+) (*SQLite[User, Role, Permission], error) {
+	db, err := open(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = initDatabase(context.Background(), db); err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+
+	return &SQLite[User, Role, Permission]{
+		db:          db,
+		users:       users,
+		roles:       roles,
+		permissions: permissions,
+		conditions:  &rbac.Conditions{},
+	}, nil
+}
+
+func (s *SQLite[User, Role, Permission]) GrantPermission(ctx context.Context, role Role, permission Permission) error {
+	return s.execRolePermission(ctx, grantPermission, role, permission)
+}
+
+func (s *SQLite[User, Role, Permission]) RevokePermission(ctx context.Context, role Role, permission Permission) error {
+	return s.execRolePermission(ctx, revokePermission, role, permission)
+}
+
+func (s *SQLite[User, Role, Permission]) GrantRole(ctx context.Context, user User, role Role) error {
+	return s.execUserRole(ctx, grantRole, user, role)
+}
+
+func (s *SQLite[User, Role, Permission]) RevokeRole(ctx context.Context, user User, role Role) error {
+	return s.execUserRole(ctx, revokeRole, user, role)
+}
+
+func (s *SQLite[User, Role, Permission]) IncludeRole(ctx context.Context, role Role, included Role) error {
+	r, err := s.roles.Encode(role)
+	if err != nil {
+		return err
+	}
+
+	i, err := s.roles.Encode(included)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// role would include itself if it is already reachable from the included role
+	var cycle bool
+	if err = tx.QueryRowContext(ctx, isReachable, i, r).Scan(&cycle); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if cycle {
+		return errors.Join(rbac.ErrRoleCycle, tx.Rollback())
+	}
+
+	if _, err = tx.ExecContext(ctx, includeRole, r, i); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQLite[User, Role, Permission]) ExcludeRole(ctx context.Context, role Role, included Role) error {
+	r, err := s.roles.Encode(role)
+	if err != nil {
+		return err
+	}
+
+	i, err := s.roles.Encode(included)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, excludeRole, r, i)
+
+	return err
+}
+
+// Can returns true if any of the user's roles, granted or inherited, holds the permission. Errors when querying the
+// database deny access.
+func (s *SQLite[User, Role, Permission]) Can(ctx context.Context, user User, permission Permission) bool {
+	u, err := s.users.Encode(user)
+	if err != nil {
+		return false
+	}
+
+	p, err := s.permissions.Encode(permission)
+	if err != nil {
+		return false
+	}
+
+	var allowed bool
+	if err = s.db.QueryRowContext(ctx, can, u, p).Scan(&allowed); err != nil {
+		return false
+	}
+
+	return allowed
+}
+
+// Roles lists the user's granted roles followed by the roles they inherit, ordered by their distance in the
+// role hierarchy.
+func (s *SQLite[User, Role, Permission]) Roles(ctx context.Context, user User) ([]Role, error) {
+	u, err := s.users.Encode(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return query(ctx, s.db, s.roles, listRoles, u, maxDepth)
+}
+
+func (s *SQLite[User, Role, Permission]) Users(ctx context.Context, permission Permission) ([]User, error) {
+	p, err := s.permissions.Encode(permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return query(ctx, s.db, s.users, listUsers, p)
+}
+
+// Close closes the underlying database.
+func (s *SQLite[User, Role, Permission]) Close() error {
+	return s.db.Close()
+}
+
+func (s *SQLite[User, Role, Permission]) execRolePermission(
+	ctx context.Context, query string, role Role, permission Permission,
+) error {
+	r, err := s.roles.Encode(role)
+	if err != nil {
+		return err
+	}
+
+	p, err := s.permissions.Encode(permission)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, query, r, p)
+
+	return err
+}
+
+func (s *SQLite[User, Role, Permission]) execUserRole(ctx context.Context, query string, user User, role Role) error {
+	u, err := s.users.Encode(user)
+	if err != nil {
+		return err
+	}
+
+	r, err := s.roles.Encode(role)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, query, u, r)
+
+	return err
+}
+
+func query[T any](ctx context.Context, db *sql.DB, codec Codec[T], query string, args ...any) ([]T, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	values := make([]T, 0, 16)
+
+	for rows.Next() {
+		var data string
+
+		if err = rows.Scan(&data); err != nil {
+			return nil, err
+		}
+
+		value, err := codec.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
@@ -0,0 +1,124 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/rbac"
+)
+
+func TestSQLite(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := New[string, string, string](filepath.Join(t.TempDir(), "rbac.db"))
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, s.Close()) }()
+
+	require.NoError(t, s.GrantPermission(ctx, "users.audit", "read"))
+	require.NoError(t, s.GrantPermission(ctx, "users.admin", "delete"))
+	require.NoError(t, s.GrantPermission(ctx, "users.admin", "delete"))
+	require.NoError(t, s.IncludeRole(ctx, "root", "users.admin"))
+	require.NoError(t, s.IncludeRole(ctx, "users.admin", "users.audit"))
+	require.NoError(t, s.GrantRole(ctx, "gopher", "root"))
+	require.NoError(t, s.GrantRole(ctx, "auditor", "users.audit"))
+
+	t.Run("Can", func(t *testing.T) {
+		require.True(t, s.Can(ctx, "gopher", "read"))
+		require.True(t, s.Can(ctx, "gopher", "delete"))
+		require.True(t, s.Can(ctx, "auditor", "read"))
+		require.False(t, s.Can(ctx, "auditor", "delete"))
+		require.False(t, s.Can(ctx, "unknown", "read"))
+	})
+
+	t.Run("Roles", func(t *testing.T) {
+		roles, err := s.Roles(ctx, "gopher")
+		require.NoError(t, err)
+		require.Equal(t, []string{"root", "users.admin", "users.audit"}, roles)
+
+		roles, err = s.Roles(ctx, "unknown")
+		require.NoError(t, err)
+		require.Empty(t, roles)
+	})
+
+	t.Run("Users", func(t *testing.T) {
+		users, err := s.Users(ctx, "read")
+		require.NoError(t, err)
+		require.Equal(t, []string{"auditor", "gopher"}, users)
+
+		users, err = s.Users(ctx, "delete")
+		require.NoError(t, err)
+		require.Equal(t, []string{"gopher"}, users)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		require.ErrorIs(t, s.IncludeRole(ctx, "users.audit", "root"), rbac.ErrRoleCycle)
+		require.ErrorIs(t, s.IncludeRole(ctx, "root", "root"), rbac.ErrRoleCycle)
+		require.False(t, s.Can(ctx, "auditor", "delete"))
+	})
+
+	t.Run("Revoke", func(t *testing.T) {
+		require.NoError(t, s.ExcludeRole(ctx, "users.admin", "users.audit"))
+		require.False(t, s.Can(ctx, "gopher", "read"))
+
+		require.NoError(t, s.RevokePermission(ctx, "users.admin", "delete"))
+		require.False(t, s.Can(ctx, "gopher", "delete"))
+
+		require.NoError(t, s.RevokeRole(ctx, "auditor", "users.audit"))
+		require.False(t, s.Can(ctx, "auditor", "read"))
+	})
+}
+
+func TestSQLite_Codec(t *testing.T) {
+	type user struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	ctx := context.Background()
+
+	s, err := New[user, string, string]("")
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, s.Close()) }()
+
+	gopher := user{ID: 1, Name: "gopher"}
+
+	require.NoError(t, s.GrantPermission(ctx, "admin", "write"))
+	require.NoError(t, s.GrantRole(ctx, gopher, "admin"))
+
+	require.True(t, s.Can(ctx, gopher, "write"))
+	require.False(t, s.Can(ctx, user{ID: 2, Name: "gopher"}, "write"))
+
+	users, err := s.Users(ctx, "write")
+	require.NoError(t, err)
+	require.Equal(t, []user{gopher}, users)
+}
+
+func TestSQLite_InMemory(t *testing.T) {
+	ctx := context.Background()
+
+	first, err := New[string, string, string]("")
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, first.Close()) }()
+
+	second, err := New[string, string, string](":memory:")
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, second.Close()) }()
+
+	require.NoError(t, first.GrantPermission(ctx, "admin", "write"))
+	require.NoError(t, first.GrantRole(ctx, "gopher", "admin"))
+
+	// in-memory stores do not share their data
+	require.True(t, first.Can(ctx, "gopher", "write"))
+	require.False(t, second.Can(ctx, "gopher", "write"))
+
+	users, err := second.Users(ctx, "write")
+	require.NoError(t, err)
+	require.Empty(t, users)
+}
@@ -8,12 +8,14 @@ import (
 	"io"
 	"log/slog"
 	"strings"
+	"time"
 
 	discordwh "github.com/zalgonoise/x/discord/webhook"
 	slackwh "github.com/zalgonoise/x/slack/webhook"
 
 	"github.com/zalgonoise/x/steam"
 	"github.com/zalgonoise/x/steam/cmd/steam/query"
+	"github.com/zalgonoise/x/steam/history"
 	"github.com/zalgonoise/x/steam/pb/proto/steam/store/v1"
 )
 
@@ -37,6 +39,9 @@ func Exec(ctx context.Context, logger *slog.Logger, args []string) (error, int)
 	platform := fs.String("platform", "logger", "target platform where to post (logger; slack; discord)")
 	url := fs.String("url", "", "webhook target URL (platform: slack; discord)")
 	targetDiscount := fs.Int("target_discount", 50, "target discount percent")
+	mode := fs.String("mode", ModeDiscount, "alert mode (discount; historical_low; below_average)")
+	days := fs.Int("days", 30, "number of days to average prices over (mode: below_average)")
+	db := fs.String("db", "", "path to the price history database, to record prices (required by modes: historical_low; below_average)")
 
 	if err := fs.Parse(args); err != nil {
 		return err, 1
@@ -46,10 +51,6 @@ func Exec(ctx context.Context, logger *slog.Logger, args []string) (error, int)
 		return errEmptyID, 1
 	}
 
-	if *targetDiscount == 0 {
-		return errEmptyTargetPrice, 1
-	}
-
 	if *platform == "" {
 		*platform = "logger"
 	}
@@ -58,58 +59,96 @@ func Exec(ctx context.Context, logger *slog.Logger, args []string) (error, int)
 		return errEmptyURL, 1
 	}
 
-	if err := QueryPrices(ctx, logger, *ids, *country, *platform, *url, *targetDiscount); err != nil {
+	var prices *history.Store
+
+	if *db != "" {
+		var err error
+
+		if prices, err = history.Open(*db); err != nil {
+			return err, 1
+		}
+
+		defer prices.Close()
+	}
+
+	trigger, err := NewTrigger(*mode, *country, *targetDiscount, *days, prices)
+	if err != nil {
+		return err, 1
+	}
+
+	if err := QueryPrices(ctx, logger, *ids, *country, *platform, *url, trigger, prices); err != nil {
 		return err, 1
 	}
 
 	return nil, 0
 }
 
+// QueryPrices fetches the current prices for the input apps, evaluating them with the input Trigger. The observed
+// prices are recorded in the price history, if one is provided.
 func QueryPrices(
 	ctx context.Context,
 	logger *slog.Logger,
 	ids, country, platform, url string,
-	targetDiscount int,
+	trigger Trigger,
+	prices *history.Store,
 ) error {
+	priceOverview, err := FetchPrices(ctx, ids, country)
+	if err != nil {
+		return err
+	}
+
+	// eval against target, before the current prices are part of the history
+	err = EvaluatePrices(ctx, logger, platform, url, trigger, priceOverview)
+
+	if prices != nil {
+		err = errors.Join(err, prices.Record(ctx, country, priceOverview, time.Now()))
+	}
+
+	return err
+}
+
+// FetchPrices queries the Steam store for the current price overview of the input apps.
+func FetchPrices(ctx context.Context, ids, country string) (map[string]*store.PriceOverview, error) {
 	storeURL := query.NewURL(ids, country, priceFilter)
 
 	res, err := query.NewRequest(ctx, storeURL)
+	if err != nil {
+		return nil, err
+	}
 
 	defer res.Body.Close()
 
 	buf, err := io.ReadAll(res.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	priceOverview, err := steam.GetPriceOverview(buf)
-	if err != nil {
-		return err
-	}
-
-	// eval against target
-	return EvaluatePrices(ctx, logger, platform, url, targetDiscount, priceOverview)
+	return steam.GetPriceOverview(buf)
 }
 
 func EvaluatePrices(
 	ctx context.Context, logger *slog.Logger,
-	platform, url string, targetDiscount int,
+	platform, url string, trigger Trigger,
 	priceOverview map[string]*store.PriceOverview,
 ) error {
 	for appID, data := range priceOverview {
-		if discount := data.DiscountPercent; int(discount) <= targetDiscount {
-			logger.InfoContext(ctx, "discount isn't low enough",
+		message, ok, err := trigger(ctx, appID, data)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			logger.InfoContext(ctx, "price isn't low enough",
 				slog.String("appID", appID),
 				slog.String("final_price", data.GetFinalFormatted()),
 				slog.Int("cur_discount_percent", int(data.GetDiscountPercent())),
-				slog.Int("target_discount_percent", targetDiscount),
 			)
 
 			continue
 		}
 
-		// exec webhook if current price is lower or equal to discount ratio
-		if err := SendMessage(ctx, logger, platform, url, OnSale(appID, data)); err != nil {
+		// exec webhook if current price meets the trigger's condition
+		if err := SendMessage(ctx, logger, platform, url, message); err != nil {
 			return err
 		}
 	}
@@ -190,7 +229,7 @@ func OffSale(appID string, data *store.PriceOverview) string {
 	return fmt.Sprintf(
 		`Shoot! The app %s is no longer on sale... Better luck next time!
 
-	Current discount: %d%
+	Current discount: %d%%
 	Current price: %s
 
 Check it out at: %s/%s`,
@@ -201,3 +240,60 @@ Check it out at: %s/%s`,
 		appID,
 	)
 }
+
+func HistoricalLow(appID string, data *store.PriceOverview, lowest history.Entry) string {
+	return fmt.Sprintf(
+		`Woah! The app %s is at its lowest price ever!!
+
+	Previous lowest price: %s
+	Current price: %s (%d percent discount)
+
+Check it out at: %s/%s`,
+		appID,
+		FormatPrice(lowest.Final, lowest.Currency),
+		data.GetFinalFormatted(),
+		data.GetDiscountPercent(),
+		productBaseURL,
+		appID,
+	)
+}
+
+func BelowAverage(appID string, data *store.PriceOverview, avg float64, days int) string {
+	return fmt.Sprintf(
+		`Nice! The app %s is below its average price of the last %d days!!
+
+	Average price: %s
+	Current price: %s (%d percent discount)
+
+Check it out at: %s/%s`,
+		appID,
+		days,
+		FormatPrice(int32(avg), data.GetCurrency()),
+		data.GetFinalFormatted(),
+		data.GetDiscountPercent(),
+		productBaseURL,
+		appID,
+	)
+}
+
+func BelowTarget(appID string, data *store.PriceOverview, targetPrice int32) string {
+	return fmt.Sprintf(
+		`Yes! The app %s reached your target price!!
+
+	Target price: %s
+	Current price: %s (%d percent discount)
+
+Check it out at: %s/%s`,
+		appID,
+		FormatPrice(targetPrice, data.GetCurrency()),
+		data.GetFinalFormatted(),
+		data.GetDiscountPercent(),
+		productBaseURL,
+		appID,
+	)
+}
+
+// FormatPrice formats a price in the smallest unit of its currency, such as the ones in a store.PriceOverview.
+func FormatPrice(value int32, currency string) string {
+	return fmt.Sprintf("%d.%02d %s", value/100, value%100, currency)
+}
@@ -0,0 +1,122 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zalgonoise/x/steam/history"
+	"github.com/zalgonoise/x/steam/pb/proto/steam/store/v1"
+)
+
+const (
+	ModeDiscount      = "discount"
+	ModeHistoricalLow = "historical_low"
+	ModeBelowAverage  = "below_average"
+
+	day = 24 * time.Hour
+)
+
+var (
+	errInvalidMode = errors.New("invalid alert mode")
+	errNoHistory   = errors.New("price history is required for this alert mode")
+	errInvalidDays = errors.New("number of days must be positive")
+)
+
+// Trigger evaluates the current price of an app, returning the message to send if it should raise an alert.
+type Trigger func(ctx context.Context, appID string, data *store.PriceOverview) (message string, ok bool, err error)
+
+// NewTrigger creates a Trigger for the input mode:
+//   - discount: alerts when the current discount is greater than targetDiscount.
+//   - historical_low: alerts when the current price is lower than any price observed before, in prices.
+//   - below_average: alerts when the current price is below the average of the past number of days, in prices.
+func NewTrigger(mode, country string, targetDiscount, days int, prices *history.Store) (Trigger, error) {
+	switch mode {
+	case ModeDiscount, "":
+		if targetDiscount == 0 {
+			return nil, errEmptyTargetPrice
+		}
+
+		return func(_ context.Context, appID string, data *store.PriceOverview) (string, bool, error) {
+			if int(data.GetDiscountPercent()) <= targetDiscount {
+				return "", false, nil
+			}
+
+			return OnSale(appID, data), true, nil
+		}, nil
+
+	case ModeHistoricalLow:
+		if prices == nil {
+			return nil, errNoHistory
+		}
+
+		return func(ctx context.Context, appID string, data *store.PriceOverview) (string, bool, error) {
+			// free or unreleased apps have no price overview
+			if data == nil {
+				return "", false, nil
+			}
+
+			lowest, err := prices.Lowest(ctx, appID, country)
+			if err != nil {
+				if errors.Is(err, history.ErrNoHistory) {
+					return "", false, nil
+				}
+
+				return "", false, err
+			}
+
+			// an app staying at its lowest price is not a new low
+			if data.GetFinal() >= lowest.Final {
+				return "", false, nil
+			}
+
+			return HistoricalLow(appID, data, lowest), true, nil
+		}, nil
+
+	case ModeBelowAverage:
+		if prices == nil {
+			return nil, errNoHistory
+		}
+
+		if days <= 0 {
+			return nil, errInvalidDays
+		}
+
+		return func(ctx context.Context, appID string, data *store.PriceOverview) (string, bool, error) {
+			if data == nil {
+				return "", false, nil
+			}
+
+			avg, _, err := prices.Average(ctx, appID, country, time.Now().Add(-time.Duration(days)*day))
+			if err != nil {
+				if errors.Is(err, history.ErrNoHistory) {
+					return "", false, nil
+				}
+
+				return "", false, err
+			}
+
+			if float64(data.GetFinal()) >= avg {
+				return "", false, nil
+			}
+
+			return BelowAverage(appID, data, avg, days), true, nil
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s", errInvalidMode, mode)
+	}
+}
+
+// NewPriceTrigger creates a Trigger that alerts when the current price is at or below targetPrice, in the smallest
+// unit of the currency.
+func NewPriceTrigger(targetPrice int32) Trigger {
+	return func(_ context.Context, appID string, data *store.PriceOverview) (string, bool, error) {
+		if data == nil || data.GetFinal() > targetPrice {
+			return "", false, nil
+		}
+
+		return BelowTarget(appID, data, targetPrice), true, nil
+	}
+}
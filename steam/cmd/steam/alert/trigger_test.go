@@ -0,0 +1,179 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/steam/history"
+	"github.com/zalgonoise/x/steam/pb/proto/steam/store/v1"
+)
+
+func TestNewTrigger(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	prices, err := history.Open("")
+	require.NoError(t, err)
+
+	defer prices.Close()
+
+	for i, final := range []int32{1999, 999, 1499} {
+		require.NoError(t, prices.Record(ctx, "PT", map[string]*store.PriceOverview{
+			"400": {Currency: "EUR", Initial: 1999, Final: final},
+		}, now.Add(-time.Duration(3-i)*day)))
+	}
+
+	for _, testcase := range []struct {
+		name           string
+		mode           string
+		targetDiscount int
+		days           int
+		appID          string
+		data           *store.PriceOverview
+		wants          bool
+	}{
+		{
+			name:           "Discount/AboveTarget",
+			mode:           ModeDiscount,
+			targetDiscount: 40,
+			appID:          "400",
+			data:           &store.PriceOverview{Final: 999, DiscountPercent: 50},
+			wants:          true,
+		},
+		{
+			name:           "Discount/AtTarget",
+			mode:           ModeDiscount,
+			targetDiscount: 50,
+			appID:          "400",
+			data:           &store.PriceOverview{Final: 999, DiscountPercent: 50},
+		},
+		{
+			name:  "HistoricalLow/Lower",
+			mode:  ModeHistoricalLow,
+			appID: "400",
+			data:  &store.PriceOverview{Final: 799},
+			wants: true,
+		},
+		{
+			name:  "HistoricalLow/EqualPrice",
+			mode:  ModeHistoricalLow,
+			appID: "400",
+			data:  &store.PriceOverview{Final: 999},
+		},
+		{
+			name:  "HistoricalLow/Higher",
+			mode:  ModeHistoricalLow,
+			appID: "400",
+			data:  &store.PriceOverview{Final: 1499},
+		},
+		{
+			name:  "HistoricalLow/NoHistory",
+			mode:  ModeHistoricalLow,
+			appID: "570",
+			data:  &store.PriceOverview{Final: 0},
+		},
+		{
+			name:  "HistoricalLow/NoPriceOverview",
+			mode:  ModeHistoricalLow,
+			appID: "400",
+		},
+		{
+			name:  "BelowAverage/Below",
+			mode:  ModeBelowAverage,
+			days:  7,
+			appID: "400",
+			data:  &store.PriceOverview{Final: 1299},
+			wants: true,
+		},
+		{
+			name:  "BelowAverage/Average",
+			mode:  ModeBelowAverage,
+			days:  7,
+			appID: "400",
+			data:  &store.PriceOverview{Final: 1499},
+		},
+		{
+			name:  "BelowAverage/NoHistory",
+			mode:  ModeBelowAverage,
+			days:  7,
+			appID: "570",
+			data:  &store.PriceOverview{Final: 0},
+		},
+		{
+			name:  "BelowAverage/NoPriceOverview",
+			mode:  ModeBelowAverage,
+			days:  7,
+			appID: "400",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			trigger, err := NewTrigger(testcase.mode, "pt", testcase.targetDiscount, testcase.days, prices)
+			require.NoError(t, err)
+
+			message, ok, err := trigger(ctx, testcase.appID, testcase.data)
+			require.NoError(t, err)
+			require.Equal(t, testcase.wants, ok)
+
+			if !testcase.wants {
+				require.Empty(t, message)
+
+				return
+			}
+
+			require.Contains(t, message, testcase.appID)
+		})
+	}
+}
+
+func TestNewTrigger_Errors(t *testing.T) {
+	prices, err := history.Open("")
+	require.NoError(t, err)
+
+	defer prices.Close()
+
+	for _, testcase := range []struct {
+		name           string
+		mode           string
+		targetDiscount int
+		days           int
+		prices         *history.Store
+		err            error
+	}{
+		{
+			name: "Discount/NoTarget",
+			mode: ModeDiscount,
+			err:  errEmptyTargetPrice,
+		},
+		{
+			name: "HistoricalLow/NoHistory",
+			mode: ModeHistoricalLow,
+			err:  errNoHistory,
+		},
+		{
+			name: "BelowAverage/NoHistory",
+			mode: ModeBelowAverage,
+			days: 7,
+			err:  errNoHistory,
+		},
+		{
+			name:   "BelowAverage/NoDays",
+			mode:   ModeBelowAverage,
+			prices: prices,
+			err:    errInvalidDays,
+		},
+		{
+			name:   "InvalidMode",
+			mode:   "lowest",
+			prices: prices,
+			err:    errInvalidMode,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			_, err := NewTrigger(testcase.mode, "pt", testcase.targetDiscount, testcase.days, testcase.prices)
+			require.ErrorIs(t, err, testcase.err)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/zalgonoise/x/steam/cmd/steam/alert"
+	"github.com/zalgonoise/x/steam/cmd/steam/print"
+	"github.com/zalgonoise/x/steam/history"
+)
+
+const day = 24 * time.Hour
+
+var (
+	errEmptyID       = errors.New("empty app ID")
+	errEmptyDB       = errors.New("empty price history database path")
+	errInvalidFormat = errors.New("invalid output format")
+)
+
+func Exec(ctx context.Context, logger *slog.Logger, args []string) (error, int) {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+
+	ids := fs.String("ids", "", "comma-separated list of app ID values")
+	country := fs.String("country", "", "country code (2-character-long)")
+	days := fs.Int("days", 30, "number of days of price history to list (0: all)")
+	format := fs.String("format", "log", "output format (log; csv; json)")
+	db := fs.String("db", "", "path to the price history database")
+
+	if err := fs.Parse(args); err != nil {
+		return err, 1
+	}
+
+	if *ids == "" {
+		return errEmptyID, 1
+	}
+
+	if *db == "" {
+		return errEmptyDB, 1
+	}
+
+	printer, ok := print.ValidHistoryPrinters[*format]
+	if !ok {
+		return fmt.Errorf("%w: %s", errInvalidFormat, *format), 1
+	}
+
+	prices, err := history.Open(*db)
+	if err != nil {
+		return err, 1
+	}
+
+	defer prices.Close()
+
+	var since time.Time
+	if *days > 0 {
+		since = time.Now().Add(-time.Duration(*days) * day)
+	}
+
+	appIDs := strings.Split(*ids, ",")
+	entries := make([]history.Entry, 0, len(appIDs)*(*days+1))
+
+	for _, appID := range appIDs {
+		appEntries, err := prices.List(ctx, appID, *country, since)
+		if err != nil {
+			return err, 1
+		}
+
+		entries = append(entries, appEntries...)
+
+		if err = describe(ctx, logger, prices, appID, *country, since, *days); err != nil {
+			return err, 1
+		}
+	}
+
+	if err = printer(ctx, logger, os.Stdout, entries); err != nil {
+		return err, 1
+	}
+
+	return nil, 0
+}
+
+// describe logs the lowest and average prices for an app, if it has any price history.
+func describe(
+	ctx context.Context, logger *slog.Logger, prices *history.Store,
+	appID, country string, since time.Time, days int,
+) error {
+	lowest, err := prices.Lowest(ctx, appID, country)
+	if err != nil {
+		if errors.Is(err, history.ErrNoHistory) {
+			logger.WarnContext(ctx, "no price history for app", slog.String("app_id", appID))
+
+			return nil
+		}
+
+		return err
+	}
+
+	avg, count, err := prices.Average(ctx, appID, country, since)
+	if err != nil && !errors.Is(err, history.ErrNoHistory) {
+		return err
+	}
+
+	attrs := []any{
+		slog.String("app_id", appID),
+		slog.String("lowest_price", alert.FormatPrice(lowest.Final, lowest.Currency)),
+		slog.Time("lowest_observed_at", lowest.ObservedAt),
+		slog.Int("num_observations", count),
+	}
+
+	if count > 0 {
+		attrs = append(attrs,
+			slog.String("average_price", alert.FormatPrice(int32(avg), lowest.Currency)),
+			slog.Int("average_days", days),
+		)
+	}
+
+	logger.InfoContext(ctx, "describing price history", attrs...)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/zalgonoise/x/steam/cmd/steam/alert"
+	"github.com/zalgonoise/x/steam/cmd/steam/history"
 	"github.com/zalgonoise/x/steam/cmd/steam/monitor"
 	"github.com/zalgonoise/x/steam/cmd/steam/search"
 	"github.com/zalgonoise/x/steam/cmd/steam/store"
@@ -17,10 +18,11 @@ const (
 	alertOp   = "alert"
 	monitorOp = "monitor"
 	searchOp  = "search"
+	historyOp = "history"
 )
 
 var allOperations = []string{
-	storeOp, alertOp, monitorOp, searchOp,
+	storeOp, alertOp, monitorOp, searchOp, historyOp,
 }
 
 var (
@@ -64,6 +66,8 @@ func run(logger *slog.Logger) (error, int) {
 		return monitor.Exec(ctx, logger, os.Args[2:])
 	case searchOp:
 		return search.Exec(ctx, logger, os.Args[2:])
+	case historyOp:
+		return history.Exec(ctx, logger, os.Args[2:])
 	default:
 		return printHelp(ctx, logger, errInvalidOp)
 	}
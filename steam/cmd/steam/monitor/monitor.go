@@ -11,6 +11,8 @@ import (
 	"syscall"
 
 	"github.com/zalgonoise/x/steam/cmd/steam/alert"
+	"github.com/zalgonoise/x/steam/history"
+	"github.com/zalgonoise/x/steam/watchlist"
 
 	"github.com/zalgonoise/micron"
 	"github.com/zalgonoise/micron/executor"
@@ -21,9 +23,8 @@ import (
 const defaultSchedule = "0 10 * * *"
 
 var (
-	errEmptyID          = errors.New("empty app ID")
-	errEmptyURL         = errors.New("empty webhook URL")
-	errEmptyTargetPrice = errors.New("empty target price")
+	errEmptyID  = errors.New("empty app ID")
+	errEmptyURL = errors.New("empty webhook URL")
 )
 
 func Exec(ctx context.Context, logger *slog.Logger, args []string) (error, int) {
@@ -36,28 +37,25 @@ func Exec(ctx context.Context, logger *slog.Logger, args []string) (error, int)
 	targetDiscount := fs.Int("target_discount", 50, "target discount percent")
 	cronSchedule := fs.String("schedule", defaultSchedule, fmt.Sprintf("schedule frequency to query for discounts, as a cron schedule string (default: %s)", defaultSchedule))
 	interval := fs.Int("interval", 0, "suspend alerts for on-sale products for # days, before alerting again")
+	mode := fs.String("mode", alert.ModeDiscount, "alert mode (discount; historical_low; below_average)")
+	days := fs.Int("days", 30, "number of days to average prices over (mode: below_average)")
+	db := fs.String("db", "", "path to the price history database, to record prices (required by modes: historical_low; below_average)")
+	watchFile := fs.String("watch", "", "path to a YAML or JSON watch file listing apps and their targets, reloaded on each run (replaces -ids; other flags are used as defaults)")
+	appsDB := fs.String("apps_db", "", "path to read and write app list data, in a SQLite DB file (to look up apps by name in the watch file)")
 
 	if err := fs.Parse(args); err != nil {
 		return err, 1
 	}
 
-	if err := fs.Parse(args); err != nil {
-		return err, 1
-	}
-
-	if *ids == "" {
+	if *ids == "" && *watchFile == "" {
 		return errEmptyID, 1
 	}
 
-	if *targetDiscount == 0 {
-		return errEmptyTargetPrice, 1
-	}
-
 	if *platform == "" {
 		*platform = "logger"
 	}
 
-	if *url == "" && *platform != "logger" {
+	if *url == "" && *platform != "logger" && *watchFile == "" {
 		return errEmptyURL, 1
 	}
 
@@ -65,28 +63,58 @@ func Exec(ctx context.Context, logger *slog.Logger, args []string) (error, int)
 		*cronSchedule = defaultSchedule
 	}
 
-	return runCron(ctx, logger, *cronSchedule, *ids, *country, *platform, *url, *targetDiscount, *interval)
-}
+	var prices *history.Store
+
+	if *db != "" {
+		var err error
+
+		if prices, err = history.Open(*db); err != nil {
+			return err, 1
+		}
+
+		defer prices.Close()
+	}
+
+	if *watchFile != "" {
+		// validate the watch file before scheduling any runs
+		if _, err := watchlist.Load(*watchFile); err != nil {
+			return err, 1
+		}
+
+		return runCron(ctx, logger, *cronSchedule, newWatchRunner(logger, *watchFile, *appsDB, watchlist.App{
+			Country:        *country,
+			Platform:       *platform,
+			URL:            *url,
+			Mode:           *mode,
+			TargetDiscount: *targetDiscount,
+			Days:           *days,
+		}, prices, *interval))
+	}
+
+	trigger, err := alert.NewTrigger(*mode, *country, *targetDiscount, *days, prices)
+	if err != nil {
+		return err, 1
+	}
 
-func runCron(
-	ctx context.Context, logger *slog.Logger,
-	cronSchedule, ids, country, platform, url string,
-	targetDiscount, interval int,
-) (error, int) {
 	var r executor.Runner = executor.Runnable(func(execCtx context.Context) error {
-		return alert.QueryPrices(execCtx, logger, ids, country, platform, url, targetDiscount)
+		return alert.QueryPrices(execCtx, logger, *ids, *country, *platform, *url, trigger, prices)
 	})
 
-	if interval > 0 {
+	if *interval > 0 {
 		r = newRunner(logger, request{
-			ids:            ids,
-			country:        country,
-			platform:       platform,
-			url:            url,
-			targetDiscount: targetDiscount,
-		}, interval)
+			ids:      *ids,
+			country:  *country,
+			platform: *platform,
+			url:      *url,
+			trigger:  trigger,
+			prices:   prices,
+		}, *interval)
 	}
 
+	return runCron(ctx, logger, *cronSchedule, r)
+}
+
+func runCron(ctx context.Context, logger *slog.Logger, cronSchedule string, r executor.Runner) (error, int) {
 	s, err := schedule.New(schedule.WithSchedule(cronSchedule))
 	if err != nil {
 		return err, 1
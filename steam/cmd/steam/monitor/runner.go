@@ -2,21 +2,17 @@ package monitor
 
 import (
 	"context"
-	"io"
+	"errors"
 	"log/slog"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/zalgonoise/x/steam"
 	"github.com/zalgonoise/x/steam/cmd/steam/alert"
-	"github.com/zalgonoise/x/steam/cmd/steam/query"
+	"github.com/zalgonoise/x/steam/history"
 	"github.com/zalgonoise/x/steam/pb/proto/steam/store/v1"
 )
 
-const (
-	priceFilter = "price_overview"
-)
-
 type runner struct {
 	logger *slog.Logger
 	req    request
@@ -28,11 +24,12 @@ type runner struct {
 }
 
 type request struct {
-	ids            string
-	country        string
-	platform       string
-	url            string
-	targetDiscount int
+	ids      string
+	country  string
+	platform string
+	url      string
+	trigger  alert.Trigger
+	prices   *history.Store
 }
 
 func newRunner(logger *slog.Logger, req request, interval int) *runner {
@@ -48,42 +45,33 @@ func newRunner(logger *slog.Logger, req request, interval int) *runner {
 }
 
 func (r *runner) Run(ctx context.Context) error {
-	return r.queryPrices(ctx, r.logger, r.req.ids, r.req.country, r.req.platform, r.req.url, r.req.targetDiscount)
-}
-
-func (r *runner) queryPrices(
-	ctx context.Context,
-	logger *slog.Logger,
-	ids, country, platform, url string,
-	targetDiscount int,
-) error {
-	storeURL := query.NewURL(ids, country, priceFilter)
-
-	res, err := query.NewRequest(ctx, storeURL)
-
-	defer res.Body.Close()
-
-	buf, err := io.ReadAll(res.Body)
+	priceOverview, err := alert.FetchPrices(ctx, r.req.ids, r.req.country)
 	if err != nil {
 		return err
 	}
 
-	priceOverview, err := steam.GetPriceOverview(buf)
-	if err != nil {
-		return err
+	// eval against target, before the current prices are part of the history
+	err = r.evaluatePrices(ctx, r.logger, r.req.platform, r.req.url, r.req.trigger, priceOverview)
+
+	if r.req.prices != nil {
+		err = errors.Join(err, r.req.prices.Record(ctx, r.req.country, priceOverview, time.Now()))
 	}
 
-	// eval against target
-	return r.evaluatePrices(ctx, logger, platform, url, targetDiscount, priceOverview)
+	return err
 }
 
 func (r *runner) evaluatePrices(
 	ctx context.Context, logger *slog.Logger,
-	platform, url string, targetDiscount int,
+	platform, url string, trigger alert.Trigger,
 	priceOverview map[string]*store.PriceOverview,
 ) error {
 	for appID, data := range priceOverview {
-		if discount := data.DiscountPercent; int(discount) <= targetDiscount {
+		message, ok, err := trigger(ctx, appID, data)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
 			r.mu.Lock()
 			onSaleDays, ok := r.onSale[appID]
 			r.onSale[appID] = 0
@@ -95,11 +83,10 @@ func (r *runner) evaluatePrices(
 				}
 			}
 
-			logger.InfoContext(ctx, "discount isn't low enough",
+			logger.InfoContext(ctx, "price isn't low enough",
 				slog.String("appID", appID),
 				slog.String("final_price", data.GetFinalFormatted()),
 				slog.Int("cur_discount_percent", int(data.GetDiscountPercent())),
-				slog.Int("target_discount_percent", targetDiscount),
 			)
 
 			continue
@@ -130,8 +117,8 @@ func (r *runner) evaluatePrices(
 			continue
 		}
 
-		// exec webhook if current price is lower or equal to discount ratio
-		if err := alert.SendMessage(ctx, logger, platform, url, alert.OnSale(appID, data)); err != nil {
+		// exec webhook if current price meets the trigger's condition
+		if err := alert.SendMessage(ctx, logger, platform, url, message); err != nil {
 			return err
 		}
 	}
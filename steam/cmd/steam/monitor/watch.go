@@ -0,0 +1,192 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zalgonoise/fts"
+
+	"github.com/zalgonoise/x/steam/apps"
+	"github.com/zalgonoise/x/steam/cmd/steam/alert"
+	"github.com/zalgonoise/x/steam/history"
+	"github.com/zalgonoise/x/steam/pb/proto/steam/store/v1"
+	"github.com/zalgonoise/x/steam/watchlist"
+)
+
+// watchRunner monitors the apps listed in a watch file, which is loaded again on every run so that it can be edited
+// while the monitor is running.
+type watchRunner struct {
+	logger *slog.Logger
+
+	path     string
+	defaults watchlist.App
+	resolver *lazyIndexer
+	prices   *history.Store
+
+	// suspend is only set if alerts for on-sale products are suspended for an interval
+	suspend *runner
+}
+
+func newWatchRunner(
+	logger *slog.Logger,
+	path, appsURI string,
+	defaults watchlist.App,
+	prices *history.Store,
+	interval int,
+) *watchRunner {
+	w := &watchRunner{
+		logger:   logger,
+		path:     path,
+		defaults: defaults,
+		resolver: &lazyIndexer{uri: appsURI, logger: logger},
+		prices:   prices,
+	}
+
+	if interval > 0 {
+		w.suspend = newRunner(logger, request{}, interval)
+	}
+
+	return w
+}
+
+func (w *watchRunner) Run(ctx context.Context) error {
+	watched, err := w.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	countries, byCountry := groupByCountry(watched)
+
+	errs := make([]error, 0, len(countries))
+
+	for _, country := range countries {
+		errs = append(errs, w.queryPrices(ctx, country, byCountry[country]))
+	}
+
+	return errors.Join(errs...)
+}
+
+// load reads the watch file, returning its apps with the runner's defaults applied and their names resolved.
+func (w *watchRunner) load(ctx context.Context) ([]watchlist.App, error) {
+	list, err := watchlist.Load(w.path)
+	if err != nil {
+		return nil, err
+	}
+
+	list.Defaults = list.Defaults.WithDefaults(w.defaults)
+
+	return list.Resolve(ctx, w.resolver)
+}
+
+// groupByCountry groups the apps per country, as apps are queried in bulk for each country. Countries are returned in
+// the order they are first listed in.
+func groupByCountry(watched []watchlist.App) ([]string, map[string][]watchlist.App) {
+	countries := make([]string, 0, len(watched))
+	byCountry := make(map[string][]watchlist.App, len(watched))
+
+	for i := range watched {
+		if _, ok := byCountry[watched[i].Country]; !ok {
+			countries = append(countries, watched[i].Country)
+		}
+
+		byCountry[watched[i].Country] = append(byCountry[watched[i].Country], watched[i])
+	}
+
+	return countries, byCountry
+}
+
+func (w *watchRunner) queryPrices(ctx context.Context, country string, watched []watchlist.App) error {
+	ids := make([]string, 0, len(watched))
+	for i := range watched {
+		ids = append(ids, watched[i].ID)
+	}
+
+	priceOverview, err := alert.FetchPrices(ctx, strings.Join(ids, ","), country)
+	if err != nil {
+		return err
+	}
+
+	// a failing app does not prevent alerts for the remaining ones
+	errs := make([]error, 0, len(watched)+1)
+
+	for i := range watched {
+		data, ok := priceOverview[watched[i].ID]
+		if !ok {
+			w.logger.WarnContext(ctx, "no price overview for watched app",
+				slog.String("appID", watched[i].ID),
+				slog.String("name", watched[i].Name),
+				slog.String("country", country),
+			)
+
+			continue
+		}
+
+		errs = append(errs, w.evaluatePrice(ctx, watched[i], data))
+	}
+
+	if w.prices != nil {
+		errs = append(errs, w.prices.Record(ctx, country, priceOverview, time.Now()))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (w *watchRunner) evaluatePrice(ctx context.Context, app watchlist.App, data *store.PriceOverview) error {
+	platform := app.Platform
+	if platform == "" {
+		platform = "logger"
+	}
+
+	if app.URL == "" && platform != "logger" {
+		return fmt.Errorf("%w: app %s", errEmptyURL, app.ID)
+	}
+
+	trigger := alert.NewPriceTrigger(app.TargetPrice)
+
+	if app.TargetPrice == 0 {
+		var err error
+
+		if trigger, err = alert.NewTrigger(app.Mode, app.Country, app.TargetDiscount, app.Days, w.prices); err != nil {
+			return fmt.Errorf("app %s: %w", app.ID, err)
+		}
+	}
+
+	priceOverview := map[string]*store.PriceOverview{app.ID: data}
+
+	if w.suspend != nil {
+		return w.suspend.evaluatePrices(ctx, w.logger, platform, app.URL, trigger, priceOverview)
+	}
+
+	return alert.EvaluatePrices(ctx, w.logger, platform, app.URL, trigger, priceOverview)
+}
+
+// lazyIndexer resolves app names with the apps indexer, which is only set up once a name is looked up, as loading
+// the apps list is costly.
+type lazyIndexer struct {
+	uri    string
+	logger *slog.Logger
+
+	mu      sync.Mutex
+	indexer fts.Indexer[int64, string]
+}
+
+func (l *lazyIndexer) Search(ctx context.Context, name string) ([]fts.Attribute[int64, string], error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.indexer == nil {
+		indexer, err := apps.NewIndexer(l.uri, l.logger)
+		if err != nil {
+			return nil, err
+		}
+
+		l.indexer = indexer
+	}
+
+	return l.indexer.Search(ctx, name)
+}
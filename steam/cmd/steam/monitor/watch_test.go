@@ -0,0 +1,186 @@
+package monitor
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/fts"
+
+	"github.com/zalgonoise/x/steam/pb/proto/steam/store/v1"
+	"github.com/zalgonoise/x/steam/watchlist"
+)
+
+const testWatchFile = `
+defaults:
+  country: us
+  mode: discount
+apps:
+  - id: "400"
+    target_discount: 50
+  - name: dota
+    country: pt
+  - name: portal 2
+    target_price: 499
+`
+
+func TestWatchRunner_Load(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	path := filepath.Join(t.TempDir(), "watch.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testWatchFile), 0o600))
+
+	indexer, err := fts.New([]fts.Attribute[int64, string]{
+		{Key: 400, Value: "Portal"},
+		{Key: 620, Value: "Portal 2"},
+		{Key: 570, Value: "Dota 2"},
+	})
+	require.NoError(t, err)
+
+	w := newWatchRunner(logger, path, "", watchlist.App{
+		Country:        "de",
+		Platform:       "discord",
+		URL:            "https://discord.example/webhook",
+		TargetDiscount: 20,
+	}, nil, 0)
+
+	// the indexer is set up beforehand so that the embedded apps list is not loaded
+	w.resolver.indexer = indexer
+
+	watched, err := w.load(context.Background())
+	require.NoError(t, err)
+
+	// the watch file's defaults take precedence over the runner's
+	defaults := watchlist.App{
+		Country:        "us",
+		Platform:       "discord",
+		URL:            "https://discord.example/webhook",
+		Mode:           "discount",
+		TargetDiscount: 20,
+	}
+
+	require.Equal(t, []watchlist.App{
+		{
+			ID:             "400",
+			Country:        defaults.Country,
+			Platform:       defaults.Platform,
+			URL:            defaults.URL,
+			Mode:           defaults.Mode,
+			TargetDiscount: 50,
+		},
+		{
+			ID:             "570",
+			Name:           "dota",
+			Country:        "pt",
+			Platform:       defaults.Platform,
+			URL:            defaults.URL,
+			Mode:           defaults.Mode,
+			TargetDiscount: defaults.TargetDiscount,
+		},
+		{
+			ID:             "620",
+			Name:           "portal 2",
+			Country:        defaults.Country,
+			Platform:       defaults.Platform,
+			URL:            defaults.URL,
+			Mode:           defaults.Mode,
+			TargetDiscount: defaults.TargetDiscount,
+			TargetPrice:    499,
+		},
+	}, watched)
+
+	t.Run("MissingFile", func(t *testing.T) {
+		w := newWatchRunner(logger, filepath.Join(t.TempDir(), "missing.yaml"), "", watchlist.App{}, nil, 0)
+
+		_, err := w.load(context.Background())
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestGroupByCountry(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		watched   []watchlist.App
+		countries []string
+		byCountry map[string][]watchlist.App
+	}{
+		{
+			name:      "Empty",
+			countries: []string{},
+			byCountry: map[string][]watchlist.App{},
+		},
+		{
+			name: "SingleCountry",
+			watched: []watchlist.App{
+				{ID: "400", Country: "pt"},
+				{ID: "570", Country: "pt"},
+			},
+			countries: []string{"pt"},
+			byCountry: map[string][]watchlist.App{
+				"pt": {{ID: "400", Country: "pt"}, {ID: "570", Country: "pt"}},
+			},
+		},
+		{
+			name: "ManyCountries",
+			watched: []watchlist.App{
+				{ID: "400", Country: "us"},
+				{ID: "570", Country: "pt"},
+				{ID: "620", Country: "us"},
+				{ID: "730"},
+			},
+			countries: []string{"us", "pt", ""},
+			byCountry: map[string][]watchlist.App{
+				"us": {{ID: "400", Country: "us"}, {ID: "620", Country: "us"}},
+				"pt": {{ID: "570", Country: "pt"}},
+				"":   {{ID: "730"}},
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			countries, byCountry := groupByCountry(testcase.watched)
+
+			require.Equal(t, testcase.countries, countries)
+			require.Equal(t, testcase.byCountry, byCountry)
+		})
+	}
+}
+
+func TestWatchRunner_EvaluatePrice(t *testing.T) {
+	w := newWatchRunner(slog.New(slog.NewTextHandler(io.Discard, nil)), "", "", watchlist.App{}, nil, 0)
+	data := &store.PriceOverview{Currency: "EUR", Initial: 1999, Final: 999, DiscountPercent: 50}
+
+	for _, testcase := range []struct {
+		name string
+		app  watchlist.App
+		err  error
+	}{
+		{
+			name: "TargetPrice",
+			app:  watchlist.App{ID: "400", TargetPrice: 999},
+		},
+		{
+			name: "Discount",
+			app:  watchlist.App{ID: "400", Mode: "discount", TargetDiscount: 40},
+		},
+		{
+			name: "NoURL",
+			app:  watchlist.App{ID: "400", Platform: "discord", TargetPrice: 999},
+			err:  errEmptyURL,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			err := w.evaluatePrice(context.Background(), testcase.app, data)
+			if testcase.err != nil {
+				require.ErrorIs(t, err, testcase.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
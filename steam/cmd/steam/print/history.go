@@ -0,0 +1,76 @@
+package print
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/zalgonoise/x/steam/history"
+)
+
+var ValidHistoryPrinters = map[string]func(context.Context, *slog.Logger, io.Writer, []history.Entry) error{
+	"log":  HistoryLog,
+	"csv":  HistoryCSV,
+	"json": HistoryJSON,
+}
+
+var historyHeader = []string{
+	"app_id", "country", "currency", "observed_at", "initial", "final", "discount_percent",
+}
+
+// HistoryLog lists the price history entries with the logger.
+func HistoryLog(ctx context.Context, logger *slog.Logger, _ io.Writer, entries []history.Entry) error {
+	for i := range entries {
+		logger.InfoContext(ctx, "listing price history",
+			slog.String("app_id", entries[i].AppID),
+			slog.Group("price",
+				slog.String("currency", entries[i].Currency),
+				slog.Int("initial", int(entries[i].Initial)),
+				slog.Int("final", int(entries[i].Final)),
+				slog.Int("discount_percent", int(entries[i].DiscountPercent)),
+			),
+			slog.Time("observed_at", entries[i].ObservedAt),
+		)
+	}
+
+	return nil
+}
+
+// HistoryCSV writes the price history entries to w as CSV records, with a header row.
+func HistoryCSV(_ context.Context, _ *slog.Logger, w io.Writer, entries []history.Entry) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(historyHeader); err != nil {
+		return err
+	}
+
+	for i := range entries {
+		if err := cw.Write([]string{
+			entries[i].AppID,
+			entries[i].Country,
+			entries[i].Currency,
+			entries[i].ObservedAt.UTC().Format(time.RFC3339),
+			strconv.Itoa(int(entries[i].Initial)),
+			strconv.Itoa(int(entries[i].Final)),
+			strconv.Itoa(int(entries[i].DiscountPercent)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// HistoryJSON writes the price history entries to w as a JSON array.
+func HistoryJSON(_ context.Context, _ *slog.Logger, w io.Writer, entries []history.Entry) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(entries)
+}
@@ -0,0 +1,66 @@
+package print
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/steam/history"
+)
+
+func TestHistoryPrinters(t *testing.T) {
+	entries := []history.Entry{
+		{
+			AppID:           "400",
+			Country:         "pt",
+			Currency:        "EUR",
+			Initial:         1999,
+			Final:           999,
+			DiscountPercent: 50,
+			ObservedAt:      time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			AppID:      "400",
+			Country:    "pt",
+			Currency:   "EUR",
+			Initial:    1999,
+			Final:      1999,
+			ObservedAt: time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("CSV", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		require.NoError(t, HistoryCSV(context.Background(), logger, buf, entries))
+		require.Equal(t, `app_id,country,currency,observed_at,initial,final,discount_percent
+400,pt,EUR,2024-06-01T12:00:00Z,1999,999,50
+400,pt,EUR,2024-06-02T12:00:00Z,1999,1999,0
+`, buf.String())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		require.NoError(t, HistoryJSON(context.Background(), logger, buf, entries))
+
+		decoded := make([]history.Entry, 0, len(entries))
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Equal(t, entries, decoded)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		require.NoError(t, HistoryCSV(context.Background(), logger, buf, nil))
+		require.Equal(t, "app_id,country,currency,observed_at,initial,final,discount_percent\n", buf.String())
+	})
+}
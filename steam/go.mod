@@ -5,6 +5,7 @@ go 1.21.0
 toolchain go1.21.4
 
 require (
+	github.com/stretchr/testify v1.8.4
 	github.com/zalgonoise/fts v1.0.0
 	github.com/zalgonoise/micron v1.0.2
 	github.com/zalgonoise/x/discord v0.0.0-20231028163844-9788f3b3b512
@@ -12,6 +13,8 @@ require (
 	github.com/zalgonoise/x/ptr v0.0.0-20231028163844-9788f3b3b512
 	github.com/zalgonoise/x/slack v0.0.0-20231028163844-9788f3b3b512
 	google.golang.org/protobuf v1.31.0
+	gopkg.in/yaml.v3 v3.0.1
+	modernc.org/sqlite v1.26.0
 )
 
 require (
@@ -162,7 +165,6 @@ require (
 	github.com/ssgreg/nlreturn/v2 v2.2.1 // indirect
 	github.com/stbenjam/no-sprintf-host-port v0.1.1 // indirect
 	github.com/stretchr/objx v0.5.0 // indirect
-	github.com/stretchr/testify v1.8.4 // indirect
 	github.com/subosito/gotenv v1.4.1 // indirect
 	github.com/switchupcb/dasgo/v10 v10.0.0-20221206085309-6335a3c15f52 // indirect
 	github.com/t-yuki/gocover-cobertura v0.0.0-20180217150009-aaee18c8195c // indirect
@@ -202,7 +204,6 @@ require (
 	golang.org/x/tools v0.14.0 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 	honnef.co/go/tools v0.4.6 // indirect
 	lukechampine.com/uint128 v1.3.0 // indirect
 	modernc.org/cc/v3 v3.41.0 // indirect
@@ -211,7 +212,6 @@ require (
 	modernc.org/mathutil v1.6.0 // indirect
 	modernc.org/memory v1.7.2 // indirect
 	modernc.org/opt v0.1.3 // indirect
-	modernc.org/sqlite v1.26.0 // indirect
 	modernc.org/strutil v1.2.0 // indirect
 	modernc.org/token v1.1.0 // indirect
 	mvdan.cc/gofumpt v0.5.0 // indirect
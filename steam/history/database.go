@@ -0,0 +1,96 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+
+	_ "modernc.org/sqlite"
+)
+
+const (
+	uriFormat = "file:%s?_pragma=busy_timeout(5000)"
+	inMemory  = ":memory:"
+
+	checkTableExists = `
+SELECT EXISTS(SELECT 1 FROM sqlite_master 
+	WHERE type='table' 
+	AND name='prices');
+`
+
+	createTableQuery = `
+CREATE TABLE prices (
+	app_id           TEXT    NOT NULL,
+	country          TEXT    NOT NULL,
+	currency         TEXT    NOT NULL,
+	initial          INTEGER NOT NULL,
+	final            INTEGER NOT NULL,
+	discount_percent INTEGER NOT NULL,
+	observed_at      INTEGER NOT NULL
+);
+
+CREATE INDEX idx_prices_app_country ON prices (app_id, country, observed_at);
+`
+)
+
+func open(uri string) (*sql.DB, error) {
+	switch uri {
+	case inMemory:
+	case "":
+		uri = inMemory
+	default:
+		if err := validateURI(uri); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := sql.Open("sqlite", fmt.Sprintf(uriFormat, uri))
+	if err != nil {
+		return nil, err
+	}
+
+	// a single connection keeps in-memory databases alive and serializes writes
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
+
+func validateURI(uri string) error {
+	stat, err := os.Stat(uri)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			f, err := os.Create(uri)
+			if err != nil {
+				return err
+			}
+
+			return f.Close()
+		}
+
+		return err
+	}
+
+	if stat.IsDir() {
+		return fmt.Errorf("%s is a directory", uri)
+	}
+
+	return nil
+}
+
+func initDatabase(ctx context.Context, db *sql.DB) error {
+	var exists bool
+
+	if err := db.QueryRowContext(ctx, checkTableExists).Scan(&exists); err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	_, err := db.ExecContext(ctx, createTableQuery)
+
+	return err
+}
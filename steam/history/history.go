@@ -0,0 +1,196 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/zalgonoise/x/steam/pb/proto/steam/store/v1"
+)
+
+const (
+	insertPriceQuery = `
+INSERT INTO prices (app_id, country, currency, initial, final, discount_percent, observed_at)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+`
+
+	listPricesQuery = `
+SELECT app_id, country, currency, initial, final, discount_percent, observed_at FROM prices
+	WHERE app_id = ? AND country = ? AND observed_at >= ?
+	ORDER BY observed_at;
+`
+
+	lowestPriceQuery = `
+SELECT app_id, country, currency, initial, final, discount_percent, observed_at FROM prices
+	WHERE app_id = ? AND country = ?
+	ORDER BY final, observed_at DESC
+	LIMIT 1;
+`
+
+	averagePriceQuery = `
+SELECT AVG(final), COUNT(*) FROM prices
+	WHERE app_id = ? AND country = ? AND observed_at >= ?;
+`
+)
+
+var ErrNoHistory = errors.New("no price history for app")
+
+// Entry is a price observed for an app in a certain country, at a certain time.
+//
+// Prices are kept in the smallest unit of the currency, as returned by the Steam store API.
+type Entry struct {
+	AppID           string    `json:"app_id"`
+	Country         string    `json:"country"`
+	Currency        string    `json:"currency"`
+	Initial         int32     `json:"initial"`
+	Final           int32     `json:"final"`
+	DiscountPercent int32     `json:"discount_percent"`
+	ObservedAt      time.Time `json:"observed_at"`
+}
+
+// Store persists the prices observed for Steam apps in a SQLite database.
+type Store struct {
+	db *sql.DB
+}
+
+// Open creates a Store from the SQLite database in uri, creating it if it doesn't exist yet. An empty uri (or
+// ":memory:") opens an in-memory database.
+func Open(uri string) (*Store, error) {
+	db, err := open(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = initDatabase(context.Background(), db); err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Record persists the price overview for each app in prices, as observed at the input time.
+func (s *Store) Record(
+	ctx context.Context, country string, prices map[string]*store.PriceOverview, observedAt time.Time,
+) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for appID, data := range prices {
+		// free or unreleased apps have no price overview
+		if data == nil {
+			continue
+		}
+
+		if _, err = tx.ExecContext(ctx, insertPriceQuery,
+			appID,
+			normalize(country),
+			data.GetCurrency(),
+			data.GetInitial(),
+			data.GetFinal(),
+			data.GetDiscountPercent(),
+			observedAt.Unix(),
+		); err != nil {
+			return errors.Join(err, tx.Rollback())
+		}
+	}
+
+	return tx.Commit()
+}
+
+// List returns the prices observed for an app in a country since the input time, ordered from oldest to newest.
+func (s *Store) List(ctx context.Context, appID, country string, since time.Time) ([]Entry, error) {
+	rows, err := s.db.QueryContext(ctx, listPricesQuery, appID, normalize(country), since.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	entries := make([]Entry, 0, 64)
+
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// Lowest returns the lowest price ever observed for an app in a country. If the same price was observed more than
+// once, the most recent observation is returned.
+//
+// It returns ErrNoHistory if no price was observed for the app in that country.
+func (s *Store) Lowest(ctx context.Context, appID, country string) (Entry, error) {
+	entry, err := scanEntry(s.db.QueryRowContext(ctx, lowestPriceQuery, appID, normalize(country)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Entry{}, ErrNoHistory
+	}
+
+	return entry, err
+}
+
+// Average returns the average price observed for an app in a country since the input time, as well as the number of
+// observations it covers.
+//
+// It returns ErrNoHistory if no price was observed for the app in that country within the time frame.
+func (s *Store) Average(ctx context.Context, appID, country string, since time.Time) (float64, int, error) {
+	var (
+		avg   sql.NullFloat64
+		count int
+	)
+
+	if err := s.db.QueryRowContext(ctx, averagePriceQuery, appID, normalize(country), since.Unix()).
+		Scan(&avg, &count); err != nil {
+		return 0, 0, err
+	}
+
+	if count == 0 || !avg.Valid {
+		return 0, 0, ErrNoHistory
+	}
+
+	return avg.Float64, count, nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanEntry(row scanner) (Entry, error) {
+	var (
+		entry      Entry
+		observedAt int64
+	)
+
+	if err := row.Scan(
+		&entry.AppID,
+		&entry.Country,
+		&entry.Currency,
+		&entry.Initial,
+		&entry.Final,
+		&entry.DiscountPercent,
+		&observedAt,
+	); err != nil {
+		return Entry{}, err
+	}
+
+	entry.ObservedAt = time.Unix(observedAt, 0)
+
+	return entry, nil
+}
+
+func normalize(country string) string {
+	return strings.ToLower(country)
+}
@@ -0,0 +1,115 @@
+package history_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/steam/history"
+	"github.com/zalgonoise/x/steam/pb/proto/steam/store/v1"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	start := time.Unix(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Unix(), 0)
+
+	prices, err := history.Open("")
+	require.NoError(t, err)
+
+	defer prices.Close()
+
+	for i, final := range []int32{1999, 999, 1499, 999} {
+		require.NoError(t, prices.Record(ctx, "PT", map[string]*store.PriceOverview{
+			"400": {Currency: "EUR", Initial: 1999, Final: final, DiscountPercent: 100 - final*100/1999},
+			// free or unreleased apps are skipped
+			"570": nil,
+		}, start.Add(time.Duration(i)*24*time.Hour)))
+	}
+
+	require.NoError(t, prices.Record(ctx, "us", map[string]*store.PriceOverview{
+		"400": {Currency: "USD", Initial: 1999, Final: 499, DiscountPercent: 75},
+	}, start))
+
+	t.Run("List", func(t *testing.T) {
+		for _, testcase := range []struct {
+			name    string
+			appID   string
+			country string
+			since   time.Time
+			wants   []int32
+		}{
+			{
+				name:    "All",
+				appID:   "400",
+				country: "pt",
+				since:   start,
+				wants:   []int32{1999, 999, 1499, 999},
+			},
+			{
+				name:    "Since",
+				appID:   "400",
+				country: "PT",
+				since:   start.Add(48 * time.Hour),
+				wants:   []int32{1499, 999},
+			},
+			{
+				name:    "OtherCountry",
+				appID:   "400",
+				country: "US",
+				since:   start,
+				wants:   []int32{499},
+			},
+			{
+				name:    "NoPriceOverview",
+				appID:   "570",
+				country: "PT",
+				since:   start,
+				wants:   []int32{},
+			},
+		} {
+			t.Run(testcase.name, func(t *testing.T) {
+				entries, err := prices.List(ctx, testcase.appID, testcase.country, testcase.since)
+				require.NoError(t, err)
+
+				finals := make([]int32, 0, len(entries))
+
+				for i := range entries {
+					finals = append(finals, entries[i].Final)
+				}
+
+				require.Equal(t, testcase.wants, finals)
+			})
+		}
+	})
+
+	t.Run("Lowest", func(t *testing.T) {
+		lowest, err := prices.Lowest(ctx, "400", "PT")
+		require.NoError(t, err)
+
+		// the most recent observation of the lowest price
+		require.Equal(t, history.Entry{
+			AppID:           "400",
+			Country:         "pt",
+			Currency:        "EUR",
+			Initial:         1999,
+			Final:           999,
+			DiscountPercent: 51,
+			ObservedAt:      start.Add(72 * time.Hour),
+		}, lowest)
+
+		_, err = prices.Lowest(ctx, "570", "PT")
+		require.ErrorIs(t, err, history.ErrNoHistory)
+	})
+
+	t.Run("Average", func(t *testing.T) {
+		avg, count, err := prices.Average(ctx, "400", "PT", start.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+		require.InDelta(t, float64(999+1499+999)/3, avg, 0.001)
+
+		_, _, err = prices.Average(ctx, "400", "PT", start.Add(96*time.Hour))
+		require.ErrorIs(t, err, history.ErrNoHistory)
+	})
+}
@@ -0,0 +1,169 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/zalgonoise/fts"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrEmptyApps     = errors.New("watch file has no apps")
+	ErrEmptyApp      = errors.New("app has no ID or name")
+	ErrNoResolver    = errors.New("no resolver to look up app names")
+	ErrNameNotFound  = errors.New("no app found with name")
+	ErrAmbiguousName = errors.New("multiple apps found with name")
+)
+
+// App describes an app to watch, as well as the conditions to alert on and where to send the alerts to.
+//
+// Apps are identified either by their ID or by their name, which is resolved to an ID with a Resolver. Any empty
+// field is set from the List's defaults.
+type App struct {
+	ID       string `yaml:"id,omitempty" json:"id,omitempty"`
+	Name     string `yaml:"name,omitempty" json:"name,omitempty"`
+	Country  string `yaml:"country,omitempty" json:"country,omitempty"`
+	Platform string `yaml:"platform,omitempty" json:"platform,omitempty"`
+	URL      string `yaml:"url,omitempty" json:"url,omitempty"`
+
+	// Mode is one of the alert modes supported by the alert and monitor commands; ignored if TargetPrice is set.
+	Mode           string `yaml:"mode,omitempty" json:"mode,omitempty"`
+	TargetDiscount int    `yaml:"target_discount,omitempty" json:"target_discount,omitempty"`
+	Days           int    `yaml:"days,omitempty" json:"days,omitempty"`
+
+	// TargetPrice is an absolute price in the smallest unit of the currency (e.g. 1999 for 19.99), to alert when
+	// the app's price is at or below it.
+	TargetPrice int32 `yaml:"target_price,omitempty" json:"target_price,omitempty"`
+}
+
+// List is the content of a watch file.
+//
+// The ID, Name and TargetPrice fields in Defaults are not applied to the apps, as they are specific to a single app.
+type List struct {
+	Defaults App   `yaml:"defaults,omitempty" json:"defaults,omitempty"`
+	Apps     []App `yaml:"apps" json:"apps"`
+}
+
+// Resolver looks up apps by their name, like the apps package's full-text search indexer.
+type Resolver interface {
+	Search(ctx context.Context, name string) ([]fts.Attribute[int64, string], error)
+}
+
+// Load reads and parses the watch file in path.
+func Load(path string) (*List, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(buf)
+}
+
+// Parse parses a watch file, in either YAML or JSON format.
+func Parse(buf []byte) (*List, error) {
+	list := &List{}
+
+	// JSON documents are valid YAML documents as well
+	if err := yaml.Unmarshal(buf, list); err != nil {
+		return nil, err
+	}
+
+	if len(list.Apps) == 0 {
+		return nil, ErrEmptyApps
+	}
+
+	return list, nil
+}
+
+// Resolve returns the apps in the List with their empty fields set from the defaults, and their names resolved to an
+// ID where no ID is set. The resolver is only used if any app is listed by name alone.
+func (l *List) Resolve(ctx context.Context, resolver Resolver) ([]App, error) {
+	apps := make([]App, 0, len(l.Apps))
+
+	for i := range l.Apps {
+		app := l.Apps[i].WithDefaults(l.Defaults)
+
+		if app.ID == "" {
+			id, err := resolve(ctx, resolver, app.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			app.ID = id
+		}
+
+		apps = append(apps, app)
+	}
+
+	return apps, nil
+}
+
+// WithDefaults returns a copy of the App with its empty fields set from defaults, except for its ID, Name and
+// TargetPrice.
+func (a App) WithDefaults(defaults App) App {
+	if a.Country == "" {
+		a.Country = defaults.Country
+	}
+
+	if a.Platform == "" {
+		a.Platform = defaults.Platform
+	}
+
+	if a.URL == "" {
+		a.URL = defaults.URL
+	}
+
+	if a.Mode == "" {
+		a.Mode = defaults.Mode
+	}
+
+	if a.TargetDiscount == 0 {
+		a.TargetDiscount = defaults.TargetDiscount
+	}
+
+	if a.Days == 0 {
+		a.Days = defaults.Days
+	}
+
+	return a
+}
+
+func resolve(ctx context.Context, resolver Resolver, name string) (string, error) {
+	if name == "" {
+		return "", ErrEmptyApp
+	}
+
+	if resolver == nil {
+		return "", fmt.Errorf("%w: %s", ErrNoResolver, name)
+	}
+
+	results, err := resolver.Search(ctx, name)
+	if err != nil {
+		if errors.Is(err, fts.ErrNotFoundKeyword) {
+			return "", fmt.Errorf("%w: %s", ErrNameNotFound, name)
+		}
+
+		return "", err
+	}
+
+	// prefer an exact match amongst the search results
+	for i := range results {
+		if strings.EqualFold(results[i].Value, name) {
+			return strconv.FormatInt(results[i].Key, 10), nil
+		}
+	}
+
+	switch len(results) {
+	case 0:
+		return "", fmt.Errorf("%w: %s", ErrNameNotFound, name)
+	case 1:
+		return strconv.FormatInt(results[0].Key, 10), nil
+	default:
+		return "", fmt.Errorf("%w: %s (%d results)", ErrAmbiguousName, name, len(results))
+	}
+}
@@ -0,0 +1,236 @@
+package watchlist_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalgonoise/fts"
+
+	"github.com/zalgonoise/x/steam/watchlist"
+)
+
+type fakeResolver struct {
+	results []fts.Attribute[int64, string]
+	err     error
+}
+
+func (r fakeResolver) Search(context.Context, string) ([]fts.Attribute[int64, string], error) {
+	return r.results, r.err
+}
+
+func TestParse(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input string
+		wants *watchlist.List
+		err   error
+	}{
+		{
+			name: "YAML",
+			input: `
+defaults:
+  country: pt
+  platform: discord
+  url: https://discord.example/webhook
+  mode: historical_low
+apps:
+  - id: "400"
+    target_price: 499
+  - name: Dota 2
+    country: us
+    mode: discount
+    target_discount: 50
+`,
+			wants: &watchlist.List{
+				Defaults: watchlist.App{
+					Country:  "pt",
+					Platform: "discord",
+					URL:      "https://discord.example/webhook",
+					Mode:     "historical_low",
+				},
+				Apps: []watchlist.App{
+					{ID: "400", TargetPrice: 499},
+					{Name: "Dota 2", Country: "us", Mode: "discount", TargetDiscount: 50},
+				},
+			},
+		},
+		{
+			name:  "JSON",
+			input: `{"defaults":{"country":"pt"},"apps":[{"id":"400","days":30,"mode":"below_average"}]}`,
+			wants: &watchlist.List{
+				Defaults: watchlist.App{Country: "pt"},
+				Apps:     []watchlist.App{{ID: "400", Mode: "below_average", Days: 30}},
+			},
+		},
+		{
+			name:  "NoApps",
+			input: "defaults:\n  country: pt\n",
+			err:   watchlist.ErrEmptyApps,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			list, err := watchlist.Parse([]byte(testcase.input))
+			if testcase.err != nil {
+				require.ErrorIs(t, err, testcase.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testcase.wants, list)
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := watchlist.Parse([]byte("apps: [id: 400"))
+		require.Error(t, err)
+	})
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watch.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("apps:\n  - id: \"400\"\n"), 0o600))
+
+	list, err := watchlist.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, []watchlist.App{{ID: "400"}}, list.Apps)
+
+	_, err = watchlist.Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestApp_WithDefaults(t *testing.T) {
+	defaults := watchlist.App{
+		ID:             "570",
+		Name:           "Dota 2",
+		Country:        "pt",
+		Platform:       "slack",
+		URL:            "https://slack.example/webhook",
+		Mode:           "discount",
+		TargetDiscount: 40,
+		Days:           30,
+		TargetPrice:    999,
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		require.Equal(t, watchlist.App{
+			ID:             "400",
+			Country:        "pt",
+			Platform:       "slack",
+			URL:            "https://slack.example/webhook",
+			Mode:           "discount",
+			TargetDiscount: 40,
+			Days:           30,
+		}, watchlist.App{ID: "400"}.WithDefaults(defaults))
+	})
+
+	t.Run("Overridden", func(t *testing.T) {
+		app := watchlist.App{
+			Name:           "Portal",
+			Country:        "us",
+			Platform:       "discord",
+			URL:            "https://discord.example/webhook",
+			Mode:           "below_average",
+			TargetDiscount: 10,
+			Days:           7,
+		}
+
+		require.Equal(t, app, app.WithDefaults(defaults))
+	})
+}
+
+func TestList_Resolve(t *testing.T) {
+	errLocked := errors.New("database is locked")
+
+	indexer, err := fts.New([]fts.Attribute[int64, string]{
+		{Key: 400, Value: "Portal"},
+		{Key: 620, Value: "Portal 2"},
+		{Key: 570, Value: "Dota 2"},
+		{Key: 1, Value: "Half-Life"},
+		{Key: 2, Value: "Half-Life 2"},
+	})
+	require.NoError(t, err)
+
+	for _, testcase := range []struct {
+		name     string
+		list     watchlist.List
+		resolver watchlist.Resolver
+		wants    []watchlist.App
+		err      error
+	}{
+		{
+			name: "ByID",
+			list: watchlist.List{
+				Defaults: watchlist.App{Country: "pt"},
+				Apps:     []watchlist.App{{ID: "400"}, {ID: "570", Country: "us"}},
+			},
+			wants: []watchlist.App{{ID: "400", Country: "pt"}, {ID: "570", Country: "us"}},
+		},
+		{
+			name: "ByName/ExactMatch",
+			list: watchlist.List{
+				Defaults: watchlist.App{Mode: "historical_low"},
+				Apps:     []watchlist.App{{Name: "portal"}},
+			},
+			resolver: indexer,
+			wants:    []watchlist.App{{ID: "400", Name: "portal", Mode: "historical_low"}},
+		},
+		{
+			name:     "ByName/SingleResult",
+			list:     watchlist.List{Apps: []watchlist.App{{Name: "dota"}}},
+			resolver: indexer,
+			wants:    []watchlist.App{{ID: "570", Name: "dota"}},
+		},
+		{
+			name:     "ByName/Ambiguous",
+			list:     watchlist.List{Apps: []watchlist.App{{Name: "half"}}},
+			resolver: indexer,
+			err:      watchlist.ErrAmbiguousName,
+		},
+		{
+			name:     "ByName/NotFound",
+			list:     watchlist.List{Apps: []watchlist.App{{Name: "minesweeper"}}},
+			resolver: indexer,
+			err:      watchlist.ErrNameNotFound,
+		},
+		{
+			name:     "ByName/NoResults",
+			list:     watchlist.List{Apps: []watchlist.App{{Name: "minesweeper"}}},
+			resolver: fakeResolver{},
+			err:      watchlist.ErrNameNotFound,
+		},
+		{
+			name:     "ByName/ResolverError",
+			list:     watchlist.List{Apps: []watchlist.App{{Name: "portal"}}},
+			resolver: fakeResolver{err: errLocked},
+			err:      errLocked,
+		},
+		{
+			name: "ByName/NoResolver",
+			list: watchlist.List{Apps: []watchlist.App{{Name: "portal"}}},
+			err:  watchlist.ErrNoResolver,
+		},
+		{
+			name:     "NoIDNorName",
+			list:     watchlist.List{Apps: []watchlist.App{{Country: "pt"}}},
+			resolver: indexer,
+			err:      watchlist.ErrEmptyApp,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			apps, err := testcase.list.Resolve(context.Background(), testcase.resolver)
+			if testcase.err != nil {
+				require.ErrorIs(t, err, testcase.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testcase.wants, apps)
+		})
+	}
+}
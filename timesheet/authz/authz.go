@@ -0,0 +1,335 @@
+package authz
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/zalgonoise/x/errs"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zalgonoise/x/timesheet/pb/timesheet/v1"
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+const (
+	challengeSize = 32
+	tokenSize     = 32
+	// maxChallenges caps the pending challenges of a user, so that Login cannot be used to fill the database
+	maxChallenges = 5
+
+	defaultChallengeExpiry = time.Minute
+	defaultTokenExpiry     = 24 * time.Hour
+)
+
+const (
+	errDomain = errs.Domain("x/timesheet/authz")
+
+	ErrInvalid    = errs.Kind("invalid")
+	ErrMismatched = errs.Kind("mismatched")
+	ErrTooMany    = errs.Kind("too many")
+	ErrMissing    = errs.Kind("missing")
+	ErrEmpty      = errs.Kind("empty")
+
+	ErrPublicKey         = errs.Entity("public key")
+	ErrChallengeResponse = errs.Entity("challenge response")
+	ErrChallenges        = errs.Entity("pending challenges")
+	ErrUsername          = errs.Entity("username")
+	ErrHeaders           = errs.Entity("headers")
+	ErrToken             = errs.Entity("authorization token")
+)
+
+var (
+	ErrInvalidPublicKey  = errs.WithDomain(errDomain, ErrInvalid, ErrPublicKey)
+	ErrKeyMismatch       = errs.WithDomain(errDomain, ErrMismatched, ErrPublicKey)
+	ErrInvalidChallenge  = errs.WithDomain(errDomain, ErrInvalid, ErrChallengeResponse)
+	ErrTooManyChallenges = errs.WithDomain(errDomain, ErrTooMany, ErrChallenges)
+	ErrEmptyUsername     = errs.WithDomain(errDomain, ErrEmpty, ErrUsername)
+)
+
+type Repository interface {
+	CreateUser(ctx context.Context, username string, pubKey []byte) error
+	GetPublicKey(ctx context.Context, username string) ([]byte, error)
+	CreateChallenge(ctx context.Context, username string, challenge []byte, expiry time.Time) error
+	ListChallenges(ctx context.Context, username string, now time.Time) ([]repository.Challenge, error)
+	DeleteChallenge(ctx context.Context, username string, challenge []byte) error
+	CreateToken(ctx context.Context, username, token string, expiry time.Time) error
+	GetTokenUser(ctx context.Context, token string, now time.Time) (string, error)
+	Cleanup(ctx context.Context, now time.Time) error
+}
+
+// Authz issues tokens to users that prove to hold the private key for their public key.
+//
+// Users are registered beforehand with their public key, through Register. Logins must present the same key, and are
+// answered with a random challenge. The challenge, signed with the user's private key, is exchanged for a token in
+// GetToken. ECDSA keys sign the SHA-512 digest of the challenge, while Ed25519 keys sign the challenge itself.
+//
+// Logins from unknown users, or with a different key, are rejected alike; and each user can only hold up to
+// maxChallenges pending challenges at a time.
+type Authz struct {
+	pb.UnimplementedAuthzServer
+
+	repo            Repository
+	challengeExpiry time.Duration
+	tokenExpiry     time.Duration
+
+	logger *slog.Logger
+	tracer trace.Tracer
+}
+
+func New(
+	repo Repository, challengeExpiry, tokenExpiry time.Duration, logger *slog.Logger, tracer trace.Tracer,
+) *Authz {
+	if challengeExpiry <= 0 {
+		challengeExpiry = defaultChallengeExpiry
+	}
+
+	if tokenExpiry <= 0 {
+		tokenExpiry = defaultTokenExpiry
+	}
+
+	return &Authz{
+		repo:            repo,
+		challengeExpiry: challengeExpiry,
+		tokenExpiry:     tokenExpiry,
+		logger:          logger,
+		tracer:          tracer,
+	}
+}
+
+func (a *Authz) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	ctx, span := a.tracer.Start(ctx, "Authz.Login", trace.WithAttributes(
+		attribute.String("username", req.GetUsername()),
+	))
+	defer span.End()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, a.fail(ctx, span, codes.InvalidArgument, "invalid request", err)
+	}
+
+	pubKey, err := parsePublicKey(req.PublicKey)
+	if err != nil {
+		return nil, a.fail(ctx, span, codes.InvalidArgument, "invalid public key", err)
+	}
+
+	now := time.Now()
+
+	if err = a.repo.Cleanup(ctx, now); err != nil {
+		a.logger.WarnContext(ctx, "failed to clean up expired challenges and tokens", slog.String("error", err.Error()))
+	}
+
+	if err = a.verifyKey(ctx, req.Username, pubKey); err != nil {
+		if errors.Is(err, ErrKeyMismatch) {
+			return nil, a.fail(ctx, span, codes.PermissionDenied, "public key mismatch", err)
+		}
+
+		return nil, a.fail(ctx, span, codes.Internal, "failed to get public key", err)
+	}
+
+	pending, err := a.repo.ListChallenges(ctx, req.Username, now)
+	if err != nil {
+		return nil, a.fail(ctx, span, codes.Internal, "failed to list challenges", err)
+	}
+
+	if len(pending) >= maxChallenges {
+		return nil, a.fail(ctx, span, codes.ResourceExhausted, "too many pending challenges", ErrTooManyChallenges)
+	}
+
+	challenge := make([]byte, challengeSize)
+
+	if _, err = rand.Read(challenge); err != nil {
+		return nil, a.fail(ctx, span, codes.Internal, "failed to generate challenge", err)
+	}
+
+	expiry := now.Add(a.challengeExpiry)
+
+	if err = a.repo.CreateChallenge(ctx, req.Username, challenge, expiry); err != nil {
+		return nil, a.fail(ctx, span, codes.Internal, "failed to store challenge", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.LoginResponse{Challenge: challenge, ExpiresOn: expiry.UnixMilli()}, nil
+}
+
+func (a *Authz) GetToken(ctx context.Context, req *pb.GetTokenRequest) (*pb.GetTokenResponse, error) {
+	ctx, span := a.tracer.Start(ctx, "Authz.GetToken", trace.WithAttributes(
+		attribute.String("username", req.GetUsername()),
+	))
+	defer span.End()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, a.fail(ctx, span, codes.InvalidArgument, "invalid request", err)
+	}
+
+	rawKey, err := a.repo.GetPublicKey(ctx, req.Username)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, a.fail(ctx, span, codes.InvalidArgument, "unknown user", ErrInvalidChallenge)
+		}
+
+		return nil, a.fail(ctx, span, codes.Internal, "failed to get public key", err)
+	}
+
+	pubKey, err := parsePublicKey(rawKey)
+	if err != nil {
+		return nil, a.fail(ctx, span, codes.Internal, "invalid stored public key", err)
+	}
+
+	now := time.Now()
+
+	challenges, err := a.repo.ListChallenges(ctx, req.Username, now)
+	if err != nil {
+		return nil, a.fail(ctx, span, codes.Internal, "failed to list challenges", err)
+	}
+
+	challenge, ok := match(pubKey, req.ChallengeResponse, challenges)
+	if !ok {
+		return nil, a.fail(ctx, span, codes.InvalidArgument, "no challenge matches the response", ErrInvalidChallenge)
+	}
+
+	// challenges are single-use
+	if err = a.repo.DeleteChallenge(ctx, req.Username, challenge); err != nil {
+		return nil, a.fail(ctx, span, codes.Internal, "failed to delete challenge", err)
+	}
+
+	buf := make([]byte, tokenSize)
+
+	if _, err = rand.Read(buf); err != nil {
+		return nil, a.fail(ctx, span, codes.Internal, "failed to generate token", err)
+	}
+
+	token := hex.EncodeToString(buf)
+	expiry := now.Add(a.tokenExpiry)
+
+	if err = a.repo.CreateToken(ctx, req.Username, token, expiry); err != nil {
+		return nil, a.fail(ctx, span, codes.Internal, "failed to store token", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.GetTokenResponse{Token: token, ExpiresOn: expiry.UnixMilli()}, nil
+}
+
+// AuthFuncOverride allows the Authz service to be called without a token, as it is the one issuing them.
+func (a *Authz) AuthFuncOverride(ctx context.Context, _ string) (context.Context, error) {
+	return ctx, nil
+}
+
+// Register creates a user with the input public key, either in DER or PEM format, so it can log in. It returns a
+// repository.ErrAlreadyExists error if the user is already registered.
+func (a *Authz) Register(ctx context.Context, username string, rawKey []byte) error {
+	if username == "" {
+		return ErrEmptyUsername
+	}
+
+	pubKey, err := parsePublicKey(rawKey)
+	if err != nil {
+		return err
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(pubKey)
+	if err != nil {
+		return err
+	}
+
+	if err = a.repo.CreateUser(ctx, username, der); err != nil {
+		return err
+	}
+
+	a.logger.InfoContext(ctx, "registered new user", slog.String("username", username))
+
+	return nil
+}
+
+// verifyKey checks that the public key is the one registered for the user, returning an ErrKeyMismatch error
+// otherwise, or if the user is not registered.
+func (a *Authz) verifyKey(ctx context.Context, username string, pubKey crypto.PublicKey) error {
+	rawKey, err := a.repo.GetPublicKey(ctx, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrKeyMismatch
+		}
+
+		return err
+	}
+
+	registered, err := parsePublicKey(rawKey)
+	if err != nil {
+		return err
+	}
+
+	if !registered.(interface{ Equal(crypto.PublicKey) bool }).Equal(pubKey) {
+		return ErrKeyMismatch
+	}
+
+	return nil
+}
+
+func (a *Authz) fail(ctx context.Context, span trace.Span, code codes.Code, msg string, err error) error {
+	span.SetStatus(otelcodes.Error, err.Error())
+	span.RecordError(err)
+
+	if code == codes.Internal {
+		a.logger.ErrorContext(ctx, msg, slog.String("error", err.Error()))
+	} else {
+		a.logger.WarnContext(ctx, msg, slog.String("error", err.Error()))
+	}
+
+	return status.Error(code, err.Error())
+}
+
+// parsePublicKey decodes a PKIX public key, either in DER or PEM format, that is either an ECDSA or an Ed25519 key.
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		data = block.Bytes
+	}
+
+	pubKey, err := x509.ParsePKIXPublicKey(data)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidPublicKey, err)
+	}
+
+	switch pubKey.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey:
+		return pubKey, nil
+	default:
+		return nil, ErrInvalidPublicKey
+	}
+}
+
+func match(pubKey crypto.PublicKey, signature []byte, challenges []repository.Challenge) ([]byte, bool) {
+	for i := range challenges {
+		if verify(pubKey, challenges[i].Challenge, signature) {
+			return challenges[i].Challenge, true
+		}
+	}
+
+	return nil, false
+}
+
+func verify(pubKey crypto.PublicKey, challenge, signature []byte) bool {
+	switch key := pubKey.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha512.Sum512(challenge)
+
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, challenge, signature)
+	default:
+		return false
+	}
+}
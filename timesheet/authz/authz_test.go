@@ -0,0 +1,147 @@
+package authz_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zalgonoise/x/timesheet/authz"
+	"github.com/zalgonoise/x/timesheet/database"
+	pb "github.com/zalgonoise/x/timesheet/pb/timesheet/v1"
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+func newAuthz(t *testing.T) (*authz.Authz, *repository.Users) {
+	t.Helper()
+
+	db, err := database.Open(":memory:")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	require.NoError(t, database.Migrate(context.Background(), db))
+
+	users := repository.NewUsers(db)
+
+	return authz.New(users, 0, 0,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		noop.NewTracerProvider().Tracer("test"),
+	), users
+}
+
+func newKey(t *testing.T) (ed25519.PrivateKey, []byte) {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+
+	return priv, der
+}
+
+func TestAuthz_Login(t *testing.T) {
+	ctx := context.Background()
+
+	priv, pubKey := newKey(t)
+	_, otherKey := newKey(t)
+
+	for _, testcase := range []struct {
+		name     string
+		username string
+		pubKey   []byte
+		code     codes.Code
+	}{
+		{
+			name:     "Registered",
+			username: "gopher",
+			pubKey:   pubKey,
+			code:     codes.OK,
+		},
+		{
+			name:     "UnknownUser",
+			username: "someone",
+			pubKey:   pubKey,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "KeyMismatch",
+			username: "gopher",
+			pubKey:   otherKey,
+			code:     codes.PermissionDenied,
+		},
+		{
+			name:     "InvalidKey",
+			username: "gopher",
+			pubKey:   []byte("not a key"),
+			code:     codes.InvalidArgument,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			a, users := newAuthz(t)
+
+			require.NoError(t, a.Register(ctx, "gopher", pubKey))
+
+			res, err := a.Login(ctx, &pb.LoginRequest{Username: testcase.username, PublicKey: testcase.pubKey})
+			require.Equal(t, testcase.code, status.Code(err), err)
+
+			if testcase.code != codes.OK {
+				// unknown users are not registered by a login
+				_, err = users.GetPublicKey(ctx, "someone")
+				require.ErrorIs(t, err, repository.ErrNotFound)
+
+				return
+			}
+
+			token, err := a.GetToken(ctx, &pb.GetTokenRequest{
+				Username:          testcase.username,
+				ChallengeResponse: ed25519.Sign(priv, res.Challenge),
+			})
+			require.NoError(t, err)
+			require.NotEmpty(t, token.Token)
+
+			// challenges are single-use
+			_, err = a.GetToken(ctx, &pb.GetTokenRequest{
+				Username:          testcase.username,
+				ChallengeResponse: ed25519.Sign(priv, res.Challenge),
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	t.Run("TooManyChallenges", func(t *testing.T) {
+		a, _ := newAuthz(t)
+
+		require.NoError(t, a.Register(ctx, "gopher", pubKey))
+
+		for i := 0; i < 5; i++ {
+			_, err := a.Login(ctx, &pb.LoginRequest{Username: "gopher", PublicKey: pubKey})
+			require.NoError(t, err)
+		}
+
+		_, err := a.Login(ctx, &pb.LoginRequest{Username: "gopher", PublicKey: pubKey})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+func TestAuthz_Register(t *testing.T) {
+	ctx := context.Background()
+	a, _ := newAuthz(t)
+
+	_, pubKey := newKey(t)
+
+	require.NoError(t, a.Register(ctx, "gopher", pubKey))
+	require.ErrorIs(t, a.Register(ctx, "gopher", pubKey), repository.ErrAlreadyExists)
+	require.ErrorIs(t, a.Register(ctx, "", pubKey), authz.ErrEmptyUsername)
+	require.ErrorIs(t, a.Register(ctx, "someone", []byte("not a key")), authz.ErrInvalidPublicKey)
+}
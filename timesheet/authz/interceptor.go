@@ -0,0 +1,82 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
+	"github.com/zalgonoise/x/errs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+type contextKey string
+
+const (
+	authHeader              = "authorization"
+	bearerScheme            = "bearer"
+	ContextKey   contextKey = "username"
+)
+
+var (
+	ErrEmptyHeaders = errs.WithDomain(errDomain, ErrEmpty, ErrHeaders)
+	ErrMissingToken = errs.WithDomain(errDomain, ErrMissing, ErrToken)
+	ErrInvalidToken = errs.WithDomain(errDomain, ErrInvalid, ErrToken)
+)
+
+type TokenVerifier interface {
+	GetTokenUser(ctx context.Context, token string, now time.Time) (string, error)
+}
+
+// UnaryInterceptor requires a valid token in the "authorization" metadata of every call, except for services that
+// implement auth.ServiceAuthFuncOverride (like Authz). The token's user is stored in the context, under ContextKey.
+func UnaryInterceptor(verifier TokenVerifier) grpc.UnaryServerInterceptor {
+	return auth.UnaryServerInterceptor(authFunc(verifier))
+}
+
+func StreamInterceptor(verifier TokenVerifier) grpc.StreamServerInterceptor {
+	return auth.StreamServerInterceptor(authFunc(verifier))
+}
+
+// User returns the username stored in the context by the interceptors.
+func User(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(ContextKey).(string)
+
+	return username, ok && username != ""
+}
+
+func authFunc(verifier TokenVerifier) auth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return ctx, status.Error(codes.Unauthenticated, ErrEmptyHeaders.Error())
+		}
+
+		values := md.Get(authHeader)
+		if len(values) == 0 || values[0] == "" {
+			return ctx, status.Error(codes.Unauthenticated, ErrMissingToken.Error())
+		}
+
+		scheme, token, ok := strings.Cut(values[0], " ")
+		if !ok || !strings.EqualFold(scheme, bearerScheme) || token == "" {
+			return ctx, status.Error(codes.Unauthenticated, ErrInvalidToken.Error())
+		}
+
+		username, err := verifier.GetTokenUser(ctx, token, time.Now())
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ctx, status.Error(codes.Unauthenticated, ErrInvalidToken.Error())
+			}
+
+			return ctx, status.Error(codes.Internal, err.Error())
+		}
+
+		return context.WithValue(ctx, ContextKey, username), nil
+	}
+}
@@ -0,0 +1,109 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zalgonoise/x/timesheet/authz"
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+type fakeVerifier map[string]string
+
+func (v fakeVerifier) GetTokenUser(_ context.Context, token string, _ time.Time) (string, error) {
+	username, ok := v[token]
+	if !ok {
+		return "", repository.ErrNotFound
+	}
+
+	return username, nil
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	interceptor := authz.UnaryInterceptor(fakeVerifier{"valid": "gopher"})
+
+	for _, testcase := range []struct {
+		name     string
+		md       metadata.MD
+		code     codes.Code
+		username string
+	}{
+		{
+			name: "NoMetadata",
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "MissingHeader",
+			md:   metadata.Pairs("other", "value"),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "EmptyHeader",
+			md:   metadata.Pairs("authorization", ""),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "NotBearer",
+			md:   metadata.Pairs("authorization", "Basic valid"),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "NoScheme",
+			md:   metadata.Pairs("authorization", "valid"),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "EmptyToken",
+			md:   metadata.Pairs("authorization", "Bearer "),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "InvalidToken",
+			md:   metadata.Pairs("authorization", "Bearer invalid"),
+			code: codes.Unauthenticated,
+		},
+		{
+			name:     "ValidToken",
+			md:       metadata.Pairs("authorization", "Bearer valid"),
+			code:     codes.OK,
+			username: "gopher",
+		},
+		{
+			name:     "CaseInsensitiveScheme",
+			md:       metadata.Pairs("authorization", "bearer valid"),
+			code:     codes.OK,
+			username: "gopher",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testcase.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, testcase.md)
+			}
+
+			var (
+				called   bool
+				username string
+			)
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test"},
+				func(ctx context.Context, _ any) (any, error) {
+					called = true
+					username, _ = authz.User(ctx)
+
+					return nil, nil
+				})
+
+			require.Equal(t, testcase.code, status.Code(err), err)
+			require.Equal(t, testcase.code == codes.OK, called)
+			require.Equal(t, testcase.username, username)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata" // Time zones for weekly summaries, in environments without a tz database
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/zalgonoise/x/timesheet/authz"
+	"github.com/zalgonoise/x/timesheet/database"
+	"github.com/zalgonoise/x/timesheet/grpcserver"
+	"github.com/zalgonoise/x/timesheet/httpserver"
+	"github.com/zalgonoise/x/timesheet/metrics"
+	pb "github.com/zalgonoise/x/timesheet/pb/timesheet/v1"
+	"github.com/zalgonoise/x/timesheet/repository"
+	"github.com/zalgonoise/x/timesheet/service"
+)
+
+const (
+	defaultHTTPPort        = 8080
+	defaultGRPCPort        = 8081
+	defaultDatabase        = "timesheet.db"
+	defaultChallengeExpiry = time.Minute
+	defaultTokenExpiry     = 24 * time.Hour
+
+	shutdownTimeout = time.Minute
+
+	registerCommand = "register"
+)
+
+var errRegisterUsage = errors.New("usage: timesheet [flags] register <username> <public-key-file>")
+
+type config struct {
+	httpPort        int
+	grpcPort        int
+	database        string
+	challengeExpiry time.Duration
+	tokenExpiry     time.Duration
+	logLevel        string
+	args            []string
+}
+
+func main() {
+	cfg := parseFlags()
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.logLevel)); err != nil {
+		level = slog.LevelInfo
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if len(cfg.args) > 0 && cfg.args[0] == registerCommand {
+		if err := register(ctx, cfg, logger); err != nil {
+			logger.ErrorContext(ctx, "failed to register user", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	if err := run(ctx, cfg, logger); err != nil {
+		logger.ErrorContext(ctx, "timesheet exited with an error", slog.String("error", err.Error()))
+		cancel()
+		os.Exit(1)
+	}
+}
+
+func parseFlags() config {
+	cfg := config{}
+
+	flag.IntVar(&cfg.httpPort, "http-port", envInt("TIMESHEET_HTTP_PORT", defaultHTTPPort), "port to serve HTTP on")
+	flag.IntVar(&cfg.grpcPort, "grpc-port", envInt("TIMESHEET_GRPC_PORT", defaultGRPCPort), "port to serve gRPC on")
+	flag.StringVar(&cfg.database, "db", envString("TIMESHEET_DATABASE", defaultDatabase),
+		"path to the SQLite database file, or :memory:")
+	flag.DurationVar(&cfg.challengeExpiry, "challenge-expiry",
+		envDuration("TIMESHEET_CHALLENGE_EXPIRY", defaultChallengeExpiry), "how long login challenges are valid for")
+	flag.DurationVar(&cfg.tokenExpiry, "token-expiry",
+		envDuration("TIMESHEET_TOKEN_EXPIRY", defaultTokenExpiry), "how long issued tokens are valid for")
+	flag.StringVar(&cfg.logLevel, "log-level", envString("TIMESHEET_LOG_LEVEL", "info"), "log level")
+
+	flag.Parse()
+
+	cfg.args = flag.Args()
+
+	return cfg
+}
+
+func run(ctx context.Context, cfg config, logger *slog.Logger) error {
+	db, err := database.Open(cfg.database)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	if err = database.Migrate(ctx, db); err != nil {
+		return err
+	}
+
+	tracer := noop.NewTracerProvider().Tracer("timesheet")
+	users := repository.NewUsers(db)
+
+	authzService := authz.New(users, cfg.challengeExpiry, cfg.tokenExpiry, logger, tracer)
+	timesheetService := service.New(repository.NewTimesheet(db), logger, tracer)
+
+	m := metrics.NewMetrics()
+
+	httpServer, err := httpserver.NewServer(fmt.Sprintf(":%d", cfg.httpPort))
+	if err != nil {
+		return err
+	}
+
+	grpcServer, err := runGRPCServer(ctx, cfg.grpcPort, users, authzService, timesheetService, httpServer, logger, m)
+	if err != nil {
+		return err
+	}
+
+	if err = registerMetrics(m, httpServer); err != nil {
+		return err
+	}
+
+	go runHTTPServer(ctx, cfg.httpPort, httpServer, logger)
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	logger.InfoContext(shutdownCtx, "shutting down")
+
+	grpcServer.Shutdown()
+
+	return httpServer.Shutdown(shutdownCtx)
+}
+
+// register creates a user with the public key in the input file, as only registered users can log in.
+func register(ctx context.Context, cfg config, logger *slog.Logger) error {
+	if len(cfg.args) != 3 {
+		return errRegisterUsage
+	}
+
+	pubKey, err := os.ReadFile(cfg.args[2])
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Open(cfg.database)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	if err = database.Migrate(ctx, db); err != nil {
+		return err
+	}
+
+	return authz.New(repository.NewUsers(db), cfg.challengeExpiry, cfg.tokenExpiry, logger,
+		noop.NewTracerProvider().Tracer("timesheet")).
+		Register(ctx, cfg.args[1], pubKey)
+}
+
+func runGRPCServer(
+	ctx context.Context,
+	port int,
+	verifier authz.TokenVerifier,
+	authzService pb.AuthzServer,
+	timesheetService pb.TimesheetServer,
+	server *httpserver.Server,
+	logger *slog.Logger,
+	m *metrics.Metrics,
+) (*grpcserver.Server, error) {
+	grpcServer := grpcserver.NewServer(m,
+		[]grpc.UnaryServerInterceptor{authz.UnaryInterceptor(verifier)},
+		[]grpc.StreamServerInterceptor{authz.StreamInterceptor(verifier)},
+	)
+
+	grpcServer.RegisterAuthzServer(authzService)
+	grpcServer.RegisterTimesheetServer(timesheetService)
+
+	logger.InfoContext(ctx, "listening on gRPC", slog.Int("port", port))
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if serveErr := grpcServer.Serve(lis); serveErr != nil {
+			logger.ErrorContext(ctx, "failed to start gRPC server",
+				slog.String("error", serveErr.Error()),
+				slog.Int("port", port),
+			)
+			os.Exit(1)
+		}
+	}()
+
+	grpcClient, err := grpc.Dial(
+		fmt.Sprintf("localhost:%d", port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = server.RegisterAuthz(ctx, pb.NewAuthzClient(grpcClient)); err != nil {
+		return nil, err
+	}
+
+	if err = server.RegisterTimesheet(ctx, pb.NewTimesheetClient(grpcClient)); err != nil {
+		return nil, err
+	}
+
+	return grpcServer, nil
+}
+
+func registerMetrics(m *metrics.Metrics, httpServer *httpserver.Server) error {
+	reg, err := m.Registry()
+	if err != nil {
+		return err
+	}
+
+	return httpServer.RegisterHTTP(http.MethodGet, "/metrics",
+		promhttp.HandlerFor(reg,
+			promhttp.HandlerOpts{Registry: reg}))
+}
+
+func runHTTPServer(ctx context.Context, port int, httpServer *httpserver.Server, logger *slog.Logger) {
+	logger.InfoContext(ctx, "listening on http", slog.Int("port", port))
+
+	err := httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.ErrorContext(ctx, "http server listen error", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,202 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+
+	_ "modernc.org/sqlite" // Database driver
+)
+
+const (
+	uriFormat = "file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+	inMemory  = ":memory:"
+
+	checkUsersTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='users');
+`
+
+	createUsersTable = `
+CREATE TABLE users
+(
+    id           INTEGER PRIMARY KEY NOT NULL,
+    username     TEXT                NOT NULL UNIQUE,
+    pub_key      BLOB                NOT NULL
+);
+`
+
+	checkChallengesTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='challenges');
+`
+
+	createChallengesTable = `
+CREATE TABLE challenges
+(
+    user_id       INTEGER REFERENCES users (id) NOT NULL,
+    challenge     BLOB                          NOT NULL,
+    expiry        INTEGER                       NOT NULL
+);
+
+CREATE INDEX idx_challenges_user_id ON challenges (user_id);
+`
+
+	checkTokensTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='tokens');
+`
+
+	createTokensTable = `
+CREATE TABLE tokens
+(
+    user_id       INTEGER REFERENCES users (id) NOT NULL,
+    token         TEXT                          NOT NULL UNIQUE,
+    expiry        INTEGER                       NOT NULL
+);
+
+CREATE INDEX idx_tokens_user_id ON tokens (user_id);
+`
+
+	checkProjectsTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='projects');
+`
+
+	createProjectsTable = `
+CREATE TABLE projects
+(
+    id            INTEGER PRIMARY KEY NOT NULL,
+    user_id       INTEGER REFERENCES users (id) NOT NULL,
+    name          TEXT                          NOT NULL,
+    description   TEXT                          NOT NULL,
+    created_at    INTEGER                       NOT NULL,
+    UNIQUE (user_id, name)
+);
+`
+
+	checkTasksTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='tasks');
+`
+
+	createTasksTable = `
+CREATE TABLE tasks
+(
+    id            INTEGER PRIMARY KEY NOT NULL,
+    project_id    INTEGER REFERENCES projects (id) NOT NULL,
+    name          TEXT                             NOT NULL,
+    created_at    INTEGER                          NOT NULL,
+    UNIQUE (project_id, name)
+);
+`
+
+	checkEntriesTableExists = `
+	SELECT EXISTS(SELECT 1 FROM sqlite_master
+	WHERE type='table'
+	AND name='entries');
+`
+
+	createEntriesTable = `
+CREATE TABLE entries
+(
+    id            INTEGER PRIMARY KEY NOT NULL,
+    user_id       INTEGER REFERENCES users (id) NOT NULL,
+    task_id       INTEGER REFERENCES tasks (id) NOT NULL,
+    started_at    INTEGER                       NOT NULL,
+    ended_at      INTEGER                       NULL,
+    note          TEXT                          NOT NULL
+);
+
+CREATE INDEX idx_entries_user_id_started_at ON entries (user_id, started_at);
+
+-- only one timer may be running for each user
+CREATE UNIQUE INDEX idx_entries_running ON entries (user_id) WHERE ended_at IS NULL;
+`
+)
+
+type migration struct {
+	check  string
+	create string
+}
+
+// Open opens the SQLite database in uri, creating the file if it does not exist. An empty uri (or ":memory:") opens
+// an in-memory database.
+func Open(uri string) (*sql.DB, error) {
+	switch uri {
+	case inMemory:
+	case "":
+		uri = inMemory
+	default:
+		if err := validateURI(uri); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := sql.Open("sqlite", fmt.Sprintf(uriFormat, uri))
+	if err != nil {
+		return nil, err
+	}
+
+	// a single connection serializes writes, and keeps in-memory databases alive
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
+
+// Migrate creates the database tables that do not exist yet.
+func Migrate(ctx context.Context, db *sql.DB) error {
+	for _, m := range []migration{
+		{check: checkUsersTableExists, create: createUsersTable},
+		{check: checkChallengesTableExists, create: createChallengesTable},
+		{check: checkTokensTableExists, create: createTokensTable},
+		{check: checkProjectsTableExists, create: createProjectsTable},
+		{check: checkTasksTableExists, create: createTasksTable},
+		{check: checkEntriesTableExists, create: createEntriesTable},
+	} {
+		var exists bool
+
+		if err := db.QueryRowContext(ctx, m.check).Scan(&exists); err != nil {
+			return err
+		}
+
+		if exists {
+			continue
+		}
+
+		if _, err := db.ExecContext(ctx, m.create); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateURI(uri string) error {
+	stat, err := os.Stat(uri)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			f, err := os.Create(uri)
+			if err != nil {
+				return err
+			}
+
+			return f.Close()
+		}
+
+		return err
+	}
+
+	if stat.IsDir() {
+		return fmt.Errorf("%s is a directory", uri)
+	}
+
+	return nil
+}
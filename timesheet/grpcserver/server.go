@@ -63,3 +63,7 @@ func (s *Server) Shutdown() {
 func (s *Server) RegisterAuthzServer(backend pb.AuthzServer) {
 	pb.RegisterAuthzServer(s.server, backend)
 }
+
+func (s *Server) RegisterTimesheetServer(backend pb.TimesheetServer) {
+	pb.RegisterTimesheetServer(s.server, backend)
+}
@@ -0,0 +1,78 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/zalgonoise/x/timesheet/pb/timesheet/v1"
+)
+
+type Server struct {
+	server http.Server
+	mux    *runtime.ServeMux
+}
+
+const (
+	defaultReadTimeout  = 2500 * time.Millisecond
+	defaultWriteTimeout = 5000 * time.Millisecond
+)
+
+// NewServer creates a gRPC gateway for the Authz and Timesheet services. The "Authorization" header is forwarded to
+// the gRPC server as metadata.
+func NewServer(addr string) (*Server, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(func(ctx context.Context, _ *http.Request) metadata.MD {
+			md := metadata.MD{}
+
+			otelgrpc.Inject(ctx, &md)
+
+			return md
+		}),
+	)
+
+	err := mux.HandlePath(http.MethodGet, "/ready", ready)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		server: http.Server{
+			Handler:      mux,
+			Addr:         addr,
+			ReadTimeout:  defaultReadTimeout,
+			WriteTimeout: defaultWriteTimeout,
+		},
+		mux: mux,
+	}, nil
+}
+
+func (s *Server) RegisterAuthz(ctx context.Context, client pb.AuthzClient) error {
+	return pb.RegisterAuthzHandlerClient(ctx, s.mux, client)
+}
+
+func (s *Server) RegisterTimesheet(ctx context.Context, client pb.TimesheetClient) error {
+	return pb.RegisterTimesheetHandlerClient(ctx, s.mux, client)
+}
+
+func (s *Server) RegisterHTTP(method, path string, handler http.Handler) error {
+	return s.mux.HandlePath(method, path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) ListenAndServe() error {
+	return s.server.ListenAndServe()
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+func ready(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.WriteHeader(http.StatusOK)
+}
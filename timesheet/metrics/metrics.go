@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// Metrics gathers the collectors registered by the timesheet's components (such as the gRPC server's metrics), to
+// expose them alongside the Go runtime and process metrics.
+type Metrics struct {
+	collectors []prometheus.Collector
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+func (m *Metrics) RegisterCollector(collector prometheus.Collector) {
+	m.collectors = append(m.collectors, collector)
+}
+
+func (m *Metrics) Registry() (*prometheus.Registry, error) {
+	reg := prometheus.NewRegistry()
+
+	for _, metric := range append([]prometheus.Collector{
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{
+			ReportErrors: false,
+		}),
+	}, m.collectors...) {
+		if err := reg.Register(metric); err != nil {
+			return nil, err
+		}
+	}
+
+	return reg, nil
+}
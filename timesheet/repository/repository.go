@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
+	ErrTimerRunning  = errors.New("a timer is already running")
+	ErrNoTimer       = errors.New("no timer is running")
+)
+
+type Project struct {
+	ID          int64
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+type Task struct {
+	ID        int64
+	ProjectID int64
+	Name      string
+	CreatedAt time.Time
+}
+
+// Entry is time tracked against a task. Its End is the zero time.Time while its timer is running.
+type Entry struct {
+	ID          int64
+	ProjectID   int64
+	ProjectName string
+	TaskID      int64
+	TaskName    string
+	Start       time.Time
+	End         time.Time
+	Note        string
+}
+
+// Running returns true if the Entry's timer was not stopped yet.
+func (e Entry) Running() bool {
+	return e.End.IsZero()
+}
+
+// Duration returns the time tracked in the Entry, up to now if its timer is still running.
+func (e Entry) Duration(now time.Time) time.Duration {
+	if e.Running() {
+		return now.Sub(e.Start)
+	}
+
+	return e.End.Sub(e.Start)
+}
+
+type Challenge struct {
+	Challenge []byte
+	Expiry    time.Time
+}
+
+func isUniqueConstraint(err error) bool {
+	var sqliteErr *sqlite.Error
+
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
+
+func fromMilli(ms int64) time.Time {
+	return time.UnixMilli(ms).UTC()
+}
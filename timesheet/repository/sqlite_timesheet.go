@@ -0,0 +1,401 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	minAllocProjects = 8
+	minAllocTasks    = 8
+	minAllocEntries  = 32
+
+	queryProjectsCreate = `
+INSERT INTO projects (user_id, name, description, created_at)
+VALUES ((SELECT id FROM users WHERE username = ?), ?, ?, ?)
+`
+
+	queryProjectsList = `
+SELECT p.id, p.name, p.description, p.created_at FROM projects p
+	JOIN users u ON u.id = p.user_id
+WHERE u.username = ?
+ORDER BY p.id
+`
+
+	queryProjectsExists = `
+SELECT EXISTS(SELECT 1 FROM projects p
+	JOIN users u ON u.id = p.user_id
+WHERE u.username = ?
+  AND p.id = ?)
+`
+
+	queryTasksCreate = `
+INSERT INTO tasks (project_id, name, created_at)
+VALUES (?, ?, ?)
+`
+
+	queryTasksList = `
+SELECT id, project_id, name, created_at FROM tasks
+WHERE project_id = ?
+ORDER BY id
+`
+
+	queryTasksExists = `
+SELECT EXISTS(SELECT 1 FROM tasks t
+	JOIN projects p ON p.id = t.project_id
+	JOIN users u ON u.id = p.user_id
+WHERE u.username = ?
+  AND t.id = ?)
+`
+
+	queryEntriesCreate = `
+INSERT INTO entries (user_id, task_id, started_at, ended_at, note)
+VALUES ((SELECT id FROM users WHERE username = ?), ?, ?, ?, ?)
+`
+
+	queryEntriesStop = `
+UPDATE entries SET ended_at = ?
+WHERE user_id = (
+	SELECT id FROM users WHERE username = ?
+)
+  AND ended_at IS NULL
+RETURNING id
+`
+
+	queryEntriesSelect = `
+SELECT e.id, p.id, p.name, t.id, t.name, e.started_at, e.ended_at, e.note FROM entries e
+	JOIN tasks t ON t.id = e.task_id
+	JOIN projects p ON p.id = t.project_id
+	JOIN users u ON u.id = e.user_id
+WHERE u.username = ?
+`
+
+	queryEntriesGet = queryEntriesSelect + `  AND e.id = ?
+`
+
+	queryEntriesGetRunning = queryEntriesSelect + `  AND e.ended_at IS NULL
+`
+
+	queryEntriesList = queryEntriesSelect + `  AND e.started_at >= ?
+  AND e.started_at < ?
+  AND (? = 0 OR p.id = ?)
+ORDER BY e.started_at, e.id
+`
+
+	queryEntriesListOverlapping = queryEntriesSelect + `  AND e.started_at < ?
+  AND (e.ended_at IS NULL OR e.ended_at > ?)
+ORDER BY e.started_at, e.id
+`
+
+	queryEntriesDelete = `
+DELETE FROM entries
+WHERE user_id = (
+	SELECT id FROM users WHERE username = ?
+)
+  AND id = ?
+`
+)
+
+// Timesheet is a SQLite repository for the users' projects, tasks and time entries.
+//
+// All methods are scoped to a user, where projects, tasks and entries owned by other users are reported as not found.
+type Timesheet struct {
+	db *sql.DB
+}
+
+func NewTimesheet(db *sql.DB) *Timesheet {
+	return &Timesheet{db: db}
+}
+
+func (r *Timesheet) CreateProject(
+	ctx context.Context, username, name, description string, createdAt time.Time,
+) (Project, error) {
+	res, err := r.db.ExecContext(ctx, queryProjectsCreate, username, name, description, createdAt.UnixMilli())
+	if err != nil {
+		if isUniqueConstraint(err) {
+			return Project{}, fmt.Errorf("%w: project %s", ErrAlreadyExists, name)
+		}
+
+		return Project{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Project{}, err
+	}
+
+	return Project{
+		ID:          id,
+		Name:        name,
+		Description: description,
+		CreatedAt:   fromMilli(createdAt.UnixMilli()),
+	}, nil
+}
+
+func (r *Timesheet) ListProjects(ctx context.Context, username string) ([]Project, error) {
+	projects := make([]Project, 0, minAllocProjects)
+
+	if err := scan(ctx, r.db, func(rows *sql.Rows) error {
+		var (
+			project   Project
+			createdAt int64
+		)
+
+		if err := rows.Scan(&project.ID, &project.Name, &project.Description, &createdAt); err != nil {
+			return err
+		}
+
+		project.CreatedAt = fromMilli(createdAt)
+		projects = append(projects, project)
+
+		return nil
+	}, queryProjectsList, username); err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// CreateTask adds a task to one of the user's projects, returning ErrNotFound if the project does not exist.
+func (r *Timesheet) CreateTask(
+	ctx context.Context, username string, projectID int64, name string, createdAt time.Time,
+) (Task, error) {
+	if err := r.exists(ctx, queryProjectsExists, username, projectID, "project"); err != nil {
+		return Task{}, err
+	}
+
+	res, err := r.db.ExecContext(ctx, queryTasksCreate, projectID, name, createdAt.UnixMilli())
+	if err != nil {
+		if isUniqueConstraint(err) {
+			return Task{}, fmt.Errorf("%w: task %s", ErrAlreadyExists, name)
+		}
+
+		return Task{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Task{}, err
+	}
+
+	return Task{
+		ID:        id,
+		ProjectID: projectID,
+		Name:      name,
+		CreatedAt: fromMilli(createdAt.UnixMilli()),
+	}, nil
+}
+
+// ListTasks lists the tasks in one of the user's projects, returning ErrNotFound if the project does not exist.
+func (r *Timesheet) ListTasks(ctx context.Context, username string, projectID int64) ([]Task, error) {
+	if err := r.exists(ctx, queryProjectsExists, username, projectID, "project"); err != nil {
+		return nil, err
+	}
+
+	tasks := make([]Task, 0, minAllocTasks)
+
+	if err := scan(ctx, r.db, func(rows *sql.Rows) error {
+		var (
+			task      Task
+			createdAt int64
+		)
+
+		if err := rows.Scan(&task.ID, &task.ProjectID, &task.Name, &createdAt); err != nil {
+			return err
+		}
+
+		task.CreatedAt = fromMilli(createdAt)
+		tasks = append(tasks, task)
+
+		return nil
+	}, queryTasksList, projectID); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// StartTimer creates a running entry for one of the user's tasks, returning ErrTimerRunning if the user already has
+// a running timer.
+func (r *Timesheet) StartTimer(
+	ctx context.Context, username string, taskID int64, note string, start time.Time,
+) (Entry, error) {
+	if err := r.exists(ctx, queryTasksExists, username, taskID, "task"); err != nil {
+		return Entry{}, err
+	}
+
+	res, err := r.db.ExecContext(ctx, queryEntriesCreate, username, taskID, start.UnixMilli(), nil, note)
+	if err != nil {
+		if isUniqueConstraint(err) {
+			return Entry{}, ErrTimerRunning
+		}
+
+		return Entry{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return r.get(ctx, queryEntriesGet, username, id)
+}
+
+// StopTimer ends the user's running entry, returning ErrNoTimer if there is none.
+func (r *Timesheet) StopTimer(ctx context.Context, username string, end time.Time) (Entry, error) {
+	var id int64
+
+	if err := r.db.QueryRowContext(ctx, queryEntriesStop, end.UnixMilli(), username).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Entry{}, ErrNoTimer
+		}
+
+		return Entry{}, err
+	}
+
+	return r.get(ctx, queryEntriesGet, username, id)
+}
+
+// GetTimer returns the user's running entry, or ErrNoTimer if there is none.
+func (r *Timesheet) GetTimer(ctx context.Context, username string) (Entry, error) {
+	entry, err := r.get(ctx, queryEntriesGetRunning, username)
+	if errors.Is(err, ErrNotFound) {
+		return Entry{}, ErrNoTimer
+	}
+
+	return entry, err
+}
+
+// CreateEntry records a finished entry for one of the user's tasks.
+func (r *Timesheet) CreateEntry(
+	ctx context.Context, username string, taskID int64, start, end time.Time, note string,
+) (Entry, error) {
+	if err := r.exists(ctx, queryTasksExists, username, taskID, "task"); err != nil {
+		return Entry{}, err
+	}
+
+	res, err := r.db.ExecContext(ctx, queryEntriesCreate, username, taskID, start.UnixMilli(), end.UnixMilli(), note)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return r.get(ctx, queryEntriesGet, username, id)
+}
+
+// ListEntries lists the user's entries that started in [from, to), for all projects if projectID is zero.
+func (r *Timesheet) ListEntries(
+	ctx context.Context, username string, from, to time.Time, projectID int64,
+) ([]Entry, error) {
+	return r.list(ctx, queryEntriesList, username, from.UnixMilli(), to.UnixMilli(), projectID, projectID)
+}
+
+// ListOverlappingEntries lists the user's entries that were tracked for any time within [from, to), including the
+// running entry, if any.
+func (r *Timesheet) ListOverlappingEntries(ctx context.Context, username string, from, to time.Time) ([]Entry, error) {
+	return r.list(ctx, queryEntriesListOverlapping, username, to.UnixMilli(), from.UnixMilli())
+}
+
+// DeleteEntry removes one of the user's entries, returning ErrNotFound if it does not exist.
+func (r *Timesheet) DeleteEntry(ctx context.Context, username string, id int64) error {
+	res, err := r.db.ExecContext(ctx, queryEntriesDelete, username, id)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%w: entry %d", ErrNotFound, id)
+	}
+
+	return nil
+}
+
+func (r *Timesheet) exists(ctx context.Context, query, username string, id int64, kind string) error {
+	var exists bool
+
+	if err := r.db.QueryRowContext(ctx, query, username, id).Scan(&exists); err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("%w: %s %d", ErrNotFound, kind, id)
+	}
+
+	return nil
+}
+
+func (r *Timesheet) get(ctx context.Context, query string, args ...any) (Entry, error) {
+	entries, err := r.list(ctx, query, args...)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	if len(entries) == 0 {
+		return Entry{}, fmt.Errorf("%w: entry", ErrNotFound)
+	}
+
+	return entries[0], nil
+}
+
+func (r *Timesheet) list(ctx context.Context, query string, args ...any) ([]Entry, error) {
+	entries := make([]Entry, 0, minAllocEntries)
+
+	if err := scan(ctx, r.db, func(rows *sql.Rows) error {
+		var (
+			entry Entry
+			start int64
+			end   sql.NullInt64
+		)
+
+		if err := rows.Scan(
+			&entry.ID, &entry.ProjectID, &entry.ProjectName, &entry.TaskID, &entry.TaskName, &start, &end, &entry.Note,
+		); err != nil {
+			return err
+		}
+
+		entry.Start = fromMilli(start)
+
+		if end.Valid {
+			entry.End = fromMilli(end.Int64)
+		}
+
+		entries = append(entries, entry)
+
+		return nil
+	}, query, args...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func scan(ctx context.Context, db *sql.DB, fn func(rows *sql.Rows) error, query string, args ...any) (err error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errors.Join(err, rows.Close())
+	}()
+
+	for rows.Next() {
+		if err = fn(rows); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,141 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/timesheet/database"
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+const testUser = "gopher"
+
+func newTimesheet(t *testing.T) (*repository.Timesheet, int64) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := database.Open(":memory:")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	require.NoError(t, database.Migrate(ctx, db))
+
+	users := repository.NewUsers(db)
+	require.NoError(t, users.CreateUser(ctx, testUser, []byte("key")))
+	require.NoError(t, users.CreateUser(ctx, "other", []byte("other key")))
+
+	repo := repository.NewTimesheet(db)
+
+	project, err := repo.CreateProject(ctx, testUser, "x", "", time.Now())
+	require.NoError(t, err)
+
+	task, err := repo.CreateTask(ctx, testUser, project.ID, "a", time.Now())
+	require.NoError(t, err)
+
+	return repo, task.ID
+}
+
+func TestTimesheet_Timer(t *testing.T) {
+	ctx := context.Background()
+	repo, taskID := newTimesheet(t)
+
+	start := time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+
+	_, err := repo.GetTimer(ctx, testUser)
+	require.ErrorIs(t, err, repository.ErrNoTimer)
+
+	_, err = repo.StopTimer(ctx, testUser, start)
+	require.ErrorIs(t, err, repository.ErrNoTimer)
+
+	running, err := repo.StartTimer(ctx, testUser, taskID, "note", start)
+	require.NoError(t, err)
+	require.True(t, running.Running())
+	require.Equal(t, start, running.Start)
+	require.Equal(t, "x", running.ProjectName)
+	require.Equal(t, "a", running.TaskName)
+
+	// only one timer runs at a time
+	_, err = repo.StartTimer(ctx, testUser, taskID, "", start.Add(time.Minute))
+	require.ErrorIs(t, err, repository.ErrTimerRunning)
+
+	timer, err := repo.GetTimer(ctx, testUser)
+	require.NoError(t, err)
+	require.Equal(t, running, timer)
+
+	// other users don't see the timer, nor can they use the task
+	_, err = repo.GetTimer(ctx, "other")
+	require.ErrorIs(t, err, repository.ErrNoTimer)
+
+	_, err = repo.StartTimer(ctx, "other", taskID, "", start)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	end := start.Add(90 * time.Minute)
+
+	stopped, err := repo.StopTimer(ctx, testUser, end)
+	require.NoError(t, err)
+	require.Equal(t, running.ID, stopped.ID)
+	require.False(t, stopped.Running())
+	require.Equal(t, end, stopped.End)
+	require.Equal(t, 90*time.Minute, stopped.Duration(time.Now()))
+
+	_, err = repo.StopTimer(ctx, testUser, end)
+	require.ErrorIs(t, err, repository.ErrNoTimer)
+
+	// a new timer can start once the previous one is stopped
+	_, err = repo.StartTimer(ctx, testUser, taskID, "", end)
+	require.NoError(t, err)
+}
+
+func TestTimesheet_ListOverlappingEntries(t *testing.T) {
+	ctx := context.Background()
+	repo, taskID := newTimesheet(t)
+
+	var (
+		from = time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+		to   = from.AddDate(0, 0, 7)
+	)
+
+	create := func(start, end time.Time) int64 {
+		entry, err := repo.CreateEntry(ctx, testUser, taskID, start, end, "")
+		require.NoError(t, err)
+
+		return entry.ID
+	}
+
+	var (
+		before      = create(from.Add(-2*time.Hour), from.Add(-time.Hour))
+		endsAtStart = create(from.Add(-time.Hour), from)
+		acrossStart = create(from.Add(-time.Hour), from.Add(time.Hour))
+		within      = create(from.Add(24*time.Hour), from.Add(25*time.Hour))
+		acrossEnd   = create(to.Add(-time.Hour), to.Add(time.Hour))
+		startsAtEnd = create(to, to.Add(time.Hour))
+	)
+
+	running, err := repo.StartTimer(ctx, testUser, taskID, "", to.Add(-30*time.Minute))
+	require.NoError(t, err)
+
+	entries, err := repo.ListOverlappingEntries(ctx, testUser, from, to)
+	require.NoError(t, err)
+
+	ids := make([]int64, 0, len(entries))
+
+	for i := range entries {
+		ids = append(ids, entries[i].ID)
+	}
+
+	// ordered by start, and bounded as [from, to)
+	require.Equal(t, []int64{acrossStart, within, acrossEnd, running.ID}, ids)
+	require.NotContains(t, ids, before)
+	require.NotContains(t, ids, endsAtStart)
+	require.NotContains(t, ids, startsAtEnd)
+	require.True(t, entries[3].Running())
+
+	entries, err = repo.ListOverlappingEntries(ctx, "other", from, to)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
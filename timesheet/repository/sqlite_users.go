@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	minAllocChallenges = 2
+
+	queryUsersCreate = `
+INSERT INTO users (username, pub_key)
+VALUES (?, ?)
+`
+
+	queryUsersGetPublicKey = `
+SELECT pub_key FROM users WHERE username = ?
+`
+
+	queryChallengesCreate = `
+INSERT INTO challenges (user_id, challenge, expiry)
+VALUES ((SELECT id FROM users WHERE username = ?), ?, ?)
+`
+
+	queryChallengesList = `
+SELECT challenge, expiry FROM challenges
+WHERE user_id = (
+	SELECT id FROM users WHERE username = ?
+)
+  AND expiry > ?
+ORDER BY expiry DESC
+`
+
+	queryChallengesDelete = `
+DELETE FROM challenges WHERE user_id = (
+	SELECT id FROM users WHERE username = ?
+) AND challenge = ?
+`
+
+	queryChallengesCleanup = `
+DELETE FROM challenges WHERE expiry < ?
+`
+
+	queryTokensCreate = `
+INSERT INTO tokens (user_id, token, expiry)
+VALUES ((SELECT id FROM users WHERE username = ?), ?, ?)
+`
+
+	queryTokensGetUser = `
+SELECT u.username FROM tokens t
+	JOIN users u ON u.id = t.user_id
+WHERE t.token = ?
+  AND t.expiry > ?
+`
+
+	queryTokensCleanup = `
+DELETE FROM tokens WHERE expiry < ?
+`
+)
+
+// Users is a SQLite repository for users, their login challenges and their authorization tokens.
+type Users struct {
+	db *sql.DB
+}
+
+func NewUsers(db *sql.DB) *Users {
+	return &Users{db: db}
+}
+
+// CreateUser registers a user with their public key, returning ErrAlreadyExists if the username is taken.
+func (r *Users) CreateUser(ctx context.Context, username string, pubKey []byte) error {
+	if _, err := r.db.ExecContext(ctx, queryUsersCreate, username, pubKey); err != nil {
+		if isUniqueConstraint(err) {
+			return fmt.Errorf("%w: user %s", ErrAlreadyExists, username)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// GetPublicKey returns the public key registered for a user, or ErrNotFound if the user does not exist.
+func (r *Users) GetPublicKey(ctx context.Context, username string) ([]byte, error) {
+	var pubKey []byte
+
+	if err := r.db.QueryRowContext(ctx, queryUsersGetPublicKey, username).Scan(&pubKey); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user %s", ErrNotFound, username)
+		}
+
+		return nil, err
+	}
+
+	return pubKey, nil
+}
+
+func (r *Users) CreateChallenge(ctx context.Context, username string, challenge []byte, expiry time.Time) error {
+	_, err := r.db.ExecContext(ctx, queryChallengesCreate, username, challenge, expiry.UnixMilli())
+
+	return err
+}
+
+// ListChallenges returns the user's challenges that did not expire yet, from the most recent to the oldest.
+func (r *Users) ListChallenges(ctx context.Context, username string, now time.Time) ([]Challenge, error) {
+	challenges := make([]Challenge, 0, minAllocChallenges)
+
+	if err := scan(ctx, r.db, func(rows *sql.Rows) error {
+		var (
+			challenge Challenge
+			expiry    int64
+		)
+
+		if err := rows.Scan(&challenge.Challenge, &expiry); err != nil {
+			return err
+		}
+
+		challenge.Expiry = fromMilli(expiry)
+		challenges = append(challenges, challenge)
+
+		return nil
+	}, queryChallengesList, username, now.UnixMilli()); err != nil {
+		return nil, err
+	}
+
+	return challenges, nil
+}
+
+func (r *Users) DeleteChallenge(ctx context.Context, username string, challenge []byte) error {
+	_, err := r.db.ExecContext(ctx, queryChallengesDelete, username, challenge)
+
+	return err
+}
+
+func (r *Users) CreateToken(ctx context.Context, username, token string, expiry time.Time) error {
+	_, err := r.db.ExecContext(ctx, queryTokensCreate, username, token, expiry.UnixMilli())
+
+	return err
+}
+
+// GetTokenUser returns the username a token was issued to, or ErrNotFound if the token does not exist or expired.
+func (r *Users) GetTokenUser(ctx context.Context, token string, now time.Time) (string, error) {
+	var username string
+
+	if err := r.db.QueryRowContext(ctx, queryTokensGetUser, token, now.UnixMilli()).Scan(&username); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%w: token", ErrNotFound)
+		}
+
+		return "", err
+	}
+
+	return username, nil
+}
+
+// Cleanup removes the challenges and tokens that expired before now.
+func (r *Users) Cleanup(ctx context.Context, now time.Time) error {
+	for _, query := range []string{queryChallengesCleanup, queryTokensCleanup} {
+		if _, err := r.db.ExecContext(ctx, query, now.UnixMilli()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
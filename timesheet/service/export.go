@@ -0,0 +1,86 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+
+	pb "github.com/zalgonoise/x/timesheet/pb/timesheet/v1"
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+const contentTypeCSV = "text/csv"
+
+var csvHeader = []string{"id", "project", "task", "start", "end", "duration_seconds", "note"}
+
+// ExportEntries writes the user's entries that started within the requested range as CSV, with timestamps in RFC 3339
+// format (UTC). Running entries have an empty end time, and their duration counted up to the current time.
+func (s *Service) ExportEntries(ctx context.Context, req *pb.ExportEntriesRequest) (*httpbody.HttpBody, error) {
+	ctx, span, username, err := s.start(ctx, "Service.ExportEntries", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := req.From.AsTime(), req.To.AsTime()
+
+	if !to.After(from) {
+		return nil, s.fail(ctx, span, "invalid request", ErrInvalidRange)
+	}
+
+	entries, err := s.repo.ListEntries(ctx, username, from, to, req.ProjectId)
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to list entries", err)
+	}
+
+	data, err := writeCSV(entries, time.Now())
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to write CSV", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &httpbody.HttpBody{
+		ContentType: contentTypeCSV,
+		Data:        data,
+	}, nil
+}
+
+func writeCSV(entries []repository.Entry, now time.Time) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		var end string
+
+		if !entries[i].Running() {
+			end = entries[i].End.Format(time.RFC3339)
+		}
+
+		if err := w.Write([]string{
+			strconv.FormatInt(entries[i].ID, 10),
+			entries[i].ProjectName,
+			entries[i].TaskName,
+			entries[i].Start.Format(time.RFC3339),
+			end,
+			strconv.FormatInt(int64(entries[i].Duration(now).Seconds()), 10),
+			entries[i].Note,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
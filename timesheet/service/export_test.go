@@ -0,0 +1,65 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+func TestWriteCSV(t *testing.T) {
+	now := time.Date(2025, 12, 15, 12, 0, 0, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name    string
+		entries []repository.Entry
+		wants   [][]string
+	}{
+		{
+			name:  "Empty",
+			wants: [][]string{csvHeader},
+		},
+		{
+			name: "Finished",
+			entries: []repository.Entry{{
+				ID:          1,
+				ProjectName: "x",
+				TaskName:    "a",
+				Start:       time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC),
+				End:         time.Date(2025, 12, 15, 10, 30, 0, 0, time.UTC),
+				Note:        "review, \"quoted\"",
+			}},
+			wants: [][]string{
+				csvHeader,
+				{"1", "x", "a", "2025-12-15T09:00:00Z", "2025-12-15T10:30:00Z", "5400", "review, \"quoted\""},
+			},
+		},
+		{
+			name: "Running",
+			entries: []repository.Entry{{
+				ID:          2,
+				ProjectName: "x",
+				TaskName:    "a",
+				Start:       time.Date(2025, 12, 15, 11, 0, 0, 0, time.UTC),
+			}},
+			// running entries have an empty end, and are counted up to now
+			wants: [][]string{
+				csvHeader,
+				{"2", "x", "a", "2025-12-15T11:00:00Z", "", "3600", ""},
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			data, err := writeCSV(testcase.entries, now)
+			require.NoError(t, err)
+
+			records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+			require.NoError(t, err)
+			require.Equal(t, testcase.wants, records)
+		})
+	}
+}
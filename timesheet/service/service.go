@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zalgonoise/x/timesheet/authz"
+	pb "github.com/zalgonoise/x/timesheet/pb/timesheet/v1"
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+var (
+	ErrUnauthenticated = errors.New("no authenticated user in the request")
+	ErrInvalidRange    = errors.New("the end time must be after the start time")
+)
+
+type Repository interface {
+	CreateProject(ctx context.Context, username, name, description string, createdAt time.Time) (repository.Project, error)
+	ListProjects(ctx context.Context, username string) ([]repository.Project, error)
+	CreateTask(
+		ctx context.Context, username string, projectID int64, name string, createdAt time.Time,
+	) (repository.Task, error)
+	ListTasks(ctx context.Context, username string, projectID int64) ([]repository.Task, error)
+	StartTimer(ctx context.Context, username string, taskID int64, note string, start time.Time) (repository.Entry, error)
+	StopTimer(ctx context.Context, username string, end time.Time) (repository.Entry, error)
+	GetTimer(ctx context.Context, username string) (repository.Entry, error)
+	CreateEntry(
+		ctx context.Context, username string, taskID int64, start, end time.Time, note string,
+	) (repository.Entry, error)
+	ListEntries(ctx context.Context, username string, from, to time.Time, projectID int64) ([]repository.Entry, error)
+	ListOverlappingEntries(ctx context.Context, username string, from, to time.Time) ([]repository.Entry, error)
+	DeleteEntry(ctx context.Context, username string, id int64) error
+}
+
+// Service implements the Timesheet gRPC service, on behalf of the user authenticated by the authz interceptors.
+type Service struct {
+	pb.UnimplementedTimesheetServer
+
+	repo Repository
+
+	logger *slog.Logger
+	tracer trace.Tracer
+}
+
+func New(repo Repository, logger *slog.Logger, tracer trace.Tracer) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+		tracer: tracer,
+	}
+}
+
+func (s *Service) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.CreateProject", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.repo.CreateProject(ctx, username, req.Name, req.Description, time.Now())
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to create project", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.CreateProjectResponse{Project: projectToPB(project)}, nil
+}
+
+func (s *Service) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.ListProjects", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.repo.ListProjects(ctx, username)
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to list projects", err)
+	}
+
+	res := &pb.ListProjectsResponse{Projects: make([]*pb.Project, 0, len(projects))}
+
+	for i := range projects {
+		res.Projects = append(res.Projects, projectToPB(projects[i]))
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return res, nil
+}
+
+func (s *Service) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.CreateTask", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.repo.CreateTask(ctx, username, req.ProjectId, req.Name, time.Now())
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to create task", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.CreateTaskResponse{Task: taskToPB(task)}, nil
+}
+
+func (s *Service) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.ListTasks", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.repo.ListTasks(ctx, username, req.ProjectId)
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to list tasks", err)
+	}
+
+	res := &pb.ListTasksResponse{Tasks: make([]*pb.Task, 0, len(tasks))}
+
+	for i := range tasks {
+		res.Tasks = append(res.Tasks, taskToPB(tasks[i]))
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return res, nil
+}
+
+func (s *Service) StartTimer(ctx context.Context, req *pb.StartTimerRequest) (*pb.StartTimerResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.StartTimer", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	entry, err := s.repo.StartTimer(ctx, username, req.TaskId, req.Note, now)
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to start timer", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.StartTimerResponse{Entry: entryToPB(entry, now)}, nil
+}
+
+func (s *Service) StopTimer(ctx context.Context, req *pb.StopTimerRequest) (*pb.StopTimerResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.StopTimer", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	entry, err := s.repo.StopTimer(ctx, username, now)
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to stop timer", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.StopTimerResponse{Entry: entryToPB(entry, now)}, nil
+}
+
+// GetTimer returns the user's running entry, or an empty response if there is none.
+func (s *Service) GetTimer(ctx context.Context, req *pb.GetTimerRequest) (*pb.GetTimerResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.GetTimer", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.repo.GetTimer(ctx, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoTimer) {
+			span.SetStatus(otelcodes.Ok, "")
+
+			return &pb.GetTimerResponse{}, nil
+		}
+
+		return nil, s.fail(ctx, span, "failed to get timer", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.GetTimerResponse{Entry: entryToPB(entry, time.Now())}, nil
+}
+
+func (s *Service) CreateEntry(ctx context.Context, req *pb.CreateEntryRequest) (*pb.CreateEntryResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.CreateEntry", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := req.Start.AsTime(), req.End.AsTime()
+
+	if !end.After(start) {
+		return nil, s.fail(ctx, span, "invalid request", ErrInvalidRange)
+	}
+
+	entry, err := s.repo.CreateEntry(ctx, username, req.TaskId, start, end, req.Note)
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to create entry", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &pb.CreateEntryResponse{Entry: entryToPB(entry, time.Now())}, nil
+}
+
+func (s *Service) ListEntries(ctx context.Context, req *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.ListEntries", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := req.From.AsTime(), req.To.AsTime()
+
+	if !to.After(from) {
+		return nil, s.fail(ctx, span, "invalid request", ErrInvalidRange)
+	}
+
+	entries, err := s.repo.ListEntries(ctx, username, from, to, req.ProjectId)
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to list entries", err)
+	}
+
+	now := time.Now()
+	res := &pb.ListEntriesResponse{Entries: make([]*pb.Entry, 0, len(entries))}
+
+	for i := range entries {
+		res.Entries = append(res.Entries, entryToPB(entries[i], now))
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return res, nil
+}
+
+func (s *Service) DeleteEntry(ctx context.Context, req *pb.DeleteEntryRequest) (*emptypb.Empty, error) {
+	ctx, span, username, err := s.start(ctx, "Service.DeleteEntry", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.DeleteEntry(ctx, username, req.Id); err != nil {
+		return nil, s.fail(ctx, span, "failed to delete entry", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return &emptypb.Empty{}, nil
+}
+
+type validator interface {
+	ValidateAll() error
+}
+
+// start opens a span for the call, returning the authenticated user once the request is validated. The returned span
+// is always valid, and any returned error is already a gRPC status error.
+func (s *Service) start(
+	ctx context.Context, name string, req validator,
+) (context.Context, trace.Span, string, error) {
+	ctx, span := s.tracer.Start(ctx, name)
+
+	username, ok := authz.User(ctx)
+	if !ok {
+		return ctx, span, "", s.fail(ctx, span, "unauthenticated request", ErrUnauthenticated)
+	}
+
+	span.SetAttributes(attribute.String("username", username))
+
+	if err := req.ValidateAll(); err != nil {
+		return ctx, span, "", s.fail(ctx, span, "invalid request", err)
+	}
+
+	return ctx, span, username, nil
+}
+
+func (s *Service) fail(ctx context.Context, span trace.Span, msg string, err error) error {
+	span.SetStatus(otelcodes.Error, err.Error())
+	span.RecordError(err)
+
+	code := toCode(err)
+
+	if code == codes.Internal {
+		s.logger.ErrorContext(ctx, msg, slog.String("error", err.Error()))
+	} else {
+		s.logger.WarnContext(ctx, msg, slog.String("error", err.Error()))
+	}
+
+	return status.Error(code, err.Error())
+}
+
+func toCode(err error) codes.Code {
+	var validationErr interface{ AllErrors() []error }
+
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, ErrInvalidRange), errors.Is(err, ErrInvalidTimeZone):
+		return codes.InvalidArgument
+	case errors.Is(err, ErrUnauthenticated):
+		return codes.Unauthenticated
+	case errors.Is(err, repository.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(err, repository.ErrTimerRunning), errors.Is(err, repository.ErrNoTimer):
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+func projectToPB(project repository.Project) *pb.Project {
+	return &pb.Project{
+		Id:          project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		CreatedAt:   timestamppb.New(project.CreatedAt),
+	}
+}
+
+func taskToPB(task repository.Task) *pb.Task {
+	return &pb.Task{
+		Id:        task.ID,
+		ProjectId: task.ProjectID,
+		Name:      task.Name,
+		CreatedAt: timestamppb.New(task.CreatedAt),
+	}
+}
+
+func entryToPB(entry repository.Entry, now time.Time) *pb.Entry {
+	e := &pb.Entry{
+		Id:        entry.ID,
+		ProjectId: entry.ProjectID,
+		TaskId:    entry.TaskID,
+		Start:     timestamppb.New(entry.Start),
+		Duration:  durationpb.New(entry.Duration(now)),
+		Note:      entry.Note,
+	}
+
+	if !entry.Running() {
+		e.End = timestamppb.New(entry.End)
+	}
+
+	return e
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zalgonoise/x/timesheet/pb/timesheet/v1"
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+const daysInWeek = 7
+
+var ErrInvalidTimeZone = errors.New("invalid time zone")
+
+type taskSummary struct {
+	projectID   int64
+	projectName string
+	taskID      int64
+	taskName    string
+	days        [daysInWeek]time.Duration
+	total       time.Duration
+}
+
+type weeklySummary struct {
+	start time.Time
+	end   time.Time
+	tasks []*taskSummary
+	days  [daysInWeek]time.Duration
+	total time.Duration
+}
+
+// GetWeeklySummary sums the time tracked in the week containing req.WeekOf, per task and per day. Weeks start on
+// Monday at midnight in the requested time zone; entries spanning several days are split at midnight, and the running
+// entry is counted up to the current time.
+func (s *Service) GetWeeklySummary(
+	ctx context.Context, req *pb.GetWeeklySummaryRequest,
+) (*pb.GetWeeklySummaryResponse, error) {
+	ctx, span, username, err := s.start(ctx, "Service.GetWeeklySummary", req)
+	defer span.End()
+
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.UTC
+
+	if req.TimeZone != "" {
+		if loc, err = time.LoadLocation(req.TimeZone); err != nil {
+			return nil, s.fail(ctx, span, "invalid request", fmt.Errorf("%w: %s", ErrInvalidTimeZone, req.TimeZone))
+		}
+	}
+
+	now := time.Now()
+	weekOf := now
+
+	if req.WeekOf != nil {
+		weekOf = req.WeekOf.AsTime()
+	}
+
+	bounds := weekBounds(weekOf, loc)
+
+	span.SetAttributes(
+		attribute.String("time_zone", loc.String()),
+		attribute.String("week_start", bounds[0].Format(time.RFC3339)),
+	)
+
+	entries, err := s.repo.ListOverlappingEntries(ctx, username, bounds[0], bounds[daysInWeek])
+	if err != nil {
+		return nil, s.fail(ctx, span, "failed to list entries", err)
+	}
+
+	span.SetStatus(otelcodes.Ok, "")
+
+	return summaryToPB(summarize(entries, bounds, now)), nil
+}
+
+// weekBounds returns the start of each day in the week (Monday to Sunday) containing t, followed by the start of the
+// next week. Days are added in calendar terms so that they remain aligned to midnight across DST changes.
+func weekBounds(t time.Time, loc *time.Location) [daysInWeek + 1]time.Time {
+	t = t.In(loc)
+	year, month, day := t.Date()
+	// time.Weekday starts on Sunday
+	offset := (int(t.Weekday()) + daysInWeek - 1) % daysInWeek
+	monday := time.Date(year, month, day-offset, 0, 0, 0, 0, loc)
+
+	var bounds [daysInWeek + 1]time.Time
+
+	for i := range bounds {
+		bounds[i] = monday.AddDate(0, 0, i)
+	}
+
+	return bounds
+}
+
+func summarize(entries []repository.Entry, bounds [daysInWeek + 1]time.Time, now time.Time) weeklySummary {
+	summary := weeklySummary{
+		start: bounds[0],
+		end:   bounds[daysInWeek],
+	}
+
+	index := make(map[int64]*taskSummary)
+
+	for i := range entries {
+		start, end := entries[i].Start, entries[i].End
+		if entries[i].Running() {
+			end = now
+		}
+
+		task, ok := index[entries[i].TaskID]
+		if !ok {
+			task = &taskSummary{
+				projectID:   entries[i].ProjectID,
+				projectName: entries[i].ProjectName,
+				taskID:      entries[i].TaskID,
+				taskName:    entries[i].TaskName,
+			}
+
+			index[entries[i].TaskID] = task
+			summary.tasks = append(summary.tasks, task)
+		}
+
+		for day := 0; day < daysInWeek; day++ {
+			overlap := minTime(end, bounds[day+1]).Sub(maxTime(start, bounds[day]))
+			if overlap <= 0 {
+				continue
+			}
+
+			task.days[day] += overlap
+			task.total += overlap
+			summary.days[day] += overlap
+			summary.total += overlap
+		}
+	}
+
+	slices.SortFunc(summary.tasks, func(a, b *taskSummary) int {
+		if n := strings.Compare(a.projectName, b.projectName); n != 0 {
+			return n
+		}
+
+		return strings.Compare(a.taskName, b.taskName)
+	})
+
+	return summary
+}
+
+func summaryToPB(summary weeklySummary) *pb.GetWeeklySummaryResponse {
+	res := &pb.GetWeeklySummaryResponse{
+		WeekStart: timestamppb.New(summary.start),
+		WeekEnd:   timestamppb.New(summary.end),
+		Tasks:     make([]*pb.TaskSummary, 0, len(summary.tasks)),
+		Days:      durationsToPB(summary.days),
+		Total:     durationpb.New(summary.total),
+	}
+
+	for _, task := range summary.tasks {
+		res.Tasks = append(res.Tasks, &pb.TaskSummary{
+			ProjectId:   task.projectID,
+			ProjectName: task.projectName,
+			TaskId:      task.taskID,
+			TaskName:    task.taskName,
+			Days:        durationsToPB(task.days),
+			Total:       durationpb.New(task.total),
+		})
+	}
+
+	return res
+}
+
+func durationsToPB(days [daysInWeek]time.Duration) []*durationpb.Duration {
+	durations := make([]*durationpb.Duration, 0, daysInWeek)
+
+	for i := range days {
+		durations = append(durations, durationpb.New(days[i]))
+	}
+
+	return durations
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
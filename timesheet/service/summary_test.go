@@ -0,0 +1,223 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zalgonoise/x/timesheet/repository"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+
+	return loc
+}
+
+func TestWeekBounds(t *testing.T) {
+	for _, testcase := range []struct {
+		name     string
+		t        time.Time
+		location string
+		monday   time.Time
+		// dayHours is the length of each day in the week, in hours
+		dayHours [daysInWeek]float64
+	}{
+		{
+			name:     "MidWeek",
+			t:        time.Date(2025, 12, 17, 15, 0, 0, 0, time.UTC),
+			location: "UTC",
+			monday:   time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+			dayHours: [daysInWeek]float64{24, 24, 24, 24, 24, 24, 24},
+		},
+		{
+			name:     "Sunday",
+			t:        time.Date(2025, 12, 21, 23, 59, 0, 0, time.UTC),
+			location: "UTC",
+			monday:   time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+			dayHours: [daysInWeek]float64{24, 24, 24, 24, 24, 24, 24},
+		},
+		{
+			name:     "MondayMidnight",
+			t:        time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+			location: "UTC",
+			monday:   time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+			dayHours: [daysInWeek]float64{24, 24, 24, 24, 24, 24, 24},
+		},
+		{
+			// Monday 02:00 UTC is still Sunday in New York
+			name:     "TimeZone",
+			t:        time.Date(2025, 12, 15, 2, 0, 0, 0, time.UTC),
+			location: "America/New_York",
+			monday:   time.Date(2025, 12, 8, 5, 0, 0, 0, time.UTC),
+			dayHours: [daysInWeek]float64{24, 24, 24, 24, 24, 24, 24},
+		},
+		{
+			name:     "DST/SpringForward",
+			t:        time.Date(2025, 3, 27, 12, 0, 0, 0, time.UTC),
+			location: "Europe/Lisbon",
+			monday:   time.Date(2025, 3, 24, 0, 0, 0, 0, time.UTC),
+			dayHours: [daysInWeek]float64{24, 24, 24, 24, 24, 24, 23},
+		},
+		{
+			name:     "DST/FallBack",
+			t:        time.Date(2025, 10, 26, 12, 0, 0, 0, time.UTC),
+			location: "Europe/Lisbon",
+			monday:   time.Date(2025, 10, 19, 23, 0, 0, 0, time.UTC),
+			dayHours: [daysInWeek]float64{24, 24, 24, 24, 24, 24, 25},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			loc := mustLoadLocation(t, testcase.location)
+			bounds := weekBounds(testcase.t, loc)
+
+			require.True(t, testcase.monday.Equal(bounds[0]), "got %s", bounds[0])
+			require.Equal(t, time.Monday, bounds[0].Weekday())
+			require.False(t, testcase.t.Before(bounds[0]))
+			require.True(t, testcase.t.Before(bounds[daysInWeek]))
+
+			for day := 0; day < daysInWeek; day++ {
+				// every bound stays at midnight, local time
+				hour, minute, _ := bounds[day+1].Clock()
+				require.Zero(t, hour)
+				require.Zero(t, minute)
+
+				require.Equal(t, testcase.dayHours[day], bounds[day+1].Sub(bounds[day]).Hours(), "day %d", day)
+			}
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	var (
+		lisbon = mustLoadLocation(t, "Europe/Lisbon")
+		week   = weekBounds(time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+		now    = time.Date(2025, 12, 19, 12, 30, 0, 0, time.UTC)
+	)
+
+	entry := func(taskID int64, project, task string, start, end time.Time) repository.Entry {
+		return repository.Entry{
+			ProjectID:   taskID * 10,
+			ProjectName: project,
+			TaskID:      taskID,
+			TaskName:    task,
+			Start:       start,
+			End:         end,
+		}
+	}
+
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 12, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	for _, testcase := range []struct {
+		name    string
+		bounds  [daysInWeek + 1]time.Time
+		entries []repository.Entry
+		days    [daysInWeek]time.Duration
+		tasks   map[string]time.Duration
+	}{
+		{
+			name:   "SingleDay",
+			bounds: week,
+			entries: []repository.Entry{
+				entry(1, "x", "a", at(15, 9, 0), at(15, 10, 30)),
+			},
+			days:  [daysInWeek]time.Duration{90 * time.Minute},
+			tasks: map[string]time.Duration{"a": 90 * time.Minute},
+		},
+		{
+			name:   "SplitAtMidnight",
+			bounds: week,
+			entries: []repository.Entry{
+				entry(1, "x", "a", at(16, 22, 0), at(17, 2, 0)),
+			},
+			days:  [daysInWeek]time.Duration{0, 2 * time.Hour, 2 * time.Hour},
+			tasks: map[string]time.Duration{"a": 4 * time.Hour},
+		},
+		{
+			name:   "ClippedToWeek",
+			bounds: week,
+			entries: []repository.Entry{
+				entry(1, "x", "a", at(14, 23, 0), at(15, 1, 0)),
+				entry(1, "x", "a", at(21, 23, 0), at(22, 1, 0)),
+			},
+			days:  [daysInWeek]time.Duration{time.Hour, 0, 0, 0, 0, 0, time.Hour},
+			tasks: map[string]time.Duration{"a": 2 * time.Hour},
+		},
+		{
+			name:   "RunningEntry",
+			bounds: week,
+			entries: []repository.Entry{
+				entry(1, "x", "a", at(18, 23, 0), time.Time{}),
+			},
+			// counted up to now, on Friday
+			days:  [daysInWeek]time.Duration{0, 0, 0, time.Hour, 12*time.Hour + 30*time.Minute},
+			tasks: map[string]time.Duration{"a": 13*time.Hour + 30*time.Minute},
+		},
+		{
+			name:   "GroupedByTask",
+			bounds: week,
+			entries: []repository.Entry{
+				entry(1, "x", "a", at(15, 9, 0), at(15, 10, 0)),
+				entry(2, "y", "b", at(15, 10, 0), at(15, 11, 0)),
+				entry(1, "x", "a", at(16, 9, 0), at(16, 9, 30)),
+			},
+			days:  [daysInWeek]time.Duration{2 * time.Hour, 30 * time.Minute},
+			tasks: map[string]time.Duration{"a": 90 * time.Minute, "b": time.Hour},
+		},
+		{
+			// clocks skip from 01:00 to 02:00 on Sunday, so 00:00 to 04:00 lasts 3 hours
+			name:   "DST",
+			bounds: weekBounds(time.Date(2025, 3, 27, 0, 0, 0, 0, time.UTC), lisbon),
+			entries: []repository.Entry{
+				entry(1, "x", "a",
+					time.Date(2025, 3, 29, 22, 0, 0, 0, lisbon),
+					time.Date(2025, 3, 30, 4, 0, 0, 0, lisbon)),
+			},
+			days:  [daysInWeek]time.Duration{0, 0, 0, 0, 0, 2 * time.Hour, 3 * time.Hour},
+			tasks: map[string]time.Duration{"a": 5 * time.Hour},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			summary := summarize(testcase.entries, testcase.bounds, now)
+
+			require.Equal(t, testcase.bounds[0], summary.start)
+			require.Equal(t, testcase.bounds[daysInWeek], summary.end)
+			require.Equal(t, testcase.days, summary.days)
+
+			var total time.Duration
+
+			for day := range summary.days {
+				total += summary.days[day]
+			}
+
+			require.Equal(t, total, summary.total)
+
+			tasks := make(map[string]time.Duration, len(summary.tasks))
+
+			for _, task := range summary.tasks {
+				tasks[task.taskName] = task.total
+			}
+
+			require.Equal(t, testcase.tasks, tasks)
+		})
+	}
+
+	t.Run("SortedByProjectAndTask", func(t *testing.T) {
+		summary := summarize([]repository.Entry{
+			entry(3, "y", "a", at(15, 9, 0), at(15, 10, 0)),
+			entry(2, "x", "b", at(15, 9, 0), at(15, 10, 0)),
+			entry(1, "x", "a", at(15, 9, 0), at(15, 10, 0)),
+		}, week, now)
+
+		require.Len(t, summary.tasks, 3)
+		require.Equal(t, []int64{1, 2, 3}, []int64{
+			summary.tasks[0].taskID, summary.tasks[1].taskID, summary.tasks[2].taskID,
+		})
+	})
+}